@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
+)
+
+type replicateCmdOptions struct {
+	options
+
+	backupName   string
+	caBundleFile string
+}
+
+func ReplicateSnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
+	opt := replicateCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:          "replicate-snapshot",
+		Short:        "Copy an etcd backup from its backup destination to a replication destination",
+		RunE:         ReplicateSnapshotFunc(log, &opt),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.CopyInto(&opt.options)
+
+			if opt.cluster == "" {
+				return errors.New("--cluster is required")
+			}
+
+			if opt.backupName == "" {
+				return errors.New("--backup-name is required")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		if err := c.Usage(); err != nil {
+			return err
+		}
+
+		// ensure we exit with code 1 later on
+		return err
+	})
+
+	cmd.PersistentFlags().StringVar(&opt.backupName, "backup-name", "", "name of the backup to replicate")
+	cmd.PersistentFlags().StringVar(&opt.caBundleFile, "ca-bundle", "/etc/ca-bundle/ca-bundle.pem", "CA bundle to use when connecting to the backup destinations")
+
+	return cmd
+}
+
+func ReplicateSnapshotFunc(log *zap.SugaredLogger, opt *replicateCmdOptions) cobraFuncE {
+	return handleErrors(log, func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		log := log.With("cluster", opt.cluster, "backup", opt.backupName)
+
+		source, sourceBucket, err := newS3ClientFromEnv("", opt.caBundleFile)
+		if err != nil {
+			return fmt.Errorf("failed to create S3 client for the backup destination: %w", err)
+		}
+
+		target, targetBucket, err := newS3ClientFromEnv(etcdbackup.ReplicationEnvVarPrefix, opt.caBundleFile)
+		if err != nil {
+			return fmt.Errorf("failed to create S3 client for the replication destination: %w", err)
+		}
+
+		objectName := fmt.Sprintf("%s-%s", opt.cluster, opt.backupName)
+
+		object, err := source.GetObject(ctx, sourceBucket, objectName, minio.GetObjectOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to download backup (%s/%s): %w", sourceBucket, objectName, err)
+		}
+		defer object.Close()
+
+		info, err := object.Stat()
+		if err != nil {
+			return fmt.Errorf("failed to download backup (%s/%s): %w", sourceBucket, objectName, err)
+		}
+
+		uploaded, err := target.PutObject(ctx, targetBucket, objectName, object, info.Size, minio.PutObjectOptions{
+			ContentType: info.ContentType,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upload backup (%s/%s): %w", targetBucket, objectName, err)
+		}
+
+		log.Infow("replicated backup", "bucket", targetBucket, "size", uploaded.Size)
+
+		return nil
+	})
+}
@@ -30,7 +30,8 @@ import (
 type snapshotCmdOptions struct {
 	options
 
-	snapshotOptions etcd.SnapshotOptions
+	encryptionKeyFile string
+	snapshotOptions   etcd.SnapshotOptions
 }
 
 func SnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
@@ -48,6 +49,15 @@ func SnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
 				return fmt.Errorf("invalid --compression algorithm, must be one of %v", etcd.ValidCompressions)
 			}
 
+			if opt.encryptionKeyFile != "" {
+				key, err := etcd.ReadEncryptionKey(opt.encryptionKeyFile)
+				if err != nil {
+					return fmt.Errorf("invalid --encryption-key-file: %w", err)
+				}
+
+				opt.snapshotOptions.EncryptionKey = key
+			}
+
 			return nil
 		},
 	}
@@ -63,6 +73,7 @@ func SnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
 
 	cmd.PersistentFlags().StringVar(&opt.snapshotOptions.Compression, "compress", "", fmt.Sprintf("compression to use (one of: %v)", etcd.ValidCompressions))
 	cmd.PersistentFlags().StringVar(&opt.snapshotOptions.File, "file", "/backup/snapshot.db", "file to save database snapshot to")
+	cmd.PersistentFlags().StringVar(&opt.encryptionKeyFile, "encryption-key-file", "", "file containing a 32 byte AES key (raw or base64-encoded) to encrypt the snapshot with")
 
 	return cmd
 }
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/v2/cmd/etcd-launcher/pkg/etcd"
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
+	"k8c.io/kubermatic/v2/pkg/util/s3"
+)
+
+type verifyCmdOptions struct {
+	options
+
+	backupName        string
+	workDir           string
+	caBundleFile      string
+	encryptionKeyFile string
+}
+
+func VerifySnapshotCommand(log *zap.SugaredLogger) *cobra.Command {
+	opt := verifyCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:          "verify-snapshot",
+		Short:        "Download an etcd backup and verify that it can be restored",
+		RunE:         VerifySnapshotFunc(log, &opt),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.CopyInto(&opt.options)
+
+			if opt.cluster == "" {
+				return errors.New("--cluster is required")
+			}
+
+			if opt.backupName == "" {
+				return errors.New("--backup-name is required")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		if err := c.Usage(); err != nil {
+			return err
+		}
+
+		// ensure we exit with code 1 later on
+		return err
+	})
+
+	cmd.PersistentFlags().StringVar(&opt.backupName, "backup-name", "", "name of the backup to verify")
+	cmd.PersistentFlags().StringVar(&opt.workDir, "work-dir", "/backup", "directory to download and restore the backup in")
+	cmd.PersistentFlags().StringVar(&opt.caBundleFile, "ca-bundle", "/etc/ca-bundle/ca-bundle.pem", "CA bundle to use when connecting to the backup destination")
+	cmd.PersistentFlags().StringVar(&opt.encryptionKeyFile, "encryption-key-file", "", "file containing the 32 byte AES key (raw or base64-encoded) the backup was encrypted with")
+
+	return cmd
+}
+
+func VerifySnapshotFunc(log *zap.SugaredLogger, opt *verifyCmdOptions) cobraFuncE {
+	return handleErrors(log, func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		log := log.With("cluster", opt.cluster, "backup", opt.backupName)
+
+		var encryptionKey []byte
+		if opt.encryptionKeyFile != "" {
+			key, err := etcd.ReadEncryptionKey(opt.encryptionKeyFile)
+			if err != nil {
+				return fmt.Errorf("invalid --encryption-key-file: %w", err)
+			}
+
+			encryptionKey = key
+		}
+
+		s3Client, bucketName, err := newS3ClientFromEnv("", opt.caBundleFile)
+		if err != nil {
+			return fmt.Errorf("failed to create S3 client: %w", err)
+		}
+
+		objectName := fmt.Sprintf("%s-%s", opt.cluster, opt.backupName)
+		downloadedSnapshotFile := filepath.Join(opt.workDir, objectName)
+
+		if err := s3Client.FGetObject(ctx, bucketName, objectName, downloadedSnapshotFile, minio.GetObjectOptions{}); err != nil {
+			return fmt.Errorf("failed to download backup (%s/%s): %w", bucketName, objectName, err)
+		}
+
+		rawBackupFile, err := etcd.DecompressSnapshot(downloadedSnapshotFile, encryptionKey)
+		if err != nil {
+			return fmt.Errorf("failed to decompress backup: %w", err)
+		}
+
+		result, err := etcd.VerifySnapshotRestore(ctx, log, rawBackupFile, opt.workDir)
+		if err != nil {
+			return fmt.Errorf("failed to verify backup: %w", err)
+		}
+
+		log.Infow("verified backup", "revision", result.Revision, "keys", result.Keys)
+
+		return nil
+	})
+}
+
+// newS3ClientFromEnv creates an S3 client for the backup destination described by the
+// environment variables of backup jobs, optionally prefixed with envPrefix. The
+// destination's bucket name is returned as well.
+func newS3ClientFromEnv(envPrefix string, caBundleFile string) (*minio.Client, string, error) {
+	caBundle, err := os.ReadFile(caBundleFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+
+	client, err := s3.NewClient(
+		os.Getenv(envPrefix+etcdbackup.BackupEndpointEnvVarKey),
+		os.Getenv(envPrefix+etcdbackup.AccessKeyIDEnvVarKey),
+		os.Getenv(envPrefix+etcdbackup.SecretAccessKeyEnvVarKey),
+		string(caBundle),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return client, os.Getenv(envPrefix + etcdbackup.BucketNameEnvVarKey), nil
+}
@@ -104,6 +104,8 @@ func addCommands(cmd *cobra.Command, logger *zap.SugaredLogger) {
 		IsRunningCommand(logger),
 		DefragCommand(logger),
 		SnapshotCommand(logger),
+		VerifySnapshotCommand(logger),
+		ReplicateSnapshotCommand(logger),
 	)
 }
 
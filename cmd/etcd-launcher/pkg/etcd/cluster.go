@@ -659,11 +659,24 @@ func (e *Cluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, log *zap
 		return fmt.Errorf("failed to download backup (%s/%s): %w", bucketName, objectName, err)
 	}
 
-	rawBackupFile, err := DecompressSnapshot(downloadedSnapshotFile)
+	encryptionKey, err := GetRestoreEncryptionKey(ctx, seedClient, activeRestore, cluster)
+	if err != nil {
+		return err
+	}
+
+	rawBackupFile, err := DecompressSnapshot(downloadedSnapshotFile, encryptionKey)
 	if err != nil {
 		return fmt.Errorf("failed to decompress snapshot file %s: %w", objectName, err)
 	}
 
+	// make sure the snapshot is intact before we throw away the existing data dir
+	status, err := VerifySnapshot(log, rawBackupFile)
+	if err != nil {
+		return fmt.Errorf("failed to verify snapshot file %s: %w", objectName, err)
+	}
+
+	log.Infow("verified snapshot", "revision", status.Revision, "keys", status.TotalKey, "hash", status.Hash)
+
 	if err := os.RemoveAll(e.DataDir); err != nil {
 		return fmt.Errorf("error deleting data directory before restore (%s): %w", e.DataDir, err)
 	}
@@ -681,3 +694,23 @@ func (e *Cluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, log *zap
 		SkipHashCheck:       false,
 	})
 }
+
+// GetRestoreEncryptionKey returns the parsed key to decrypt the restore's backup, or nil
+// if the backup destination does not use encryption.
+func GetRestoreEncryptionKey(ctx context.Context, seedClient ctrlruntimeclient.Client, restore *kubermaticv1.EtcdRestore, cluster *kubermaticv1.Cluster) ([]byte, error) {
+	rawKey, err := resources.GetEtcdRestoreEncryptionKey(ctx, restore, seedClient, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get encryption key: %w", err)
+	}
+
+	if rawKey == nil {
+		return nil, nil
+	}
+
+	key, err := ParseEncryptionKey(rawKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Encrypted snapshots use envelope encryption: every snapshot is encrypted with a
+// random data key, which itself is encrypted with the key-encryption key provided
+// by the user. The file layout is:
+//
+//	magic | data key nonce | encrypted data key | chunk...
+//
+// Each chunk is a 4 byte big endian length followed by the AES-GCM sealed chunk
+// data. The nonce of each chunk is derived from its index and marks the final
+// chunk, so that reordered or truncated snapshots are detected.
+const (
+	encryptionChunkSize = 1 << 20
+	encryptionKeySize   = 32
+)
+
+var encryptionMagic = []byte("KKPENC01")
+
+// ReadEncryptionKey reads an AES-256 key from the given file. The key can
+// either be stored as 32 raw bytes or base64-encoded.
+func ReadEncryptionKey(filename string) ([]byte, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseEncryptionKey(data)
+}
+
+// ParseEncryptionKey validates an AES-256 key, which can either be given as
+// 32 raw bytes or base64-encoded.
+func ParseEncryptionKey(data []byte) ([]byte, error) {
+	if len(data) == encryptionKeySize {
+		return data, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(decoded) != encryptionKeySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes long, either raw or base64-encoded", encryptionKeySize)
+	}
+
+	return decoded, nil
+}
+
+func isEncrypted(header []byte) bool {
+	return bytes.HasPrefix(header, encryptionMagic)
+}
+
+type encryptingWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+	closed  bool
+}
+
+// newEncryptingWriter returns a writer that encrypts everything written to it
+// into w. Close must be called to write the final chunk.
+func newEncryptingWriter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	kek, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, kek.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	header := append([]byte{}, encryptionMagic...)
+	header = append(header, nonce...)
+	header = kek.Seal(header, nonce, dataKey, encryptionMagic)
+
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptingWriter{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+func (e *encryptingWriter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("write to closed writer")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		// only flush once more data arrives, so the last chunk is always
+		// written by Close()
+		if len(e.buf) == encryptionChunkSize {
+			if err := e.flush(false); err != nil {
+				return written, err
+			}
+		}
+
+		n := copy(e.buf[len(e.buf):encryptionChunkSize], p)
+		e.buf = e.buf[:len(e.buf)+n]
+		p = p[n:]
+		written += n
+	}
+
+	return written, nil
+}
+
+func (e *encryptingWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+
+	return e.flush(true)
+}
+
+func (e *encryptingWriter) flush(final bool) error {
+	sealed := e.aead.Seal(nil, chunkNonce(e.aead.NonceSize(), e.counter, final), e.buf, nil)
+
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(len(sealed)))
+
+	if _, err := e.w.Write(length); err != nil {
+		return err
+	}
+	if _, err := e.w.Write(sealed); err != nil {
+		return err
+	}
+
+	e.counter++
+	e.buf = e.buf[:0]
+
+	return nil
+}
+
+type decryptingReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+	done    bool
+}
+
+// newDecryptingReader returns a reader that decrypts a snapshot that was
+// encrypted using newEncryptingWriter.
+func newDecryptingReader(r io.Reader, key []byte) (io.Reader, error) {
+	kek, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, len(encryptionMagic)+kek.NonceSize()+encryptionKeySize+kek.Overhead())
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed to read encryption header: %w", err)
+	}
+
+	if !isEncrypted(header) {
+		return nil, errors.New("file is not an encrypted snapshot")
+	}
+
+	nonce := header[len(encryptionMagic) : len(encryptionMagic)+kek.NonceSize()]
+	dataKey, err := kek.Open(nil, nonce, header[len(encryptionMagic)+kek.NonceSize():], encryptionMagic)
+	if err != nil {
+		return nil, errors.New("failed to decrypt data key, wrong encryption key?")
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptingReader{
+		r:    r,
+		aead: aead,
+	}, nil
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+
+		if err := d.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+
+	return n, nil
+}
+
+func (d *decryptingReader) readChunk() error {
+	length := make([]byte, 4)
+	if _, err := io.ReadFull(d.r, length); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("encrypted snapshot is truncated")
+		}
+		return err
+	}
+
+	size := binary.BigEndian.Uint32(length)
+	if size > encryptionChunkSize+uint32(d.aead.Overhead()) {
+		return fmt.Errorf("invalid chunk size %d", size)
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(d.r, sealed); err != nil {
+		return fmt.Errorf("encrypted snapshot is truncated: %w", err)
+	}
+
+	// try to open the chunk as a regular chunk first and as the final chunk second
+	plain, err := d.aead.Open(nil, chunkNonce(d.aead.NonceSize(), d.counter, false), sealed, nil)
+	if err != nil {
+		plain, err = d.aead.Open(nil, chunkNonce(d.aead.NonceSize(), d.counter, true), sealed, nil)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt chunk %d: %w", d.counter, err)
+		}
+
+		d.done = true
+	}
+
+	d.counter++
+	d.buf = plain
+
+	return nil
+}
+
+func chunkNonce(size int, counter uint64, final bool) []byte {
+	nonce := make([]byte, size)
+	binary.BigEndian.PutUint64(nonce[size-8:], counter)
+	if final {
+		nonce[0] = 1
+	}
+
+	return nonce
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func testKey(t *testing.T) []byte {
+	key := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	return key
+}
+
+func encrypt(t *testing.T, key []byte, data []byte) []byte {
+	var buf bytes.Buffer
+
+	w, err := newEncryptingWriter(&buf, key)
+	if err != nil {
+		t.Fatalf("Failed to create encrypting writer: %v", err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		t.Fatalf("Failed to write: %v", err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("Failed to close: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestEncryptionRoundtrip(t *testing.T) {
+	key := testKey(t)
+
+	testCases := []struct {
+		name string
+		size int
+	}{
+		{name: "empty", size: 0},
+		{name: "small", size: 100},
+		{name: "exactly one chunk", size: encryptionChunkSize},
+		{name: "multiple chunks", size: 2*encryptionChunkSize + 42},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := make([]byte, tc.size)
+			if _, err := rand.Read(data); err != nil {
+				t.Fatalf("Failed to generate data: %v", err)
+			}
+
+			encrypted := encrypt(t, key, data)
+
+			r, err := newDecryptingReader(bytes.NewReader(encrypted), key)
+			if err != nil {
+				t.Fatalf("Failed to create decrypting reader: %v", err)
+			}
+
+			decrypted, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("Failed to decrypt: %v", err)
+			}
+
+			if !bytes.Equal(data, decrypted) {
+				t.Fatal("Decrypted data does not match original data.")
+			}
+		})
+	}
+}
+
+func TestDecryptionFailures(t *testing.T) {
+	key := testKey(t)
+	data := bytes.Repeat([]byte("etcd"), encryptionChunkSize)
+	encrypted := encrypt(t, key, data)
+
+	testCases := []struct {
+		name   string
+		key    []byte
+		modify func([]byte) []byte
+	}{
+		{
+			name:   "wrong key",
+			key:    testKey(t),
+			modify: func(b []byte) []byte { return b },
+		},
+		{
+			name: "truncated",
+			key:  key,
+			modify: func(b []byte) []byte {
+				// cut off the final chunk
+				return b[:len(b)-100]
+			},
+		},
+		{
+			name: "tampered",
+			key:  key,
+			modify: func(b []byte) []byte {
+				b[len(b)/2] ^= 0xff
+				return b
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := tc.modify(append([]byte{}, encrypted...))
+
+			r, err := newDecryptingReader(bytes.NewReader(input), tc.key)
+			if err == nil {
+				_, err = io.ReadAll(r)
+			}
+
+			if err == nil {
+				t.Fatal("Expected decryption to fail, but it succeeded.")
+			}
+		})
+	}
+}
+
+func TestParseEncryptionKey(t *testing.T) {
+	key := testKey(t)
+
+	if parsed, err := ParseEncryptionKey(key); err != nil || !bytes.Equal(parsed, key) {
+		t.Errorf("Failed to parse raw key: %v", err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(key) + "\n"
+	if parsed, err := ParseEncryptionKey([]byte(encoded)); err != nil || !bytes.Equal(parsed, key) {
+		t.Errorf("Failed to parse base64-encoded key: %v", err)
+	}
+
+	if _, err := ParseEncryptionKey([]byte("too-short")); err == nil {
+		t.Error("Expected short key to be rejected.")
+	}
+}
+
+func TestDecompressSnapshot(t *testing.T) {
+	key := testKey(t)
+	data := bytes.Repeat([]byte("snapshot"), 1000)
+
+	gzipped := func(b []byte) []byte {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		_, _ = w.Write(b)
+		_ = w.Close()
+		return buf.Bytes()
+	}
+
+	zstded := func(b []byte) []byte {
+		var buf bytes.Buffer
+		w, _ := zstd.NewWriter(&buf)
+		_, _ = w.Write(b)
+		_ = w.Close()
+		return buf.Bytes()
+	}
+
+	testCases := []struct {
+		name     string
+		filename string
+		content  []byte
+		key      []byte
+	}{
+		{
+			name:     "gzip",
+			filename: "backup.db.gz",
+			content:  gzipped(data),
+		},
+		{
+			name:     "zstd with legacy extension",
+			filename: "backup.db.gz",
+			content:  zstded(data),
+		},
+		{
+			name:     "encrypted gzip",
+			filename: "backup.db.gz",
+			content:  encrypt(t, key, gzipped(data)),
+			key:      key,
+		},
+		{
+			name:     "encrypted zstd",
+			filename: "backup.db.zst",
+			content:  encrypt(t, key, zstded(data)),
+			key:      key,
+		},
+		{
+			name:     "encrypted raw snapshot",
+			filename: "backup.db",
+			content:  encrypt(t, key, data),
+			key:      key,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), tc.filename)
+			if err := os.WriteFile(filename, tc.content, 0o600); err != nil {
+				t.Fatalf("Failed to write test file: %v", err)
+			}
+
+			rawFilename, err := DecompressSnapshot(filename, tc.key)
+			if err != nil {
+				t.Fatalf("Failed to decompress snapshot: %v", err)
+			}
+
+			raw, err := os.ReadFile(rawFilename)
+			if err != nil {
+				t.Fatalf("Failed to read decompressed snapshot: %v", err)
+			}
+
+			if !bytes.Equal(data, raw) {
+				t.Fatal("Decompressed snapshot does not match original data.")
+			}
+		})
+	}
+}
+
+func TestDecompressEncryptedSnapshotWithoutKey(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "backup.db.gz")
+	if err := os.WriteFile(filename, encrypt(t, testKey(t), []byte("data")), 0o600); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	if _, err := DecompressSnapshot(filename, nil); err == nil {
+		t.Fatal("Expected decompression of encrypted snapshot without key to fail.")
+	}
+}
@@ -17,14 +17,19 @@ limitations under the License.
 package etcd
 
 import (
+	"bufio"
+	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/klauspost/compress/zstd"
 	client "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/etcdutl/v3/snapshot"
 	"go.uber.org/zap"
@@ -33,17 +38,24 @@ import (
 type SnapshotOptions struct {
 	File        string
 	Compression string
+	// EncryptionKey, if set, is used to encrypt the snapshot using AES-GCM
+	// after it has been compressed.
+	EncryptionKey []byte
 }
 
-var ValidCompressions = []string{"gzip"}
+var ValidCompressions = []string{"gzip", "zstd"}
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
 
 func CreateSnapshot(ctx context.Context, log *zap.SugaredLogger, etcdConfig client.Config, opt *SnapshotOptions) error {
 	snapv3 := snapshot.NewV3(log.Desugar())
 
-	if opt.Compression == "" {
-		if _, err := snapv3.Save(ctx, etcdConfig, opt.File); err != nil {
-			return err
-		}
+	if opt.Compression == "" && opt.EncryptionKey == nil {
+		_, err := snapv3.Save(ctx, etcdConfig, opt.File)
+		return err
 	}
 
 	tmpFile := opt.File + ".tmp"
@@ -53,11 +65,11 @@ func CreateSnapshot(ctx context.Context, log *zap.SugaredLogger, etcdConfig clie
 		return err
 	}
 
-	compressedFile, err := os.Create(opt.File)
+	outputFile, err := os.Create(opt.File)
 	if err != nil {
 		return err
 	}
-	defer compressedFile.Close()
+	defer outputFile.Close()
 
 	rawFile, err := os.Open(tmpFile)
 	if err != nil {
@@ -65,70 +77,206 @@ func CreateSnapshot(ctx context.Context, log *zap.SugaredLogger, etcdConfig clie
 	}
 	defer rawFile.Close()
 
-	var compressor io.WriteCloser
+	// writers are stacked on top of the output file: data is first
+	// compressed, then encrypted
+	var writer io.Writer = outputFile
+	var closers []io.Closer
+
+	if opt.EncryptionKey != nil {
+		encryptor, err := newEncryptingWriter(writer, opt.EncryptionKey)
+		if err != nil {
+			return fmt.Errorf("failed to set up encryption: %w", err)
+		}
+
+		writer = encryptor
+		closers = append(closers, encryptor)
+	}
 
 	switch opt.Compression {
+	case "":
+		// no compression
 	case "gzip":
-		compressor, err = gzip.NewWriterLevel(compressedFile, gzip.BestCompression)
+		compressor, err := gzip.NewWriterLevel(writer, gzip.BestCompression)
+		if err != nil {
+			return err
+		}
+
+		writer = compressor
+		closers = append(closers, compressor)
+	case "zstd":
+		compressor, err := zstd.NewWriter(writer)
 		if err != nil {
 			return err
 		}
+
+		writer = compressor
+		closers = append(closers, compressor)
 	default:
 		return fmt.Errorf("unknown compression algorithm %q", opt.Compression)
 	}
 
-	defer compressor.Close()
-
-	if _, err = io.Copy(compressor, rawFile); err != nil {
+	if _, err = io.Copy(writer, rawFile); err != nil {
 		return err
 	}
 
-	return nil
+	// flush the writers from the outermost to the innermost one
+	for i := len(closers) - 1; i >= 0; i-- {
+		if err := closers[i].Close(); err != nil {
+			return err
+		}
+	}
+
+	return outputFile.Close()
 }
 
-func DecompressSnapshot(filename string) (string, error) {
+// DecompressSnapshot turns a downloaded backup into a raw etcd snapshot. The format of the
+// backup is detected based on its content, so encrypted, gzip and zstd compressed backups
+// are handled regardless of their file extension. The encryption key is only required for
+// encrypted backups.
+func DecompressSnapshot(filename string, encryptionKey []byte) (string, error) {
 	ext := filepath.Ext(filename)
 
+	var rawFilename string
 	switch ext {
 	case ".db":
-		return filename, nil
+		rawFilename = filename + ".raw"
+	case ".gz", ".gzip", ".zst", ".zstd", ".enc":
+		rawFilename = strings.TrimSuffix(filename, ext)
+	default:
+		return "", fmt.Errorf("unsupported backup file extension %q", ext)
+	}
+
+	inputFile, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer inputFile.Close()
 
-	case ".gz", ".gzip":
-		rawFilename := strings.TrimSuffix(filename, ext)
+	buffered := bufio.NewReader(inputFile)
+	var reader io.Reader = buffered
+
+	encrypted, err := hasPrefix(buffered, encryptionMagic)
+	if err != nil {
+		return "", err
+	}
+
+	if encrypted {
+		if encryptionKey == nil {
+			return "", errors.New("backup is encrypted, but no encryption key is configured")
+		}
 
-		rawFile, err := os.Create(rawFilename)
+		decryptor, err := newDecryptingReader(buffered, encryptionKey)
 		if err != nil {
 			return "", err
 		}
-		defer rawFile.Close()
 
-		compressedFile, err := os.Open(filename)
+		buffered = bufio.NewReader(decryptor)
+		reader = buffered
+	} else if ext == ".db" {
+		// plain snapshot, nothing to do
+		return filename, nil
+	}
+
+	isGzip, err := hasPrefix(buffered, gzipMagic)
+	if err != nil {
+		return "", err
+	}
+
+	isZstd, err := hasPrefix(buffered, zstdMagic)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case isGzip:
+		decompressor, err := gzip.NewReader(buffered)
 		if err != nil {
 			return "", err
 		}
-		defer compressedFile.Close()
-
-		var decompressor io.ReadCloser
-
-		switch ext {
-		case ".gz", ".gzip":
-			decompressor, err = gzip.NewReader(compressedFile)
-			if err != nil {
-				return "", err
-			}
-		default:
-			panic("Inner switch statement is out-of-sync with outer switch statement.")
-		}
-
 		defer decompressor.Close()
 
-		if _, err = io.Copy(rawFile, decompressor); err != nil {
+		reader = decompressor
+
+	case isZstd:
+		decompressor, err := zstd.NewReader(buffered)
+		if err != nil {
 			return "", err
 		}
+		defer decompressor.Close()
 
-		return rawFilename, nil
+		reader = decompressor
+	}
 
-	default:
-		return "", fmt.Errorf("unsupported backup file extension %q", ext)
+	rawFile, err := os.Create(rawFilename)
+	if err != nil {
+		return "", err
+	}
+	defer rawFile.Close()
+
+	if _, err = io.Copy(rawFile, reader); err != nil {
+		return "", err
+	}
+
+	return rawFilename, rawFile.Close()
+}
+
+func hasPrefix(r *bufio.Reader, prefix []byte) (bool, error) {
+	header, err := r.Peek(len(prefix))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
 	}
+
+	return bytes.Equal(header, prefix), nil
+}
+
+// VerifySnapshot checks the integrity of an uncompressed snapshot file. The sha256 checksum
+// that etcd appends to every snapshot is validated first, then the database is checked the
+// same way `etcdutl snapshot status` does.
+func VerifySnapshot(log *zap.SugaredLogger, filename string) (*snapshot.Status, error) {
+	if err := verifySnapshotChecksum(filename); err != nil {
+		return nil, err
+	}
+
+	status, err := snapshot.NewV3(log.Desugar()).Status(filename)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot is corrupt: %w", err)
+	}
+
+	return &status, nil
+}
+
+func verifySnapshotChecksum(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	// etcd pads snapshots to a multiple of 512 bytes and appends the
+	// sha256 digest of the database
+	size := info.Size()
+	if size%512 != sha256.Size {
+		return fmt.Errorf("snapshot has no integrity hash")
+	}
+
+	h := sha256.New()
+	if _, err := io.CopyN(h, f, size-sha256.Size); err != nil {
+		return err
+	}
+
+	expected := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(f, expected); err != nil {
+		return err
+	}
+
+	if actual := h.Sum(nil); !bytes.Equal(expected, actual) {
+		return fmt.Errorf("snapshot integrity hash mismatch: expected %x, got %x", expected, actual)
+	}
+
+	return nil
 }
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	client "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
+	"go.uber.org/zap"
+)
+
+const (
+	verificationMemberName = "verify"
+	// the peer URL is only recorded in the restored member list, the embedded
+	// etcd is started on random ports
+	verificationPeerURL = "http://127.0.0.1:2380"
+
+	verificationStartTimeout = 2 * time.Minute
+)
+
+// RestoreVerification is the result of restoring a snapshot into a temporary etcd.
+type RestoreVerification struct {
+	// Revision is the revision of the restored database.
+	Revision int64
+	// Keys is the number of keys in the restored database.
+	Keys int64
+}
+
+// VerifySnapshotRestore restores the given uncompressed snapshot into a temporary data
+// directory below workDir and starts a throwaway single-member etcd on it. The restored
+// database must be readable, contain keys and have the same revision as the snapshot.
+func VerifySnapshotRestore(ctx context.Context, log *zap.SugaredLogger, filename string, workDir string) (*RestoreVerification, error) {
+	status, err := VerifySnapshot(log, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	dataDir, err := os.MkdirTemp(workDir, "verify-*.etcd")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	err = snapshot.NewV3(log.Desugar()).Restore(snapshot.RestoreConfig{
+		SnapshotPath:        filename,
+		Name:                verificationMemberName,
+		OutputDataDir:       dataDir,
+		OutputWALDir:        filepath.Join(dataDir, "member", "wal"),
+		PeerURLs:            []string{verificationPeerURL},
+		InitialCluster:      fmt.Sprintf("%s=%s", verificationMemberName, verificationPeerURL),
+		InitialClusterToken: verificationMemberName,
+		SkipHashCheck:       false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore snapshot: %w", err)
+	}
+
+	server, err := startVerificationServer(log, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	defer server.Close()
+
+	etcdClient := v3client.New(server.Server)
+	defer etcdClient.Close()
+
+	resp, err := etcdClient.Get(ctx, "\x00", client.WithFromKey(), client.WithCountOnly())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read restored database: %w", err)
+	}
+
+	result := &RestoreVerification{
+		Revision: resp.Header.Revision,
+		Keys:     resp.Count,
+	}
+
+	if result.Revision != status.Revision {
+		return result, fmt.Errorf("restored database has revision %d, but snapshot has revision %d", result.Revision, status.Revision)
+	}
+
+	if result.Keys == 0 {
+		return result, errors.New("restored database does not contain any keys")
+	}
+
+	return result, nil
+}
+
+func startVerificationServer(log *zap.SugaredLogger, dataDir string) (*embed.Etcd, error) {
+	cfg := embed.NewConfig()
+	cfg.Name = verificationMemberName
+	cfg.Dir = dataDir
+	cfg.InitialCluster = fmt.Sprintf("%s=%s", verificationMemberName, verificationPeerURL)
+	cfg.ZapLoggerBuilder = embed.NewZapLoggerBuilder(log.Desugar().Named("embedded-etcd").WithOptions(zap.IncreaseLevel(zap.WarnLevel)))
+
+	return startEmbeddedEtcd(cfg)
+}
+
+// startEmbeddedEtcd starts an etcd listening on random local ports and waits for it to become ready.
+func startEmbeddedEtcd(cfg *embed.Config) (*embed.Etcd, error) {
+	localhost := url.URL{Scheme: "http", Host: "127.0.0.1:0"}
+
+	cfg.ListenClientUrls = []url.URL{localhost}
+	cfg.AdvertiseClientUrls = []url.URL{localhost}
+	cfg.ListenPeerUrls = []url.URL{localhost}
+
+	server, err := embed.StartEtcd(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start embedded etcd: %w", err)
+	}
+
+	select {
+	case <-server.Server.ReadyNotify():
+		return server, nil
+	case err := <-server.Err():
+		server.Close()
+		return nil, fmt.Errorf("embedded etcd failed: %w", err)
+	case <-time.After(verificationStartTimeout):
+		server.Close()
+		return nil, errors.New("timed out waiting for embedded etcd to become ready")
+	}
+}
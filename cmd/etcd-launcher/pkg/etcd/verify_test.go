@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	client "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"go.etcd.io/etcd/server/v3/embed"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
+	"go.uber.org/zap"
+)
+
+func createTestSnapshot(t *testing.T, keys int) string {
+	t.Helper()
+
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+	dir := t.TempDir()
+
+	cfg := embed.NewConfig()
+	cfg.Dir = filepath.Join(dir, "source.etcd")
+	cfg.ZapLoggerBuilder = embed.NewZapLoggerBuilder(zap.NewNop())
+
+	server, err := startEmbeddedEtcd(cfg)
+	if err != nil {
+		t.Fatalf("Failed to start etcd: %v", err)
+	}
+	defer server.Close()
+
+	etcdClient := v3client.New(server.Server)
+	defer etcdClient.Close()
+
+	for i := range keys {
+		if _, err := etcdClient.Put(ctx, fmt.Sprintf("/registry/key-%d", i), "value"); err != nil {
+			t.Fatalf("Failed to put key: %v", err)
+		}
+	}
+
+	filename := filepath.Join(dir, "snapshot.db")
+	etcdConfig := client.Config{Endpoints: []string{server.Clients[0].Addr().String()}}
+	if _, err := snapshot.NewV3(log.Desugar()).Save(ctx, etcdConfig, filename); err != nil {
+		t.Fatalf("Failed to save snapshot: %v", err)
+	}
+
+	return filename
+}
+
+func TestVerifySnapshotRestore(t *testing.T) {
+	filename := createTestSnapshot(t, 10)
+
+	result, err := VerifySnapshotRestore(context.Background(), zap.NewNop().Sugar(), filename, t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to verify snapshot: %v", err)
+	}
+
+	if result.Keys != 10 {
+		t.Fatalf("Expected 10 keys, got %d.", result.Keys)
+	}
+
+	if result.Revision != 11 {
+		t.Fatalf("Expected revision 11, got %d.", result.Revision)
+	}
+}
+
+func TestVerifyCorruptSnapshotRestore(t *testing.T) {
+	filename := createTestSnapshot(t, 10)
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Failed to read snapshot: %v", err)
+	}
+
+	data[len(data)/2] ^= 0xff
+
+	if err := os.WriteFile(filename, data, 0o600); err != nil {
+		t.Fatalf("Failed to write snapshot: %v", err)
+	}
+
+	if _, err := VerifySnapshotRestore(context.Background(), zap.NewNop().Sugar(), filename, t.TempDir()); err == nil {
+		t.Fatal("Expected verification of corrupt snapshot to fail.")
+	}
+}
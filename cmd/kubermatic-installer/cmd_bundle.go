@@ -0,0 +1,387 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"k8c.io/kubermatic/v2/pkg/install/bundle"
+	"k8c.io/kubermatic/v2/pkg/install/helm"
+	"k8c.io/kubermatic/v2/pkg/install/images"
+	kubermaticversion "k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type BundleCreateOptions struct {
+	MirrorImagesOptions
+
+	Architectures string
+	Output        string
+}
+
+type BundleApplyOptions struct {
+	DeployOptions
+
+	Registry          string
+	Directory         string
+	BinariesDirectory string
+	Deploy            string
+	SkipImages        bool
+	DryRun            bool
+}
+
+func BundleCommand(logger *logrus.Logger, versions kubermaticversion.Versions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Create and apply offline bundles for air-gapped installations",
+		Long:  "Creates a single, checksummed archive containing all images, binaries, Helm charts and addons required to install KKP, and installs KKP from such an archive without network access",
+	}
+
+	cmd.AddCommand(
+		BundleCreateCommand(logger, versions),
+		BundleApplyCommand(logger, versions),
+	)
+
+	return cmd
+}
+
+func BundleCreateCommand(logger *logrus.Logger, versions kubermaticversion.Versions) *cobra.Command {
+	opt := BundleCreateOptions{
+		MirrorImagesOptions: MirrorImagesOptions{
+			HelmTimeout: 5 * time.Minute,
+			HelmBinary:  "helm",
+			Archive:     true,
+		},
+		Architectures: "amd64,arm64",
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an offline bundle",
+		Long:  "Downloads all container images, binaries, Helm charts (including their dependencies) and addons used by KKP and writes them, together with this installer, into a single archive",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			options.CopyInto(&opt.Options)
+
+			if opt.Config == "" {
+				opt.Config = os.Getenv("CONFIG_YAML")
+			}
+
+			if opt.HelmValuesFile == "" {
+				opt.HelmValuesFile = os.Getenv("HELM_VALUES")
+			}
+
+			if opt.HelmBinary == "" {
+				opt.HelmBinary = os.Getenv("HELM_BINARY")
+			}
+
+			if opt.Output == "" {
+				opt.Output = fmt.Sprintf("kubermatic-%s-bundle.tar.gz", versions.GitVersion)
+			}
+
+			opt.Versions = versions
+		},
+		RunE:         BundleCreateFunc(logger, versions, &opt),
+		SilenceUsage: true,
+	}
+
+	cmd.PersistentFlags().StringVar(&opt.Config, "config", "", "Path to the KubermaticConfiguration YAML file")
+	cmd.PersistentFlags().StringVar(&opt.Output, "output", "", "Path to write the bundle to (defaults to kubermatic-<version>-bundle.tar.gz)")
+	cmd.PersistentFlags().StringVar(&opt.VersionFilter, "version-filter", "", "Version constraint which can be used to filter for specific versions")
+	cmd.PersistentFlags().StringArrayVar(&opt.ProviderFilter, "provider-filter", nil, fmt.Sprintf("Cloud providers to include images for. Valid values are: %s. Can be specified multiple times. If not specified, images for all providers will be included", strings.Join(allSupportedProviderNames(), ", ")))
+	cmd.PersistentFlags().StringVar(&opt.Architectures, "architectures", opt.Architectures, "Comma-separated list of architectures to include binaries for (e.g., amd64,arm64)")
+	cmd.PersistentFlags().StringVar(&opt.RegistryPrefix, "registry-prefix", "", "Check source registries against this prefix and only include images that match it")
+	cmd.PersistentFlags().BoolVar(&opt.IgnoreRepositoryOverrides, "ignore-repository-overrides", true, "Ignore any configured registry overrides in the referenced KubermaticConfiguration (see mirror-images)")
+
+	cmd.PersistentFlags().StringVar(&opt.AddonsPath, "addons-path", "", "Path to a local directory containing KKP addons. Takes precedence over --addons-image")
+	cmd.PersistentFlags().StringVar(&opt.AddonsImage, "addons-image", "", "Docker image containing KKP addons, if not given, falls back to the Docker image configured in the KubermaticConfiguration")
+
+	cmd.PersistentFlags().DurationVar(&opt.HelmTimeout, "helm-timeout", opt.HelmTimeout, "time to wait for Helm operations to finish")
+	cmd.PersistentFlags().StringVar(&opt.HelmValuesFile, "helm-values", "", "Use this values.yaml when rendering Helm charts")
+	cmd.PersistentFlags().StringVar(&opt.HelmBinary, "helm-binary", opt.HelmBinary, "Helm 3.x or 4.x binary to use for rendering charts and downloading chart dependencies")
+
+	return cmd
+}
+
+func BundleCreateFunc(logger *logrus.Logger, versions kubermaticversion.Versions, opt *BundleCreateOptions) cobraFuncE {
+	return handleErrors(logger, func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		archList, err := validateArchitectures(opt.Architectures)
+		if err != nil {
+			return fmt.Errorf("invalid architectures: %w", err)
+		}
+
+		kubermaticConfig, err := getKubermaticConfiguration(&opt.MirrorImagesOptions)
+		if err != nil {
+			return fmt.Errorf("failed to get KubermaticConfiguration: %w", err)
+		}
+
+		stagingDir, err := os.MkdirTemp("", "kkp-bundle-*")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+		defer os.RemoveAll(stagingDir)
+
+		if opt.AddonsPath == "" {
+			opt.AddonsPath, err = getAddonsPath(ctx, logger, &opt.MirrorImagesOptions, kubermaticConfig)
+			if err != nil {
+				return fmt.Errorf("failed to get addons path: %w", err)
+			}
+			defer os.RemoveAll(opt.AddonsPath)
+		}
+
+		logger.WithField("bundle", opt.Output).Info("🚀 Creating offline bundle…")
+
+		logger.Info("🚀 Adding addons…")
+		if err := bundle.CopyDirectory(opt.AddonsPath, filepath.Join(stagingDir, bundle.AddonsDirectory)); err != nil {
+			return fmt.Errorf("failed to add addons: %w", err)
+		}
+
+		// the charts directory also contains all CRDs installed by the installer
+		logger.Info("🚀 Adding Helm charts…")
+		if err := addCharts(logger, opt, filepath.Join(stagingDir, bundle.ChartsDirectory)); err != nil {
+			return fmt.Errorf("failed to add Helm charts: %w", err)
+		}
+
+		logger.Info("🚀 Collecting images…")
+		imageSet, err := collectImages(ctx, logger, versions, kubermaticConfig, &opt.MirrorImagesOptions)
+		if err != nil {
+			return err
+		}
+
+		imageList := sets.List(imageSet)
+		count, fullCount, err := images.ArchiveImages(ctx, logger, filepath.Join(stagingDir, bundle.ImagesArchive), false, imageList)
+		if err != nil {
+			return fmt.Errorf("failed to archive images: %w", err)
+		}
+
+		// unlike mirror-images, a bundle must be complete, as there is no way
+		// to fetch missing images later on
+		if count != fullCount {
+			return fmt.Errorf("only %d of %d images could be fetched, see the log above for details", count, fullCount)
+		}
+
+		logger.Info("🚀 Downloading binaries…")
+		if err := mirrorBinaries(ctx, logger, kubermaticConfig, opt.VersionFilter, archList, filepath.Join(stagingDir, bundle.BinariesDirectory)); err != nil {
+			return fmt.Errorf("failed to add binaries: %w", err)
+		}
+
+		if err := addInstaller(filepath.Join(stagingDir, bundle.InstallerBinary)); err != nil {
+			return fmt.Errorf("failed to add installer: %w", err)
+		}
+
+		manifest := &bundle.Manifest{
+			KubermaticVersion: versions.GitVersion,
+			KubermaticEdition: versions.KubermaticEdition.String(),
+			CreatedAt:         time.Now().UTC(),
+			VersionFilter:     opt.VersionFilter,
+			ProviderFilter:    opt.ProviderFilter,
+			Architectures:     archList,
+		}
+
+		logger.Info("🚀 Writing bundle…")
+		if err := bundle.Write(stagingDir, opt.Output, manifest); err != nil {
+			return fmt.Errorf("failed to write bundle: %w", err)
+		}
+
+		logger.WithFields(logrus.Fields{
+			"bundle": opt.Output,
+			"files":  len(manifest.Files),
+			"images": count,
+		}).Info("✅ Finished creating bundle.")
+
+		return nil
+	})
+}
+
+// addCharts copies the charts directory into the bundle and downloads the
+// dependencies of all charts, so that they can be deployed without network access.
+func addCharts(logger *logrus.Logger, opt *BundleCreateOptions, target string) error {
+	if err := bundle.CopyDirectory(opt.ChartsDirectory, target); err != nil {
+		return err
+	}
+
+	helmClient, err := helm.NewCLI(opt.HelmBinary, "", "", opt.HelmTimeout, logger)
+	if err != nil {
+		return fmt.Errorf("failed to create Helm client: %w", err)
+	}
+
+	return filepath.WalkDir(target, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.IsDir() {
+			return err
+		}
+
+		if _, err := os.Stat(filepath.Join(path, "Chart.yaml")); err != nil {
+			return nil
+		}
+
+		logger.WithField("chart", filepath.Base(path)).Debug("Downloading chart dependencies…")
+
+		if err := helmClient.BuildChartDependencies(path, nil); err != nil {
+			return fmt.Errorf("failed to download dependencies for %s: %w", filepath.Base(path), err)
+		}
+
+		// subcharts are handled by Helm
+		return filepath.SkipDir
+	})
+}
+
+func addInstaller(target string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to determine path to installer: %w", err)
+	}
+
+	source, err := os.ReadFile(executable)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(target, source, 0o755)
+}
+
+func BundleApplyCommand(logger *logrus.Logger, versions kubermaticversion.Versions) *cobra.Command {
+	opt := BundleApplyOptions{
+		DeployOptions: newDeployOptions(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply BUNDLE",
+		Short: "Push the contents of an offline bundle into a registry and optionally deploy KKP",
+		Long:  "Verifies and extracts an offline bundle, pushes all contained images into the given registry and optionally deploys a stack using the Helm charts from the bundle. The KubermaticConfiguration and Helm values must already point to the target registry.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opt.loadEnvironment()
+
+			if !opt.SkipImages && opt.Registry == "" {
+				return errors.New("no target registry was passed, use --registry or --skip-images")
+			}
+
+			return nil
+		},
+		RunE:         BundleApplyFunc(logger, versions, &opt),
+		SilenceUsage: true,
+	}
+
+	opt.addFlags(cmd)
+
+	cmd.PersistentFlags().StringVar(&opt.Registry, "registry", "", "Registry to push the images from the bundle to")
+	cmd.PersistentFlags().BoolVar(&opt.SkipImages, "skip-images", false, "Do not push images, e.g. because they have been pushed already")
+	cmd.PersistentFlags().BoolVar(&opt.DryRun, "dry-run", false, "Only print the names of the images that would be pushed")
+	cmd.PersistentFlags().StringVar(&opt.Directory, "directory", "", "Directory to extract the bundle to (defaults to a temporary directory; must be empty)")
+	cmd.PersistentFlags().StringVar(&opt.BinariesDirectory, "binaries-directory", "", "Copy the binaries from the bundle into this directory, e.g. the root of a web server used as binary mirror")
+	cmd.PersistentFlags().StringVar(&opt.Deploy, "deploy", "", "Deploy this stack (kubermatic-master, kubermatic-seed, seed-mla or usercluster-mla) using the Helm charts from the bundle; all deploy flags are supported")
+
+	return cmd
+}
+
+func BundleApplyFunc(logger *logrus.Logger, versions kubermaticversion.Versions, opt *BundleApplyOptions) cobraFuncE {
+	return handleErrors(logger, func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		userAgent := fmt.Sprintf("kubermatic-installer/%s", versions.GitVersion)
+
+		directory := opt.Directory
+		if directory == "" {
+			tempDir, err := os.MkdirTemp("", "kkp-bundle-*")
+			if err != nil {
+				return fmt.Errorf("failed to create temporary directory: %w", err)
+			}
+			defer os.RemoveAll(tempDir)
+
+			directory = tempDir
+		}
+
+		logger.WithField("bundle", args[0]).Info("🚀 Extracting and verifying bundle…")
+
+		manifest, err := bundle.Extract(args[0], directory)
+		if err != nil {
+			return err
+		}
+
+		logger.WithFields(logrus.Fields{
+			"version": manifest.KubermaticVersion,
+			"edition": manifest.KubermaticEdition,
+			"created": manifest.CreatedAt.Format(time.RFC3339),
+		}).Info("✅ Bundle verified.")
+
+		if !opt.SkipImages {
+			archivePath := filepath.Join(directory, bundle.ImagesArchive)
+
+			logger.WithField("registry", opt.Registry).Info("🚀 Pushing images…")
+			if err := images.LoadImages(ctx, logger, archivePath, opt.DryRun, opt.Registry, userAgent); err != nil {
+				return fmt.Errorf("failed to push images: %w", err)
+			}
+			logger.Info("✅ Finished pushing images.")
+		}
+
+		if opt.BinariesDirectory != "" {
+			logger.WithField("directory", opt.BinariesDirectory).Info("🚀 Copying binaries…")
+			if err := bundle.CopyDirectory(filepath.Join(directory, bundle.BinariesDirectory), opt.BinariesDirectory); err != nil {
+				return fmt.Errorf("failed to copy binaries: %w", err)
+			}
+			logger.Info("✅ Finished copying binaries.")
+		}
+
+		if opt.Deploy == "" {
+			return nil
+		}
+
+		return deployFromBundle(ctx, logger, versions, opt, manifest, directory)
+	})
+}
+
+func deployFromBundle(ctx context.Context, logger *logrus.Logger, versions kubermaticversion.Versions, opt *BundleApplyOptions, manifest *bundle.Manifest, directory string) error {
+	// the charts in the bundle must match the stacks built into the installer
+	if manifest.KubermaticVersion != versions.GitVersion || manifest.KubermaticEdition != versions.KubermaticEdition.String() {
+		return fmt.Errorf("bundle was created for KKP %s (%s), but this installer is %s (%s); use the %s binary from the bundle to deploy it", manifest.KubermaticVersion, manifest.KubermaticEdition, versions.GitVersion, versions.KubermaticEdition, bundle.InstallerBinary)
+	}
+
+	if opt.DryRun {
+		logger.Info("Skipping deployment in dry-run mode.")
+		return nil
+	}
+
+	// all chart dependencies are part of the bundle already
+	opt.ChartsDirectory = filepath.Join(directory, bundle.ChartsDirectory)
+	opt.SkipDependencies = true
+
+	kubermaticStack, deployOptions, err := setupDeployment(ctx, logger, versions, &opt.DeployOptions, []string{opt.Deploy})
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("🛫 Deploying %s…", kubermaticStack.Name())
+
+	if err := kubermaticStack.Deploy(ctx, deployOptions); err != nil {
+		return err
+	}
+
+	logger.Infof("🛬 Installation completed successfully. %s", greeting())
+
+	return nil
+}
@@ -102,11 +102,7 @@ type DeployOptions struct {
 }
 
 func DeployCommand(logger *logrus.Logger, versions kubermatic.Versions) *cobra.Command {
-	opt := DeployOptions{
-		HelmTimeout:        5 * time.Minute,
-		HelmBinary:         "helm",
-		SkipSeedValidation: sets.New[string](),
-	}
+	opt := newDeployOptions()
 
 	cmd := &cobra.Command{
 		Use:          "deploy [kubermatic-master | kubermatic-seed | seed-mla | usercluster-mla]",
@@ -115,28 +111,48 @@ func DeployCommand(logger *logrus.Logger, versions kubermatic.Versions) *cobra.C
 		RunE:         DeployFunc(logger, versions, &opt),
 		SilenceUsage: true,
 		PreRun: func(cmd *cobra.Command, args []string) {
-			options.CopyInto(&opt.Options)
-
-			if opt.Config == "" {
-				opt.Config = os.Getenv("CONFIG_YAML")
-			}
-			if opt.Kubeconfig == "" {
-				opt.Kubeconfig = os.Getenv("KUBECONFIG")
-			}
-			if opt.KubeContext == "" {
-				opt.KubeContext = os.Getenv("KUBE_CONTEXT")
-			}
-			if len(opt.HelmValues) == 0 {
-				if envVal := os.Getenv("HELM_VALUES"); envVal != "" {
-					opt.HelmValues = []string{envVal}
-				}
-			}
-			if opt.HelmBinary == "" {
-				opt.HelmBinary = os.Getenv("HELM_BINARY")
-			}
+			opt.loadEnvironment()
 		},
 	}
 
+	opt.addFlags(cmd)
+
+	return cmd
+}
+
+func newDeployOptions() DeployOptions {
+	return DeployOptions{
+		HelmTimeout:        5 * time.Minute,
+		HelmBinary:         "helm",
+		SkipSeedValidation: sets.New[string](),
+	}
+}
+
+// loadEnvironment copies the global options into opt and fills in unset
+// options from their environment variables.
+func (opt *DeployOptions) loadEnvironment() {
+	options.CopyInto(&opt.Options)
+
+	if opt.Config == "" {
+		opt.Config = os.Getenv("CONFIG_YAML")
+	}
+	if opt.Kubeconfig == "" {
+		opt.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+	if opt.KubeContext == "" {
+		opt.KubeContext = os.Getenv("KUBE_CONTEXT")
+	}
+	if len(opt.HelmValues) == 0 {
+		if envVal := os.Getenv("HELM_VALUES"); envVal != "" {
+			opt.HelmValues = []string{envVal}
+		}
+	}
+	if opt.HelmBinary == "" {
+		opt.HelmBinary = os.Getenv("HELM_BINARY")
+	}
+}
+
+func (opt *DeployOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVar(&opt.Config, "config", "", "full path to the KubermaticConfiguration YAML file (only required during first installation, on upgrades the configuration can automatically be read from the cluster instead)")
 	cmd.PersistentFlags().StringVar(&opt.Kubeconfig, "kubeconfig", "", "full path to where a kubeconfig with cluster-admin permissions for the target cluster")
 	cmd.PersistentFlags().StringVar(&opt.KubeContext, "kube-context", "", "context to use from the given kubeconfig")
@@ -165,189 +181,214 @@ func DeployCommand(logger *logrus.Logger, versions kubermatic.Versions) *cobra.C
 	cmd.PersistentFlags().BoolVar(&opt.MLAIncludeIap, "mla-include-iap", false, "(UserCluster MLA) Include Identity-Aware Proxy installation")
 	cmd.PersistentFlags().BoolVar(&opt.MLASkipLogging, "mla-skip-logging", false, "Skip logging stack installation")
 
-	wrapDeployFlags(cmd.PersistentFlags(), &opt)
+	wrapDeployFlags(cmd.PersistentFlags(), opt)
 
 	cmd.PersistentFlags().StringSliceVar(&opt.SkipCharts, "skip-charts", nil, "skip helm chart deployment (some of cert-manager, nginx-ingress-controller, dex)")
-
-	return cmd
 }
 
 func DeployFunc(logger *logrus.Logger, versions kubermatic.Versions, opt *DeployOptions) cobraFuncE {
 	return handleErrors(logger, func(cmd *cobra.Command, args []string) error {
-		fields := logrus.Fields{
-			"version": versions.GitVersion,
-			"edition": versions.KubermaticEdition,
-		}
-
-		helmClient, err := setupHelmClient(logger, opt)
-		if err != nil {
-			return fmt.Errorf("failed to create the helm client: %w", err)
-		}
+		appContext := context.Background()
 
-		kubermaticStack, err := setupKubermaticStack(logger, args, opt)
+		kubermaticStack, deployOptions, err := setupDeployment(appContext, logger, versions, opt, args)
 		if err != nil {
-			return fmt.Errorf("failed to define the stack: %w", err)
+			return err
 		}
 
-		logger.WithFields(fields).Info("🚀 Initializing installer…")
-
-		// load config files
-		if len(opt.Kubeconfig) == 0 {
-			return errors.New("no kubeconfig (--kubeconfig or $KUBECONFIG) given")
-		}
+		logger.Infof("🛫 Deploying %s…", kubermaticStack.Name())
 
-		// this can result in both configs being nil, if no --config is given
-		kubermaticConfig, rawKubermaticConfig, err := loadKubermaticConfiguration(opt.Config)
-		if err != nil {
-			return fmt.Errorf("failed to load KubermaticConfiguration: %w", err)
+		if err := kubermaticStack.Deploy(appContext, deployOptions); err != nil {
+			return err
 		}
 
-		helmValues, err := loadHelmValues(opt.HelmValues)
-		if err != nil {
-			return fmt.Errorf("failed to load Helm values: %w", err)
-		}
+		logger.Infof("🛬 Installation completed successfully. %s", greeting())
 
-		if err := validateGatewayAPIMigrationFlags(opt, helmValues); err != nil {
-			return err
-		}
+		return nil
+	})
+}
 
-		deployOptions := stack.DeployOptions{
-			HelmClient:                         helmClient,
-			HelmValues:                         helmValues,
-			KubermaticConfiguration:            kubermaticConfig,
-			RawKubermaticConfiguration:         rawKubermaticConfig,
-			StorageClassProvider:               opt.StorageClass,
-			ForceHelmReleaseUpgrade:            opt.Force,
-			ChartsDirectory:                    opt.ChartsDirectory,
-			EnableCertManagerV2Migration:       opt.MigrateCertManager,
-			SeparateSeed:                       opt.SeparateSeed,
-			EnableCertManagerUpstreamMigration: opt.MigrateUpstreamCertManager,
-			EnableNginxIngressMigration:        opt.MigrateNginx,
-			DisableTelemetry:                   opt.DisableTelemetry,
-			DisableDependencyUpdate:            opt.SkipDependencies,
-			AllowEditionChange:                 opt.AllowEditionChange,
-			MLASkipMinio:                       opt.MLASkipMinio,
-			MLASkipMinioLifecycleMgr:           opt.MLASkipMinioLifecycleMgr,
-			MLAForceSecrets:                    opt.MLAForceMLASecrets,
-			MLAIncludeIap:                      opt.MLAIncludeIap,
-			MLASkipLogging:                     opt.MLASkipLogging,
-			Versions:                           versions,
-			SkipCharts:                         opt.SkipCharts,
-			DeployDefaultAppCatalog:            opt.DeployDefaultAppCatalog,
-			DeployDefaultPolicyTemplateCatalog: opt.DeployDefaultPolicyTemplateCatalog,
-			SkipSeedValidation:                 opt.SkipSeedValidation,
-			MigrateToGatewayAPI:                opt.MigrateGatewayAPI,
-			SkipIngressCleanup:                 opt.SkipIngressCleanup,
-		}
+// connectDeployment loads the given configuration and connects to the cluster,
+// auto-detecting the KubermaticConfiguration if none was given. Neither the
+// configuration nor the cluster state are validated.
+func connectDeployment(appContext context.Context, logger *logrus.Logger, versions kubermatic.Versions, opt *DeployOptions, args []string) (stack.Stack, stack.DeployOptions, error) {
+	fields := logrus.Fields{
+		"version": versions.GitVersion,
+		"edition": versions.KubermaticEdition,
+	}
 
-		// prepare Kubernetes and Helm clients
-		ctrlConfig, err := ctrlruntimeconfig.GetConfigWithContext(opt.KubeContext)
-		if err != nil {
-			return fmt.Errorf("failed to get config: %w", err)
-		}
+	helmClient, err := setupHelmClient(logger, opt)
+	if err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to create the helm client: %w", err)
+	}
 
-		ctrlruntimelog.SetLogger(zapr.NewLogger(zap.NewNop()))
+	kubermaticStack, err := setupKubermaticStack(logger, args, opt)
+	if err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to define the stack: %w", err)
+	}
 
-		mgr, err := manager.New(ctrlConfig, manager.Options{
-			Metrics:                metricsserver.Options{BindAddress: "0"},
-			HealthProbeBindAddress: "0",
-		})
-		if err != nil {
-			return fmt.Errorf("failed to construct mgr: %w", err)
-		}
+	logger.WithFields(fields).Info("🚀 Initializing installer…")
 
-		if err := setupKubermaticInstallerScheme(mgr); err != nil {
-			return fmt.Errorf("failed to setup installer scheme: %w", err)
-		}
+	// load config files
+	if len(opt.Kubeconfig) == 0 {
+		return nil, stack.DeployOptions{}, errors.New("no kubeconfig (--kubeconfig or $KUBECONFIG) given")
+	}
 
-		// start the manager in its own goroutine
-		appContext := context.Background()
+	// this can result in both configs being nil, if no --config is given
+	kubermaticConfig, rawKubermaticConfig, err := loadKubermaticConfiguration(opt.Config)
+	if err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to load KubermaticConfiguration: %w", err)
+	}
 
-		go func() {
-			if err := mgr.Start(appContext); err != nil {
-				logger.Fatalf("Failed to start Kubernetes client manager: %v", err)
-			}
-		}()
-
-		// wait for caches to be synced
-		mgrSyncCtx, cancel := context.WithTimeout(appContext, 30*time.Second)
-		defer cancel()
-		if synced := mgr.GetCache().WaitForCacheSync(mgrSyncCtx); !synced {
-			logger.Fatal("Timed out while waiting for Kubernetes client caches to synchronize.")
-		}
+	helmValues, err := loadHelmValues(opt.HelmValues)
+	if err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to load Helm values: %w", err)
+	}
 
-		kubeClient := mgr.GetClient()
+	if err := validateGatewayAPIMigrationFlags(opt, helmValues); err != nil {
+		return nil, stack.DeployOptions{}, err
+	}
 
-		// try to auto-find the KubermaticConfiguration
-		if kubermaticConfig == nil {
-			kubermaticConfig, err = findKubermaticConfiguration(appContext, kubeClient, kubermaticmaster.KubermaticOperatorNamespace)
-			if err != nil {
-				return fmt.Errorf("failed to detect current KubermaticConfiguration: %w", err)
-			}
-		}
+	deployOptions := stack.DeployOptions{
+		HelmClient:                         helmClient,
+		HelmValues:                         helmValues,
+		KubermaticConfiguration:            kubermaticConfig,
+		RawKubermaticConfiguration:         rawKubermaticConfig,
+		StorageClassProvider:               opt.StorageClass,
+		ForceHelmReleaseUpgrade:            opt.Force,
+		ChartsDirectory:                    opt.ChartsDirectory,
+		EnableCertManagerV2Migration:       opt.MigrateCertManager,
+		SeparateSeed:                       opt.SeparateSeed,
+		EnableCertManagerUpstreamMigration: opt.MigrateUpstreamCertManager,
+		EnableNginxIngressMigration:        opt.MigrateNginx,
+		DisableTelemetry:                   opt.DisableTelemetry,
+		DisableDependencyUpdate:            opt.SkipDependencies,
+		AllowEditionChange:                 opt.AllowEditionChange,
+		MLASkipMinio:                       opt.MLASkipMinio,
+		MLASkipMinioLifecycleMgr:           opt.MLASkipMinioLifecycleMgr,
+		MLAForceSecrets:                    opt.MLAForceMLASecrets,
+		MLAIncludeIap:                      opt.MLAIncludeIap,
+		MLASkipLogging:                     opt.MLASkipLogging,
+		Versions:                           versions,
+		SkipCharts:                         opt.SkipCharts,
+		DeployDefaultAppCatalog:            opt.DeployDefaultAppCatalog,
+		DeployDefaultPolicyTemplateCatalog: opt.DeployDefaultPolicyTemplateCatalog,
+		SkipSeedValidation:                 opt.SkipSeedValidation,
+		MigrateToGatewayAPI:                opt.MigrateGatewayAPI,
+		SkipIngressCleanup:                 opt.SkipIngressCleanup,
+	}
 
-		// validate the configuration (in order to auto-fetch the config during upgrades,
-		// this validation has to happen after we connected to the cluster)
-		logger.Info("🚦 Validating the provided configuration…")
+	// prepare Kubernetes and Helm clients
+	ctrlConfig, err := ctrlruntimeconfig.GetConfigWithContext(opt.KubeContext)
+	if err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to get config: %w", err)
+	}
 
-		subLogger := log.Prefix(logrus.NewEntry(logger), "   ")
+	ctrlruntimelog.SetLogger(zapr.NewLogger(zap.NewNop()))
 
-		kubermaticConfig, helmValues, validationErrors := kubermaticStack.ValidateConfiguration(kubermaticConfig, helmValues, deployOptions, subLogger)
-		if len(validationErrors) > 0 {
-			logger.Error("⛔ The provided configuration files are invalid:")
+	mgr, err := manager.New(ctrlConfig, manager.Options{
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	})
+	if err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to construct mgr: %w", err)
+	}
 
-			for _, e := range validationErrors {
-				subLogger.Errorf("%v", e)
-			}
+	if err := setupKubermaticInstallerScheme(mgr); err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to setup installer scheme: %w", err)
+	}
 
-			return errors.New("please review your configuration and try again")
+	// start the manager in its own goroutine
+	go func() {
+		if err := mgr.Start(appContext); err != nil {
+			logger.Fatalf("Failed to start Kubernetes client manager: %v", err)
 		}
+	}()
 
-		logger.Info("✅ Provided configuration is valid.")
+	// wait for caches to be synced
+	mgrSyncCtx, cancel := context.WithTimeout(appContext, 30*time.Second)
+	defer cancel()
+	if synced := mgr.GetCache().WaitForCacheSync(mgrSyncCtx); !synced {
+		logger.Fatal("Timed out while waiting for Kubernetes client caches to synchronize.")
+	}
 
-		// prepare seed access components
-		seedsGetter, err := seedsGetterFactory(appContext, kubeClient)
-		if err != nil {
-			return fmt.Errorf("failed to create Seeds getter: %w", err)
-		}
+	kubeClient := mgr.GetClient()
 
-		seedKubeconfigGetter, err := seedKubeconfigGetterFactory(appContext, kubeClient)
+	// try to auto-find the KubermaticConfiguration
+	if kubermaticConfig == nil {
+		kubermaticConfig, err = findKubermaticConfiguration(appContext, kubeClient, kubermaticmaster.KubermaticOperatorNamespace)
 		if err != nil {
-			return fmt.Errorf("failed to create Seed kubeconfig getter: %w", err)
+			return nil, stack.DeployOptions{}, fmt.Errorf("failed to detect current KubermaticConfiguration: %w", err)
 		}
+	}
+
+	// prepare seed access components
+	seedsGetter, err := seedsGetterFactory(appContext, kubeClient)
+	if err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to create Seeds getter: %w", err)
+	}
+
+	seedKubeconfigGetter, err := seedKubeconfigGetterFactory(appContext, kubeClient)
+	if err != nil {
+		return nil, stack.DeployOptions{}, fmt.Errorf("failed to create Seed kubeconfig getter: %w", err)
+	}
+
+	deployOptions.KubermaticConfiguration = kubermaticConfig
+	deployOptions.HelmValues = helmValues
+	deployOptions.KubeClient = kubeClient
+	deployOptions.RestConfig = ctrlConfig
+	deployOptions.Logger = log.Prefix(logrus.NewEntry(logger), "   ")
+	deployOptions.SeedsGetter = seedsGetter
+	deployOptions.SeedClientGetter = kubernetesprovider.SeedClientGetterFactory(seedKubeconfigGetter)
+
+	return kubermaticStack, deployOptions, nil
+}
 
-		deployOptions.KubermaticConfiguration = kubermaticConfig
-		deployOptions.HelmValues = helmValues
-		deployOptions.KubeClient = kubeClient
-		deployOptions.RestConfig = ctrlConfig
-		deployOptions.Logger = subLogger
-		deployOptions.SeedsGetter = seedsGetter
-		deployOptions.SeedClientGetter = kubernetesprovider.SeedClientGetterFactory(seedKubeconfigGetter)
+// setupDeployment loads and validates the given configuration, connects to
+// the cluster and runs the preflight checks for the chosen stack. The returned
+// options are ready to be used to deploy the stack.
+func setupDeployment(appContext context.Context, logger *logrus.Logger, versions kubermatic.Versions, opt *DeployOptions, args []string) (stack.Stack, stack.DeployOptions, error) {
+	kubermaticStack, deployOptions, err := connectDeployment(appContext, logger, versions, opt, args)
+	if err != nil {
+		return nil, stack.DeployOptions{}, err
+	}
 
-		logger.Info("🚦 Validating existing installation…")
+	// validate the configuration (in order to auto-fetch the config during upgrades,
+	// this validation has to happen after we connected to the cluster)
+	logger.Info("🚦 Validating the provided configuration…")
 
-		if errs := kubermaticStack.ValidateState(appContext, deployOptions); len(errs) > 0 {
-			logger.Error("⛔ Cannot proceed with the installation:")
+	subLogger := deployOptions.Logger
 
-			for _, e := range errs {
-				subLogger.Errorf("%v", e)
-			}
+	kubermaticConfig, helmValues, validationErrors := kubermaticStack.ValidateConfiguration(deployOptions.KubermaticConfiguration, deployOptions.HelmValues, deployOptions, subLogger)
+	if len(validationErrors) > 0 {
+		logger.Error("⛔ The provided configuration files are invalid:")
 
-			return errors.New("preflight checks have failed")
+		for _, e := range validationErrors {
+			subLogger.Errorf("%v", e)
 		}
 
-		logger.Info("✅ Existing installation is valid.")
+		return nil, stack.DeployOptions{}, errors.New("please review your configuration and try again")
+	}
 
-		logger.Infof("🛫 Deploying %s…", kubermaticStack.Name())
+	logger.Info("✅ Provided configuration is valid.")
 
-		if err := kubermaticStack.Deploy(appContext, deployOptions); err != nil {
-			return err
+	deployOptions.KubermaticConfiguration = kubermaticConfig
+	deployOptions.HelmValues = helmValues
+
+	logger.Info("🚦 Validating existing installation…")
+
+	if errs := kubermaticStack.ValidateState(appContext, deployOptions); len(errs) > 0 {
+		logger.Error("⛔ Cannot proceed with the installation:")
+
+		for _, e := range errs {
+			subLogger.Errorf("%v", e)
 		}
 
-		logger.Infof("🛬 Installation completed successfully. %s", greeting())
+		return nil, stack.DeployOptions{}, errors.New("preflight checks have failed")
+	}
 
-		return nil
-	})
+	logger.Info("✅ Existing installation is valid.")
+
+	return kubermaticStack, deployOptions, nil
 }
 
 func greeting() string {
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"k8c.io/kubermatic/v2/pkg/install/doctor"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+)
+
+// errUnhealthy is returned when at least one check has failed, so that the
+// installer exits with a non-zero code.
+var errUnhealthy = errors.New("the installation has problems")
+
+type DoctorOptions struct {
+	DeployOptions
+
+	Format                   string
+	CertificateWarningPeriod time.Duration
+	StuckClusterThreshold    time.Duration
+}
+
+func DoctorCommand(logger *logrus.Logger, versions kubermatic.Versions) *cobra.Command {
+	opt := DoctorOptions{
+		DeployOptions:            newDeployOptions(),
+		Format:                   planFormatText,
+		CertificateWarningPeriod: 30 * 24 * time.Hour,
+		StuckClusterThreshold:    time.Hour,
+	}
+
+	cmd := &cobra.Command{
+		Use:          "doctor [kubermatic-master | kubermatic-seed | seed-mla | usercluster-mla]",
+		Short:        "Examine an existing installation for problems",
+		Long:         "Runs the preflight checks of a stack and additional health checks (seed connectivity, certificates, CRD versions, webhooks, user clusters and etcd backups) against the master and all seed clusters without changing anything. Exits with a non-zero code if any check has failed.",
+		RunE:         DoctorFunc(logger, versions, &opt),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opt.loadEnvironment()
+
+			if opt.Format != planFormatText && opt.Format != planFormatJSON {
+				return fmt.Errorf("invalid --format %q, must be one of %s, %s", opt.Format, planFormatText, planFormatJSON)
+			}
+
+			return nil
+		},
+	}
+
+	opt.addFlags(cmd)
+
+	cmd.PersistentFlags().StringVar(&opt.Format, "format", opt.Format, fmt.Sprintf("output format (one of %s, %s)", planFormatText, planFormatJSON))
+	cmd.PersistentFlags().DurationVar(&opt.CertificateWarningPeriod, "certificate-warning-period", opt.CertificateWarningPeriod, "warn about certificates that expire within this period")
+	cmd.PersistentFlags().DurationVar(&opt.StuckClusterThreshold, "stuck-cluster-threshold", opt.StuckClusterThreshold, "report user clusters that are being deleted or unhealthy for longer than this")
+
+	return cmd
+}
+
+func DoctorFunc(logger *logrus.Logger, versions kubermatic.Versions, opt *DoctorOptions) cobraFuncE {
+	return handleErrors(logger, func(cmd *cobra.Command, args []string) error {
+		// keep stdout clean for the report
+		logger.SetOutput(os.Stderr)
+
+		appContext := context.Background()
+
+		kubermaticStack, deployOptions, err := connectDeployment(appContext, logger, versions, &opt.DeployOptions, args)
+		if err != nil {
+			return err
+		}
+
+		logger.Infof("🩺 Examining %s…", kubermaticStack.Name())
+
+		report := doctor.Run(appContext, kubermaticStack, deployOptions, doctor.Options{
+			CertificateWarningPeriod: opt.CertificateWarningPeriod,
+			StuckClusterThreshold:    opt.StuckClusterThreshold,
+		})
+
+		switch opt.Format {
+		case planFormatJSON:
+			err = report.WriteJSON(os.Stdout)
+		default:
+			err = report.WriteText(os.Stdout)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+
+		if report.HasErrors() {
+			return errUnhealthy
+		}
+
+		logger.Info("✅ No problems found.")
+
+		return nil
+	})
+}
@@ -353,44 +353,52 @@ func MirrorBinariesFunc(logger *logrus.Logger, options *MirrorBinariesOptions) c
 			return fmt.Errorf("failed to get KubermaticConfiguration: %w", err)
 		}
 
-		// Extract all Kubernetes versions from the configuration.
-		versions, err := images.GetVersions(logger, kubermaticConfig, options.VersionFilter)
-		if err != nil {
-			return fmt.Errorf("failed to load versions: %w", err)
+		if err := mirrorBinaries(ctx, logger, kubermaticConfig, options.VersionFilter, archList, options.OutputDir); err != nil {
+			return err
 		}
+		logger.Info("✅ Finished loading images.")
 
-		logger.Debugf("Found %d Kubernetes version(s) in the configuration.", len(versions))
+		return nil
+	})
+}
 
-		binPath := options.OutputDir
+// mirrorBinaries downloads the binaries for all Kubernetes versions in the
+// configuration and all given architectures into binPath.
+func mirrorBinaries(ctx context.Context, logger *logrus.Logger, kubermaticConfig *kubermaticv1.KubermaticConfiguration, versionFilter string, archList []string, binPath string) error {
+	// Extract all Kubernetes versions from the configuration.
+	versions, err := images.GetVersions(logger, kubermaticConfig, versionFilter)
+	if err != nil {
+		return fmt.Errorf("failed to load versions: %w", err)
+	}
 
-		for _, arch := range archList {
-			logger.Infof("🚀 Starting mirroring for architecture: %s", arch)
-			logger.Debugf("⏳ Starting CNI plugins download for %s...", arch)
-			if err := downloadCNIPlugins(ctx, logger, binPath, arch); err != nil {
-				return fmt.Errorf("failed to download CNI plugins for %s: %w", arch, err)
-			}
-			logger.Infof("✅ CNI plugins download complete for %s.", arch)
+	logger.Debugf("Found %d Kubernetes version(s) in the configuration.", len(versions))
 
-			logger.Debugf("⏳ Starting CRI tools download for all available Kubernetes versions (%s)...", arch)
-			for _, version := range versions {
-				if err := downloadCRITools(ctx, logger, *version.Version, binPath, arch); err != nil {
-					return fmt.Errorf("failed to download CRI tools for Kubernetes version %s (%s): %w", version.Version, arch, err)
-				}
+	for _, arch := range archList {
+		logger.Infof("🚀 Starting mirroring for architecture: %s", arch)
+		logger.Debugf("⏳ Starting CNI plugins download for %s...", arch)
+		if err := downloadCNIPlugins(ctx, logger, binPath, arch); err != nil {
+			return fmt.Errorf("failed to download CNI plugins for %s: %w", arch, err)
+		}
+		logger.Infof("✅ CNI plugins download complete for %s.", arch)
+
+		logger.Debugf("⏳ Starting CRI tools download for all available Kubernetes versions (%s)...", arch)
+		for _, version := range versions {
+			if err := downloadCRITools(ctx, logger, *version.Version, binPath, arch); err != nil {
+				return fmt.Errorf("failed to download CRI tools for Kubernetes version %s (%s): %w", version.Version, arch, err)
 			}
-			logger.Infof("✅ CRI tools download complete for all available Kubernetes versions (%s).", arch)
+		}
+		logger.Infof("✅ CRI tools download complete for all available Kubernetes versions (%s).", arch)
 
-			logger.Debugf("⏳ Starting kube binaries download for all available Kubernetes versions (%s)...", arch)
-			for _, version := range versions {
-				if err := downloadKubeBinaries(ctx, logger, version, binPath, arch); err != nil {
-					return fmt.Errorf("failed to download kube binaries for Kubernetes version %s (%s): %w", version.Version, arch, err)
-				}
+		logger.Debugf("⏳ Starting kube binaries download for all available Kubernetes versions (%s)...", arch)
+		for _, version := range versions {
+			if err := downloadKubeBinaries(ctx, logger, version, binPath, arch); err != nil {
+				return fmt.Errorf("failed to download kube binaries for Kubernetes version %s (%s): %w", version.Version, arch, err)
 			}
-			logger.Infof("✅ Kube binaries download complete for all available Kubernetes versions (%s).", arch)
 		}
-		logger.Info("✅ Finished loading images.")
+		logger.Infof("✅ Kube binaries download complete for all available Kubernetes versions (%s).", arch)
+	}
 
-		return nil
-	})
+	return nil
 }
 
 // Ensure the directory exists without deleting it if it already exists.
@@ -319,16 +319,6 @@ func mirrorImages(ctx context.Context, logger *logrus.Logger, versions kubermati
 		return fmt.Errorf("failed to get KubermaticConfiguration: %w", err)
 	}
 
-	clusterVersions, err := images.GetVersions(logger, kubermaticConfig, options.VersionFilter)
-	if err != nil {
-		return fmt.Errorf("failed to load versions: %w", err)
-	}
-
-	caBundle, err := certificates.NewCABundleFromFile(filepath.Join(options.ChartsDirectory, "kubermatic-operator/static/ca-bundle.pem"))
-	if err != nil {
-		return fmt.Errorf("failed to load CA bundle: %w", err)
-	}
-
 	if options.AddonsPath == "" {
 		options.AddonsPath, err = getAddonsPath(ctx, logger, options, kubermaticConfig)
 		if err != nil {
@@ -337,15 +327,36 @@ func mirrorImages(ctx context.Context, logger *logrus.Logger, versions kubermati
 		defer os.RemoveAll(options.AddonsPath)
 	}
 
+	imageSet, err := collectImages(ctx, logger, versions, kubermaticConfig, options)
+	if err != nil {
+		return err
+	}
+
+	return archiveOrCopyImages(ctx, logger, imageSet, options, userAgent)
+}
+
+// collectImages returns all images required for the given configuration.
+// options.AddonsPath must point to a local directory containing the addons.
+func collectImages(ctx context.Context, logger *logrus.Logger, versions kubermaticversion.Versions, kubermaticConfig *kubermaticv1.KubermaticConfiguration, options *MirrorImagesOptions) (sets.Set[string], error) {
+	clusterVersions, err := images.GetVersions(logger, kubermaticConfig, options.VersionFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load versions: %w", err)
+	}
+
+	caBundle, err := certificates.NewCABundleFromFile(filepath.Join(options.ChartsDirectory, "kubermatic-operator/static/ca-bundle.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load CA bundle: %w", err)
+	}
+
 	allAddons, err := addonutil.LoadAddonsFromDirectory(options.AddonsPath)
 	if err != nil {
-		return fmt.Errorf("failed to load addons: %w", err)
+		return nil, fmt.Errorf("failed to load addons: %w", err)
 	}
 
 	// Parse and validate the provider filter
 	providerFilter, err := parseProviderFilter(options.ProviderFilter)
 	if err != nil {
-		return fmt.Errorf("failed to parse provider filter: %w", err)
+		return nil, fmt.Errorf("failed to parse provider filter: %w", err)
 	}
 
 	// Filter cloud specs based on the provider filter
@@ -364,7 +375,7 @@ func mirrorImages(ctx context.Context, logger *logrus.Logger, versions kubermati
 
 	imageList, err := CollectImageMatrix(logger, clusterVersions, kubermaticConfig, allAddons, versions, caBundle, options.RegistryPrefix, cloudSpecs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	imageSet.Insert(imageList...)
 
@@ -377,20 +388,20 @@ func mirrorImages(ctx context.Context, logger *logrus.Logger, versions kubermati
 	// if we have a charts directory, we try to render the charts and add the images to our list
 	helmChartImages, err := collectHelmChartImages(ctx, logger, kubermaticConfig, clusterVersions, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	imageSet.Insert(sets.List(helmChartImages)...)
 
 	// get images from system and default applications
 	applicationImages, err := collectApplicationImages(logger, kubermaticConfig, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	imageSet.Insert(sets.List(applicationImages)...)
 
 	// finally, add some static images that are not covered by any of the above
 	imageSet.Insert(staticImages()...)
-	return archiveOrCopyImages(ctx, logger, imageSet, options, userAgent)
+	return imageSet, nil
 }
 
 func collectHelmChartImages(ctx context.Context, logger *logrus.Logger, kubermaticConfig *kubermaticv1.KubermaticConfiguration, clusterVersions []*version.Version, options *MirrorImagesOptions) (sets.Set[string], error) {
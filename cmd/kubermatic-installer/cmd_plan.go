@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"k8c.io/kubermatic/v2/pkg/install/plan"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+)
+
+const (
+	planFormatText = "text"
+	planFormatJSON = "json"
+)
+
+// errDrift is returned when the plan contains changes, so that the
+// installer exits with a non-zero code.
+var errDrift = errors.New("the cluster does not match the desired state")
+
+type PlanOptions struct {
+	DeployOptions
+
+	Format string
+}
+
+func PlanCommand(logger *logrus.Logger, versions kubermatic.Versions) *cobra.Command {
+	opt := PlanOptions{
+		DeployOptions: newDeployOptions(),
+		Format:        planFormatText,
+	}
+
+	cmd := &cobra.Command{
+		Use:          "plan [kubermatic-master | kubermatic-seed | seed-mla | usercluster-mla]",
+		Short:        "Show the changes that deploy would make to the current installation",
+		Long:         "Renders all charts of a stack with the given configuration and compares them to the Helm releases, resources and CRDs in the cluster. Exits with a non-zero code if deploying would change the cluster.",
+		RunE:         PlanFunc(logger, versions, &opt),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			opt.loadEnvironment()
+
+			if opt.Format != planFormatText && opt.Format != planFormatJSON {
+				return fmt.Errorf("invalid --format %q, must be one of %s, %s", opt.Format, planFormatText, planFormatJSON)
+			}
+
+			return nil
+		},
+	}
+
+	opt.addFlags(cmd)
+
+	cmd.PersistentFlags().StringVar(&opt.Format, "format", opt.Format, fmt.Sprintf("output format (one of %s, %s)", planFormatText, planFormatJSON))
+
+	return cmd
+}
+
+func PlanFunc(logger *logrus.Logger, versions kubermatic.Versions, opt *PlanOptions) cobraFuncE {
+	return handleErrors(logger, func(cmd *cobra.Command, args []string) error {
+		// keep stdout clean for the report
+		logger.SetOutput(os.Stderr)
+
+		appContext := context.Background()
+
+		kubermaticStack, deployOptions, err := setupDeployment(appContext, logger, versions, &opt.DeployOptions, args)
+		if err != nil {
+			return err
+		}
+
+		logger.Infof("🔍 Planning %s…", kubermaticStack.Name())
+
+		report, err := plan.Build(appContext, kubermaticStack, deployOptions)
+		if err != nil {
+			return err
+		}
+
+		switch opt.Format {
+		case planFormatJSON:
+			err = report.WriteJSON(os.Stdout)
+		default:
+			err = report.WriteText(os.Stdout)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write plan: %w", err)
+		}
+
+		if report.HasDrift() {
+			return errDrift
+		}
+
+		logger.Info("✅ The cluster is up-to-date.")
+
+		return nil
+	})
+}
@@ -37,10 +37,13 @@ func addCommands(cmd *cobra.Command, logger *logrus.Logger, versions kubermatic.
 	cmd.AddCommand(
 		ConvertKubeconfigCommand(logger),
 		DeployCommand(logger, versions),
+		PlanCommand(logger, versions),
+		DoctorCommand(logger, versions),
 		PrintCommand(),
 		VersionCommand(logger, versions),
 		MirrorImagesCommand(logger, versions),
 		MirrorBinariesCommand(logger, versions),
+		BundleCommand(logger, versions),
 		LocalCommand(logger),
 	)
 }
@@ -156,7 +156,7 @@ func main() {
 	// setup Cluster webhooks
 
 	// validation webhook can already use ctrl-runtime boilerplate
-	clusterValidator := clustervalidation.NewValidator(mgr.GetClient(), seedGetter, configGetter, options.featureGates, caPool)
+	clusterValidator := clustervalidation.NewValidator(mgr.GetClient(), seedGetter, configGetter, options.featureGates, caPool, options.namespace)
 	if err := builder.WebhookManagedBy(mgr, &kubermaticv1.Cluster{}).WithValidator(clusterValidator).Complete(); err != nil {
 		log.Fatalw("Failed to setup cluster validation webhook", zap.Error(err))
 	}
@@ -55,6 +55,9 @@ func main() {
 	flag.DurationVar(&ctrlOpts.UpstreamTCPKeepaliveTime, "upstream-tcp-keepalive-time", 0, "Idle time before sending TCP keepalive probes on upstream cluster sockets. Set to 0 to leave unset; keepalive is configured only when at least one upstream keepalive option is set.")
 	flag.DurationVar(&ctrlOpts.UpstreamTCPKeepaliveProbeInterval, "upstream-tcp-keepalive-interval", 0, "Interval between TCP keepalive probes on upstream cluster sockets. Set to 0 to leave unset; keepalive is configured only when at least one upstream keepalive option is set.")
 	flag.IntVar(&ctrlOpts.UpstreamTCPKeepaliveProbeAttempts, "upstream-tcp-keepalive-probes", 0, "Maximum unanswered TCP keepalive probes on upstream cluster sockets before considering a connection dead. Set to 0 to leave unset; keepalive is configured only when at least one upstream keepalive option is set.")
+	flag.IntVar(&ctrlOpts.MaxConnections, "max-connections", 0, "Maximum number of concurrent connections per exposed service port. Can be overridden per service using the "+nodeportproxy.MaxConnectionsAnnotationKey+" annotation. Set to 0 to disable the limit.")
+	flag.IntVar(&ctrlOpts.ConnectionRate, "connection-rate", 0, "Number of new connections per second accepted per exposed service port. Can be overridden per service using the "+nodeportproxy.ConnectionRateAnnotationKey+" annotation. Set to 0 to disable rate limiting.")
+	flag.IntVar(&ctrlOpts.ConnectionBurst, "connection-burst", 0, "Number of new connections per exposed service port that can be accepted at once before the connection rate is enforced. Can be overridden per service using the "+nodeportproxy.ConnectionBurstAnnotationKey+" annotation. Defaults to the connection rate.")
 	flag.StringVar(&ctrlOpts.Namespace, "namespace", "", "The namespace we should use for pods and services. Leave empty for all namespaces.")
 	flag.StringVar(&ctrlOpts.ExposeAnnotationKey, "expose-annotation-key", nodeportproxy.DefaultExposeAnnotationKey, "The annotation key used to determine if a service should be exposed")
 	flag.Parse()
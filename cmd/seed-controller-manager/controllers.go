@@ -17,10 +17,12 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	addonutil "k8c.io/kubermatic/v2/pkg/addon"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/addon"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/addoninstaller"
@@ -29,6 +31,7 @@ import (
 	autoupdatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/auto-update-controller"
 	cloudcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cloud"
 	clustercredentialscontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-credentials-controller"
+	clusterdeletiondryruncontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-deletion-dry-run-controller"
 	clusterphasecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-phase-controller"
 	clusterstuckcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-stuck-controller"
 	clustertemplatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cluster-template-controller"
@@ -52,7 +55,11 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/pvwatcher"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/seedresourcesuptodatecondition"
 	updatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/update-controller"
+	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/features"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud"
+	"k8c.io/machine-controller/sdk/providerconfig"
 )
 
 // AllControllers stores the list of all controllers that we want to run,
@@ -83,6 +90,7 @@ var AllControllers = map[string]controllerCreator{
 	encryptionatrestcontroller.ControllerName:               createEncryptionAtRestController,
 	ipam.ControllerName:                                     createIPAMController,
 	clusterstuckcontroller.ControllerName:                   createClusterStuckController,
+	clusterdeletiondryruncontroller.ControllerName:          createClusterDeletionDryRunController,
 	operatingsystemprofilesynchronizer.ControllerName:       createOperatingSystemProfileController,
 	defaultapplicationcontroller.ControllerName:             createDefaultApplicationController,
 	clustercredentialscontroller.ControllerName:             createClusterCredentialsController,
@@ -206,6 +214,8 @@ func createEtcdRestoreController(ctrlCtx *controllerContext) error {
 		ctrlCtx.runOptions.workerName,
 		ctrlCtx.versions,
 		ctrlCtx.seedGetter,
+		ctrlCtx.runOptions.etcdLauncherImage,
+		ctrlCtx.runOptions.overwriteRegistry,
 	)
 }
 
@@ -407,9 +417,44 @@ func createPresetController(ctrlCtx *controllerContext) error {
 		ctrlCtx.log,
 		ctrlCtx.runOptions.workerName,
 		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.secretStores,
+		presetCredentialsValidator(ctrlCtx),
 	)
 }
 
+// presetCredentialsValidator validates rotated preset credentials against the cloud provider of a cluster.
+func presetCredentialsValidator(ctrlCtx *controllerContext) presetcontroller.CredentialsValidator {
+	return func(ctx context.Context, cluster *kubermaticv1.Cluster, credentials map[string][]byte) error {
+		seed, err := ctrlCtx.seedGetter()
+		if err != nil {
+			return fmt.Errorf("failed to get Seed: %w", err)
+		}
+
+		datacenter, fieldErr := defaulting.DatacenterForClusterSpec(&cluster.Spec, seed)
+		if fieldErr != nil {
+			return fieldErr
+		}
+
+		// the credentials are not stored yet, so they are resolved from the given data
+		// instead of the credential Secret of the cluster
+		storedSecretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, ctrlCtx.mgr.GetClient())
+		secretKeySelector := func(configVar *providerconfig.GlobalSecretKeySelector, key string) (string, error) {
+			if value, ok := credentials[key]; ok {
+				return string(value), nil
+			}
+
+			return storedSecretKeySelector(configVar, key)
+		}
+
+		cloudProvider, err := cloud.Provider(datacenter, secretKeySelector, ctrlCtx.runOptions.caBundle.CertPool())
+		if err != nil {
+			return err
+		}
+
+		return cloudProvider.ValidateCloudSpec(ctx, cluster.Spec.Cloud)
+	}
+}
+
 func createEncryptionAtRestController(ctrlCtx *controllerContext) error {
 	return encryptionatrestcontroller.Add(
 		ctrlCtx.mgr,
@@ -447,6 +492,17 @@ func createClusterStuckController(ctrlCtx *controllerContext) error {
 	)
 }
 
+func createClusterDeletionDryRunController(ctrlCtx *controllerContext) error {
+	return clusterdeletiondryruncontroller.Add(
+		ctrlCtx.mgr,
+		ctrlCtx.runOptions.workerCount,
+		ctrlCtx.runOptions.workerName,
+		ctrlCtx.clientProvider,
+		ctrlCtx.log,
+		ctrlCtx.versions,
+	)
+}
+
 func createOperatingSystemProfileController(ctrlCtx *controllerContext) error {
 	return operatingsystemprofilesynchronizer.Add(
 		ctrlCtx.mgr,
@@ -480,6 +536,7 @@ func createClusterCredentialsController(ctrlCtx *controllerContext) error {
 		ctrlCtx.log,
 		ctrlCtx.versions,
 		ctrlCtx.runOptions.namespace,
+		ctrlCtx.secretStores,
 	)
 }
 
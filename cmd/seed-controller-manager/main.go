@@ -40,6 +40,7 @@ import (
 	metricserver "k8c.io/kubermatic/v2/pkg/metrics/server"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider/secretstore"
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
 	"k8c.io/kubermatic/v2/pkg/util/cli"
 	"k8c.io/kubermatic/v2/pkg/util/flagopts"
@@ -222,6 +223,7 @@ Please install the VerticalPodAutoscaler according to the documentation: https:/
 		dockerPullConfigJSON: dockerPullConfigJSON,
 		log:                  log,
 		versions:             versions,
+		secretStores:         secretstore.NewResolver(mgr.GetClient(), options.caBundle.CertPool()),
 	}
 
 	if err := createAllControllers(ctrlCtx); err != nil {
@@ -246,6 +248,10 @@ Please install the VerticalPodAutoscaler according to the documentation: https:/
 		log.Debug("Starting addons collector")
 		collectors.MustRegisterAddonCollector(prometheus.DefaultRegisterer, ctrlCtx.mgr.GetAPIReader())
 	}
+	if !slices.Contains(disabledCollectors, string(kubermaticv1.IPAMPoolCollector)) {
+		log.Debug("Starting IPAM pools collector")
+		collectors.MustRegisterIPAMPoolCollector(prometheus.DefaultRegisterer, ctrlCtx.mgr.GetAPIReader())
+	}
 	if !slices.Contains(disabledCollectors, string(kubermaticv1.ProjectCollector)) {
 		// The canonical source of projects is the master cluster, but since they are replicated onto
 		// seeds, we start the project collctor on seed clusters as well, just for convenience for the admin.
@@ -127,7 +127,7 @@ func main() {
 	applicationinstallationmutation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient()).SetupWebhookWithManager(seedMgr)
 
 	// Setup the validation admission handler for ApplicationInstallation CRDs in seed manager.
-	applicationinstallationvalidation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient(), options.clusterName).SetupWebhookWithManager(seedMgr)
+	applicationinstallationvalidation.NewAdmissionHandler(log, seedMgr.GetScheme(), seedMgr.GetClient(), userMgr.GetClient(), options.clusterName).SetupWebhookWithManager(seedMgr)
 
 	// Setup Machine Webhook in user manager.
 	machineValidator, err := machinevalidation.NewValidator(seedMgr.GetClient(), userMgr.GetClient(), log, options.caBundle, options.projectID)
@@ -138,6 +138,15 @@ func main() {
 		log.Fatalw("Failed to setup Machine validation webhook", zap.Error(err))
 	}
 
+	// Setup MachineDeployment Webhook in user manager.
+	machineDeploymentValidator, err := machinevalidation.NewMachineDeploymentValidator(seedMgr.GetClient(), userMgr.GetClient(), log, options.caBundle, options.projectID)
+	if err != nil {
+		log.Fatalw("Failed to setup MachineDeployment validator", zap.Error(err))
+	}
+	if err := builder.WebhookManagedBy(userMgr, &clusterv1alpha1.MachineDeployment{}).WithValidator(machineDeploymentValidator).Complete(); err != nil {
+		log.Fatalw("Failed to setup MachineDeployment validation webhook", zap.Error(err))
+	}
+
 	// /////////////////////////////////////////
 	// Start managers
 
@@ -117,11 +117,16 @@ func NewTemplateData(
 	if ipamAllocations != nil {
 		ipamAllocationsData = make(map[string]IPAMAllocation, len(ipamAllocations.Items))
 		for _, ipamAllocation := range ipamAllocations.Items {
-			ipamAllocationsData[ipamAllocation.Name] = IPAMAllocation{
+			data := IPAMAllocation{
 				Type:      ipamAllocation.Spec.Type,
 				CIDR:      ipamAllocation.Spec.CIDR,
 				Addresses: ipamAllocation.Spec.Addresses,
 			}
+			if ipv6 := ipamAllocation.Spec.IPv6; ipv6 != nil {
+				data.IPv6CIDR = ipv6.CIDR
+				data.IPv6Addresses = ipv6.Addresses
+			}
+			ipamAllocationsData[ipamAllocation.Name] = data
 		}
 	}
 
@@ -289,6 +294,9 @@ type IPAMAllocation struct {
 	Type      kubermaticv1.IPAMPoolAllocationType
 	CIDR      kubermaticv1.SubnetCIDR
 	Addresses []string
+	// IPv6CIDR and IPv6Addresses are only set for dual-stack allocations.
+	IPv6CIDR      kubermaticv1.SubnetCIDR
+	IPv6Addresses []string
 }
 
 type CNIPlugin struct {
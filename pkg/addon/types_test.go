@@ -80,6 +80,18 @@ func TestNewTemplateData(t *testing.T) {
 					Addresses: []string{"192.168.0.1-192.168.0.8", "192.168.0.10-192.168.0.17"},
 				},
 			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ipam-pool-3",
+				},
+				Spec: kubermaticv1.IPAMAllocationSpec{
+					Type: "prefix",
+					CIDR: "192.168.1.0/28",
+					IPv6: &kubermaticv1.IPAMAllocationFamily{
+						CIDR: "2001:db8::/64",
+					},
+				},
+			},
 		},
 	}
 
@@ -103,6 +115,11 @@ func TestNewTemplateData(t *testing.T) {
 			Type:      "range",
 			Addresses: []string{"192.168.0.1-192.168.0.8", "192.168.0.10-192.168.0.17"},
 		},
+		"ipam-pool-3": {
+			Type:     "prefix",
+			CIDR:     "192.168.1.0/28",
+			IPv6CIDR: "2001:db8::/64",
+		},
 	}, templateData.Cluster.Network.IPAMAllocations)
 }
 
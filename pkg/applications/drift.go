@@ -0,0 +1,227 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications/providers/template"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DetectDrift compares the desired objects with the live objects in the user cluster and returns the ones which
+// differ. Only fields set in the desired objects are compared, so fields defaulted by the API server or added by other
+// controllers are not reported as drift. Apart from labels and annotations, the metadata and the status of the objects
+// are ignored.
+func DetectDrift(ctx context.Context, userClient ctrlruntimeclient.Client, desired []*unstructured.Unstructured) ([]appskubermaticv1.DriftedResource, error) {
+	var drifted []appskubermaticv1.DriftedResource
+
+	for _, obj := range desired {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+
+		if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), live); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				drifted = append(drifted, appskubermaticv1.DriftedResource{
+					AppliedResource: resourceReference(obj),
+					Reason:          appskubermaticv1.DriftReasonMissing,
+				})
+				continue
+			}
+			return nil, fmt.Errorf("failed to get %s %q: %w", obj.GetKind(), ctrlruntimeclient.ObjectKeyFromObject(obj), err)
+		}
+
+		if field := diffValue("", comparableContent(obj), comparableContent(live)); field != "" {
+			drifted = append(drifted, appskubermaticv1.DriftedResource{
+				AppliedResource: resourceReference(obj),
+				Reason:          appskubermaticv1.DriftReasonModified,
+				Field:           field,
+			})
+		}
+	}
+
+	return drifted, nil
+}
+
+// CorrectDrift server-side applies the desired objects which are listed in drifted into the user cluster. Fields which
+// have been changed by other field managers are taken over.
+func CorrectDrift(ctx context.Context, userClient ctrlruntimeclient.Client, desired []*unstructured.Unstructured, drifted []appskubermaticv1.DriftedResource) error {
+	for _, obj := range desired {
+		ref := resourceReference(obj)
+		if !slices.ContainsFunc(drifted, func(res appskubermaticv1.DriftedResource) bool { return res.AppliedResource == ref }) {
+			continue
+		}
+
+		if err := userClient.Apply(ctx, ctrlruntimeclient.ApplyConfigurationFromUnstructured(obj.DeepCopy()), ctrlruntimeclient.FieldOwner(template.ApplicationFieldOwner), ctrlruntimeclient.ForceOwnership); err != nil {
+			return fmt.Errorf("failed to apply %s %q: %w", obj.GetKind(), ctrlruntimeclient.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceReference(obj *unstructured.Unstructured) appskubermaticv1.AppliedResource {
+	return appskubermaticv1.AppliedResource{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// comparableContent returns the content of obj without the fields which are ignored when detecting drift. The
+// stringData of Secrets is merged into their data, as the API server does.
+func comparableContent(obj *unstructured.Unstructured) map[string]any {
+	content := obj.DeepCopy().Object
+	delete(content, "apiVersion")
+	delete(content, "kind")
+	delete(content, "status")
+
+	metadata := map[string]any{}
+	for _, field := range []string{"labels", "annotations"} {
+		if value, ok, _ := unstructured.NestedFieldCopy(obj.Object, "metadata", field); ok {
+			metadata[field] = value
+		}
+	}
+	content["metadata"] = metadata
+
+	if obj.GetAPIVersion() == "v1" && obj.GetKind() == "Secret" {
+		if stringData, ok := content["stringData"].(map[string]any); ok {
+			data, _ := content["data"].(map[string]any)
+			if data == nil {
+				data = make(map[string]any, len(stringData))
+			}
+			for key, value := range stringData {
+				data[key] = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(value)))
+			}
+			content["data"] = data
+			delete(content, "stringData")
+		}
+	}
+
+	return content
+}
+
+// diffValue returns the path of the first field set in desired which differs in live, or an empty string if all
+// fields set in desired are equal in live. Lists must have the same length and their items are compared in order.
+func diffValue(path string, desired, live any) string {
+	switch desiredValue := desired.(type) {
+	case nil:
+		return ""
+
+	case map[string]any:
+		liveValue, ok := live.(map[string]any)
+		if !ok {
+			if len(desiredValue) == 0 && live == nil {
+				return ""
+			}
+			return path
+		}
+		for _, key := range slices.Sorted(maps.Keys(desiredValue)) {
+			if field := diffValue(fieldPath(path, key), desiredValue[key], liveValue[key]); field != "" {
+				return field
+			}
+		}
+		return ""
+
+	case []any:
+		liveValue, ok := live.([]any)
+		if !ok {
+			if len(desiredValue) == 0 && live == nil {
+				return ""
+			}
+			return path
+		}
+		if len(desiredValue) != len(liveValue) {
+			return path
+		}
+		for i := range desiredValue {
+			if field := diffValue(fmt.Sprintf("%s[%d]", path, i), desiredValue[i], liveValue[i]); field != "" {
+				return field
+			}
+		}
+		return ""
+
+	default:
+		if !scalarEqual(desired, live) {
+			return path
+		}
+		return ""
+	}
+}
+
+func fieldPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// scalarEqual compares two scalar values. Numbers are compared independently of their type and strings are compared
+// as quantities if possible, because the API server normalizes quantities (e.g. "1000m" to "1" or 1 to "1").
+func scalarEqual(desired, live any) bool {
+	if desired == live {
+		return true
+	}
+
+	desiredNumber, desiredIsNumber := toFloat(desired)
+	liveNumber, liveIsNumber := toFloat(live)
+	if desiredIsNumber && liveIsNumber {
+		return desiredNumber == liveNumber
+	}
+
+	_, desiredIsString := desired.(string)
+	_, liveIsString := live.(string)
+	if (desiredIsString || desiredIsNumber) && (liveIsString || liveIsNumber) {
+		desiredQuantity, err := resource.ParseQuantity(fmt.Sprint(desired))
+		if err != nil {
+			return false
+		}
+		liveQuantity, err := resource.ParseQuantity(fmt.Sprint(live))
+		if err != nil {
+			return false
+		}
+		return desiredQuantity.Cmp(liveQuantity) == 0
+	}
+
+	return false
+}
+
+func toFloat(value any) (float64, bool) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), true
+	case int32:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case float64:
+		return number, true
+	default:
+		return 0, false
+	}
+}
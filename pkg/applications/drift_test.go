@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"testing"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const desiredDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+  labels:
+    app: app
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app:1.0.0
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 1Gi
+`
+
+func liveDeployment(modify func(*appsv1.Deployment)) *appsv1.Deployment {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "app",
+			Namespace:       defaultNamespace,
+			Labels:          map[string]string{"app": "app", "added-by": "someone-else"},
+			ResourceVersion: "1",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:            "app",
+						Image:           "app:1.0.0",
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("1"),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+						},
+					}},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{Replicas: 2},
+	}
+	if modify != nil {
+		modify(deployment)
+	}
+	return deployment
+}
+
+func decodeObject(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(manifest), &obj.Object); err != nil {
+		t.Fatalf("failed to decode manifest: %v", err)
+	}
+	return obj
+}
+
+func TestDetectDrift(t *testing.T) {
+	testCases := []struct {
+		name     string
+		live     []ctrlruntimeclient.Object
+		expected []appskubermaticv1.DriftedResource
+	}{
+		{
+			name: "scenario 1: defaulted fields, additional labels and status are not reported as drift",
+			live: []ctrlruntimeclient.Object{liveDeployment(nil)},
+		},
+		{
+			name: "scenario 2: missing resource is reported",
+			expected: []appskubermaticv1.DriftedResource{{
+				AppliedResource: appskubermaticv1.AppliedResource{APIVersion: "apps/v1", Kind: "Deployment", Namespace: defaultNamespace, Name: "app"},
+				Reason:          appskubermaticv1.DriftReasonMissing,
+			}},
+		},
+		{
+			name: "scenario 3: modified field is reported",
+			live: []ctrlruntimeclient.Object{liveDeployment(func(d *appsv1.Deployment) {
+				d.Spec.Template.Spec.Containers[0].Image = "app:2.0.0"
+			})},
+			expected: []appskubermaticv1.DriftedResource{{
+				AppliedResource: appskubermaticv1.AppliedResource{APIVersion: "apps/v1", Kind: "Deployment", Namespace: defaultNamespace, Name: "app"},
+				Reason:          appskubermaticv1.DriftReasonModified,
+				Field:           "spec.template.spec.containers[0].image",
+			}},
+		},
+		{
+			name: "scenario 4: modified label is reported",
+			live: []ctrlruntimeclient.Object{liveDeployment(func(d *appsv1.Deployment) {
+				d.Labels["app"] = "other"
+			})},
+			expected: []appskubermaticv1.DriftedResource{{
+				AppliedResource: appskubermaticv1.AppliedResource{APIVersion: "apps/v1", Kind: "Deployment", Namespace: defaultNamespace, Name: "app"},
+				Reason:          appskubermaticv1.DriftReasonModified,
+				Field:           "metadata.labels.app",
+			}},
+		},
+		{
+			name: "scenario 5: added list item is reported",
+			live: []ctrlruntimeclient.Object{liveDeployment(func(d *appsv1.Deployment) {
+				d.Spec.Template.Spec.Containers = append(d.Spec.Template.Spec.Containers, corev1.Container{Name: "sidecar", Image: "sidecar"})
+			})},
+			expected: []appskubermaticv1.DriftedResource{{
+				AppliedResource: appskubermaticv1.AppliedResource{APIVersion: "apps/v1", Kind: "Deployment", Namespace: defaultNamespace, Name: "app"},
+				Reason:          appskubermaticv1.DriftReasonModified,
+				Field:           "spec.template.spec.containers",
+			}},
+		},
+		{
+			name: "scenario 6: changed quantity is reported",
+			live: []ctrlruntimeclient.Object{liveDeployment(func(d *appsv1.Deployment) {
+				d.Spec.Template.Spec.Containers[0].Resources.Limits[corev1.ResourceCPU] = resource.MustParse("500m")
+			})},
+			expected: []appskubermaticv1.DriftedResource{{
+				AppliedResource: appskubermaticv1.AppliedResource{APIVersion: "apps/v1", Kind: "Deployment", Namespace: defaultNamespace, Name: "app"},
+				Reason:          appskubermaticv1.DriftReasonModified,
+				Field:           "spec.template.spec.containers[0].resources.limits.cpu",
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userClient := fake.NewClientBuilder().WithObjects(tc.live...).Build()
+
+			drifted, err := DetectDrift(context.Background(), userClient, []*unstructured.Unstructured{decodeObject(t, desiredDeployment)})
+			if err != nil {
+				t.Fatalf("failed to detect drift: %v", err)
+			}
+
+			if len(drifted) != len(tc.expected) {
+				t.Fatalf("expected drifted resources %v, got %v", tc.expected, drifted)
+			}
+			for i := range drifted {
+				if drifted[i] != tc.expected[i] {
+					t.Errorf("expected drifted resource %v, got %v", tc.expected[i], drifted[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDetectDriftSecretStringData(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: defaultNamespace},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+	userClient := fake.NewClientBuilder().WithObjects(secret).Build()
+
+	desired := decodeObject(t, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: secret
+  namespace: default
+stringData:
+  password: secret
+`)
+
+	drifted, err := DetectDrift(context.Background(), userClient, []*unstructured.Unstructured{desired})
+	if err != nil {
+		t.Fatalf("failed to detect drift: %v", err)
+	}
+	if len(drifted) != 0 {
+		t.Errorf("expected no drift, got %v", drifted)
+	}
+}
+
+func TestCorrectDrift(t *testing.T) {
+	ctx := context.Background()
+	userClient := fake.NewClientBuilder().
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(fake.NewScheme())).
+		WithObjects(liveDeployment(func(d *appsv1.Deployment) {
+			d.Spec.Replicas = ptr.To[int32](5)
+		})).
+		Build()
+
+	desired := []*unstructured.Unstructured{decodeObject(t, desiredDeployment)}
+
+	drifted, err := DetectDrift(ctx, userClient, desired)
+	if err != nil {
+		t.Fatalf("failed to detect drift: %v", err)
+	}
+	if len(drifted) != 1 {
+		t.Fatalf("expected 1 drifted resource, got %v", drifted)
+	}
+
+	if err := CorrectDrift(ctx, userClient, desired, drifted); err != nil {
+		t.Fatalf("failed to correct drift: %v", err)
+	}
+
+	drifted, err = DetectDrift(ctx, userClient, desired)
+	if err != nil {
+		t.Fatalf("failed to detect drift: %v", err)
+	}
+	if len(drifted) != 0 {
+		t.Errorf("expected no drift after correction, got %v", drifted)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"fmt"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AssessHealth returns a message for every Deployment which is not available, StatefulSet which is not ready and Job
+// which has not succeeded among objs. Other kinds of resources are not assessed. An empty result means the
+// application is healthy.
+func AssessHealth(ctx context.Context, userClient ctrlruntimeclient.Client, objs []*unstructured.Unstructured) ([]string, error) {
+	var unhealthy []string
+
+	for _, obj := range objs {
+		assess := healthAssessor(obj)
+		if assess == nil {
+			continue
+		}
+
+		// get the workload as unstructured object, so that it is read from the API server instead of starting an informer
+		key := ctrlruntimeclient.ObjectKeyFromObject(obj)
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		if err := userClient.Get(ctx, key, live); err != nil {
+			if apierrors.IsNotFound(err) {
+				unhealthy = append(unhealthy, fmt.Sprintf("%s %q does not exist", obj.GetKind(), key))
+				continue
+			}
+			return nil, fmt.Errorf("failed to get %s %q: %w", obj.GetKind(), key, err)
+		}
+
+		message, err := assess(live)
+		if err != nil {
+			return nil, fmt.Errorf("failed to assess %s %q: %w", obj.GetKind(), key, err)
+		}
+		if message != "" {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s %q %s", obj.GetKind(), key, message))
+		}
+	}
+
+	return unhealthy, nil
+}
+
+// Workloads returns references to the objects among objs whose health is assessed by AssessHealth.
+func Workloads(objs []*unstructured.Unstructured) []appskubermaticv1.AppliedResource {
+	var workloads []appskubermaticv1.AppliedResource
+	for _, obj := range objs {
+		if healthAssessor(obj) != nil {
+			workloads = append(workloads, resourceReference(obj))
+		}
+	}
+	return workloads
+}
+
+// WorkloadObjects returns objects which only have the type and the key of the referenced workloads set. This allows
+// assessing the health of an application with AssessHealth without rendering its resources.
+func WorkloadObjects(workloads []appskubermaticv1.AppliedResource) []*unstructured.Unstructured {
+	objs := make([]*unstructured.Unstructured, 0, len(workloads))
+	for _, workload := range workloads {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(workload.APIVersion)
+		obj.SetKind(workload.Kind)
+		obj.SetNamespace(workload.Namespace)
+		obj.SetName(workload.Name)
+		objs = append(objs, obj)
+	}
+	return objs
+}
+
+func healthAssessor(obj *unstructured.Unstructured) func(*unstructured.Unstructured) (string, error) {
+	switch obj.GroupVersionKind().GroupKind() {
+	case appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind():
+		return deploymentHealth
+	case appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():
+		return statefulSetHealth
+	case batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():
+		return jobHealth
+	default:
+		return nil
+	}
+}
+
+func deploymentHealth(obj *unstructured.Unstructured) (string, error) {
+	deployment := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment); err != nil {
+		return "", err
+	}
+
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return "has not been observed by its controller yet", nil
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentAvailable && condition.Status == corev1.ConditionTrue {
+			return "", nil
+		}
+	}
+
+	return "is not available", nil
+}
+
+func statefulSetHealth(obj *unstructured.Unstructured) (string, error) {
+	statefulSet := &appsv1.StatefulSet{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, statefulSet); err != nil {
+		return "", err
+	}
+
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation {
+		return "has not been observed by its controller yet", nil
+	}
+
+	replicas := ptr.Deref(statefulSet.Spec.Replicas, 1)
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return fmt.Sprintf("has %d/%d ready replicas", statefulSet.Status.ReadyReplicas, replicas), nil
+	}
+
+	return "", nil
+}
+
+func jobHealth(obj *unstructured.Unstructured) (string, error) {
+	job := &batchv1.Job{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+		return "", err
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return "", nil
+		case batchv1.JobFailed:
+			return fmt.Sprintf("failed: %s", condition.Message), nil
+		}
+	}
+
+	return "has not succeeded yet", nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applications
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func workloadObject(apiVersion, kind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(defaultNamespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestAssessHealth(t *testing.T) {
+	objs := []*unstructured.Unstructured{
+		workloadObject("apps/v1", "Deployment", "deployment"),
+		workloadObject("apps/v1", "StatefulSet", "statefulset"),
+		workloadObject("batch/v1", "Job", "job"),
+		workloadObject("v1", "ConfigMap", "config"),
+	}
+
+	healthyDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployment", Namespace: defaultNamespace, Generation: 2},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Conditions:         []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}},
+		},
+	}
+	healthyStatefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "statefulset", Namespace: defaultNamespace},
+		Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To[int32](3)},
+		Status:     appsv1.StatefulSetStatus{ReadyReplicas: 3},
+	}
+	healthyJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: defaultNamespace},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		live     []ctrlruntimeclient.Object
+		expected []string
+	}{
+		{
+			name: "scenario 1: available deployment, ready statefulset and succeeded job are healthy",
+			live: []ctrlruntimeclient.Object{healthyDeployment, healthyStatefulSet, healthyJob},
+		},
+		{
+			name: "scenario 2: unavailable deployment is unhealthy",
+			live: []ctrlruntimeclient.Object{
+				func() *appsv1.Deployment {
+					d := healthyDeployment.DeepCopy()
+					d.Status.Conditions[0].Status = corev1.ConditionFalse
+					return d
+				}(),
+				healthyStatefulSet, healthyJob,
+			},
+			expected: []string{`Deployment "default/deployment" is not available`},
+		},
+		{
+			name: "scenario 3: deployment not observed yet and statefulset not ready are unhealthy",
+			live: []ctrlruntimeclient.Object{
+				func() *appsv1.Deployment {
+					d := healthyDeployment.DeepCopy()
+					d.Generation = 3
+					return d
+				}(),
+				func() *appsv1.StatefulSet {
+					s := healthyStatefulSet.DeepCopy()
+					s.Status.ReadyReplicas = 1
+					return s
+				}(),
+				healthyJob,
+			},
+			expected: []string{
+				`Deployment "default/deployment" has not been observed by its controller yet`,
+				`StatefulSet "default/statefulset" has 1/3 ready replicas`,
+			},
+		},
+		{
+			name: "scenario 4: failed job and missing workloads are unhealthy",
+			live: []ctrlruntimeclient.Object{
+				healthyDeployment,
+				func() *batchv1.Job {
+					j := healthyJob.DeepCopy()
+					j.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}}
+					return j
+				}(),
+			},
+			expected: []string{
+				`StatefulSet "default/statefulset" does not exist`,
+				`Job "default/job" failed: BackoffLimitExceeded`,
+			},
+		},
+		{
+			name: "scenario 5: running job is unhealthy",
+			live: []ctrlruntimeclient.Object{
+				healthyDeployment,
+				healthyStatefulSet,
+				func() *batchv1.Job {
+					j := healthyJob.DeepCopy()
+					j.Status.Conditions = nil
+					return j
+				}(),
+			},
+			expected: []string{`Job "default/job" has not succeeded yet`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userClient := fake.NewClientBuilder().WithObjects(tc.live...).Build()
+
+			unhealthy, err := AssessHealth(context.Background(), userClient, objs)
+			if err != nil {
+				t.Fatalf("failed to assess health: %v", err)
+			}
+
+			if strings.Join(unhealthy, "\n") != strings.Join(tc.expected, "\n") {
+				t.Errorf("expected unhealthy resources %q, got %q", tc.expected, unhealthy)
+			}
+		})
+	}
+}
+
+func TestWorkloads(t *testing.T) {
+	objs := []*unstructured.Unstructured{
+		workloadObject("v1", "ConfigMap", "config"),
+		workloadObject("apps/v1", "Deployment", "deployment"),
+		workloadObject("batch/v1", "Job", "job"),
+	}
+
+	workloads := Workloads(objs)
+	expected := []appskubermaticv1.AppliedResource{
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: defaultNamespace, Name: "deployment"},
+		{APIVersion: "batch/v1", Kind: "Job", Namespace: defaultNamespace, Name: "job"},
+	}
+	if !reflect.DeepEqual(workloads, expected) {
+		t.Fatalf("expected workloads %v, got %v", expected, workloads)
+	}
+
+	// the health of recorded workloads can be assessed without the rendered objects
+	userClient := fake.NewClientBuilder().Build()
+	unhealthy, err := AssessHealth(context.Background(), userClient, WorkloadObjects(workloads))
+	if err != nil {
+		t.Fatalf("failed to assess health: %v", err)
+	}
+
+	expectedUnhealthy := []string{
+		`Deployment "default/deployment" does not exist`,
+		`Job "default/job" does not exist`,
+	}
+	if !reflect.DeepEqual(unhealthy, expectedUnhealthy) {
+		t.Errorf("expected unhealthy resources %q, got %q", expectedUnhealthy, unhealthy)
+	}
+}
@@ -475,6 +475,15 @@ func (h HelmClient) GetMetadata(releaseName string) (*action.Metadata, error) {
 	return res, nil
 }
 
+// GetManifest returns the rendered manifest of the latest release revision.
+func (h HelmClient) GetManifest(releaseName string) (string, error) {
+	rel, err := h.actionConfig.Releases.Last(releaseName)
+	if err != nil {
+		return "", fmt.Errorf("could not retrieve release %q: %w", releaseName, err)
+	}
+	return rel.Manifest, nil
+}
+
 // IsPending returns true when the latest release revision is in any Helm pending state.
 func (h HelmClient) IsPending(releaseName string) (bool, error) {
 	metadata, err := h.GetMetadata(releaseName)
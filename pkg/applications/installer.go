@@ -29,6 +29,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -44,6 +45,10 @@ type ApplicationInstaller interface {
 	// Apply function installs the application on the user-cluster and returns an error if the installation has failed. StatusUpdater is guaranteed to be non nil. This is idempotent.
 	Apply(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) (util.StatusUpdater, error)
 
+	// RenderResources returns the resources of the application as they should exist in the user-cluster. It is used to
+	// detect drift and to assess the health of the application after it has been applied.
+	RenderResources(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) ([]*unstructured.Unstructured, error)
+
 	// Delete function uninstalls the application on the user-cluster and returns an error if the uninstallation has failed. StatusUpdater is guaranteed to be non nil. This is idempotent.
 	Delete(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error)
 
@@ -94,7 +99,7 @@ func (a *ApplicationManager) DownloadSource(ctx context.Context, log *zap.Sugare
 
 // Apply creates the namespace where the application will be installed (if necessary) and installs the application.
 func (a *ApplicationManager) Apply(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) (util.StatusUpdater, error) {
-	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
+	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, userClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
 	if err != nil {
 		return util.NoStatusUpdate, fmt.Errorf("failed to initialize template provider: %w", err)
 	}
@@ -106,9 +111,19 @@ func (a *ApplicationManager) Apply(ctx context.Context, log *zap.SugaredLogger,
 	return templateProvider.InstallOrUpgrade(appSourcePath, appDefinition, applicationInstallation)
 }
 
+// RenderResources renders the resources of the application using the appropriate provider.
+func (a *ApplicationManager) RenderResources(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) ([]*unstructured.Unstructured, error) {
+	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, userClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize template provider: %w", err)
+	}
+
+	return templateProvider.RenderResources(appSourcePath, appDefinition, applicationInstallation)
+}
+
 // Delete uninstalls the application where the application was installed if necessary.
 func (a *ApplicationManager) Delete(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
-	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
+	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, userClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
 	if err != nil {
 		return util.NoStatusUpdate, fmt.Errorf("failed to initialize template provider: %w", err)
 	}
@@ -165,7 +180,7 @@ func (a *ApplicationManager) IsStuck(ctx context.Context, log *zap.SugaredLogger
 		return false, nil
 	}
 
-	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
+	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, userClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
 	if err != nil {
 		return false, fmt.Errorf("failed to initialize template provider: %w", err)
 	}
@@ -183,7 +198,7 @@ func (a *ApplicationManager) IsDeployed(ctx context.Context, log *zap.SugaredLog
 		return false, nil
 	}
 
-	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
+	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, userClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
 	if err != nil {
 		return false, fmt.Errorf("failed to initialize template provider: %w", err)
 	}
@@ -206,7 +221,7 @@ func namespaceExists(ctx context.Context, userClient ctrlruntimeclient.Client, n
 // Rollback rolls an Application back to the latest successful release, or uninstalls it when no successful release exists.
 // A successful uninstall fallback allows the next reconcile to install the desired release cleanly.
 func (a *ApplicationManager) Rollback(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation) error {
-	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
+	templateProvider, err := providers.NewTemplateProvider(ctx, seedClient, userClient, a.ClusterName, a.Kubeconfig, a.ApplicationCache, log, applicationInstallation, a.SecretNamespace)
 	if err != nil {
 		return fmt.Errorf("failed to initialize template provider: %w", err)
 	}
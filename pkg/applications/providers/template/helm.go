@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"path"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -31,6 +32,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -55,6 +57,9 @@ type HelmTemplate struct {
 
 	// SeedClient to seed cluster.
 	SeedClient ctrlruntimeclient.Client
+
+	// UserClient to user cluster.
+	UserClient ctrlruntimeclient.Client
 }
 
 // InstallOrUpgrade the chart located at chartLoc with parameters (releaseName, values) defined applicationInstallation into cluster.
@@ -95,9 +100,9 @@ func (h HelmTemplate) InstallOrUpgrade(chartLoc string, appDefinition *appskuber
 		return util.NoStatusUpdate, err
 	}
 
-	values, err := applicationInstallation.Spec.GetParsedValues()
+	values, err := GetValues(h.Ctx, h.SeedClient, h.UserClient, h.SecretNamespace, applicationInstallation)
 	if err != nil {
-		return util.NoStatusUpdate, fmt.Errorf("failed to unmarshal values: %w", err)
+		return util.NoStatusUpdate, err
 	}
 
 	renderedValues, err := h.templatePreDefinedValues(values)
@@ -177,6 +182,28 @@ func (h HelmTemplate) Uninstall(applicationInstallation *appskubermaticv1.Applic
 	return statusUpdater, err
 }
 
+// RenderResources returns the resources of the latest release of the chart, as they have been rendered by Helm. The
+// chart located at chartLoc is not rendered again, because the release manifest is what Helm deployed into the cluster.
+func (h HelmTemplate) RenderResources(chartLoc string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) ([]*unstructured.Unstructured, error) {
+	helmClient, cleanup, err := h.newHelmClient(applicationInstallation.Spec.Namespace.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	manifest, err := helmClient.GetManifest(getReleaseName(applicationInstallation))
+	if err != nil {
+		return nil, err
+	}
+
+	objs, err := decodeManifests(strings.NewReader(manifest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode release manifest: %w", err)
+	}
+
+	return objs, setDefaultNamespaces(h.UserClient, applicationInstallation, objs)
+}
+
 // getReleaseName computes the release name from the applicationInstallation.
 // The releaseName length must be less or equal to 53. So we first start to compute this release Name:
 //
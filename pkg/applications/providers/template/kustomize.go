@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications/providers/util"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KustomizeTemplate install, upgrade or uninstall a kustomization into cluster.
+type KustomizeTemplate struct {
+	Ctx context.Context
+
+	// CacheDir is the directory path where temporary files are written.
+	CacheDir string
+
+	Log *zap.SugaredLogger
+
+	// Namespace where credential secrets and values referenced in the seed cluster are stored.
+	SecretNamespace string
+
+	// ClusterName of the user-cluster
+	ClusterName string
+
+	// SeedClient to seed cluster.
+	SeedClient ctrlruntimeclient.Client
+
+	// UserClient to user cluster.
+	UserClient ctrlruntimeclient.Client
+}
+
+// InstallOrUpgrade builds the kustomization located at source and applies the resulting resources into the cluster.
+// The values of applicationInstallation are used as an overlay kustomization on top of it.
+func (k KustomizeTemplate) InstallOrUpgrade(source string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	objs, err := k.render(source, applicationInstallation)
+	if err != nil {
+		return util.NoStatusUpdate, err
+	}
+
+	return applyResources(k.Ctx, k.Log, k.UserClient, applicationInstallation, objs)
+}
+
+// RenderResources builds the kustomization located at source and returns the resulting resources as they are
+// applied into the cluster.
+func (k KustomizeTemplate) RenderResources(source string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) ([]*unstructured.Unstructured, error) {
+	objs, err := k.render(source, applicationInstallation)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, setDefaultNamespaces(k.UserClient, applicationInstallation, objs)
+}
+
+// render builds the kustomization located at source with the values of applicationInstallation as overlay.
+func (k KustomizeTemplate) render(source string, applicationInstallation *appskubermaticv1.ApplicationInstallation) ([]*unstructured.Unstructured, error) {
+	tmpDir, err := os.MkdirTemp(k.CacheDir, "kustomize-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	values, err := renderValues(k.Ctx, k.SeedClient, k.UserClient, k.ClusterName, k.SecretNamespace, applicationInstallation)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceDir, err := expandSource(source, filepath.Join(tmpDir, "source"))
+	if err != nil {
+		return nil, err
+	}
+
+	overlayDir := filepath.Join(tmpDir, "overlay")
+	if err := os.Mkdir(overlayDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create overlay directory: %w", err)
+	}
+
+	base, err := filepath.Rel(overlayDir, sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildOverlay(overlayDir, []string{base}, values)
+}
+
+// Uninstall deletes all resources applied by the application from the user cluster.
+func (k KustomizeTemplate) Uninstall(applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	return uninstallResources(k.Ctx, k.Log, k.UserClient, applicationInstallation)
+}
+
+// IsStuck always returns false, because resources are applied synchronously and there is no pending state.
+func (k KustomizeTemplate) IsStuck(applicationInstallation *appskubermaticv1.ApplicationInstallation) (bool, error) {
+	return false, nil
+}
+
+// IsDeployed returns true if all resources applied by the application exist in the user cluster.
+func (k KustomizeTemplate) IsDeployed(applicationInstallation *appskubermaticv1.ApplicationInstallation) (bool, error) {
+	return resourcesDeployed(k.Ctx, k.UserClient, applicationInstallation)
+}
+
+// Rollback is a no-op, as there is no release history to roll back to. The resources are applied again on the next reconcile.
+func (k KustomizeTemplate) Rollback(applicationInstallation *appskubermaticv1.ApplicationInstallation) error {
+	return nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testClusterName  = "test-cluster"
+	testAppNamespace = "my-app"
+)
+
+func newSeedClient() ctrlruntimeclient.Client {
+	return fake.NewClientBuilder().WithObjects(&kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testClusterName,
+		},
+		Status: kubermaticv1.ClusterStatus{
+			Versions: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane: semver.Semver("v1.32.0"),
+			},
+		},
+	}).Build()
+}
+
+func newUserClient() ctrlruntimeclient.Client {
+	return fake.NewClientBuilder().WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(fake.NewScheme())).Build()
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func newAppInstallation(valuesBlock string) *appskubermaticv1.ApplicationInstallation {
+	return &appskubermaticv1.ApplicationInstallation{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "kube-system"},
+		Spec: appskubermaticv1.ApplicationInstallationSpec{
+			Namespace:   &appskubermaticv1.AppNamespaceSpec{Name: testAppNamespace},
+			ValuesBlock: valuesBlock,
+		},
+	}
+}
+
+func assertExists(t *testing.T, client ctrlruntimeclient.Client, obj ctrlruntimeclient.Object, namespace, name string, expected bool) {
+	t.Helper()
+
+	err := client.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, obj)
+	switch {
+	case err == nil && !expected:
+		t.Errorf("expected %T %s/%s to not exist", obj, namespace, name)
+	case apierrors.IsNotFound(err) && expected:
+		t.Errorf("expected %T %s/%s to exist", obj, namespace, name)
+	case err != nil && !apierrors.IsNotFound(err):
+		t.Fatalf("failed to get %T %s/%s: %v", obj, namespace, name, err)
+	}
+}
+
+func TestKustomizeTemplate(t *testing.T) {
+	ctx := context.Background()
+	sourceDir := t.TempDir()
+	writeFiles(t, sourceDir, map[string]string{
+		"kustomization.yaml": `
+resources:
+- configmap.yaml
+- serviceaccount.yaml
+`,
+		"configmap.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  cluster: unknown
+`,
+		"serviceaccount.yaml": `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: sa
+`,
+	})
+
+	userClient := newUserClient()
+	provider := KustomizeTemplate{
+		Ctx:         ctx,
+		CacheDir:    t.TempDir(),
+		Log:         zap.NewNop().Sugar(),
+		ClusterName: testClusterName,
+		SeedClient:  newSeedClient(),
+		UserClient:  userClient,
+	}
+
+	appInstallation := newAppInstallation(`
+namePrefix: app-
+patches:
+- target:
+    kind: ConfigMap
+  patch: |
+    - op: replace
+      path: /data/cluster
+      value: "{{ .Cluster.Name }}"
+`)
+
+	statusUpdater, err := provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation)
+	if err != nil {
+		t.Fatalf("failed to install application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	if n := len(appInstallation.Status.AppliedResources); n != 2 {
+		t.Fatalf("expected 2 applied resources, got %d: %v", n, appInstallation.Status.AppliedResources)
+	}
+
+	cm := &corev1.ConfigMap{}
+	assertExists(t, userClient, cm, testAppNamespace, "app-config", true)
+	if cm.Data["cluster"] != testClusterName {
+		t.Errorf("expected ConfigMap to be patched with the cluster name, got %q", cm.Data["cluster"])
+	}
+	assertExists(t, userClient, &corev1.ServiceAccount{}, testAppNamespace, "app-sa", true)
+
+	deployed, err := provider.IsDeployed(appInstallation)
+	if err != nil {
+		t.Fatalf("failed to check if application is deployed: %v", err)
+	}
+	if !deployed {
+		t.Error("expected application to be deployed")
+	}
+
+	// remove the ServiceAccount from the kustomization, it must be pruned
+	writeFiles(t, sourceDir, map[string]string{
+		"kustomization.yaml": `
+resources:
+- configmap.yaml
+`,
+	})
+
+	statusUpdater, err = provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation)
+	if err != nil {
+		t.Fatalf("failed to upgrade application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	expected := []appskubermaticv1.AppliedResource{{APIVersion: "v1", Kind: "ConfigMap", Namespace: testAppNamespace, Name: "app-config"}}
+	if len(appInstallation.Status.AppliedResources) != 1 || appInstallation.Status.AppliedResources[0] != expected[0] {
+		t.Errorf("expected applied resources %v, got %v", expected, appInstallation.Status.AppliedResources)
+	}
+	assertExists(t, userClient, &corev1.ServiceAccount{}, testAppNamespace, "app-sa", false)
+
+	statusUpdater, err = provider.Uninstall(appInstallation)
+	if err != nil {
+		t.Fatalf("failed to uninstall application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	if appInstallation.Status.AppliedResources != nil {
+		t.Errorf("expected no applied resources after uninstall, got %v", appInstallation.Status.AppliedResources)
+	}
+	assertExists(t, userClient, &corev1.ConfigMap{}, testAppNamespace, "app-config", false)
+
+	deployed, err = provider.IsDeployed(appInstallation)
+	if err != nil {
+		t.Fatalf("failed to check if application is deployed: %v", err)
+	}
+	if deployed {
+		t.Error("expected application to not be deployed after uninstall")
+	}
+}
+
+func TestKustomizeTemplateRenderResources(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeFiles(t, sourceDir, map[string]string{
+		"kustomization.yaml": `
+resources:
+- resources.yaml
+`,
+		"resources.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: role
+`,
+	})
+
+	userClient := newUserClient()
+	provider := KustomizeTemplate{
+		Ctx:         context.Background(),
+		CacheDir:    t.TempDir(),
+		Log:         zap.NewNop().Sugar(),
+		ClusterName: testClusterName,
+		SeedClient:  newSeedClient(),
+		UserClient:  userClient,
+	}
+
+	objs, err := provider.RenderResources(sourceDir, &appskubermaticv1.ApplicationDefinition{}, newAppInstallation("namePrefix: app-\n"))
+	if err != nil {
+		t.Fatalf("failed to render resources: %v", err)
+	}
+
+	namespaces := map[string]string{}
+	for _, obj := range objs {
+		namespaces[obj.GetKind()+"/"+obj.GetName()] = obj.GetNamespace()
+	}
+	expected := map[string]string{"ConfigMap/app-config": testAppNamespace, "ClusterRole/app-role": ""}
+	if !maps.Equal(namespaces, expected) {
+		t.Errorf("expected rendered resources %v, got %v", expected, namespaces)
+	}
+
+	// rendering must not apply anything
+	assertExists(t, userClient, &corev1.ConfigMap{}, testAppNamespace, "app-config", false)
+}
+
+func TestKustomizeTemplateReservedValues(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeFiles(t, sourceDir, map[string]string{
+		"kustomization.yaml": "resources: []\n",
+	})
+
+	provider := KustomizeTemplate{
+		Ctx:         context.Background(),
+		CacheDir:    t.TempDir(),
+		Log:         zap.NewNop().Sugar(),
+		ClusterName: testClusterName,
+		SeedClient:  newSeedClient(),
+		UserClient:  newUserClient(),
+	}
+
+	appInstallation := newAppInstallation("resources:\n- https://example.com/foo.yaml\n")
+	if _, err := provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation); err == nil {
+		t.Fatal("expected setting resources through values to fail")
+	}
+}
+
+func TestKustomizeTemplateFromChartArchive(t *testing.T) {
+	chartDir := filepath.Join(t.TempDir(), "app")
+	writeFiles(t, chartDir, map[string]string{
+		"Chart.yaml": `
+apiVersion: v2
+name: app
+version: 1.0.0
+`,
+		"kustomization.yaml": `
+resources:
+- configmap.yaml
+`,
+		"configmap.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`,
+	})
+
+	chart, err := loader.Load(chartDir)
+	if err != nil {
+		t.Fatalf("failed to load chart: %v", err)
+	}
+	archive, err := chartutil.Save(chart, t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to package chart: %v", err)
+	}
+
+	userClient := newUserClient()
+	provider := KustomizeTemplate{
+		Ctx:         context.Background(),
+		CacheDir:    t.TempDir(),
+		Log:         zap.NewNop().Sugar(),
+		ClusterName: testClusterName,
+		SeedClient:  newSeedClient(),
+		UserClient:  userClient,
+	}
+
+	appInstallation := newAppInstallation("")
+	if _, err := provider.InstallOrUpgrade(archive, &appskubermaticv1.ApplicationDefinition{}, appInstallation); err != nil {
+		t.Fatalf("failed to install application: %v", err)
+	}
+
+	assertExists(t, userClient, &corev1.ConfigMap{}, testAppNamespace, "config", true)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications/providers/util"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// manifestsFile is the file in the overlay directory holding the manifests read from the application's source.
+const manifestsFile = "manifests.yaml"
+
+// ManifestsTemplate install, upgrade or uninstall plain Kubernetes manifests into cluster.
+type ManifestsTemplate struct {
+	Ctx context.Context
+
+	// CacheDir is the directory path where temporary files are written.
+	CacheDir string
+
+	Log *zap.SugaredLogger
+
+	// Namespace where credential secrets and values referenced in the seed cluster are stored.
+	SecretNamespace string
+
+	// ClusterName of the user-cluster
+	ClusterName string
+
+	// SeedClient to seed cluster.
+	SeedClient ctrlruntimeclient.Client
+
+	// UserClient to user cluster.
+	UserClient ctrlruntimeclient.Client
+}
+
+// InstallOrUpgrade applies the manifests located at source into the cluster. The values of applicationInstallation are
+// used as an overlay kustomization on top of the manifests.
+func (m ManifestsTemplate) InstallOrUpgrade(source string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	objs, err := m.render(source, applicationInstallation)
+	if err != nil {
+		return util.NoStatusUpdate, err
+	}
+
+	return applyResources(m.Ctx, m.Log, m.UserClient, applicationInstallation, objs)
+}
+
+// RenderResources returns the manifests located at source with the overlay built from the values of
+// applicationInstallation applied, as they are applied into the cluster.
+func (m ManifestsTemplate) RenderResources(source string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) ([]*unstructured.Unstructured, error) {
+	objs, err := m.render(source, applicationInstallation)
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, setDefaultNamespaces(m.UserClient, applicationInstallation, objs)
+}
+
+// render reads the manifests located at source and builds them with the values of applicationInstallation as overlay.
+func (m ManifestsTemplate) render(source string, applicationInstallation *appskubermaticv1.ApplicationInstallation) ([]*unstructured.Unstructured, error) {
+	tmpDir, err := os.MkdirTemp(m.CacheDir, "manifests-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	values, err := renderValues(m.Ctx, m.SeedClient, m.UserClient, m.ClusterName, m.SecretNamespace, applicationInstallation)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceDir, err := expandSource(source, filepath.Join(tmpDir, "source"))
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := readManifests(sourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifests: %w", err)
+	}
+	if len(manifests) == 0 {
+		return nil, errors.New("no manifests found in application source")
+	}
+
+	overlayDir := filepath.Join(tmpDir, "overlay")
+	if err := os.Mkdir(overlayDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create overlay directory: %w", err)
+	}
+
+	var data []byte
+	for _, manifest := range manifests {
+		doc, err := yaml.Marshal(manifest.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s %q: %w", manifest.GetKind(), manifest.GetName(), err)
+		}
+		data = append(data, "---\n"...)
+		data = append(data, doc...)
+	}
+	if err := os.WriteFile(filepath.Join(overlayDir, manifestsFile), data, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	return buildOverlay(overlayDir, []string{manifestsFile}, values)
+}
+
+// Uninstall deletes all resources applied by the application from the user cluster.
+func (m ManifestsTemplate) Uninstall(applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	return uninstallResources(m.Ctx, m.Log, m.UserClient, applicationInstallation)
+}
+
+// IsStuck always returns false, because resources are applied synchronously and there is no pending state.
+func (m ManifestsTemplate) IsStuck(applicationInstallation *appskubermaticv1.ApplicationInstallation) (bool, error) {
+	return false, nil
+}
+
+// IsDeployed returns true if all resources applied by the application exist in the user cluster.
+func (m ManifestsTemplate) IsDeployed(applicationInstallation *appskubermaticv1.ApplicationInstallation) (bool, error) {
+	return resourcesDeployed(m.Ctx, m.UserClient, applicationInstallation)
+}
+
+// Rollback is a no-op, as there is no release history to roll back to. The resources are applied again on the next reconcile.
+func (m ManifestsTemplate) Rollback(applicationInstallation *appskubermaticv1.ApplicationInstallation) error {
+	return nil
+}
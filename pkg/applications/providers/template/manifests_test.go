@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestManifestsTemplate(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeFiles(t, sourceDir, map[string]string{
+		// documents without kind are ignored
+		"Chart.yaml": `
+apiVersion: v2
+name: app
+version: 1.0.0
+`,
+		"manifests.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: other-config
+  namespace: other
+`,
+		"rbac/clusterrole.json": `{"apiVersion": "rbac.authorization.k8s.io/v1", "kind": "ClusterRole", "metadata": {"name": "app"}}`,
+		"README.md":             "# not a manifest",
+		// hidden directories are skipped
+		".github/workflow.yaml": `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: ignored
+`,
+	})
+
+	userClient := newUserClient()
+	provider := ManifestsTemplate{
+		Ctx:         context.Background(),
+		CacheDir:    t.TempDir(),
+		Log:         zap.NewNop().Sugar(),
+		ClusterName: testClusterName,
+		SeedClient:  newSeedClient(),
+		UserClient:  userClient,
+	}
+
+	appInstallation := newAppInstallation(`
+labels:
+- pairs:
+    cluster: "{{ .Cluster.Name }}"
+`)
+
+	statusUpdater, err := provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation)
+	if err != nil {
+		t.Fatalf("failed to install application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	// resources are applied in kustomize's legacy order, which starts with cluster-scoped RBAC
+	expected := []appskubermaticv1.AppliedResource{
+		{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "app"},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "other", Name: "other-config"},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: testAppNamespace, Name: "config"},
+	}
+	if len(appInstallation.Status.AppliedResources) != len(expected) {
+		t.Fatalf("expected applied resources %v, got %v", expected, appInstallation.Status.AppliedResources)
+	}
+	for i := range expected {
+		if appInstallation.Status.AppliedResources[i] != expected[i] {
+			t.Errorf("expected applied resources %v, got %v", expected, appInstallation.Status.AppliedResources)
+			break
+		}
+	}
+
+	cm := &corev1.ConfigMap{}
+	assertExists(t, userClient, cm, testAppNamespace, "config", true)
+	if cm.Labels["cluster"] != testClusterName {
+		t.Errorf("expected ConfigMap to be labelled with the cluster name, got %v", cm.Labels)
+	}
+	assertExists(t, userClient, &corev1.ConfigMap{}, "other", "other-config", true)
+	assertExists(t, userClient, &rbacv1.ClusterRole{}, "", "app", true)
+	assertExists(t, userClient, &corev1.Secret{}, testAppNamespace, "ignored", false)
+
+	statusUpdater, err = provider.Uninstall(appInstallation)
+	if err != nil {
+		t.Fatalf("failed to uninstall application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	if appInstallation.Status.AppliedResources != nil {
+		t.Errorf("expected no applied resources after uninstall, got %v", appInstallation.Status.AppliedResources)
+	}
+	assertExists(t, userClient, &corev1.ConfigMap{}, testAppNamespace, "config", false)
+	assertExists(t, userClient, &rbacv1.ClusterRole{}, "", "app", false)
+}
+
+func TestManifestsTemplateOwnership(t *testing.T) {
+	ctx := context.Background()
+	sourceDir := t.TempDir()
+	writeFiles(t, sourceDir, map[string]string{
+		"manifests.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  key: app
+`,
+	})
+
+	// a ConfigMap created by someone else before the application was installed
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: testAppNamespace},
+		Data:       map[string]string{"key": "user"},
+	}
+
+	userClient := newUserClient()
+	if err := userClient.Create(ctx, existing); err != nil {
+		t.Fatalf("failed to create ConfigMap: %v", err)
+	}
+
+	provider := ManifestsTemplate{
+		Ctx:         ctx,
+		CacheDir:    t.TempDir(),
+		Log:         zap.NewNop().Sugar(),
+		ClusterName: testClusterName,
+		SeedClient:  newSeedClient(),
+		UserClient:  userClient,
+	}
+	appInstallation := newAppInstallation("")
+
+	statusUpdater, err := provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation)
+	if err == nil {
+		t.Fatal("expected installing the application to fail, because the ConfigMap is not managed by it")
+	}
+	statusUpdater(&appInstallation.Status)
+
+	cm := &corev1.ConfigMap{}
+	assertExists(t, userClient, cm, testAppNamespace, "config", true)
+	if cm.Data["key"] != "user" {
+		t.Errorf("expected ConfigMap not to be adopted by the application, got data %v", cm.Data)
+	}
+
+	// the ConfigMap is tracked because the application failed, but it must not be deleted
+	statusUpdater, err = provider.Uninstall(appInstallation)
+	if err != nil {
+		t.Fatalf("failed to uninstall application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	if appInstallation.Status.AppliedResources != nil {
+		t.Errorf("expected no applied resources after uninstall, got %v", appInstallation.Status.AppliedResources)
+	}
+	assertExists(t, userClient, &corev1.ConfigMap{}, testAppNamespace, "config", true)
+
+	// once the ConfigMap is gone, the application creates and owns it
+	if err := userClient.Delete(ctx, existing); err != nil {
+		t.Fatalf("failed to delete ConfigMap: %v", err)
+	}
+
+	statusUpdater, err = provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation)
+	if err != nil {
+		t.Fatalf("failed to install application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	cm = &corev1.ConfigMap{}
+	assertExists(t, userClient, cm, testAppNamespace, "config", true)
+	if owner := cm.Annotations[ApplicationOwnerAnnotation]; owner != "kube-system/app" {
+		t.Errorf("expected ConfigMap to be owned by %q, got %q", "kube-system/app", owner)
+	}
+
+	// applying again updates the owned ConfigMap
+	if _, err := provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation); err != nil {
+		t.Fatalf("failed to upgrade application: %v", err)
+	}
+
+	statusUpdater, err = provider.Uninstall(appInstallation)
+	if err != nil {
+		t.Fatalf("failed to uninstall application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	assertExists(t, userClient, &corev1.ConfigMap{}, testAppNamespace, "config", false)
+}
+
+func TestManifestsTemplateAPIVersionUpgrade(t *testing.T) {
+	sourceDir := t.TempDir()
+	pdb := func(apiVersion string) string {
+		return `
+apiVersion: ` + apiVersion + `
+kind: PodDisruptionBudget
+metadata:
+  name: app
+spec:
+  maxUnavailable: 1
+`
+	}
+	writeFiles(t, sourceDir, map[string]string{"pdb.yaml": pdb("policy/v1beta1")})
+
+	userClient := newUserClient()
+	provider := ManifestsTemplate{
+		Ctx:         context.Background(),
+		CacheDir:    t.TempDir(),
+		Log:         zap.NewNop().Sugar(),
+		ClusterName: testClusterName,
+		SeedClient:  newSeedClient(),
+		UserClient:  userClient,
+	}
+	appInstallation := newAppInstallation("")
+
+	statusUpdater, err := provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation)
+	if err != nil {
+		t.Fatalf("failed to install application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	// the new version of the application uses the newer API version of the same resource
+	writeFiles(t, sourceDir, map[string]string{"pdb.yaml": pdb("policy/v1")})
+
+	statusUpdater, err = provider.InstallOrUpgrade(sourceDir, &appskubermaticv1.ApplicationDefinition{}, appInstallation)
+	if err != nil {
+		t.Fatalf("failed to upgrade application: %v", err)
+	}
+	statusUpdater(&appInstallation.Status)
+
+	expected := []appskubermaticv1.AppliedResource{
+		{APIVersion: "policy/v1", Kind: "PodDisruptionBudget", Namespace: testAppNamespace, Name: "app"},
+	}
+	if !slices.Equal(appInstallation.Status.AppliedResources, expected) {
+		t.Errorf("expected applied resources %v, got %v", expected, appInstallation.Status.AppliedResources)
+	}
+
+	// both API versions are served from the same object, which must not be pruned
+	assertExists(t, userClient, &policyv1beta1.PodDisruptionBudget{}, testAppNamespace, "app", true)
+	assertExists(t, userClient, &policyv1.PodDisruptionBudget{}, testAppNamespace, "app", true)
+}
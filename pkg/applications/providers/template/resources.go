@@ -0,0 +1,440 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/chartutil"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications/providers/util"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/kustomize/api/krusty"
+	kustomizetypes "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/yaml"
+)
+
+// ApplicationFieldOwner is the field manager used to server-side apply the resources of kustomize and manifests
+// applications, and to correct drifted resources of all applications.
+const ApplicationFieldOwner = "kubermatic-application-installer"
+
+// ApplicationOwnerAnnotation is set on the resources applied for kustomize and manifests applications and contains
+// the namespace and name of the owning ApplicationInstallation. Resources without a matching annotation are neither
+// adopted nor deleted.
+const ApplicationOwnerAnnotation = "apps.kubermatic.k8c.io/installed-by"
+
+// reservedOverlayFields are kustomization fields that cannot be set through the values, because the overlay
+// kustomization built from the values references the application's resources itself.
+var reservedOverlayFields = []string{"resources", "bases", "components"}
+
+// renderValues gets the values of the applicationInstallation and renders the pre-defined template values.
+func renderValues(ctx context.Context, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, clusterName string, secretNamespace string, applicationInstallation *appskubermaticv1.ApplicationInstallation) (map[string]any, error) {
+	values, err := GetValues(ctx, seedClient, userClient, secretNamespace, applicationInstallation)
+	if err != nil {
+		return nil, err
+	}
+
+	templateData, err := GetTemplateData(ctx, seedClient, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render pre-defined values: %w", err)
+	}
+
+	renderedValues, err := RenderValueTemplate(values, templateData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render pre-defined values: %w", err)
+	}
+
+	return renderedValues, nil
+}
+
+// expandSource returns the directory holding the application's sources. Helm sources are downloaded as a chart
+// archive, which is extracted into dest.
+func expandSource(source string, dest string) (string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", err
+	}
+
+	if info.IsDir() || !(strings.HasSuffix(source, ".tgz") || strings.HasSuffix(source, ".tar.gz")) {
+		return source, nil
+	}
+
+	if err := chartutil.ExpandFile(dest, source); err != nil {
+		return "", fmt.Errorf("failed to extract chart archive: %w", err)
+	}
+
+	// the archive is extracted into a directory named after the chart
+	entries, err := os.ReadDir(dest)
+	if err != nil {
+		return "", err
+	}
+	if len(entries) != 1 || !entries[0].IsDir() {
+		return "", fmt.Errorf("expected chart archive %q to contain a single directory", filepath.Base(source))
+	}
+
+	return filepath.Join(dest, entries[0].Name()), nil
+}
+
+// readManifests returns all Kubernetes objects found in the YAML and JSON files at source. If source is a directory,
+// it is walked recursively (hidden directories such as .git are skipped). Documents without a kind, like a Chart.yaml or
+// a values.yaml, are ignored.
+func readManifests(source string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+
+	err := filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path != source && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		switch filepath.Ext(path) {
+		case ".yaml", ".yml", ".json":
+		default:
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		decoded, err := decodeManifests(f)
+		if err != nil {
+			return fmt.Errorf("failed to decode %q: %w", path, err)
+		}
+		objs = append(objs, decoded...)
+
+		return nil
+	})
+
+	return objs, err
+}
+
+// decodeManifests returns all Kubernetes objects of the YAML or JSON stream r. Documents without a kind are ignored.
+func decodeManifests(r io.Reader) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+
+	decoder := yamlutil.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		obj := map[string]any{}
+		if err := decoder.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, err
+		}
+
+		if kind, _ := obj["kind"].(string); kind == "" {
+			continue
+		}
+		objs = append(objs, &unstructured.Unstructured{Object: obj})
+	}
+}
+
+// buildOverlay writes a kustomization into overlayDir which references resources and whose remaining fields are taken
+// from values, and returns the resources built from it. This allows to patch the application's resources through the
+// values of the ApplicationInstallation (e.g. patches, images, namePrefix, labels).
+func buildOverlay(overlayDir string, resources []string, values map[string]any) ([]*unstructured.Unstructured, error) {
+	kustomization := make(map[string]any, len(values)+3)
+	for key, value := range values {
+		if slices.Contains(reservedOverlayFields, key) {
+			return nil, fmt.Errorf("field %q cannot be set in values", key)
+		}
+		kustomization[key] = value
+	}
+	kustomization["apiVersion"] = kustomizetypes.KustomizationVersion
+	kustomization["kind"] = kustomizetypes.KustomizationKind
+	kustomization["resources"] = resources
+
+	data, err := yaml.Marshal(kustomization)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal kustomization: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(overlayDir, "kustomization.yaml"), data, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write kustomization: %w", err)
+	}
+
+	opts := krusty.MakeDefaultOptions()
+	// apply namespaces and CRDs before the resources depending on them
+	opts.Reorder = krusty.ReorderOptionLegacy
+
+	resMap, err := krusty.MakeKustomizer(opts).Run(filesys.MakeFsOnDisk(), overlayDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kustomization: %w", err)
+	}
+
+	objs := make([]*unstructured.Unstructured, 0, resMap.Size())
+	for _, res := range resMap.Resources() {
+		obj, err := res.Map()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert resource %s: %w", res.CurId(), err)
+		}
+		objs = append(objs, &unstructured.Unstructured{Object: obj})
+	}
+
+	return objs, nil
+}
+
+// applyResources server-side applies objs into the user cluster and prunes the resources that were applied previously
+// but are not part of objs anymore. Namespaced objects without namespace are created in the namespace of the
+// applicationInstallation. Existing objects are only updated if they are owned by the applicationInstallation. The
+// returned StatusUpdater records every resource that may still exist in the cluster, so that it can be pruned or
+// uninstalled later even if an error occurred.
+func applyResources(ctx context.Context, log *zap.SugaredLogger, userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation, objs []*unstructured.Unstructured) (util.StatusUpdater, error) {
+	previous := applicationInstallation.Status.AppliedResources
+	applied := make([]appskubermaticv1.AppliedResource, 0, len(objs))
+
+	// on error, keep tracking the previous resources as well as the ones applied so far
+	trackAll := func() util.StatusUpdater {
+		resources := slices.Clone(applied)
+		for _, res := range previous {
+			if !containsResource(resources, res) {
+				resources = append(resources, res)
+			}
+		}
+		return setAppliedResources(resources)
+	}
+
+	owner := ownerValue(applicationInstallation)
+
+	for _, obj := range objs {
+		if err := setDefaultNamespace(userClient, obj, applicationInstallation.Spec.Namespace.Name); err != nil {
+			return trackAll(), err
+		}
+
+		if err := ensureOwnership(ctx, userClient, obj, owner, previous); err != nil {
+			return trackAll(), err
+		}
+
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[ApplicationOwnerAnnotation] = owner
+		obj.SetAnnotations(annotations)
+
+		if err := userClient.Apply(ctx, ctrlruntimeclient.ApplyConfigurationFromUnstructured(obj), ctrlruntimeclient.FieldOwner(ApplicationFieldOwner), ctrlruntimeclient.ForceOwnership); err != nil {
+			return trackAll(), fmt.Errorf("failed to apply %s %q: %w", obj.GetKind(), ctrlruntimeclient.ObjectKeyFromObject(obj), err)
+		}
+
+		applied = append(applied, appliedResource(obj))
+	}
+
+	var prune []appskubermaticv1.AppliedResource
+	for _, res := range previous {
+		if !containsResource(applied, res) {
+			prune = append(prune, res)
+		}
+	}
+
+	remaining, err := deleteResources(ctx, log, userClient, owner, prune)
+
+	return setAppliedResources(append(applied, remaining...)), err
+}
+
+// ensureOwnership returns an error if obj already exists in the user cluster but is not owned by the
+// applicationInstallation, to not take over resources created by someone else. Resources that have been applied by
+// the applicationInstallation before the ownership annotation was introduced are still considered owned.
+func ensureOwnership(ctx context.Context, userClient ctrlruntimeclient.Client, obj *unstructured.Unstructured, owner string, previous []appskubermaticv1.AppliedResource) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+
+	if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), existing); err != nil {
+		// the kind might not exist yet if its CRD is applied by the application as well
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get %s %q: %w", obj.GetKind(), ctrlruntimeclient.ObjectKeyFromObject(obj), err)
+	}
+
+	existingOwner, ok := existing.GetAnnotations()[ApplicationOwnerAnnotation]
+	if existingOwner == owner || (!ok && containsResource(previous, appliedResource(obj))) {
+		return nil
+	}
+
+	return fmt.Errorf("%s %q already exists and is not managed by this application", obj.GetKind(), ctrlruntimeclient.ObjectKeyFromObject(obj))
+}
+
+// ownerValue returns the value of the ApplicationOwnerAnnotation for resources owned by the applicationInstallation.
+func ownerValue(applicationInstallation *appskubermaticv1.ApplicationInstallation) string {
+	return applicationInstallation.Namespace + "/" + applicationInstallation.Name
+}
+
+// setDefaultNamespaces sets the namespace of all namespaced objs without namespace to the namespace of the
+// applicationInstallation, like the resources are created when they are applied.
+func setDefaultNamespaces(userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation, objs []*unstructured.Unstructured) error {
+	for _, obj := range objs {
+		if err := setDefaultNamespace(userClient, obj, applicationInstallation.Spec.Namespace.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setDefaultNamespace(userClient ctrlruntimeclient.Client, obj *unstructured.Unstructured, namespace string) error {
+	if obj.GetNamespace() != "" {
+		return nil
+	}
+
+	namespaced, err := userClient.IsObjectNamespaced(obj)
+	if err != nil {
+		return fmt.Errorf("failed to determine scope of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	if namespaced {
+		obj.SetNamespace(namespace)
+	}
+	return nil
+}
+
+// deleteResources deletes resources from the user cluster in reverse order and returns the resources which could not
+// be deleted. Resources which are not owned by owner are left untouched and not returned, as they have been taken over
+// by someone else.
+func deleteResources(ctx context.Context, log *zap.SugaredLogger, userClient ctrlruntimeclient.Client, owner string, resources []appskubermaticv1.AppliedResource) ([]appskubermaticv1.AppliedResource, error) {
+	var (
+		remaining []appskubermaticv1.AppliedResource
+		errs      []error
+	)
+
+	for i := len(resources) - 1; i >= 0; i-- {
+		res := resources[i]
+		obj := resourceObject(res)
+
+		err := userClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), obj)
+		if err == nil {
+			if obj.GetAnnotations()[ApplicationOwnerAnnotation] != owner {
+				log.Infow("Not deleting resource which is not managed by the application", "kind", res.Kind, "namespace", res.Namespace, "name", res.Name)
+				continue
+			}
+
+			// only delete the object whose ownership has been checked
+			err = userClient.Delete(ctx, obj, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground), ctrlruntimeclient.Preconditions{UID: ptr.To(obj.GetUID())})
+		}
+		// the kind might not exist anymore if its CRD has already been deleted
+		if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			remaining = append([]appskubermaticv1.AppliedResource{res}, remaining...)
+			errs = append(errs, fmt.Errorf("failed to delete %s %q: %w", res.Kind, ctrlruntimeclient.ObjectKeyFromObject(obj), err))
+			continue
+		}
+
+		log.Debugw("Deleted resource", "kind", res.Kind, "namespace", res.Namespace, "name", res.Name)
+	}
+
+	return remaining, kerrors.NewAggregate(errs)
+}
+
+// uninstallResources deletes all resources applied by the applicationInstallation.
+func uninstallResources(ctx context.Context, log *zap.SugaredLogger, userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+	remaining, err := deleteResources(ctx, log, userClient, ownerValue(applicationInstallation), applicationInstallation.Status.AppliedResources)
+	return setAppliedResources(remaining), err
+}
+
+// resourcesDeployed returns true if all resources applied by the applicationInstallation exist in the user cluster.
+func resourcesDeployed(ctx context.Context, userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation) (bool, error) {
+	if len(applicationInstallation.Status.AppliedResources) == 0 {
+		return false, nil
+	}
+
+	for _, res := range applicationInstallation.Status.AppliedResources {
+		obj := resourceObject(res)
+		if err := userClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to get %s %q: %w", res.Kind, ctrlruntimeclient.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	return true, nil
+}
+
+func setAppliedResources(resources []appskubermaticv1.AppliedResource) util.StatusUpdater {
+	return func(status *appskubermaticv1.ApplicationInstallationStatus) {
+		if len(resources) == 0 {
+			resources = nil
+		}
+		status.AppliedResources = resources
+	}
+}
+
+func appliedResource(obj *unstructured.Unstructured) appskubermaticv1.AppliedResource {
+	return appskubermaticv1.AppliedResource{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// containsResource returns true if resources contain res. Resources are compared by group, kind, namespace and name,
+// so that a resource applied with a different API version, e.g. after an upgrade of the application, is still
+// considered to be the same resource.
+func containsResource(resources []appskubermaticv1.AppliedResource, res appskubermaticv1.AppliedResource) bool {
+	key := resourceKey(res)
+	return slices.ContainsFunc(resources, func(other appskubermaticv1.AppliedResource) bool {
+		return resourceKey(other) == key
+	})
+}
+
+type appliedResourceKey struct {
+	groupKind schema.GroupKind
+	namespace string
+	name      string
+}
+
+func resourceKey(res appskubermaticv1.AppliedResource) appliedResourceKey {
+	return appliedResourceKey{
+		groupKind: schema.FromAPIVersionAndKind(res.APIVersion, res.Kind).GroupKind(),
+		namespace: res.Namespace,
+		name:      res.Name,
+	}
+}
+
+func resourceObject(res appskubermaticv1.AppliedResource) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(res.APIVersion)
+	obj.SetKind(res.Kind)
+	obj.SetNamespace(res.Namespace)
+	obj.SetName(res.Name)
+	return obj
+}
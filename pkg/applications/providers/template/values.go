@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"dario.cat/mergo"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// GetValues returns the values of the applicationInstallation. The values referenced in ValuesFrom are merged in the
+// listed order and Values / ValuesBlock are merged on top of them. References to the seed cluster are resolved in
+// seedNamespace, all other references in the namespace of the applicationInstallation in the user cluster.
+func GetValues(ctx context.Context, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, seedNamespace string, applicationInstallation *appskubermaticv1.ApplicationInstallation) (map[string]any, error) {
+	values := map[string]any{}
+
+	for i, ref := range applicationInstallation.Spec.ValuesFrom {
+		client, namespace := userClient, applicationInstallation.Namespace
+		if ref.Seed {
+			client, namespace = seedClient, seedNamespace
+		}
+
+		refValues, err := getReferencedValues(ctx, client, namespace, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get values from valuesFrom[%d]: %w", i, err)
+		}
+
+		if err := mergo.Merge(&values, refValues, mergo.WithOverride); err != nil {
+			return nil, fmt.Errorf("failed to merge values from valuesFrom[%d]: %w", i, err)
+		}
+	}
+
+	inlineValues, err := applicationInstallation.Spec.GetParsedValues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal values: %w", err)
+	}
+
+	if err := mergo.Merge(&values, inlineValues, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge values: %w", err)
+	}
+
+	return values, nil
+}
+
+// getReferencedValues reads the values held by the Secret or ConfigMap referenced by ref. An empty map is returned if
+// the object or key does not exist and the reference is optional.
+func getReferencedValues(ctx context.Context, client ctrlruntimeclient.Client, namespace string, ref appskubermaticv1.ValuesReference) (map[string]any, error) {
+	var (
+		obj  ctrlruntimeclient.Object
+		data func() ([]byte, bool)
+	)
+
+	key := ref.GetKey()
+	switch ref.Kind {
+	case appskubermaticv1.ValuesReferenceKindSecret:
+		secret := &corev1.Secret{}
+		obj = secret
+		data = func() ([]byte, bool) {
+			value, ok := secret.Data[key]
+			return value, ok
+		}
+	case appskubermaticv1.ValuesReferenceKindConfigMap:
+		configMap := &corev1.ConfigMap{}
+		obj = configMap
+		data = func() ([]byte, bool) {
+			if value, ok := configMap.Data[key]; ok {
+				return []byte(value), true
+			}
+			value, ok := configMap.BinaryData[key]
+			return value, ok
+		}
+	default:
+		return nil, fmt.Errorf("unsupported kind %q", ref.Kind)
+	}
+
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, obj); err != nil {
+		if apierrors.IsNotFound(err) && ref.Optional {
+			return map[string]any{}, nil
+		}
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", ref.Kind, namespace, ref.Name, err)
+	}
+
+	if ref.Seed && obj.GetLabels()[appskubermaticv1.ApplicationValuesSourceLabel] != "true" {
+		return nil, fmt.Errorf("%s %s/%s in the seed cluster cannot be referenced, it is not labelled with %s=true", ref.Kind, namespace, ref.Name, appskubermaticv1.ApplicationValuesSourceLabel)
+	}
+
+	raw, ok := data()
+	if !ok {
+		if ref.Optional {
+			return map[string]any{}, nil
+		}
+		return nil, fmt.Errorf("key %q not found in %s %s/%s", key, ref.Kind, namespace, ref.Name)
+	}
+
+	values := map[string]any{}
+	if err := yaml.Unmarshal(raw, &values); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal key %q of %s %s/%s: %w", key, ref.Kind, namespace, ref.Name, err)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testSeedNamespace = "cluster-abcd1234"
+
+func TestGetValues(t *testing.T) {
+	userObjects := []ctrlruntimeclient.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "license", Namespace: "kube-system"},
+			Data: map[string][]byte{
+				"values.yaml": []byte("license:\n  key: secret\nreplicas: 1\n"),
+				"other.yaml":  []byte("replicas: 2\n"),
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "kube-system"},
+			Data: map[string]string{
+				"values.yaml": "license:\n  edition: enterprise\nreplicas: 3\n",
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "other"},
+			Data: map[string]string{
+				"values.yaml": "replicas: 4\n",
+			},
+		},
+	}
+	seedObjects := []ctrlruntimeclient.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "admin-values",
+				Namespace: testSeedNamespace,
+				Labels:    map[string]string{appskubermaticv1.ApplicationValuesSourceLabel: "true"},
+			},
+			Data: map[string][]byte{
+				"values.yaml": []byte("registry: registry.example.com\n"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "admin-kubeconfig", Namespace: testSeedNamespace},
+			Data: map[string][]byte{
+				"values.yaml": []byte("kubeconfig: secret\n"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		valuesFrom     []appskubermaticv1.ValuesReference
+		valuesBlock    string
+		expectedValues map[string]any
+		expectedErr    bool
+	}{
+		{
+			name:           "no valuesFrom returns inline values",
+			valuesBlock:    "replicas: 5\n",
+			expectedValues: map[string]any{"replicas": float64(5)},
+		},
+		{
+			name: "references are merged in order and inline values override them",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "license"},
+				{Kind: appskubermaticv1.ValuesReferenceKindConfigMap, Name: "config"},
+			},
+			valuesBlock: "license:\n  key: inline\n",
+			expectedValues: map[string]any{
+				"license":  map[string]any{"key": "inline", "edition": "enterprise"},
+				"replicas": float64(3),
+			},
+		},
+		{
+			name: "custom key is read",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "license", Key: "other.yaml"},
+			},
+			expectedValues: map[string]any{"replicas": float64(2)},
+		},
+		{
+			name: "labelled object in the seed cluster is read",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "admin-values", Seed: true},
+			},
+			expectedValues: map[string]any{"registry": "registry.example.com"},
+		},
+		{
+			name: "unlabelled object in the seed cluster cannot be referenced",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "admin-kubeconfig", Seed: true, Optional: true},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "missing optional object and key are ignored",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ValuesReferenceKindConfigMap, Name: "missing", Optional: true},
+				{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "license", Key: "missing", Optional: true},
+			},
+			expectedValues: map[string]any{},
+		},
+		{
+			name: "missing object fails",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ValuesReferenceKindConfigMap, Name: "missing"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "missing key fails",
+			valuesFrom: []appskubermaticv1.ValuesReference{
+				{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "license", Key: "missing"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appInstallation := &appskubermaticv1.ApplicationInstallation{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "kube-system"},
+				Spec: appskubermaticv1.ApplicationInstallationSpec{
+					ValuesBlock: tc.valuesBlock,
+					ValuesFrom:  tc.valuesFrom,
+				},
+			}
+
+			values, err := GetValues(
+				context.Background(),
+				fake.NewClientBuilder().WithObjects(seedObjects...).Build(),
+				fake.NewClientBuilder().WithObjects(userObjects...).Build(),
+				testSeedNamespace,
+				appInstallation,
+			)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(values, tc.expectedValues) {
+				t.Errorf("expected values %v, got %v", tc.expectedValues, values)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/applications/providers/template"
 	"k8c.io/kubermatic/v2/pkg/applications/providers/util"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -57,6 +58,10 @@ type TemplateProvider interface {
 	// InstallOrUpgrade the application from the source.
 	InstallOrUpgrade(source string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error)
 
+	// RenderResources returns the resources of the application as they should exist in the cluster. Namespaced
+	// resources without namespace are defaulted to the namespace of the application.
+	RenderResources(source string, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation) ([]*unstructured.Unstructured, error)
+
 	// Uninstall the application.
 	Uninstall(applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error)
 
@@ -72,10 +77,14 @@ type TemplateProvider interface {
 }
 
 // NewTemplateProvider return the concrete implementation of TemplateProvider according to the templateMethod.
-func NewTemplateProvider(ctx context.Context, seedClient ctrlruntimeclient.Client, clusterName string, kubeconfig string, cacheDir string, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation, secretNamespace string) (TemplateProvider, error) {
+func NewTemplateProvider(ctx context.Context, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, clusterName string, kubeconfig string, cacheDir string, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation, secretNamespace string) (TemplateProvider, error) {
 	switch appInstallation.Status.Method {
 	case appskubermaticv1.HelmTemplateMethod:
-		return template.HelmTemplate{Ctx: ctx, Kubeconfig: kubeconfig, CacheDir: cacheDir, Log: log, SecretNamespace: secretNamespace, ClusterName: clusterName, SeedClient: seedClient}, nil
+		return template.HelmTemplate{Ctx: ctx, Kubeconfig: kubeconfig, CacheDir: cacheDir, Log: log, SecretNamespace: secretNamespace, ClusterName: clusterName, SeedClient: seedClient, UserClient: userClient}, nil
+	case appskubermaticv1.KustomizeTemplateMethod:
+		return template.KustomizeTemplate{Ctx: ctx, CacheDir: cacheDir, Log: log, SecretNamespace: secretNamespace, ClusterName: clusterName, SeedClient: seedClient, UserClient: userClient}, nil
+	case appskubermaticv1.ManifestsTemplateMethod:
+		return template.ManifestsTemplate{Ctx: ctx, CacheDir: cacheDir, Log: log, SecretNamespace: secretNamespace, ClusterName: clusterName, SeedClient: seedClient, UserClient: userClient}, nil
 	default:
 		return nil, fmt.Errorf("template method '%v' not implemented", appInstallation.Status.Method)
 	}
@@ -46,13 +46,37 @@ type Deletion struct {
 	seedClient              ctrlruntimeclient.Client
 	recorder                events.EventRecorder
 	userClusterClientGetter func() (ctrlruntimeclient.Client, error)
+
+	// progress and currentStep are collected during a single CleanupCluster
+	// call and reported in the cluster status afterwards.
+	progress    map[kubermaticv1.ClusterDeletionStep]stepProgress
+	currentStep kubermaticv1.ClusterDeletionStep
 }
 
-// CleanupCluster is responsible for cleaning up a cluster.
+// CleanupCluster is responsible for cleaning up a cluster. The progress of the
+// individual steps is recorded in the cluster status.
 func (d *Deletion) CleanupCluster(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
 	log = log.Named("cleanup")
 
+	if err := d.skipSteps(ctx, log, cluster); err != nil {
+		return err
+	}
+
+	cleanupErr := d.cleanupCluster(ctx, log, cluster)
+	if cleanupErr != nil && d.currentStep != "" {
+		d.setProgress(d.currentStep, kubermaticv1.ClusterDeletionStepPhaseBlocked, cleanupErr.Error())
+	}
+
+	if err := d.updateDeletionStatus(ctx, cluster); err != nil {
+		log.Errorw("Failed to update deletion status", zap.Error(err))
+	}
+
+	return cleanupErr
+}
+
+func (d *Deletion) cleanupCluster(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
 	// Delete OPA constraints first to make sure some rules dont block deletion
+	d.enterStep(cluster, kubermaticv1.ClusterDeletionStepConstraints)
 	if err := d.cleanupConstraints(ctx, log, cluster); err != nil {
 		return err
 	}
@@ -71,6 +95,7 @@ func (d *Deletion) CleanupCluster(ctx context.Context, log *zap.SugaredLogger, c
 		return nil
 	}
 
+	d.enterStep(cluster, kubermaticv1.ClusterDeletionStepEtcdBackupConfigs)
 	if err := d.cleanupEtcdBackupConfigs(ctx, cluster); err != nil {
 		return err
 	}
@@ -80,6 +105,7 @@ func (d *Deletion) CleanupCluster(ctx context.Context, log *zap.SugaredLogger, c
 		return err
 	}
 
+	d.enterStep(cluster, kubermaticv1.ClusterDeletionStepNodes)
 	if err := d.cleanupNodes(ctx, cluster); err != nil {
 		return err
 	}
@@ -101,8 +127,10 @@ func (d *Deletion) CleanupCluster(ctx context.Context, log *zap.SugaredLogger, c
 	cred := kubermaticv1.CredentialsSecretsCleanupFinalizer
 	ns := kubermaticv1.NamespaceCleanupFinalizer
 
+	d.enterStep(cluster, kubermaticv1.ClusterDeletionStepNamespace)
 	if !kuberneteshelper.HasFinalizerSuperset(cluster, cred, ns) {
 		d.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "ClusterCleanup", "Reconciling", "Waiting for all finalizers except %q and %q to be removed before removing cluster namespace.", cred, ns)
+		d.waitForFinalizers(cluster, kubermaticv1.ClusterDeletionStepNamespace, cred, ns)
 		return nil
 	}
 
@@ -115,6 +143,7 @@ func (d *Deletion) CleanupCluster(ctx context.Context, log *zap.SugaredLogger, c
 		return nil // an event was already emitted in cleanupNamespace
 	}
 
+	d.enterStep(cluster, kubermaticv1.ClusterDeletionStepCredentialsSecrets)
 	if err := d.cleanupCredentialsSecrets(ctx, cluster); err != nil {
 		return err
 	}
@@ -140,6 +169,8 @@ func (d *Deletion) cleanupInClusterResources(ctx context.Context, log *zap.Sugar
 		var deletedSomeResource bool
 
 		if shouldDeleteLBs {
+			d.enterStep(cluster, kubermaticv1.ClusterDeletionStepLoadBalancers)
+
 			deletedSomeLBs, err := d.cleanupLBs(ctx, log, cluster)
 			if err != nil {
 				return fmt.Errorf("failed to cleanup LBs: %w", err)
@@ -148,6 +179,8 @@ func (d *Deletion) cleanupInClusterResources(ctx context.Context, log *zap.Sugar
 		}
 
 		if shouldDeletePVs {
+			d.enterStep(cluster, kubermaticv1.ClusterDeletionStepVolumes)
+
 			deletedSomeVolumes, err := d.cleanupVolumes(ctx, log, cluster)
 			if err != nil {
 				return fmt.Errorf("failed to cleanup PVs: %w", err)
@@ -171,6 +204,7 @@ func (d *Deletion) cleanupInClusterResources(ctx context.Context, log *zap.Sugar
 		}
 		// Return so we check again later
 		if !lbsAreGone {
+			d.setProgress(kubermaticv1.ClusterDeletionStepLoadBalancers, kubermaticv1.ClusterDeletionStepPhaseBlocked, "Waiting for the cloud provider to confirm that all LoadBalancers have been destroyed.")
 			return nil
 		}
 	}
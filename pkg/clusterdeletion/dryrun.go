@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DryRun lists the resources that would be removed when deleting the cluster, without
+// changing anything. Steps that have already been completed are not evaluated. Errors
+// are reported per step, so that a broken user cluster does not hide the other steps.
+func (d *Deletion) DryRun(ctx context.Context, cluster *kubermaticv1.Cluster) *kubermaticv1.ClusterDeletionDryRun {
+	skipped, _ := SkippedDeletionSteps(cluster)
+
+	var (
+		userClusterClient ctrlruntimeclient.Client
+		userClusterErr    error
+	)
+
+	if cluster.Status.NamespaceName == "" {
+		userClusterErr = errors.New("cluster has no namespace")
+	} else {
+		userClusterClient, userClusterErr = d.userClusterClientGetter()
+	}
+
+	result := &kubermaticv1.ClusterDeletionDryRun{
+		Time: metav1.Now(),
+	}
+
+	for _, step := range kubermaticv1.AllClusterDeletionSteps {
+		if !kuberneteshelper.HasFinalizer(cluster, stepFinalizers[step]) {
+			continue
+		}
+
+		var (
+			objects []ctrlruntimeclient.Object
+			err     error
+		)
+
+		userCluster := false
+
+		switch step {
+		case kubermaticv1.ClusterDeletionStepLoadBalancers, kubermaticv1.ClusterDeletionStepVolumes, kubermaticv1.ClusterDeletionStepNodes:
+			userCluster = true
+
+			if userClusterErr != nil {
+				err = fmt.Errorf("failed to connect to user cluster: %w", userClusterErr)
+				break
+			}
+
+			objects, err = dryRunUserClusterStep(ctx, userClusterClient, step)
+
+		default:
+			objects, err = d.dryRunSeedStep(ctx, cluster, step)
+		}
+
+		dryRunStep := kubermaticv1.ClusterDeletionDryRunStep{
+			Name:          step,
+			ResourceCount: len(objects),
+		}
+
+		for _, obj := range objects {
+			if len(dryRunStep.Resources) < maxReportedResources {
+				dryRunStep.Resources = append(dryRunStep.Resources, deletionResource(obj, userCluster))
+			}
+		}
+
+		switch {
+		case err != nil:
+			dryRunStep.Message = fmt.Sprintf("Could not determine resources: %v", err)
+		case skipped.Has(step):
+			dryRunStep.Message = "This step will be skipped, the resources will be left behind."
+		}
+
+		if dryRunStep.ResourceCount > 0 || dryRunStep.Message != "" {
+			result.Steps = append(result.Steps, dryRunStep)
+		}
+	}
+
+	return result
+}
+
+func (d *Deletion) dryRunSeedStep(ctx context.Context, cluster *kubermaticv1.Cluster, step kubermaticv1.ClusterDeletionStep) ([]ctrlruntimeclient.Object, error) {
+	switch step {
+	case kubermaticv1.ClusterDeletionStepConstraints:
+		if cluster.Status.NamespaceName == "" {
+			return nil, nil
+		}
+
+		constraints := &kubermaticv1.ConstraintList{}
+		if err := d.seedClient.List(ctx, constraints, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+			return nil, fmt.Errorf("failed to list Constraints: %w", err)
+		}
+
+		return toObjects(constraints.Items), nil
+
+	case kubermaticv1.ClusterDeletionStepEtcdBackupConfigs:
+		if cluster.Status.NamespaceName == "" {
+			return nil, nil
+		}
+
+		backupConfigs := &kubermaticv1.EtcdBackupConfigList{}
+		if err := d.seedClient.List(ctx, backupConfigs, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+			return nil, fmt.Errorf("failed to list EtcdBackupConfigs: %w", err)
+		}
+
+		return toObjects(backupConfigs.Items), nil
+
+	case kubermaticv1.ClusterDeletionStepNamespace:
+		namespace := cluster.Status.NamespaceName
+		if namespace == "" {
+			namespace = kubernetesprovider.NamespaceName(cluster.Name)
+		}
+
+		return getOptionalObject(ctx, d.seedClient, types.NamespacedName{Name: namespace}, &corev1.Namespace{})
+
+	case kubermaticv1.ClusterDeletionStepCredentialsSecrets:
+		secretName := cluster.GetSecretName()
+		if secretName == "" {
+			return nil, nil
+		}
+
+		return getOptionalObject(ctx, d.seedClient, types.NamespacedName{Name: secretName, Namespace: resources.KubermaticNamespace}, &corev1.Secret{})
+	}
+
+	return nil, nil
+}
+
+func dryRunUserClusterStep(ctx context.Context, client ctrlruntimeclient.Client, step kubermaticv1.ClusterDeletionStep) ([]ctrlruntimeclient.Object, error) {
+	switch step {
+	case kubermaticv1.ClusterDeletionStepLoadBalancers:
+		services := &corev1.ServiceList{}
+		if err := client.List(ctx, services); err != nil {
+			return nil, fmt.Errorf("failed to list Services: %w", err)
+		}
+
+		objects := []ctrlruntimeclient.Object{}
+		for i, service := range services.Items {
+			if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+				objects = append(objects, &services.Items[i])
+			}
+		}
+
+		return objects, nil
+
+	case kubermaticv1.ClusterDeletionStepVolumes:
+		pvcs := &corev1.PersistentVolumeClaimList{}
+		if err := client.List(ctx, pvcs); err != nil {
+			return nil, fmt.Errorf("failed to list PersistentVolumeClaims: %w", err)
+		}
+
+		pvs := &corev1.PersistentVolumeList{}
+		if err := client.List(ctx, pvs); err != nil {
+			return nil, fmt.Errorf("failed to list PersistentVolumes: %w", err)
+		}
+
+		objects := toObjects(pvcs.Items)
+		for i, pv := range pvs.Items {
+			// only dynamically provisioned volumes are removed by their provisioner
+			if pv.Annotations[AnnDynamicallyProvisioned] != "" && pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimDelete {
+				objects = append(objects, &pvs.Items[i])
+			}
+		}
+
+		return objects, nil
+
+	case kubermaticv1.ClusterDeletionStepNodes:
+		listOpts := ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)
+
+		machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+		if err := client.List(ctx, machineDeployments, listOpts); err != nil && !meta.IsNoMatchError(err) {
+			return nil, fmt.Errorf("failed to list MachineDeployments: %w", err)
+		}
+
+		machines := &clusterv1alpha1.MachineList{}
+		if err := client.List(ctx, machines, listOpts); err != nil && !meta.IsNoMatchError(err) {
+			return nil, fmt.Errorf("failed to list Machines: %w", err)
+		}
+
+		return append(toObjects(machineDeployments.Items), toObjects(machines.Items)...), nil
+	}
+
+	return nil, nil
+}
+
+func getOptionalObject(ctx context.Context, client ctrlruntimeclient.Client, key types.NamespacedName, obj ctrlruntimeclient.Object) ([]ctrlruntimeclient.Object, error) {
+	if err := client.Get(ctx, key, obj); err != nil {
+		return nil, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	return []ctrlruntimeclient.Object{obj}, nil
+}
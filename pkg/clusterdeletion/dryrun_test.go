@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestDryRun(t *testing.T) {
+	const namespace = "cluster-cluster"
+
+	testCases := []struct {
+		name               string
+		annotations        map[string]string
+		userClusterErr     error
+		expectedCounts     map[kubermaticv1.ClusterDeletionStep]int
+		expectedWithErrors []kubermaticv1.ClusterDeletionStep
+		expectedSkipped    []kubermaticv1.ClusterDeletionStep
+	}{
+		{
+			name: "all resources are listed",
+			expectedCounts: map[kubermaticv1.ClusterDeletionStep]int{
+				kubermaticv1.ClusterDeletionStepLoadBalancers: 1,
+				kubermaticv1.ClusterDeletionStepVolumes:       2,
+				kubermaticv1.ClusterDeletionStepNodes:         1,
+				kubermaticv1.ClusterDeletionStepNamespace:     1,
+			},
+		},
+		{
+			name: "skipped steps are marked",
+			annotations: map[string]string{
+				kubermaticv1.SkipDeletionStepsAnnotation: "Volumes",
+			},
+			expectedCounts: map[kubermaticv1.ClusterDeletionStep]int{
+				kubermaticv1.ClusterDeletionStepLoadBalancers: 1,
+				kubermaticv1.ClusterDeletionStepVolumes:       2,
+				kubermaticv1.ClusterDeletionStepNodes:         1,
+				kubermaticv1.ClusterDeletionStepNamespace:     1,
+			},
+			expectedSkipped: []kubermaticv1.ClusterDeletionStep{kubermaticv1.ClusterDeletionStepVolumes},
+		},
+		{
+			name:           "unreachable user cluster does not hide seed resources",
+			userClusterErr: errors.New("connection refused"),
+			expectedCounts: map[kubermaticv1.ClusterDeletionStep]int{
+				kubermaticv1.ClusterDeletionStepLoadBalancers: 0,
+				kubermaticv1.ClusterDeletionStepVolumes:       0,
+				kubermaticv1.ClusterDeletionStepNodes:         0,
+				kubermaticv1.ClusterDeletionStepNamespace:     1,
+			},
+			expectedWithErrors: []kubermaticv1.ClusterDeletionStep{
+				kubermaticv1.ClusterDeletionStepLoadBalancers,
+				kubermaticv1.ClusterDeletionStepVolumes,
+				kubermaticv1.ClusterDeletionStepNodes,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cluster",
+					Annotations: tc.annotations,
+					Finalizers: []string{
+						kubermaticv1.InClusterLBCleanupFinalizer,
+						kubermaticv1.InClusterPVCleanupFinalizer,
+						kubermaticv1.NodeDeletionFinalizer,
+						kubermaticv1.NamespaceCleanupFinalizer,
+					},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: namespace,
+				},
+			}
+
+			userClusterClient := fake.
+				NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(
+					&corev1.Service{
+						ObjectMeta: metav1.ObjectMeta{Name: "lb", Namespace: "default"},
+						Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+					},
+					&corev1.Service{
+						ObjectMeta: metav1.ObjectMeta{Name: "internal", Namespace: "default"},
+						Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+					},
+					&corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"},
+					},
+					&corev1.PersistentVolume{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        "dynamic",
+							Annotations: map[string]string{AnnDynamicallyProvisioned: "example.com/provisioner"},
+						},
+						Spec: corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+					},
+					&corev1.PersistentVolume{
+						ObjectMeta: metav1.ObjectMeta{Name: "static"},
+						Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain},
+					},
+					&clusterv1alpha1.Machine{
+						ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: metav1.NamespaceSystem},
+					},
+				).
+				Build()
+
+			seedClient := fake.
+				NewClientBuilder().
+				WithObjects(cluster, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}).
+				Build()
+
+			deletion := New(seedClient, &events.FakeRecorder{}, func() (ctrlruntimeclient.Client, error) {
+				return userClusterClient, tc.userClusterErr
+			})
+
+			result := deletion.DryRun(context.Background(), cluster)
+
+			steps := map[kubermaticv1.ClusterDeletionStep]kubermaticv1.ClusterDeletionDryRunStep{}
+			for _, step := range result.Steps {
+				steps[step.Name] = step
+			}
+
+			if len(steps) != len(tc.expectedCounts) {
+				t.Errorf("Expected %d steps, got %+v.", len(tc.expectedCounts), result.Steps)
+			}
+
+			for name, count := range tc.expectedCounts {
+				if step := steps[name]; step.ResourceCount != count || len(step.Resources) != count {
+					t.Errorf("Expected step %s to list %d resources, got %+v.", name, count, step)
+				}
+			}
+
+			for _, name := range tc.expectedWithErrors {
+				if steps[name].Message == "" {
+					t.Errorf("Expected step %s to contain an error message.", name)
+				}
+			}
+
+			for _, name := range tc.expectedSkipped {
+				if steps[name].Message == "" {
+					t.Errorf("Expected step %s to be marked as skipped.", name)
+				}
+			}
+
+			// a dry-run must not change anything
+			machines := &clusterv1alpha1.MachineList{}
+			if err := userClusterClient.List(context.Background(), machines); err != nil {
+				t.Fatalf("Failed to list machines: %v", err)
+			}
+			if len(machines.Items) != 1 {
+				t.Error("Expected machines to not be deleted during a dry-run.")
+			}
+		})
+	}
+}
@@ -47,6 +47,7 @@ func (d *Deletion) cleanupEtcdBackupConfigs(ctx context.Context, cluster *kuberm
 			}
 
 			d.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "EtcdBackupConfigCleanup", "Reconciling", "There are %d EtcdBackupConfig objects waiting for deletion.", len(backupConfigs.Items))
+			d.waitFor(kubermaticv1.ClusterDeletionStepEtcdBackupConfigs, false, fmt.Sprintf("Waiting for %d EtcdBackupConfig(s) to be deleted.", len(backupConfigs.Items)), toObjects(backupConfigs.Items)...)
 			return nil
 		}
 	}
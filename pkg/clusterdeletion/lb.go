@@ -52,6 +52,8 @@ func (d *Deletion) cleanupLBs(ctx context.Context, log *zap.SugaredLogger, clust
 		return false, fmt.Errorf("failed to list Service's from user cluster: %w", err)
 	}
 
+	deleted := []ctrlruntimeclient.Object{}
+
 	for _, service := range serviceList.Items {
 		// This service is already in deletion, nothing further needs to happen.
 		if service.DeletionTimestamp != nil {
@@ -71,6 +73,11 @@ func (d *Deletion) cleanupLBs(ctx context.Context, log *zap.SugaredLogger, clust
 			return deletedSomeLBs, fmt.Errorf("failed to delete service %q inside user cluster: %w", serviceName, err)
 		}
 		deletedSomeLBs = true
+		deleted = append(deleted, &service)
+	}
+
+	if deletedSomeLBs {
+		d.waitFor(kubermaticv1.ClusterDeletionStepLoadBalancers, true, fmt.Sprintf("Deleting %d LoadBalancer Service(s).", len(deleted)), deleted...)
 	}
 
 	return deletedSomeLBs, nil
@@ -63,6 +63,7 @@ func (d *Deletion) cleanupNamespace(ctx context.Context, log *zap.SugaredLogger,
 		}
 
 		d.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "ClusterNamespaceCleanup", "Reconciling", "Cluster namespace is still terminating, some resources might be blocked by finalizers.")
+		d.waitFor(kubermaticv1.ClusterDeletionStepNamespace, false, namespaceTerminationMessage(ns), ns)
 		return nil
 	}
 
@@ -79,3 +80,18 @@ func (d *Deletion) cleanupNamespace(ctx context.Context, log *zap.SugaredLogger,
 
 	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, kubermaticv1.NamespaceCleanupFinalizer)
 }
+
+// namespaceTerminationMessage returns a description of what the terminating namespace
+// is waiting for, based on the conditions set by the namespace controller.
+func namespaceTerminationMessage(ns *corev1.Namespace) string {
+	for _, condition := range ns.Status.Conditions {
+		switch condition.Type {
+		case corev1.NamespaceContentRemaining, corev1.NamespaceFinalizersRemaining:
+			if condition.Status == corev1.ConditionTrue {
+				return fmt.Sprintf("Waiting for the cluster namespace to be removed: %s", condition.Message)
+			}
+		}
+	}
+
+	return "Waiting for the cluster namespace to be removed."
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/util"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxReportedResources is the maximum number of resources listed per step in the
+	// cluster status, to keep the Cluster object reasonably small.
+	maxReportedResources = 10
+)
+
+// stepFinalizers maps each deletion step to the Cluster finalizer that is removed once
+// the step has been completed.
+var stepFinalizers = map[kubermaticv1.ClusterDeletionStep]string{
+	kubermaticv1.ClusterDeletionStepConstraints:        kubermaticv1.KubermaticConstraintCleanupFinalizer,
+	kubermaticv1.ClusterDeletionStepLoadBalancers:      kubermaticv1.InClusterLBCleanupFinalizer,
+	kubermaticv1.ClusterDeletionStepVolumes:            kubermaticv1.InClusterPVCleanupFinalizer,
+	kubermaticv1.ClusterDeletionStepEtcdBackupConfigs:  kubermaticv1.EtcdBackupConfigCleanupFinalizer,
+	kubermaticv1.ClusterDeletionStepNodes:              kubermaticv1.NodeDeletionFinalizer,
+	kubermaticv1.ClusterDeletionStepNamespace:          kubermaticv1.NamespaceCleanupFinalizer,
+	kubermaticv1.ClusterDeletionStepCredentialsSecrets: kubermaticv1.CredentialsSecretsCleanupFinalizer,
+}
+
+// stepProgress is the progress of a single step, as observed during the current
+// reconciliation.
+type stepProgress struct {
+	phase     kubermaticv1.ClusterDeletionStepPhase
+	message   string
+	resources []kubermaticv1.ClusterDeletionResource
+}
+
+// SkippedDeletionSteps returns the deletion steps an administrator chose to skip
+// using the SkipDeletionStepsAnnotation, as well as all unknown step names.
+func SkippedDeletionSteps(cluster *kubermaticv1.Cluster) (sets.Set[kubermaticv1.ClusterDeletionStep], []string) {
+	skipped := sets.New[kubermaticv1.ClusterDeletionStep]()
+	unknown := []string{}
+
+	for _, name := range strings.Split(cluster.Annotations[kubermaticv1.SkipDeletionStepsAnnotation], ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		step := kubermaticv1.ClusterDeletionStep(name)
+		if _, ok := stepFinalizers[step]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+
+		skipped.Insert(step)
+	}
+
+	return skipped, unknown
+}
+
+// skipSteps removes the finalizers of all steps that are listed in the SkipDeletionStepsAnnotation,
+// so that the cleanup does not wait for them anymore.
+func (d *Deletion) skipSteps(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	skipped, unknown := SkippedDeletionSteps(cluster)
+	if len(unknown) > 0 {
+		d.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ClusterCleanup", "Reconciling", "Ignoring unknown deletion steps in %s annotation: %s", kubermaticv1.SkipDeletionStepsAnnotation, strings.Join(unknown, ", "))
+	}
+
+	for _, step := range kubermaticv1.AllClusterDeletionSteps {
+		finalizer := stepFinalizers[step]
+		if !skipped.Has(step) || !kuberneteshelper.HasFinalizer(cluster, finalizer) {
+			continue
+		}
+
+		log.Warnw("Skipping deletion step", "step", step)
+		d.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ClusterCleanup", "Reconciling", "Deletion step %s has been skipped, resources might have been left behind.", step)
+
+		if err := kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, finalizer); err != nil {
+			return fmt.Errorf("failed to skip deletion step %s: %w", step, err)
+		}
+
+		d.setProgress(step, kubermaticv1.ClusterDeletionStepPhaseSkipped, "Skipped by an administrator.")
+	}
+
+	return nil
+}
+
+// enterStep marks the given step as the one currently being worked on, if it is not completed yet.
+func (d *Deletion) enterStep(cluster *kubermaticv1.Cluster, step kubermaticv1.ClusterDeletionStep) {
+	if !kuberneteshelper.HasFinalizer(cluster, stepFinalizers[step]) {
+		return
+	}
+
+	d.currentStep = step
+	d.setProgress(step, kubermaticv1.ClusterDeletionStepPhaseInProgress, "")
+}
+
+func (d *Deletion) setProgress(step kubermaticv1.ClusterDeletionStep, phase kubermaticv1.ClusterDeletionStepPhase, message string, resources ...kubermaticv1.ClusterDeletionResource) {
+	if d.progress == nil {
+		d.progress = map[kubermaticv1.ClusterDeletionStep]stepProgress{}
+	}
+
+	d.progress[step] = stepProgress{
+		phase:     phase,
+		message:   message,
+		resources: resources,
+	}
+}
+
+// waitFor records that the given step is waiting for the given objects to disappear. The step
+// is considered blocked if all of the objects are already being deleted, i.e. if KKP has
+// nothing left to do but wait for other controllers to remove their finalizers.
+func (d *Deletion) waitFor(step kubermaticv1.ClusterDeletionStep, userCluster bool, message string, objects ...ctrlruntimeclient.Object) {
+	phase := kubermaticv1.ClusterDeletionStepPhaseBlocked
+
+	var resources []kubermaticv1.ClusterDeletionResource
+
+	for _, obj := range objects {
+		if obj.GetDeletionTimestamp() == nil {
+			phase = kubermaticv1.ClusterDeletionStepPhaseInProgress
+		}
+
+		if len(resources) < maxReportedResources {
+			resources = append(resources, deletionResource(obj, userCluster))
+		}
+	}
+
+	d.setProgress(step, phase, message, resources...)
+}
+
+func deletionResource(obj ctrlruntimeclient.Object, userCluster bool) kubermaticv1.ClusterDeletionResource {
+	return kubermaticv1.ClusterDeletionResource{
+		Kind:        reflect.TypeOf(obj).Elem().Name(),
+		Namespace:   obj.GetNamespace(),
+		Name:        obj.GetName(),
+		UserCluster: userCluster,
+		Finalizers:  obj.GetFinalizers(),
+	}
+}
+
+// updateDeletionStatus writes the progress of all deletion steps into the cluster status. Steps
+// whose finalizer is gone are completed, all others keep their last known state until they
+// are reached again.
+func (d *Deletion) updateDeletionStatus(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	now := metav1.Now()
+
+	err := util.UpdateClusterStatus(ctx, d.seedClient, cluster, func(c *kubermaticv1.Cluster) {
+		if c.Status.Deletion == nil {
+			c.Status.Deletion = &kubermaticv1.ClusterDeletionStatus{}
+		}
+
+		c.Status.Deletion.Steps = d.stepStatuses(c, c.Status.Deletion.Steps, now)
+	})
+
+	// the cluster is gone once the last step has been completed
+	return ctrlruntimeclient.IgnoreNotFound(err)
+}
+
+func (d *Deletion) stepStatuses(cluster *kubermaticv1.Cluster, previous []kubermaticv1.ClusterDeletionStepStatus, now metav1.Time) []kubermaticv1.ClusterDeletionStepStatus {
+	previousByName := map[kubermaticv1.ClusterDeletionStep]kubermaticv1.ClusterDeletionStepStatus{}
+	for _, status := range previous {
+		previousByName[status.Name] = status
+	}
+
+	result := []kubermaticv1.ClusterDeletionStepStatus{}
+
+	for _, step := range kubermaticv1.AllClusterDeletionSteps {
+		prev, hasPrevious := previousByName[step]
+		progress, hasProgress := d.progress[step]
+
+		status := kubermaticv1.ClusterDeletionStepStatus{
+			Name:  step,
+			Phase: kubermaticv1.ClusterDeletionStepPhasePending,
+		}
+
+		switch {
+		case !kuberneteshelper.HasFinalizer(cluster, stepFinalizers[step]):
+			status.Phase = kubermaticv1.ClusterDeletionStepPhaseCompleted
+
+			if hasProgress && progress.phase == kubermaticv1.ClusterDeletionStepPhaseSkipped {
+				status.Phase = progress.phase
+				status.Message = progress.message
+			} else if hasPrevious && prev.Phase == kubermaticv1.ClusterDeletionStepPhaseSkipped {
+				status.Phase = prev.Phase
+				status.Message = prev.Message
+			}
+
+		case hasProgress:
+			status.Phase = progress.phase
+			status.Message = progress.message
+			status.BlockingResources = progress.resources
+
+		case hasPrevious:
+			// the step was not reached during this reconciliation
+			status = prev
+		}
+
+		status.LastTransitionTime = now
+		if hasPrevious && prev.Phase == status.Phase {
+			status.LastTransitionTime = prev.LastTransitionTime
+		}
+
+		result = append(result, status)
+	}
+
+	return result
+}
+
+// waitForFinalizers records that the given step is waiting for other controllers to remove their
+// finalizers from the Cluster, e.g. for the cloud provider resources to be cleaned up.
+func (d *Deletion) waitForFinalizers(cluster *kubermaticv1.Cluster, step kubermaticv1.ClusterDeletionStep, ignored ...string) {
+	remaining := sets.List(sets.New(cluster.Finalizers...).Delete(ignored...))
+	message := fmt.Sprintf("Waiting for the finalizers %s to be removed from the Cluster.", strings.Join(remaining, ", "))
+
+	d.setProgress(step, kubermaticv1.ClusterDeletionStepPhaseBlocked, message, deletionResource(cluster, false))
+}
+
+// toObjects converts the items of a typed list into a list of generic objects.
+func toObjects[T any, PT interface {
+	*T
+	ctrlruntimeclient.Object
+}](items []T) []ctrlruntimeclient.Object {
+	objects := make([]ctrlruntimeclient.Object, 0, len(items))
+	for i := range items {
+		objects = append(objects, PT(&items[i]))
+	}
+
+	return objects
+}
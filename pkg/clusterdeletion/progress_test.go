@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func getStuckPV() *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "stuck-pv",
+			Annotations:       map[string]string{AnnDynamicallyProvisioned: "example.com/provisioner"},
+			Finalizers:        []string{"example.com/foreign"},
+			DeletionTimestamp: &metav1.Time{Time: metav1.Now().Time},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+		},
+	}
+}
+
+func getDeletionStep(t *testing.T, cluster *kubermaticv1.Cluster, step kubermaticv1.ClusterDeletionStep) kubermaticv1.ClusterDeletionStepStatus {
+	t.Helper()
+
+	if cluster.Status.Deletion == nil {
+		t.Fatal("Expected deletion status to be set.")
+	}
+
+	for _, status := range cluster.Status.Deletion.Steps {
+		if status.Name == step {
+			return status
+		}
+	}
+
+	t.Fatalf("Deletion status does not contain step %s.", step)
+	return kubermaticv1.ClusterDeletionStepStatus{}
+}
+
+func TestDeletionStatus(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		annotations           map[string]string
+		userClusterObjects    []ctrlruntimeclient.Object
+		expectedPhases        map[kubermaticv1.ClusterDeletionStep]kubermaticv1.ClusterDeletionStepPhase
+		expectedBlockingKinds map[kubermaticv1.ClusterDeletionStep]string
+		expectedFinalizers    []string
+	}{
+		{
+			name:               "volume with foreign finalizer blocks the deletion",
+			userClusterObjects: []ctrlruntimeclient.Object{getStuckPV()},
+			expectedPhases: map[kubermaticv1.ClusterDeletionStep]kubermaticv1.ClusterDeletionStepPhase{
+				kubermaticv1.ClusterDeletionStepConstraints: kubermaticv1.ClusterDeletionStepPhaseCompleted,
+				kubermaticv1.ClusterDeletionStepVolumes:     kubermaticv1.ClusterDeletionStepPhaseBlocked,
+				kubermaticv1.ClusterDeletionStepNodes:       kubermaticv1.ClusterDeletionStepPhasePending,
+			},
+			expectedBlockingKinds: map[kubermaticv1.ClusterDeletionStep]string{
+				kubermaticv1.ClusterDeletionStepVolumes: "PersistentVolume",
+			},
+			expectedFinalizers: []string{
+				kubermaticv1.InClusterPVCleanupFinalizer,
+				kubermaticv1.NodeDeletionFinalizer,
+			},
+		},
+		{
+			name: "skipped volume step lets the deletion continue",
+			annotations: map[string]string{
+				kubermaticv1.SkipDeletionStepsAnnotation: "Volumes, Unknown",
+			},
+			userClusterObjects: []ctrlruntimeclient.Object{
+				getStuckPV(),
+				&clusterv1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "machine",
+						Namespace: metav1.NamespaceSystem,
+					},
+				},
+			},
+			expectedPhases: map[kubermaticv1.ClusterDeletionStep]kubermaticv1.ClusterDeletionStepPhase{
+				kubermaticv1.ClusterDeletionStepConstraints: kubermaticv1.ClusterDeletionStepPhaseCompleted,
+				kubermaticv1.ClusterDeletionStepVolumes:     kubermaticv1.ClusterDeletionStepPhaseSkipped,
+				kubermaticv1.ClusterDeletionStepNodes:       kubermaticv1.ClusterDeletionStepPhaseInProgress,
+			},
+			expectedBlockingKinds: map[kubermaticv1.ClusterDeletionStep]string{
+				kubermaticv1.ClusterDeletionStepNodes: "Machine",
+			},
+			expectedFinalizers: []string{
+				kubermaticv1.NodeDeletionFinalizer,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cluster",
+					Annotations: tc.annotations,
+					Finalizers: []string{
+						kubermaticv1.KubermaticConstraintCleanupFinalizer,
+						kubermaticv1.InClusterPVCleanupFinalizer,
+						kubermaticv1.NodeDeletionFinalizer,
+					},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-cluster",
+				},
+			}
+
+			userClusterClient := fake.
+				NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(tc.userClusterObjects...).
+				Build()
+
+			seedClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+
+			ctx := context.Background()
+			deletion := New(seedClient, &events.FakeRecorder{}, func() (ctrlruntimeclient.Client, error) {
+				return userClusterClient, nil
+			})
+
+			if err := deletion.CleanupCluster(ctx, kubermaticlog.Logger, cluster); err != nil {
+				t.Fatalf("Deletion failed: %v", err)
+			}
+
+			updated := &kubermaticv1.Cluster{}
+			if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			for step, expected := range tc.expectedPhases {
+				if status := getDeletionStep(t, updated, step); status.Phase != expected {
+					t.Errorf("Expected step %s to be %s, but is %s (%s).", step, expected, status.Phase, status.Message)
+				}
+			}
+
+			for step, kind := range tc.expectedBlockingKinds {
+				status := getDeletionStep(t, updated, step)
+				if len(status.BlockingResources) != 1 || status.BlockingResources[0].Kind != kind {
+					t.Errorf("Expected step %s to be blocked by a %s, but got %+v.", step, kind, status.BlockingResources)
+				}
+			}
+
+			if len(updated.Finalizers) != len(tc.expectedFinalizers) {
+				t.Fatalf("Expected finalizers %v, but got %v.", tc.expectedFinalizers, updated.Finalizers)
+			}
+			for i, finalizer := range tc.expectedFinalizers {
+				if updated.Finalizers[i] != finalizer {
+					t.Errorf("Expected finalizers %v, but got %v.", tc.expectedFinalizers, updated.Finalizers)
+				}
+			}
+		})
+	}
+}
+
+func TestDeletionStatusKeepsTransitionTime(t *testing.T) {
+	transition := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Finalizers: []string{kubermaticv1.InClusterPVCleanupFinalizer},
+		},
+	}
+
+	d := &Deletion{}
+	d.setProgress(kubermaticv1.ClusterDeletionStepVolumes, kubermaticv1.ClusterDeletionStepPhaseBlocked, "still stuck")
+
+	previous := []kubermaticv1.ClusterDeletionStepStatus{
+		{
+			Name:               kubermaticv1.ClusterDeletionStepVolumes,
+			Phase:              kubermaticv1.ClusterDeletionStepPhaseBlocked,
+			LastTransitionTime: transition,
+		},
+		{
+			Name:               kubermaticv1.ClusterDeletionStepNodes,
+			Phase:              kubermaticv1.ClusterDeletionStepPhaseInProgress,
+			LastTransitionTime: transition,
+		},
+	}
+
+	steps := d.stepStatuses(cluster, previous, metav1.Now())
+	if len(steps) != len(kubermaticv1.AllClusterDeletionSteps) {
+		t.Fatalf("Expected %d steps, got %d.", len(kubermaticv1.AllClusterDeletionSteps), len(steps))
+	}
+
+	for _, step := range steps {
+		switch step.Name {
+		case kubermaticv1.ClusterDeletionStepVolumes:
+			if !step.LastTransitionTime.Equal(&transition) || step.Message != "still stuck" {
+				t.Errorf("Expected unchanged phase to keep its transition time, got %+v.", step)
+			}
+		case kubermaticv1.ClusterDeletionStepNodes:
+			// the finalizer is gone, so the step must have been completed
+			if step.Phase != kubermaticv1.ClusterDeletionStepPhaseCompleted || step.LastTransitionTime.Equal(&transition) {
+				t.Errorf("Expected step to be completed with a new transition time, got %+v.", step)
+			}
+		}
+	}
+}
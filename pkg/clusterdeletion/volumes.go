@@ -92,6 +92,9 @@ func (d *Deletion) cleanupVolumes(ctx context.Context, log *zap.SugaredLogger, c
 		}
 	}
 
+	pending := append(toObjects(pvcList.Items), toObjects(pvList.Items)...)
+	d.waitFor(kubermaticv1.ClusterDeletionStepVolumes, true, fmt.Sprintf("Waiting for %d PersistentVolumeClaim(s) and %d PersistentVolume(s) to be removed.", len(pvcList.Items), len(pvList.Items)), pending...)
+
 	if len(pvList.Items) > 0 {
 		// We don't delete PVs but we want to wait for provisioners to cleanup dynamically provisioned PVs
 		// pretend we need to requeue to avoid removing finalizer prematurely
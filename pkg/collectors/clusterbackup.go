@@ -42,6 +42,7 @@ type clusterBackupCollector struct {
 	ObjectLastModifiedDate *prometheus.Desc
 	EmptyObjectCount       *prometheus.Desc
 	QuerySuccess           *prometheus.Desc
+	LastVerifiedBackupAge  *prometheus.Desc
 	client                 ctrlruntimeclient.Reader
 	logger                 *zap.SugaredLogger
 	caBundle               *certificates.CABundle
@@ -78,6 +79,10 @@ func MustRegisterClusterBackupCollector(
 		"kubermatic_etcdbackup_query_success",
 		"Whether querying the S3 was successful",
 		[]string{"destination"}, nil)
+	collector.LastVerifiedBackupAge = prometheus.NewDesc(
+		"kubermatic_etcdbackup_last_verified_backup_age_seconds",
+		"Age of the latest backup that was successfully verified, partitioned by cluster and EtcdBackupConfig",
+		[]string{"cluster", "backup_config"}, nil)
 
 	registry.MustRegister(&collector)
 }
@@ -87,6 +92,7 @@ func (c *clusterBackupCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.ObjectLastModifiedDate
 	ch <- c.EmptyObjectCount
 	ch <- c.QuerySuccess
+	ch <- c.LastVerifiedBackupAge
 }
 
 func (c *clusterBackupCollector) Collect(ch chan<- prometheus.Metric) {
@@ -110,6 +116,11 @@ func (c *clusterBackupCollector) collect(ctx context.Context, ch chan<- promethe
 		return nil
 	}
 
+	if err := c.collectVerifications(ctx, ch); err != nil {
+		// do not return an error, the S3 metrics do not depend on the verifications
+		c.logger.Errorw("Failed to collect backup verification metrics", zap.Error(err))
+	}
+
 	clusterList := &kubermaticv1.ClusterList{}
 	if err := c.client.List(ctx, clusterList); err != nil {
 		return fmt.Errorf("failed to list clusters: %w", err)
@@ -133,6 +144,32 @@ func (c *clusterBackupCollector) collect(ctx context.Context, ch chan<- promethe
 	return nil
 }
 
+func (c *clusterBackupCollector) collectVerifications(ctx context.Context, ch chan<- prometheus.Metric) error {
+	backupConfigs := &kubermaticv1.EtcdBackupConfigList{}
+	if err := c.client.List(ctx, backupConfigs); err != nil {
+		return fmt.Errorf("failed to list EtcdBackupConfigs: %w", err)
+	}
+
+	now := time.Now()
+
+	for _, backupConfig := range backupConfigs.Items {
+		if backupConfig.Spec.Verification == nil {
+			continue
+		}
+
+		// no metric is exported until the first backup has been verified
+		backup := getLatestVerifiedBackup(&backupConfig)
+		if backup == nil {
+			continue
+		}
+
+		age := now.Sub(backup.BackupFinishedTime.Time).Seconds()
+		ch <- prometheus.MustNewConstMetric(c.LastVerifiedBackupAge, prometheus.GaugeValue, age, backupConfig.Spec.Cluster.Name, backupConfig.Name)
+	}
+
+	return nil
+}
+
 func (c *clusterBackupCollector) collectDestination(ctx context.Context, ch chan<- prometheus.Metric, clusters []kubermaticv1.Cluster, destName string, destination *kubermaticv1.BackupDestination) error {
 	listOpts := minio.ListObjectsOptions{
 		Recursive: true,
@@ -222,3 +259,20 @@ func getEmptyObjectCount(objects []minio.ObjectInfo) (emptyObjects int) {
 
 	return emptyObjects
 }
+
+// getLatestVerifiedBackup returns the most recently finished backup that was successfully verified.
+func getLatestVerifiedBackup(backupConfig *kubermaticv1.EtcdBackupConfig) *kubermaticv1.BackupStatus {
+	var latest *kubermaticv1.BackupStatus
+
+	for i, backup := range backupConfig.Status.CurrentBackups {
+		if backup.VerificationPhase != kubermaticv1.BackupStatusPhaseCompleted {
+			continue
+		}
+
+		if latest == nil || backup.BackupFinishedTime.After(latest.BackupFinishedTime.Time) {
+			latest = &backupConfig.Status.CurrentBackups[i]
+		}
+	}
+
+	return latest
+}
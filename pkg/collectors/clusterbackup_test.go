@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetLatestVerifiedBackup(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(hoursAgo int) metav1.Time {
+		return metav1.NewTime(now.Add(-time.Duration(hoursAgo) * time.Hour))
+	}
+
+	testCases := []struct {
+		name     string
+		backups  []kubermaticv1.BackupStatus
+		expected string
+	}{
+		{
+			name: "no verified backups",
+			backups: []kubermaticv1.BackupStatus{
+				{BackupName: "unverified", BackupFinishedTime: at(2)},
+				{BackupName: "running", BackupFinishedTime: at(1), VerificationPhase: kubermaticv1.BackupStatusPhaseRunning},
+			},
+			expected: "",
+		},
+		{
+			name: "failed verifications are ignored",
+			backups: []kubermaticv1.BackupStatus{
+				{BackupName: "old", BackupFinishedTime: at(3), VerificationPhase: kubermaticv1.BackupStatusPhaseCompleted},
+				{BackupName: "middle", BackupFinishedTime: at(2), VerificationPhase: kubermaticv1.BackupStatusPhaseCompleted},
+				{BackupName: "latest", BackupFinishedTime: at(1), VerificationPhase: kubermaticv1.BackupStatusPhaseFailed},
+			},
+			expected: "middle",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backupConfig := &kubermaticv1.EtcdBackupConfig{
+				Status: kubermaticv1.EtcdBackupConfigStatus{
+					CurrentBackups: tc.backups,
+				},
+			}
+
+			name := ""
+			if backup := getLatestVerifiedBackup(backupConfig); backup != nil {
+				name = backup.BackupName
+			}
+
+			if name != tc.expected {
+				t.Fatalf("Expected backup %q, got %q.", tc.expected, name)
+			}
+		})
+	}
+}
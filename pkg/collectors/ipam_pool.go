@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ipamPoolPrefix = "kubermatic_ipam_pool_"
+)
+
+// IPAMPoolCollector exports metrics for IPAM pools, based on their status.
+type IPAMPoolCollector struct {
+	client ctrlruntimeclient.Reader
+
+	allocated *prometheus.Desc
+	free      *prometheus.Desc
+	exhausted *prometheus.Desc
+}
+
+func newIPAMPoolCollector(client ctrlruntimeclient.Reader) *IPAMPoolCollector {
+	return &IPAMPoolCollector{
+		client: client,
+		allocated: prometheus.NewDesc(
+			ipamPoolPrefix+"allocated",
+			"Number of IPs (type=range) or subnets (type=prefix) allocated to clusters",
+			[]string{"pool", "datacenter", "family"},
+			nil,
+		),
+		free: prometheus.NewDesc(
+			ipamPoolPrefix+"free",
+			"Number of IPs (type=range) or subnets (type=prefix) that are neither allocated nor excluded",
+			[]string{"pool", "datacenter", "family"},
+			nil,
+		),
+		exhausted: prometheus.NewDesc(
+			ipamPoolPrefix+"exhausted",
+			"Whether the pool cannot satisfy another allocation in the datacenter",
+			[]string{"pool", "datacenter"},
+			nil,
+		),
+	}
+}
+
+// MustRegisterIPAMPoolCollector registers the IPAM pool collector at the given prometheus registry.
+func MustRegisterIPAMPoolCollector(registry prometheus.Registerer, client ctrlruntimeclient.Reader) {
+	registry.MustRegister(newIPAMPoolCollector(client))
+}
+
+// Describe returns the metrics descriptors.
+func (cc IPAMPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cc.allocated
+	ch <- cc.free
+	ch <- cc.exhausted
+}
+
+// Collect gets called by prometheus to collect the metrics.
+func (cc IPAMPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	pools := &kubermaticv1.IPAMPoolList{}
+	if err := cc.client.List(context.Background(), pools); err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list IPAM pools in IPAMPoolCollector: %w", err))
+		return
+	}
+
+	for _, pool := range pools.Items {
+		for dc, status := range pool.Status.Datacenters {
+			cc.collectUtilization(ch, pool.Name, dc, primaryFamily(pool.Spec.Datacenters[dc]), status.IPAMPoolUtilization)
+			if status.IPv6 != nil {
+				cc.collectUtilization(ch, pool.Name, dc, "ipv6", *status.IPv6)
+			}
+
+			exhausted := 0
+			if status.Exhausted {
+				exhausted = 1
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				cc.exhausted,
+				prometheus.GaugeValue,
+				float64(exhausted),
+				pool.Name,
+				dc,
+			)
+		}
+	}
+}
+
+func (cc *IPAMPoolCollector) collectUtilization(ch chan<- prometheus.Metric, pool, dc, family string, utilization kubermaticv1.IPAMPoolUtilization) {
+	ch <- prometheus.MustNewConstMetric(
+		cc.allocated,
+		prometheus.GaugeValue,
+		float64(utilization.Allocated),
+		pool,
+		dc,
+		family,
+	)
+
+	ch <- prometheus.MustNewConstMetric(
+		cc.free,
+		prometheus.GaugeValue,
+		float64(utilization.Free),
+		pool,
+		dc,
+		family,
+	)
+}
+
+// primaryFamily returns the IP family of the pool CIDR configured directly on the datacenter settings.
+func primaryFamily(settings kubermaticv1.IPAMPoolDatacenterSettings) string {
+	ip, _, err := net.ParseCIDR(string(settings.PoolCIDR))
+	if err == nil && ip.To4() == nil {
+		return "ipv6"
+	}
+
+	return "ipv4"
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIPAMPoolMetrics(t *testing.T) {
+	kubermaticFakeClient := fake.
+		NewClientBuilder().
+		WithObjects(
+			&kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "metallb",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc1": {
+							Type:            kubermaticv1.IPAMPoolAllocationTypeRange,
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 8,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:        "2001:db8::/124",
+								AllocationRange: 8,
+							},
+						},
+						"dc2": {
+							Type:             kubermaticv1.IPAMPoolAllocationTypePrefix,
+							PoolCIDR:         "2001:db8::/56",
+							AllocationPrefix: 64,
+						},
+					},
+				},
+				Status: kubermaticv1.IPAMPoolStatus{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterStatus{
+						"dc1": {
+							IPAMPoolUtilization: kubermaticv1.IPAMPoolUtilization{Allocated: 8, Free: 8},
+							IPv6:                &kubermaticv1.IPAMPoolUtilization{Allocated: 16, Free: 0},
+							Clusters:            []string{"cluster1", "cluster2"},
+							Exhausted:           true,
+						},
+						"dc2": {
+							IPAMPoolUtilization: kubermaticv1.IPAMPoolUtilization{Allocated: 1, Free: 255},
+							Clusters:            []string{"cluster3"},
+						},
+					},
+				},
+			},
+		).
+		Build()
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(newIPAMPoolCollector(kubermaticFakeClient)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+# HELP kubermatic_ipam_pool_allocated Number of IPs (type=range) or subnets (type=prefix) allocated to clusters
+# TYPE kubermatic_ipam_pool_allocated gauge
+kubermatic_ipam_pool_allocated{datacenter="dc1",family="ipv4",pool="metallb"} 8
+kubermatic_ipam_pool_allocated{datacenter="dc1",family="ipv6",pool="metallb"} 16
+kubermatic_ipam_pool_allocated{datacenter="dc2",family="ipv6",pool="metallb"} 1
+# HELP kubermatic_ipam_pool_free Number of IPs (type=range) or subnets (type=prefix) that are neither allocated nor excluded
+# TYPE kubermatic_ipam_pool_free gauge
+kubermatic_ipam_pool_free{datacenter="dc1",family="ipv4",pool="metallb"} 8
+kubermatic_ipam_pool_free{datacenter="dc1",family="ipv6",pool="metallb"} 0
+kubermatic_ipam_pool_free{datacenter="dc2",family="ipv6",pool="metallb"} 255
+# HELP kubermatic_ipam_pool_exhausted Whether the pool cannot satisfy another allocation in the datacenter
+# TYPE kubermatic_ipam_pool_exhausted gauge
+kubermatic_ipam_pool_exhausted{datacenter="dc1",pool="metallb"} 1
+kubermatic_ipam_pool_exhausted{datacenter="dc2",pool="metallb"} 0
+`
+
+	if err := testutil.CollectAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	resourceQuotaPrefix = "kubermatic_resource_quota_"
+)
+
+// ResourceQuotaCollector exports metrics for project resource quotas.
+type ResourceQuotaCollector struct {
+	client ctrlruntimeclient.Reader
+
+	usage     *prometheus.Desc
+	limit     *prometheus.Desc
+	softLimit *prometheus.Desc
+	warning   *prometheus.Desc
+}
+
+func newResourceQuotaCollector(client ctrlruntimeclient.Reader) *ResourceQuotaCollector {
+	return &ResourceQuotaCollector{
+		client: client,
+		usage: prometheus.NewDesc(
+			resourceQuotaPrefix+"usage",
+			"Global usage of a resource in the project",
+			[]string{"project", "resource"},
+			nil,
+		),
+		limit: prometheus.NewDesc(
+			resourceQuotaPrefix+"limit",
+			"Quota of a resource in the project",
+			[]string{"project", "resource"},
+			nil,
+		),
+		softLimit: prometheus.NewDesc(
+			resourceQuotaPrefix+"soft_limit",
+			"Usage of a resource at which warnings are issued",
+			[]string{"project", "resource"},
+			nil,
+		),
+		warning: prometheus.NewDesc(
+			resourceQuotaPrefix+"warning",
+			"Whether a soft limit has been reached or the quota is exceeded in the project",
+			[]string{"project"},
+			nil,
+		),
+	}
+}
+
+// MustRegisterResourceQuotaCollector registers the resource quota collector at the given prometheus registry.
+func MustRegisterResourceQuotaCollector(registry prometheus.Registerer, client ctrlruntimeclient.Reader) {
+	registry.MustRegister(newResourceQuotaCollector(client))
+}
+
+// Describe returns the metrics descriptors.
+func (cc ResourceQuotaCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cc.usage
+	ch <- cc.limit
+	ch <- cc.softLimit
+	ch <- cc.warning
+}
+
+// Collect gets called by prometheus to collect the metrics.
+func (cc ResourceQuotaCollector) Collect(ch chan<- prometheus.Metric) {
+	quotas := &kubermaticv1.ResourceQuotaList{}
+	if err := cc.client.List(context.Background(), quotas); err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list resource quotas in ResourceQuotaCollector: %w", err))
+		return
+	}
+
+	for _, quota := range quotas.Items {
+		if quota.Spec.Subject.Kind != kubermaticv1.ProjectSubjectKind {
+			continue
+		}
+
+		cc.collectResourceQuota(ch, &quota)
+	}
+}
+
+func (cc *ResourceQuotaCollector) collectResourceQuota(ch chan<- prometheus.Metric, rq *kubermaticv1.ResourceQuota) {
+	project := rq.Spec.Subject.Name
+
+	thresholds := kubermaticv1.ResourceThresholds{}
+	if rq.Spec.WarningThresholds != nil {
+		thresholds = *rq.Spec.WarningThresholds
+	}
+
+	quota := rq.Spec.Quota
+	usage := rq.Status.GlobalUsage
+
+	for _, r := range []struct {
+		name      string
+		usage     *resource.Quantity
+		limit     *resource.Quantity
+		threshold *int32
+	}{
+		{name: "cpu", usage: usage.CPU, limit: quota.CPU, threshold: thresholds.CPU},
+		{name: "memory", usage: usage.Memory, limit: quota.Memory, threshold: thresholds.Memory},
+		{name: "storage", usage: usage.Storage, limit: quota.Storage, threshold: thresholds.Storage},
+		{name: "gpus", usage: usage.GPUs, limit: quota.GPUs, threshold: thresholds.GPUs},
+		{name: "nodes", usage: usage.Nodes, limit: quota.Nodes, threshold: thresholds.Nodes},
+		{name: "loadBalancers", usage: usage.LoadBalancers, limit: quota.LoadBalancers, threshold: thresholds.LoadBalancers},
+		{name: "clusters", usage: usage.Clusters, limit: quota.Clusters, threshold: thresholds.Clusters},
+	} {
+		if r.usage != nil {
+			ch <- prometheus.MustNewConstMetric(cc.usage, prometheus.GaugeValue, r.usage.AsApproximateFloat64(), project, r.name)
+		}
+
+		if r.limit != nil {
+			ch <- prometheus.MustNewConstMetric(cc.limit, prometheus.GaugeValue, r.limit.AsApproximateFloat64(), project, r.name)
+
+			if r.threshold != nil {
+				ch <- prometheus.MustNewConstMetric(cc.softLimit, prometheus.GaugeValue, r.limit.AsApproximateFloat64()*float64(*r.threshold)/100, project, r.name)
+			}
+		}
+	}
+
+	warning := 0
+	if rq.Status.Conditions[kubermaticv1.ResourceQuotaConditionQuotaWarning].Status == corev1.ConditionTrue {
+		warning = 1
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		cc.warning,
+		prometheus.GaugeValue,
+		float64(warning),
+		project,
+	)
+}
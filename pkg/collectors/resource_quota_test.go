@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestResourceQuotaMetrics(t *testing.T) {
+	kubermaticFakeClient := fake.
+		NewClientBuilder().
+		WithObjects(
+			&kubermaticv1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "project-abc",
+				},
+				Spec: kubermaticv1.ResourceQuotaSpec{
+					Subject: kubermaticv1.Subject{
+						Name: "abc",
+						Kind: kubermaticv1.ProjectSubjectKind,
+					},
+					Quota: kubermaticv1.ResourceDetails{
+						CPU:  ptr.To(resource.MustParse("10")),
+						GPUs: ptr.To(resource.MustParse("4")),
+					},
+					WarningThresholds: &kubermaticv1.ResourceThresholds{
+						GPUs: ptr.To[int32](75),
+					},
+				},
+				Status: kubermaticv1.ResourceQuotaStatus{
+					GlobalUsage: kubermaticv1.ResourceDetails{
+						CPU:  ptr.To(resource.MustParse("2500m")),
+						GPUs: ptr.To(resource.MustParse("3")),
+					},
+					Conditions: map[kubermaticv1.ResourceQuotaConditionType]kubermaticv1.ResourceQuotaCondition{
+						kubermaticv1.ResourceQuotaConditionQuotaWarning: {
+							Status: corev1.ConditionTrue,
+							Reason: "SoftLimitReached",
+						},
+					},
+				},
+			},
+		).
+		Build()
+
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(newResourceQuotaCollector(kubermaticFakeClient)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `
+# HELP kubermatic_resource_quota_limit Quota of a resource in the project
+# TYPE kubermatic_resource_quota_limit gauge
+kubermatic_resource_quota_limit{project="abc",resource="cpu"} 10
+kubermatic_resource_quota_limit{project="abc",resource="gpus"} 4
+# HELP kubermatic_resource_quota_soft_limit Usage of a resource at which warnings are issued
+# TYPE kubermatic_resource_quota_soft_limit gauge
+kubermatic_resource_quota_soft_limit{project="abc",resource="gpus"} 3
+# HELP kubermatic_resource_quota_usage Global usage of a resource in the project
+# TYPE kubermatic_resource_quota_usage gauge
+kubermatic_resource_quota_usage{project="abc",resource="cpu"} 2.5
+kubermatic_resource_quota_usage{project="abc",resource="gpus"} 3
+# HELP kubermatic_resource_quota_warning Whether a soft limit has been reached or the quota is exceeded in the project
+# TYPE kubermatic_resource_quota_warning gauge
+kubermatic_resource_quota_warning{project="abc"} 1
+`
+
+	if err := testutil.CollectAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
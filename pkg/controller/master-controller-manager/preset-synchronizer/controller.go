@@ -29,11 +29,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const (
@@ -64,14 +67,22 @@ func Add(
 		recorder:     masterMgr.GetEventRecorder(ControllerName),
 	}
 
+	bldr := builder.ControllerManagedBy(masterMgr).
+		Named(ControllerName).
+		For(&kubermaticv1.Preset{})
+
 	for seedName, seedManager := range seedManagers {
 		r.seedClients[seedName] = seedManager.GetClient()
+
+		// the seed presets report the clusters created from them in their status
+		bldr.WatchesRawSource(source.Kind(
+			seedManager.GetCache(),
+			&kubermaticv1.Preset{},
+			&handler.TypedEnqueueRequestForObject[*kubermaticv1.Preset]{},
+		))
 	}
 
-	_, err := builder.ControllerManagedBy(masterMgr).
-		Named(ControllerName).
-		For(&kubermaticv1.Preset{}).
-		Build(r)
+	_, err := bldr.Build(r)
 
 	return err
 }
@@ -124,6 +135,50 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, requ
 		r.recorder.Eventf(preset, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
 		return fmt.Errorf("reconciled preset: %s: %w", preset.Name, err)
 	}
+
+	if err := r.reconcileClusterCounts(ctx, log, request.NamespacedName); err != nil {
+		return fmt.Errorf("failed to count clusters of preset %s: %w", preset.Name, err)
+	}
+
+	return nil
+}
+
+// reconcileClusterCounts records the number of clusters created from the preset on all
+// other seeds in the status of each seed preset, so that the cluster validation webhook
+// on every seed can enforce the cluster limit of the preset across all seeds.
+func (r *reconciler) reconcileClusterCounts(ctx context.Context, log *zap.SugaredLogger, name types.NamespacedName) error {
+	seedPresets := map[string]*kubermaticv1.Preset{}
+	var total int32
+
+	err := r.seedClients.Each(ctx, log, func(seedName string, seedClient ctrlruntimeclient.Client, _ *zap.SugaredLogger) error {
+		seedPreset := &kubermaticv1.Preset{}
+		if err := seedClient.Get(ctx, name, seedPreset); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+
+		seedPresets[seedName] = seedPreset
+		total += int32(len(seedPreset.Status.Clusters))
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for seedName, seedPreset := range seedPresets {
+		clustersOnOtherSeeds := total - int32(len(seedPreset.Status.Clusters))
+		if seedPreset.Status.ClustersOnOtherSeeds == clustersOnOtherSeeds {
+			continue
+		}
+
+		oldPreset := seedPreset.DeepCopy()
+		seedPreset.Status.ClustersOnOtherSeeds = clustersOnOtherSeeds
+
+		if err := r.seedClients[seedName].Status().Patch(ctx, seedPreset, ctrlruntimeclient.MergeFrom(oldPreset)); err != nil {
+			return fmt.Errorf("failed to update status of preset on seed %s: %w", seedName, err)
+		}
+	}
+
 	return nil
 }
 
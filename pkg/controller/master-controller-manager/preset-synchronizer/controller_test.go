@@ -130,3 +130,47 @@ func generatePreset(name string, deleted bool) *kubermaticv1.Preset {
 	}
 	return pr
 }
+
+func TestReconcileClusterCounts(t *testing.T) {
+	seedPreset := func(clusters ...string) *kubermaticv1.Preset {
+		preset := generatePreset(presetName, false)
+		preset.Status.Clusters = clusters
+		return preset
+	}
+
+	seedClients := map[string]ctrlruntimeclient.Client{
+		"first":  fake.NewClientBuilder().WithObjects(seedPreset("a", "b")).Build(),
+		"second": fake.NewClientBuilder().WithObjects(seedPreset("c")).Build(),
+		"third":  fake.NewClientBuilder().Build(),
+	}
+
+	ctx := context.Background()
+	r := &reconciler{
+		log:          kubermaticlog.Logger,
+		recorder:     &events.FakeRecorder{},
+		masterClient: fake.NewClientBuilder().WithObjects(generatePreset(presetName, false)).Build(),
+		seedClients:  seedClients,
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: presetName}}
+	if _, err := r.Reconcile(ctx, request); err != nil {
+		t.Fatalf("reconciling failed: %v", err)
+	}
+
+	expected := map[string]int32{
+		"first":  1,
+		"second": 2,
+		"third":  3,
+	}
+
+	for seedName, clustersOnOtherSeeds := range expected {
+		preset := &kubermaticv1.Preset{}
+		if err := seedClients[seedName].Get(ctx, request.NamespacedName, preset); err != nil {
+			t.Fatalf("failed to get preset on seed %s: %v", seedName, err)
+		}
+
+		if preset.Status.ClustersOnOtherSeeds != clustersOnOtherSeeds {
+			t.Errorf("expected %d clusters on other seeds for seed %s, got %d", clustersOnOtherSeeds, seedName, preset.Status.ClustersOnOtherSeeds)
+		}
+	}
+}
@@ -16,6 +16,7 @@ limitations under the License.
 
 /*
 Package presetsynchronizer contains a controller that is responsible for ensuring that the
-kubermatic Preset objects are synced from master to the seed clusters.
+kubermatic Preset objects are synced from master to the seed clusters. It also sums up the clusters
+created from each preset on all seeds, so that the cluster limit of a preset is enforced globally.
 */
 package presetsynchronizer
@@ -97,6 +97,16 @@ type Options struct {
 	// UpstreamTCPKeepaliveProbeAttempts configures how many unanswered upstream
 	// keepalive probes are allowed before the socket is considered dead.
 	UpstreamTCPKeepaliveProbeAttempts int
+
+	// The following limits are applied per exposed Service port and can be
+	// overridden using Service annotations. Zero values disable the limit.
+	// MaxConnections bounds the number of concurrent upstream connections.
+	MaxConnections int
+	// ConnectionRate bounds the number of new connections per second.
+	ConnectionRate int
+	// ConnectionBurst is the number of new connections that can be accepted
+	// at once before ConnectionRate is enforced. Defaults to ConnectionRate.
+	ConnectionBurst int
 }
 
 func (o Options) IsSNIEnabled() bool {
@@ -171,6 +181,30 @@ func (o Options) GetUpstreamTCPKeepaliveProbeAttempts() uint32 {
 	return uint32(o.UpstreamTCPKeepaliveProbeAttempts)
 }
 
+func (o Options) GetMaxConnections() uint32 {
+	if o.MaxConnections <= 0 {
+		return 0
+	}
+
+	return uint32(o.MaxConnections)
+}
+
+func (o Options) GetConnectionRate() uint32 {
+	if o.ConnectionRate <= 0 {
+		return 0
+	}
+
+	return uint32(o.ConnectionRate)
+}
+
+func (o Options) GetConnectionBurst() uint32 {
+	if o.ConnectionBurst <= 0 {
+		return 0
+	}
+
+	return uint32(o.ConnectionBurst)
+}
+
 // NewReconciler returns a new Reconciler or an error if something goes wrong
 // during the initial snapshot setup.
 func NewReconciler(ctx context.Context, log *zap.SugaredLogger, client ctrlruntimeclient.Client, opts Options) (*Reconciler, envoycachev3.SnapshotCache, error) {
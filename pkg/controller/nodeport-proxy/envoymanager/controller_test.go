@@ -18,6 +18,8 @@ package envoymanager
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -30,9 +32,15 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyrbacconfigv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhttplocalratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoyhttprbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	envoyhttpconnectionmanagerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoylocalratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
 	envoytcpfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoyproxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	envoyresourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 
@@ -782,7 +790,7 @@ func TestConnectionSettings(t *testing.T) {
 			assert: func(t *testing.T, sb *snapshotBuilder) {
 				t.Helper()
 
-				filterChains := makeSNIFilterChains(svc, portHostMapping{"https": "host.com"}, sb.GetSNIListenerIdleTimeout())
+				filterChains := makeSNIFilterChains(svc, portHostMapping{"https": "host.com"}, sb.GetSNIListenerIdleTimeout(), connectionLimits{})
 				if len(filterChains) != 1 {
 					t.Fatalf("expected exactly one filter chain, got %d", len(filterChains))
 				}
@@ -818,7 +826,7 @@ func TestConnectionSettings(t *testing.T) {
 					t.Fatalf("expected tunneling stream idle timeout to be unset when not configured")
 				}
 
-				nodePortListeners, _ := sb.makeListenersForNodePortService(svc)
+				nodePortListeners, _ := sb.makeListenersForNodePortService(svc, connectionLimits{})
 				if len(nodePortListeners) != 1 {
 					t.Fatalf("expected exactly one nodeport listener, got %d", len(nodePortListeners))
 				}
@@ -830,7 +838,7 @@ func TestConnectionSettings(t *testing.T) {
 					t.Fatalf("expected nodeport listener tcp keepalive to be unset when not configured")
 				}
 
-				clusters := sb.makeClusters(svc, epSlices, sets.New("https"))
+				clusters := sb.makeClusters(svc, epSlices, sets.New("https"), connectionLimits{}, nil)
 				if len(clusters) != 1 {
 					t.Fatalf("expected exactly one cluster, got %d", len(clusters))
 				}
@@ -861,7 +869,7 @@ func TestConnectionSettings(t *testing.T) {
 			assert: func(t *testing.T, sb *snapshotBuilder) {
 				t.Helper()
 
-				filterChains := makeSNIFilterChains(svc, portHostMapping{"https": "host.com"}, sb.GetSNIListenerIdleTimeout())
+				filterChains := makeSNIFilterChains(svc, portHostMapping{"https": "host.com"}, sb.GetSNIListenerIdleTimeout(), connectionLimits{})
 				tcpProxyAny := filterChains[0].Filters[0].GetTypedConfig()
 				tcpProxy := &envoytcpfilterv3.TcpProxy{}
 				if err := tcpProxyAny.UnmarshalTo(tcpProxy); err != nil {
@@ -889,11 +897,11 @@ func TestConnectionSettings(t *testing.T) {
 					t.Fatalf("unexpected tunneling stream idle timeout: got %s, want %s", got, want)
 				}
 
-				nodePortListeners, _ := sb.makeListenersForNodePortService(svc)
+				nodePortListeners, _ := sb.makeListenersForNodePortService(svc, connectionLimits{})
 				nodePortListener := nodePortListeners[0].(*envoylistenerv3.Listener)
 				assertTCPKeepalive(t, nodePortListener.GetTcpKeepalive(), 5, 5*time.Minute, 30*time.Second)
 
-				clusters := sb.makeClusters(svc, epSlices, sets.New("https"))
+				clusters := sb.makeClusters(svc, epSlices, sets.New("https"), connectionLimits{}, nil)
 				cluster := clusters[0].(*envoyclusterv3.Cluster)
 				assertTCPKeepalive(t, cluster.GetUpstreamConnectionOptions().GetTcpKeepalive(), 5, 5*time.Minute, 30*time.Second)
 			},
@@ -923,7 +931,7 @@ func TestConnectionSettings(t *testing.T) {
 					t.Fatalf("expected downstream keepalive probes to be unset when not configured")
 				}
 
-				clusters := sb.makeClusters(svc, epSlices, sets.New("https"))
+				clusters := sb.makeClusters(svc, epSlices, sets.New("https"), connectionLimits{}, nil)
 				cluster := clusters[0].(*envoyclusterv3.Cluster)
 				upstreamKeepalive := cluster.GetUpstreamConnectionOptions().GetTcpKeepalive()
 				if upstreamKeepalive == nil {
@@ -954,6 +962,282 @@ func TestConnectionSettings(t *testing.T) {
 	}
 }
 
+func TestConnectionLimits(t *testing.T) {
+	svc := test.NewServiceBuilder(test.NamespacedName{Name: "my-nodeport", Namespace: "test"}).
+		WithServiceType(corev1.ServiceTypeNodePort).
+		WithServicePort("https", 443, 32000, intstr.FromString("https"), corev1.ProtocolTCP).
+		Build()
+
+	tests := []struct {
+		name                   string
+		options                Options
+		annotations            map[string]string
+		expectedMaxConnections uint32
+		expectedRate           uint32
+		expectedBucketSize     uint32
+	}{
+		{
+			name: "no_limits",
+		},
+		{
+			name: "default_limits",
+			options: Options{
+				MaxConnections: 100,
+				ConnectionRate: 10,
+			},
+			expectedMaxConnections: 100,
+			expectedRate:           10,
+			expectedBucketSize:     10,
+		},
+		{
+			name: "annotations_override_default_limits",
+			options: Options{
+				MaxConnections: 100,
+				ConnectionRate: 10,
+			},
+			annotations: map[string]string{
+				nodeportproxy.MaxConnectionsAnnotationKey:  "0",
+				nodeportproxy.ConnectionRateAnnotationKey:  "5",
+				nodeportproxy.ConnectionBurstAnnotationKey: "20",
+			},
+			expectedRate:       5,
+			expectedBucketSize: 20,
+		},
+		{
+			name: "invalid_annotations_are_ignored",
+			options: Options{
+				MaxConnections: 100,
+			},
+			annotations: map[string]string{
+				nodeportproxy.MaxConnectionsAnnotationKey: "lots",
+			},
+			expectedMaxConnections: 100,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sb := &snapshotBuilder{
+				Options: tc.options,
+				log:     zaptest.NewLogger(t).Sugar(),
+			}
+
+			svc := svc.DeepCopy()
+			svc.Annotations = tc.annotations
+
+			limits, _ := connectionLimitsFromAnnotations(svc, sb.defaultConnectionLimits())
+
+			clusters := sb.makeClusters(svc, nil, sets.New("https"), limits, nil)
+			cluster := clusters[0].(*envoyclusterv3.Cluster)
+			if got := cluster.GetCircuitBreakers().GetThresholds(); tc.expectedMaxConnections == 0 {
+				if got != nil {
+					t.Fatalf("expected circuit breakers to be unset, got %v", got)
+				}
+			} else if len(got) != 1 || got[0].GetMaxConnections().GetValue() != tc.expectedMaxConnections {
+				t.Fatalf("expected max connections %d, got %v", tc.expectedMaxConnections, got)
+			}
+
+			nodePortListeners, _ := sb.makeListenersForNodePortService(svc, limits)
+			nodePortListener := nodePortListeners[0].(*envoylistenerv3.Listener)
+			assertRateLimitFilter(t, nodePortListener.FilterChains[0].Filters, tc.expectedRate, tc.expectedBucketSize)
+
+			filterChains := makeSNIFilterChains(svc, portHostMapping{"https": "host.com"}, 0, limits)
+			assertRateLimitFilter(t, filterChains[0].Filters, tc.expectedRate, tc.expectedBucketSize)
+
+			vhs, _ := sb.makeTunnelingVirtualHosts(svc, limits)
+			hcm := &envoyhttpconnectionmanagerv3.HttpConnectionManager{}
+			if err := sb.makeTunnelingListener(vhs...).FilterChains[0].Filters[0].GetTypedConfig().UnmarshalTo(hcm); err != nil {
+				t.Fatalf("failed to unmarshal HTTP connection manager config: %v", err)
+			}
+
+			perFilterConfig, ok := vhs[0].GetTypedPerFilterConfig()[httpLocalRateLimitFilterName]
+			if tc.expectedRate == 0 {
+				if ok {
+					t.Fatal("expected no HTTP rate limit for the virtual host")
+				}
+				if len(hcm.HttpFilters) != 1 {
+					t.Fatalf("expected only the router HTTP filter, got %d filters", len(hcm.HttpFilters))
+				}
+				return
+			}
+
+			if hcm.HttpFilters[0].Name != httpLocalRateLimitFilterName {
+				t.Fatalf("expected first HTTP filter to be %s, got %s", httpLocalRateLimitFilterName, hcm.HttpFilters[0].Name)
+			}
+
+			rateLimit := &envoyhttplocalratelimitv3.LocalRateLimit{}
+			if err := perFilterConfig.UnmarshalTo(rateLimit); err != nil {
+				t.Fatalf("failed to unmarshal HTTP local rate limit config: %v", err)
+			}
+			assertTokenBucket(t, rateLimit.GetTokenBucket(), tc.expectedRate, tc.expectedBucketSize)
+		})
+	}
+}
+
+func TestProxyProtocol(t *testing.T) {
+	svc := test.NewServiceBuilder(test.NamespacedName{Name: "my-service", Namespace: "test"}).
+		WithServiceType(corev1.ServiceTypeClusterIP).
+		WithServicePort("secure", 443, 0, intstr.FromInt(6443), corev1.ProtocolTCP).
+		WithServicePort("secure-proxy-protocol", 6444, 0, intstr.FromInt(6444), corev1.ProtocolTCP).
+		WithAnnotation(nodeportproxy.ProxyProtocolPortsAnnotationKey, "secure-proxy-protocol").
+		Build()
+
+	sb := &snapshotBuilder{
+		log: zaptest.NewLogger(t).Sugar(),
+	}
+
+	clusters := sb.makeClusters(svc, nil, sets.New("secure", "secure-proxy-protocol"), connectionLimits{}, proxyProtocolPortsFromAnnotation(svc))
+	if len(clusters) != 2 {
+		t.Fatalf("expected two clusters, got %d", len(clusters))
+	}
+
+	if transportSocket := clusters[0].(*envoyclusterv3.Cluster).GetTransportSocket(); transportSocket != nil {
+		t.Fatalf("expected no transport socket for port without PROXY protocol, got %v", transportSocket)
+	}
+
+	transportSocket := clusters[1].(*envoyclusterv3.Cluster).GetTransportSocket()
+	if transportSocket.GetName() != proxyProtocolTransportSocketName {
+		t.Fatalf("expected %s transport socket, got %v", proxyProtocolTransportSocketName, transportSocket)
+	}
+
+	proxyProtocol := &envoyproxyprotocolv3.ProxyProtocolUpstreamTransport{}
+	if err := transportSocket.GetTypedConfig().UnmarshalTo(proxyProtocol); err != nil {
+		t.Fatalf("failed to unmarshal upstream proxy protocol config: %v", err)
+	}
+
+	if got := proxyProtocol.GetConfig().GetVersion(); got != envoycorev3.ProxyProtocolConfig_V2 {
+		t.Fatalf("expected PROXY protocol version %v, got %v", envoycorev3.ProxyProtocolConfig_V2, got)
+	}
+}
+
+func TestTunnelingAllowedIPRanges(t *testing.T) {
+	svc := test.NewServiceBuilder(test.NamespacedName{Name: "my-service", Namespace: "test"}).
+		WithServiceType(corev1.ServiceTypeClusterIP).
+		WithServicePort("secure", 443, 0, intstr.FromInt(6443), corev1.ProtocolTCP).
+		Build()
+
+	tests := []struct {
+		name               string
+		annotations        map[string]string
+		expectedRBAC       bool
+		expectedPrincipals []string
+	}{
+		{
+			name: "no_restriction",
+		},
+		{
+			name: "allowed_ip_ranges",
+			annotations: map[string]string{
+				nodeportproxy.TunnelingAllowedIPRangesAnnotationKey: "10.0.0.0/8, 192.168.1.1/32",
+			},
+			expectedRBAC:       true,
+			expectedPrincipals: []string{"10.0.0.0/8", "192.168.1.1/32"},
+		},
+		{
+			name: "invalid_ip_ranges_reject_all",
+			annotations: map[string]string{
+				nodeportproxy.TunnelingAllowedIPRangesAnnotationKey: "10.0.0.0/8,invalid",
+			},
+			expectedRBAC: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sb := &snapshotBuilder{
+				log: zaptest.NewLogger(t).Sugar(),
+			}
+
+			svc := svc.DeepCopy()
+			svc.Annotations = tc.annotations
+
+			vhs, _ := sb.makeTunnelingVirtualHosts(svc, connectionLimits{})
+			hcm := &envoyhttpconnectionmanagerv3.HttpConnectionManager{}
+			if err := sb.makeTunnelingListener(vhs...).FilterChains[0].Filters[0].GetTypedConfig().UnmarshalTo(hcm); err != nil {
+				t.Fatalf("failed to unmarshal HTTP connection manager config: %v", err)
+			}
+
+			perFilterConfig, ok := vhs[0].GetTypedPerFilterConfig()[envoywellknown.HTTPRoleBasedAccessControl]
+			if !tc.expectedRBAC {
+				if ok {
+					t.Fatal("expected no HTTP RBAC for the virtual host")
+				}
+				if len(hcm.HttpFilters) != 1 {
+					t.Fatalf("expected only the router HTTP filter, got %d filters", len(hcm.HttpFilters))
+				}
+				return
+			}
+
+			if hcm.HttpFilters[0].Name != envoywellknown.HTTPRoleBasedAccessControl {
+				t.Fatalf("expected first HTTP filter to be %s, got %s", envoywellknown.HTTPRoleBasedAccessControl, hcm.HttpFilters[0].Name)
+			}
+
+			rbac := &envoyhttprbacv3.RBACPerRoute{}
+			if err := perFilterConfig.UnmarshalTo(rbac); err != nil {
+				t.Fatalf("failed to unmarshal HTTP RBAC config: %v", err)
+			}
+
+			rules := rbac.GetRbac().GetRules()
+			if rules.GetAction() != envoyrbacconfigv3.RBAC_ALLOW {
+				t.Fatalf("expected RBAC action %v, got %v", envoyrbacconfigv3.RBAC_ALLOW, rules.GetAction())
+			}
+
+			var principals []string
+			for _, policy := range rules.GetPolicies() {
+				for _, principal := range policy.GetPrincipals() {
+					cidr := principal.GetDirectRemoteIp()
+					principals = append(principals, fmt.Sprintf("%s/%d", cidr.GetAddressPrefix(), cidr.GetPrefixLen().GetValue()))
+				}
+			}
+			if !slices.Equal(tc.expectedPrincipals, principals) {
+				t.Fatalf("expected allowed IP ranges %v, got %v", tc.expectedPrincipals, principals)
+			}
+		})
+	}
+}
+
+func assertRateLimitFilter(t *testing.T, filters []*envoylistenerv3.Filter, rate, bucketSize uint32) {
+	t.Helper()
+
+	if rate == 0 {
+		if len(filters) != 1 || filters[0].Name != envoywellknown.TCPProxy {
+			t.Fatalf("expected only the TCP proxy filter, got %v", filters)
+		}
+		return
+	}
+
+	if len(filters) != 2 || filters[0].Name != localRateLimitFilterName {
+		t.Fatalf("expected the local rate limit filter in front of the TCP proxy filter, got %v", filters)
+	}
+
+	rateLimit := &envoylocalratelimitv3.LocalRateLimit{}
+	if err := filters[0].GetTypedConfig().UnmarshalTo(rateLimit); err != nil {
+		t.Fatalf("failed to unmarshal local rate limit config: %v", err)
+	}
+
+	if got, want := rateLimit.GetStatPrefix(), "test/my-nodeport-https"; got != want {
+		t.Fatalf("unexpected stat prefix: got %s, want %s", got, want)
+	}
+
+	assertTokenBucket(t, rateLimit.GetTokenBucket(), rate, bucketSize)
+}
+
+func assertTokenBucket(t *testing.T, bucket *envoytypev3.TokenBucket, rate, bucketSize uint32) {
+	t.Helper()
+
+	if got := bucket.GetTokensPerFill().GetValue(); got != rate {
+		t.Fatalf("unexpected tokens per fill: got %d, want %d", got, rate)
+	}
+
+	if got := bucket.GetMaxTokens(); got != bucketSize {
+		t.Fatalf("unexpected max tokens: got %d, want %d", got, bucketSize)
+	}
+
+	if got := bucket.GetFillInterval().AsDuration(); got != time.Second {
+		t.Fatalf("unexpected fill interval: got %s, want %s", got, time.Second)
+	}
+}
+
 func assertTCPKeepalive(t *testing.T, tcpKeepalive *envoycorev3.TcpKeepalive, probes uint32, keepaliveTime, keepaliveInterval time.Duration) {
 	t.Helper()
 
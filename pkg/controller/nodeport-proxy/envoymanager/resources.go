@@ -33,14 +33,21 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyrbacconfigv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoylistenerlogv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
 	envoyhealthv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/health_check/v3"
+	envoyhttplocalratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoyhttprbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	envoyrouterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoytlsinspectorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	envoyhttpconnectionmanagerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoylocalratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
 	envoytcpfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoyproxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	envoyrawbufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
 	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	envoycachetype "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoycachev3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	envoyresourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
@@ -62,6 +69,13 @@ const (
 	UpgradeType = "CONNECT"
 )
 
+const (
+	localRateLimitFilterName     = "envoy.filters.network.local_ratelimit"
+	httpLocalRateLimitFilterName = "envoy.filters.http.local_ratelimit"
+
+	proxyProtocolTransportSocketName = "envoy.transport_sockets.upstream_proxy_protocol"
+)
+
 // portHostMappingGetter returns the portHostMapping for the given Service or
 // an error.
 type portHostMappingGetter func(*corev1.Service) (portHostMapping, error)
@@ -107,6 +121,11 @@ func (sb *snapshotBuilder) addService(svc *corev1.Service, epSlices *discoveryv1
 		svcLog.Debug("skipping service: no expose types provided")
 	}
 
+	limits, err := connectionLimitsFromAnnotations(svc, sb.defaultConnectionLimits())
+	if err != nil {
+		svcLog.Warnw("ignoring invalid connection limits", "error", err)
+	}
+
 	// Exclude all ports by default, to avoid creating unused clusters.
 	var includePorts sets.Set[string]
 	// Create listeners for NodePortType
@@ -116,33 +135,43 @@ func (sb *snapshotBuilder) addService(svc *corev1.Service, epSlices *discoveryv1
 			svcLog.Warn("skipping service: it is not of type NodePort", "service")
 		} else {
 			// Add listeners for nodeport services
-			ls, ports := sb.makeListenersForNodePortService(svc)
+			ls, ports := sb.makeListenersForNodePortService(svc, limits)
 			includePorts = ports.Union(includePorts)
 			sb.listeners = append(sb.listeners, ls...)
 		}
 	}
 	// Create filter chains for SNIType
 	if expTypes.Has(nodeportproxy.SNIType) && sb.IsSNIEnabled() {
-		fcs, ports := sb.makeSNIFilterChains(svcLog, svc)
+		fcs, ports := sb.makeSNIFilterChains(svcLog, svc, limits)
 		includePorts = ports.Union(includePorts)
 		sb.fcs = append(sb.fcs, fcs...)
 	}
 	// Create virtual hosts for TunnelingType
 	if expTypes.Has(nodeportproxy.TunnelingType) && sb.IsTunnelingEnabled() {
-		vhs, ports := sb.makeTunnelingVirtualHosts(svc)
+		vhs, ports := sb.makeTunnelingVirtualHosts(svc, limits)
 		includePorts = ports.Union(includePorts)
 		sb.vhs = append(sb.vhs, vhs...)
 	}
 
 	// Create clusters
 	sb.log.Debugw("creating clusters", "includePorts", includePorts)
-	sb.clusters = append(sb.clusters, sb.makeClusters(svc, epSlices, includePorts)...)
+	sb.clusters = append(sb.clusters, sb.makeClusters(svc, epSlices, includePorts, limits, proxyProtocolPortsFromAnnotation(svc))...)
+}
+
+// defaultConnectionLimits returns the connection limits that apply to
+// Services without connection limit annotations.
+func (sb *snapshotBuilder) defaultConnectionLimits() connectionLimits {
+	return connectionLimits{
+		maxConnections: sb.GetMaxConnections(),
+		rate:           sb.GetConnectionRate(),
+		burst:          sb.GetConnectionBurst(),
+	}
 }
 
 // makeSNIFilterChains returns the FilterChains for the given service and the
 // set of ports that are exposed. Note that the set can be nil, don't try to
 // write to it before doing a nil check.
-func (sb *snapshotBuilder) makeSNIFilterChains(svcLog *zap.SugaredLogger, svc *corev1.Service) ([]*envoylistenerv3.FilterChain, sets.Set[string]) {
+func (sb *snapshotBuilder) makeSNIFilterChains(svcLog *zap.SugaredLogger, svc *corev1.Service, limits connectionLimits) ([]*envoylistenerv3.FilterChain, sets.Set[string]) {
 	m, err := sb.portHostMappingGetter(svc)
 	if err != nil {
 		svcLog.Warnw("port host mapping is required with SNI expose type", "error", err)
@@ -167,7 +196,7 @@ func (sb *snapshotBuilder) makeSNIFilterChains(svcLog *zap.SugaredLogger, svc *c
 
 	svcLog.Debugw("creating sni filter chains", "portHostMapping", m)
 	// Besides the filter chains returns the ports that are exposed.
-	return makeSNIFilterChains(svc, m, sb.GetSNIListenerIdleTimeout()), ports
+	return makeSNIFilterChains(svc, m, sb.GetSNIListenerIdleTimeout(), limits), ports
 }
 
 // build returns a new Snapshot from the resources derived by the Services
@@ -209,7 +238,7 @@ func makeAccessLog() []*envoyaccesslogv3.AccessLog {
 	return accessLog
 }
 
-func makeSNIFilterChains(service *corev1.Service, p portHostMapping, idleTimeout time.Duration) []*envoylistenerv3.FilterChain {
+func makeSNIFilterChains(service *corev1.Service, p portHostMapping, idleTimeout time.Duration, limits connectionLimits) []*envoylistenerv3.FilterChain {
 	var sniFilterChains []*envoylistenerv3.FilterChain
 
 	serviceKey := ServiceKey(service)
@@ -234,14 +263,12 @@ func makeSNIFilterChains(service *corev1.Service, p portHostMapping, idleTimeout
 			}
 
 			sniFilterChains = append(sniFilterChains, &envoylistenerv3.FilterChain{
-				Filters: []*envoylistenerv3.Filter{
-					{
-						Name: envoywellknown.TCPProxy,
-						ConfigType: &envoylistenerv3.Filter_TypedConfig{
-							TypedConfig: tcpProxyConfigMarshalled,
-						},
+				Filters: append(makeRateLimitFilters(servicePortKey, limits), &envoylistenerv3.Filter{
+					Name: envoywellknown.TCPProxy,
+					ConfigType: &envoylistenerv3.Filter_TypedConfig{
+						TypedConfig: tcpProxyConfigMarshalled,
 					},
-				},
+				}),
 				FilterChainMatch: &envoylistenerv3.FilterChainMatch{
 					ServerNames:       []string{name},
 					TransportProtocol: "tls",
@@ -296,10 +323,17 @@ func (sb *snapshotBuilder) makeSNIListener(fcs ...*envoylistenerv3.FilterChain)
 	return sniListener
 }
 
-func (sb *snapshotBuilder) makeTunnelingVirtualHosts(service *corev1.Service) (vhs []*envoyroutev3.VirtualHost, ports sets.Set[string]) {
+func (sb *snapshotBuilder) makeTunnelingVirtualHosts(service *corev1.Service, limits connectionLimits) (vhs []*envoyroutev3.VirtualHost, ports sets.Set[string]) {
 	serviceKey := ServiceKey(service)
 	ports = sets.New[string]()
 
+	allowedIPRanges, err := tunnelingAllowedIPRangesFromAnnotation(service)
+	if err != nil {
+		// Reject all connections rather than silently dropping the restriction.
+		sb.log.Warnw("rejecting all tunneled connections to service", "service", serviceKey, "error", err)
+		allowedIPRanges = []*net.IPNet{}
+	}
+
 	for _, servicePort := range service.Spec.Ports {
 		servicePortKey := ServicePortKey(serviceKey, &servicePort)
 		if servicePort.Protocol != corev1.ProtocolTCP {
@@ -308,8 +342,17 @@ func (sb *snapshotBuilder) makeTunnelingVirtualHosts(service *corev1.Service) (v
 		}
 		ports.Insert(servicePort.Name)
 
+		perFilterConfig := makeHTTPRateLimitConfig(servicePortKey, limits)
+		if allowedIPRanges != nil {
+			if perFilterConfig == nil {
+				perFilterConfig = map[string]*anypb.Any{}
+			}
+			perFilterConfig[envoywellknown.HTTPRoleBasedAccessControl] = makeHTTPRBACConfig(servicePortKey, allowedIPRanges)
+		}
+
 		vhs = append(vhs, &envoyroutev3.VirtualHost{
-			Name: servicePortKey,
+			Name:                 servicePortKey,
+			TypedPerFilterConfig: perFilterConfig,
 			Domains: []string{
 				fmt.Sprintf("%s.%s.svc.cluster.local:%d", service.Name, service.Namespace, servicePort.Port),
 			},
@@ -355,6 +398,26 @@ func (sb *snapshotBuilder) makeTunnelingListener(vhs ...*envoyroutev3.VirtualHos
 		panic(fmt.Errorf("failed to marshal router: %w", err))
 	}
 
+	httpFilters := []*envoyhttpconnectionmanagerv3.HttpFilter{
+		{
+			Name: envoywellknown.Router,
+			ConfigType: &envoyhttpconnectionmanagerv3.HttpFilter_TypedConfig{
+				TypedConfig: routerpb,
+			},
+		},
+	}
+	// The rate limit filter is only enabled for the virtual hosts that
+	// configure a token bucket, so it is only added when actually needed.
+	if hasHTTPRateLimitConfig(vhs) {
+		httpFilters = append([]*envoyhttpconnectionmanagerv3.HttpFilter{makeHTTPRateLimitFilter()}, httpFilters...)
+	}
+	// Likewise the RBAC filter is only enforced for the virtual hosts that
+	// restrict the allowed IP ranges, and runs first to reject connections
+	// before they consume rate limit tokens.
+	if hasHTTPRBACConfig(vhs) {
+		httpFilters = append([]*envoyhttpconnectionmanagerv3.HttpFilter{makeHTTPRBACFilter()}, httpFilters...)
+	}
+
 	hcm := &envoyhttpconnectionmanagerv3.HttpConnectionManager{
 		CodecType:  envoyhttpconnectionmanagerv3.HttpConnectionManager_AUTO,
 		StatPrefix: "ingress_http",
@@ -364,15 +427,8 @@ func (sb *snapshotBuilder) makeTunnelingListener(vhs ...*envoyroutev3.VirtualHos
 				VirtualHosts: vhs,
 			},
 		},
-		AccessLog: makeAccessLog(),
-		HttpFilters: []*envoyhttpconnectionmanagerv3.HttpFilter{
-			{
-				Name: envoywellknown.Router,
-				ConfigType: &envoyhttpconnectionmanagerv3.HttpFilter_TypedConfig{
-					TypedConfig: routerpb,
-				},
-			},
-		},
+		AccessLog:   makeAccessLog(),
+		HttpFilters: httpFilters,
 		Http2ProtocolOptions: &envoycorev3.Http2ProtocolOptions{
 			AllowConnect: true,
 		},
@@ -433,7 +489,7 @@ func (sb *snapshotBuilder) makeTunnelingListener(vhs ...*envoyroutev3.VirtualHos
 	return tunnelingListener
 }
 
-func (sb *snapshotBuilder) makeClusters(service *corev1.Service, epSlices *discoveryv1.EndpointSliceList, includePorts sets.Set[string]) (clusters []envoycachetype.Resource) {
+func (sb *snapshotBuilder) makeClusters(service *corev1.Service, epSlices *discoveryv1.EndpointSliceList, includePorts sets.Set[string], limits connectionLimits, proxyProtocolPorts sets.Set[string]) (clusters []envoycachetype.Resource) {
 	serviceKey := ServiceKey(service)
 	for _, servicePort := range service.Spec.Ports {
 		if !includePorts.Has(servicePort.Name) {
@@ -475,12 +531,26 @@ func (sb *snapshotBuilder) makeClusters(service *corev1.Service, epSlices *disco
 				),
 			}
 		}
+		if proxyProtocolPorts.Has(servicePort.Name) {
+			cluster.TransportSocket = makeProxyProtocolTransportSocket()
+		}
+		if limits.maxConnections > 0 {
+			cluster.CircuitBreakers = &envoyclusterv3.CircuitBreakers{
+				Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{
+					{
+						MaxConnections: wrapperspb.UInt32(limits.maxConnections),
+						// exposes the remaining connections as per-cluster gauge
+						TrackRemaining: true,
+					},
+				},
+			}
+		}
 		clusters = append(clusters, cluster)
 	}
 	return
 }
 
-func (sb *snapshotBuilder) makeListenersForNodePortService(service *corev1.Service) (listeners []envoycachetype.Resource, exposedPorts sets.Set[string]) {
+func (sb *snapshotBuilder) makeListenersForNodePortService(service *corev1.Service, limits connectionLimits) (listeners []envoycachetype.Resource, exposedPorts sets.Set[string]) {
 	serviceKey := ServiceKey(service)
 	exposedPorts = sets.New[string]()
 	for _, servicePort := range service.Spec.Ports {
@@ -520,14 +590,12 @@ func (sb *snapshotBuilder) makeListenersForNodePortService(service *corev1.Servi
 			},
 			FilterChains: []*envoylistenerv3.FilterChain{
 				{
-					Filters: []*envoylistenerv3.Filter{
-						{
-							Name: envoywellknown.TCPProxy,
-							ConfigType: &envoylistenerv3.Filter_TypedConfig{
-								TypedConfig: tcpProxyConfigMarshalled,
-							},
+					Filters: append(makeRateLimitFilters(servicePortKey, limits), &envoylistenerv3.Filter{
+						Name: envoywellknown.TCPProxy,
+						ConfigType: &envoylistenerv3.Filter_TypedConfig{
+							TypedConfig: tcpProxyConfigMarshalled,
 						},
-					},
+					}),
 				},
 			},
 		}
@@ -704,6 +772,203 @@ func hasReadyEndpoints(epSlices *discoveryv1.EndpointSliceList) bool {
 	return false
 }
 
+// makeTokenBucket returns the token bucket used to enforce the connection
+// rate of the given limits.
+func makeTokenBucket(limits connectionLimits) *envoytypev3.TokenBucket {
+	return &envoytypev3.TokenBucket{
+		MaxTokens:     limits.bucketSize(),
+		TokensPerFill: wrapperspb.UInt32(limits.rate),
+		FillInterval:  durationpb.New(time.Second),
+	}
+}
+
+// makeRateLimitFilters returns the network filters that need to precede the
+// TCP proxy filter to enforce the connection rate of the given limits. The
+// stats of the filter are prefixed with the given service port key.
+func makeRateLimitFilters(servicePortKey string, limits connectionLimits) []*envoylistenerv3.Filter {
+	if !limits.hasRateLimit() {
+		return nil
+	}
+
+	rateLimitMarshalled, err := anypb.New(&envoylocalratelimitv3.LocalRateLimit{
+		StatPrefix:  servicePortKey,
+		TokenBucket: makeTokenBucket(limits),
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal local rate limit: %w", err))
+	}
+
+	return []*envoylistenerv3.Filter{
+		{
+			Name: localRateLimitFilterName,
+			ConfigType: &envoylistenerv3.Filter_TypedConfig{
+				TypedConfig: rateLimitMarshalled,
+			},
+		},
+	}
+}
+
+// makeHTTPRateLimitConfig returns the per virtual host configuration of the
+// HTTP rate limit filter used to enforce the rate of CONNECT streams of the
+// given limits.
+func makeHTTPRateLimitConfig(servicePortKey string, limits connectionLimits) map[string]*anypb.Any {
+	if !limits.hasRateLimit() {
+		return nil
+	}
+
+	enabled := &envoycorev3.RuntimeFractionalPercent{
+		DefaultValue: &envoytypev3.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoytypev3.FractionalPercent_HUNDRED,
+		},
+	}
+
+	rateLimitMarshalled, err := anypb.New(&envoyhttplocalratelimitv3.LocalRateLimit{
+		StatPrefix:     servicePortKey,
+		TokenBucket:    makeTokenBucket(limits),
+		FilterEnabled:  enabled,
+		FilterEnforced: enabled,
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal HTTP local rate limit: %w", err))
+	}
+
+	return map[string]*anypb.Any{
+		httpLocalRateLimitFilterName: rateLimitMarshalled,
+	}
+}
+
+// makeHTTPRateLimitFilter returns the HTTP rate limit filter. It is disabled
+// by default and only enforced for virtual hosts configuring a token bucket.
+func makeHTTPRateLimitFilter() *envoyhttpconnectionmanagerv3.HttpFilter {
+	rateLimitMarshalled, err := anypb.New(&envoyhttplocalratelimitv3.LocalRateLimit{
+		StatPrefix: "tunneling_rate_limiter",
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal HTTP local rate limit: %w", err))
+	}
+
+	return &envoyhttpconnectionmanagerv3.HttpFilter{
+		Name: httpLocalRateLimitFilterName,
+		ConfigType: &envoyhttpconnectionmanagerv3.HttpFilter_TypedConfig{
+			TypedConfig: rateLimitMarshalled,
+		},
+	}
+}
+
+func hasHTTPRateLimitConfig(vhs []*envoyroutev3.VirtualHost) bool {
+	for _, vh := range vhs {
+		if _, ok := vh.GetTypedPerFilterConfig()[httpLocalRateLimitFilterName]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// makeHTTPRBACConfig returns the per virtual host configuration of the HTTP
+// RBAC filter, only allowing CONNECT requests from the given IP ranges. The
+// direct remote address is used, so that it cannot be spoofed using headers.
+// An empty list of ranges rejects all requests.
+func makeHTTPRBACConfig(servicePortKey string, allowedIPRanges []*net.IPNet) *anypb.Any {
+	policies := map[string]*envoyrbacconfigv3.Policy{}
+	if len(allowedIPRanges) > 0 {
+		principals := make([]*envoyrbacconfigv3.Principal, 0, len(allowedIPRanges))
+		for _, ipNet := range allowedIPRanges {
+			prefixLen, _ := ipNet.Mask.Size()
+			principals = append(principals, &envoyrbacconfigv3.Principal{
+				Identifier: &envoyrbacconfigv3.Principal_DirectRemoteIp{
+					DirectRemoteIp: &envoycorev3.CidrRange{
+						AddressPrefix: ipNet.IP.String(),
+						PrefixLen:     wrapperspb.UInt32(uint32(prefixLen)),
+					},
+				},
+			})
+		}
+
+		policies["allowed-ip-ranges"] = &envoyrbacconfigv3.Policy{
+			Permissions: []*envoyrbacconfigv3.Permission{
+				{
+					Rule: &envoyrbacconfigv3.Permission_Any{Any: true},
+				},
+			},
+			Principals: principals,
+		}
+	}
+
+	rbacMarshalled, err := anypb.New(&envoyhttprbacv3.RBACPerRoute{
+		Rbac: &envoyhttprbacv3.RBAC{
+			Rules: &envoyrbacconfigv3.RBAC{
+				Action:   envoyrbacconfigv3.RBAC_ALLOW,
+				Policies: policies,
+			},
+			RulesStatPrefix: servicePortKey,
+		},
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal HTTP RBAC: %w", err))
+	}
+
+	return rbacMarshalled
+}
+
+// makeHTTPRBACFilter returns the HTTP RBAC filter. It does not enforce any
+// rules by default and is only configured for virtual hosts restricting the
+// allowed IP ranges.
+func makeHTTPRBACFilter() *envoyhttpconnectionmanagerv3.HttpFilter {
+	rbacMarshalled, err := anypb.New(&envoyhttprbacv3.RBAC{})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal HTTP RBAC: %w", err))
+	}
+
+	return &envoyhttpconnectionmanagerv3.HttpFilter{
+		Name: envoywellknown.HTTPRoleBasedAccessControl,
+		ConfigType: &envoyhttpconnectionmanagerv3.HttpFilter_TypedConfig{
+			TypedConfig: rbacMarshalled,
+		},
+	}
+}
+
+func hasHTTPRBACConfig(vhs []*envoyroutev3.VirtualHost) bool {
+	for _, vh := range vhs {
+		if _, ok := vh.GetTypedPerFilterConfig()[envoywellknown.HTTPRoleBasedAccessControl]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// makeProxyProtocolTransportSocket returns the transport socket used to pass
+// the address of the downstream client to the upstream using the PROXY
+// protocol v2.
+func makeProxyProtocolTransportSocket() *envoycorev3.TransportSocket {
+	rawBufferMarshalled, err := anypb.New(&envoyrawbufferv3.RawBuffer{})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal raw buffer: %w", err))
+	}
+
+	proxyProtocolMarshalled, err := anypb.New(&envoyproxyprotocolv3.ProxyProtocolUpstreamTransport{
+		Config: &envoycorev3.ProxyProtocolConfig{
+			Version: envoycorev3.ProxyProtocolConfig_V2,
+		},
+		TransportSocket: &envoycorev3.TransportSocket{
+			Name: envoywellknown.TransportSocketRawBuffer,
+			ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+				TypedConfig: rawBufferMarshalled,
+			},
+		},
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal upstream proxy protocol: %w", err))
+	}
+
+	return &envoycorev3.TransportSocket{
+		Name: proxyProtocolTransportSocketName,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+			TypedConfig: proxyProtocolMarshalled,
+		},
+	}
+}
+
 func makeTCPKeepalive(keepaliveTime, keepaliveInterval time.Duration, keepaliveProbes uint32) *envoycorev3.TcpKeepalive {
 	keepalive := &envoycorev3.TcpKeepalive{}
 
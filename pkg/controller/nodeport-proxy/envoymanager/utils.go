@@ -18,8 +18,11 @@ package envoymanager
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 
 	"k8c.io/kubermatic/v2/pkg/resources/nodeportproxy"
@@ -145,3 +148,90 @@ func (p portHostMapping) validate(svc *corev1.Service) error {
 	}
 	return nil
 }
+
+// proxyProtocolPortsFromAnnotation returns the names of the ports of the given
+// Service that expect the PROXY protocol.
+func proxyProtocolPortsFromAnnotation(svc *corev1.Service) sets.Set[string] {
+	ports := sets.New[string]()
+	for _, name := range strings.Split(svc.GetAnnotations()[nodeportproxy.ProxyProtocolPortsAnnotationKey], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			ports.Insert(name)
+		}
+	}
+	return ports
+}
+
+// connectionLimits contains the connection and rate limits applied to each
+// port of an exposed Service. Zero values disable the corresponding limit.
+type connectionLimits struct {
+	maxConnections uint32
+	rate           uint32
+	burst          uint32
+}
+
+func (l connectionLimits) hasRateLimit() bool {
+	return l.rate > 0
+}
+
+// bucketSize returns the size of the token bucket used for rate limiting,
+// which is never smaller than the amount of tokens refilled every second.
+func (l connectionLimits) bucketSize() uint32 {
+	return max(l.burst, l.rate)
+}
+
+// connectionLimitsFromAnnotations returns the connection limits for the given
+// Service, using the values from its annotations in favor of the given
+// defaults. Values that cannot be parsed are reported as error and the
+// defaults are used instead.
+func connectionLimitsFromAnnotations(svc *corev1.Service, defaults connectionLimits) (connectionLimits, error) {
+	limits := defaults
+
+	var errs []error
+	for _, a := range []struct {
+		key    string
+		target *uint32
+	}{
+		{key: nodeportproxy.MaxConnectionsAnnotationKey, target: &limits.maxConnections},
+		{key: nodeportproxy.ConnectionRateAnnotationKey, target: &limits.rate},
+		{key: nodeportproxy.ConnectionBurstAnnotationKey, target: &limits.burst},
+	} {
+		val, ok := svc.GetAnnotations()[a.key]
+		if !ok {
+			continue
+		}
+
+		parsed, err := strconv.ParseUint(strings.TrimSpace(val), 10, 32)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid value %q for annotation %s: %w", val, a.key, err))
+			continue
+		}
+
+		*a.target = uint32(parsed)
+	}
+
+	return limits, errors.Join(errs...)
+}
+
+// tunnelingAllowedIPRangesFromAnnotation returns the IP ranges allowed to
+// connect to the given Service via the Tunneling listener. A nil slice means
+// that access is not restricted.
+func tunnelingAllowedIPRangesFromAnnotation(svc *corev1.Service) ([]*net.IPNet, error) {
+	val, ok := svc.GetAnnotations()[nodeportproxy.TunnelingAllowedIPRangesAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+
+	ranges := []*net.IPNet{}
+	for _, cidr := range strings.Split(val, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP range %q in annotation %s: %w", cidr, nodeportproxy.TunnelingAllowedIPRangesAnnotationKey, err)
+		}
+		ranges = append(ranges, ipNet)
+	}
+
+	return ranges, nil
+}
@@ -105,6 +105,7 @@ func EnvoyDeploymentReconciler(cfg *kubermaticv1.KubermaticConfiguration, seed *
 				fmt.Sprintf("-envoy-tunneling-port=%d", EnvoyTunnelingPort),
 			}
 			args = append(args, envoyManagerConnectionSettingsArgs(seed)...)
+			args = append(args, envoyManagerConnectionLimitsArgs(seed)...)
 			d.Spec.Template.Spec.Containers = []corev1.Container{
 				{
 					Name:    "envoy-manager",
@@ -216,6 +217,16 @@ func envoyManagerConnectionSettingsArgs(seed *kubermaticv1.Seed) []string {
 	}
 }
 
+func envoyManagerConnectionLimitsArgs(seed *kubermaticv1.Seed) []string {
+	limits := seed.Spec.NodeportProxy.Envoy.ConnectionLimits
+
+	return []string{
+		fmt.Sprintf("-max-connections=%d", limits.MaxConnections),
+		fmt.Sprintf("-connection-rate=%d", limits.ConnectionRate),
+		fmt.Sprintf("-connection-burst=%d", limits.ConnectionBurst),
+	}
+}
+
 func EnvoyPDBReconciler() reconciling.NamedPodDisruptionBudgetReconcilerFactory {
 	maxUnavailable := intstr.FromInt(1)
 	return func() (string, reconciling.PodDisruptionBudgetReconciler) {
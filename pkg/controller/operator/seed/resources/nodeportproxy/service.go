@@ -70,6 +70,12 @@ func ServiceReconciler(seed *kubermaticv1.Seed) reconciling.NamedServiceReconcil
 				}
 			}
 
+			// The client addresses are only preserved with the Local policy, which is required
+			// to pass them on to the control plane using the PROXY protocol.
+			if policy := seed.Spec.NodeportProxy.Envoy.LoadBalancerService.ExternalTrafficPolicy; policy != "" {
+				s.Spec.ExternalTrafficPolicy = policy
+			}
+
 			if seed.Spec.NodeportProxy.IPFamilies != nil {
 				s.Spec.IPFamilies = seed.Spec.NodeportProxy.IPFamilies
 			}
@@ -283,6 +283,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: 3 * time.Second}, nil
 	}
 
+	// This happens outside of the reconcile wrappers, as waiting for the maintenance
+	// window must not mark the addon or cluster as failed.
+	deferred, err := r.deferAddonUpgrade(ctx, log, addon, cluster)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if deferred != nil {
+		return *deferred, nil
+	}
+
 	// Add a wrapping here so we can emit an event on error
 	result, err := r.addonReconcileWrapper(
 		ctx,
@@ -327,6 +337,49 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return *result, nil
 }
 
+// deferAddonUpgrade holds back re-applying an addon after a KKP update until the
+// cluster's maintenance window opens. It returns a result if the addon must not be
+// reconciled yet.
+func (r *Reconciler) deferAddonUpgrade(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	if cluster.Spec.Pause || cluster.Labels[kubermaticv1.WorkerNameLabelKey] != r.workerName {
+		return nil, nil
+	}
+
+	// addons that are not enforced anymore are never upgraded anyway
+	if addon.DeletionTimestamp != nil || (addonResourcesCreated(addon) && !hasEnsureResourcesLabel(addon)) {
+		return nil, nil
+	}
+
+	lastSuccess := addon.Status.Conditions[kubermaticv1.AddonReconciledSuccessfully]
+	if lastSuccess.KubermaticVersion == "" || lastSuccess.KubermaticVersion == r.versions.GitVersion {
+		return nil, nil
+	}
+
+	open, nextWindow, err := util.MaintenanceWindowOpen(cluster, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		if open {
+			util.ClearPendingMaintenance(c, kubermaticv1.MaintenanceOperationAddonUpgrade)
+		} else {
+			util.SetPendingMaintenance(c, kubermaticv1.MaintenanceOperationAddonUpgrade, fmt.Sprintf("Upgrade of addons to KKP %s is waiting for the maintenance window.", r.versions.GitVersion), nextWindow)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pending maintenance: %w", err)
+	}
+
+	if open {
+		return nil, nil
+	}
+
+	log.Debugw("Addon upgrade is waiting for the maintenance window", "nextWindow", nextWindow)
+
+	return &reconcile.Result{RequeueAfter: time.Until(nextWindow)}, nil
+}
+
 func (r *Reconciler) addonReconcileWrapper(
 	ctx context.Context,
 	addon *kubermaticv1.Addon,
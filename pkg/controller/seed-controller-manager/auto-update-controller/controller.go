@@ -19,6 +19,8 @@ package autoupdatecontroller
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -125,6 +127,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if err != nil {
 		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	} else {
+		util.RequeueForMaintenance(result, cluster, kubermaticv1.MaintenanceOperationAutomaticUpdate)
 	}
 
 	return *result, err
@@ -144,35 +148,65 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 
 	updateManager := version.NewFromConfiguration(config)
 
-	if err := r.controlPlaneUpgrade(ctx, log, cluster, updateManager); err != nil {
+	open, nextWindow, err := util.MaintenanceWindowOpen(cluster, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Outside of the maintenance window, updates are only determined, but not applied.
+	var pending []string
+
+	controlPlaneUpdate, err := r.controlPlaneUpgrade(ctx, log, cluster, updateManager, open)
+	if err != nil {
 		return nil, fmt.Errorf("failed to update the controlplane: %w", err)
 	}
+	if !open && controlPlaneUpdate != "" {
+		pending = append(pending, fmt.Sprintf("control plane to %s", controlPlaneUpdate))
+	}
 
 	// nodeUpdate works based on the Cluster.Status.Versions.ControlPlane field, so it properly waits
 	// for the control plane to be upgraded before updating the nodes.
-	if err := r.nodeUpdate(ctx, log, cluster, updateManager); err != nil {
+	machineDeploymentUpdates, err := r.nodeUpdate(ctx, log, cluster, updateManager, open)
+	if err != nil {
 		return nil, fmt.Errorf("failed to update the controlplane: %w", err)
 	}
+	if !open && machineDeploymentUpdates > 0 {
+		pending = append(pending, fmt.Sprintf("%d MachineDeployment(s)", machineDeploymentUpdates))
+	}
+
+	err = util.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		if len(pending) == 0 {
+			util.ClearPendingMaintenance(c, kubermaticv1.MaintenanceOperationAutomaticUpdate)
+		} else {
+			util.SetPendingMaintenance(c, kubermaticv1.MaintenanceOperationAutomaticUpdate, fmt.Sprintf("Automatic update of %s is waiting for the maintenance window.", strings.Join(pending, " and ")), nextWindow)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pending maintenance: %w", err)
+	}
 
 	return nil, nil
 }
 
-func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager) error {
+// nodeUpdate applies automatic updates to MachineDeployments. If apply is false, the updates are
+// not applied. It returns the number of MachineDeployments that are (or would have been) updated.
+func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager, apply bool) (int, error) {
 	c, err := r.userClusterConnectionProvider.GetClient(ctx, cluster)
 	if err != nil {
-		return fmt.Errorf("failed to get usercluster client: %w", err)
+		return 0, fmt.Errorf("failed to get usercluster client: %w", err)
 	}
 
 	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
 	// Kubermatic only creates MachineDeployments in the kube-system namespace, everything else is essentially unsupported
 	if err := c.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
-		return fmt.Errorf("failed to list MachineDeployments: %w", err)
+		return 0, fmt.Errorf("failed to list MachineDeployments: %w", err)
 	}
 
+	updates := 0
 	for _, md := range machineDeployments.Items {
 		targetVersion, err := updateManager.AutomaticNodeUpdate(md.Spec.Template.Spec.Versions.Kubelet, cluster.Status.Versions.ControlPlane.String())
 		if err != nil {
-			return fmt.Errorf("failed to get automatic update for machinedeployment %s/%s that has version %q: %w", md.Namespace, md.Name, md.Spec.Template.Spec.Versions.Kubelet, err)
+			return 0, fmt.Errorf("failed to get automatic update for machinedeployment %s/%s that has version %q: %w", md.Namespace, md.Name, md.Spec.Template.Spec.Versions.Kubelet, err)
 		}
 		if targetVersion == nil {
 			continue
@@ -185,33 +219,46 @@ func (r *Reconciler) nodeUpdate(ctx context.Context, log *zap.SugaredLogger, clu
 			oldMD := md.DeepCopy()
 			identifier := fmt.Sprintf("%s/%s", md.Namespace, md.Name)
 
+			updates++
+			if !apply {
+				log.Debugw("Automatic update of MachineDeployment is waiting for the maintenance window", "machinedeployment", identifier, "from", old, "to", target)
+				continue
+			}
+
 			log.Infow("Applying automatic update to MachineDeployment", "machinedeployment", identifier, "from", old, "to", target)
 
 			md.Spec.Template.Spec.Versions.Kubelet = target
 			if err := c.Patch(ctx, &md, ctrlruntimeclient.MergeFrom(oldMD)); err != nil {
-				return fmt.Errorf("failed to update MachineDeployment: %w", err)
+				return 0, fmt.Errorf("failed to update MachineDeployment: %w", err)
 			}
 
 			r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "AutoUpdateMachineDeployment", "Reconciling", "Triggered automatic update of MachineDeployment %s to version %q", identifier, target)
 		}
 	}
 
-	return nil
+	return updates, nil
 }
 
-func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager) error {
+// controlPlaneUpgrade applies an automatic update to the cluster's control plane. If apply is false,
+// the update is not applied. It returns the version the cluster is (or would have been) updated to.
+func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, updateManager *version.Manager, apply bool) (string, error) {
 	update, err := updateManager.AutomaticControlplaneUpdate(cluster.Spec.Version.String())
 	if err != nil {
-		return fmt.Errorf("failed to get automatic update for cluster for version %s: %w", cluster.Spec.Version.String(), err)
+		return "", fmt.Errorf("failed to get automatic update for cluster for version %s: %w", cluster.Spec.Version.String(), err)
 	}
 	if update == nil {
-		return nil
+		return "", nil
 	}
 	oldCluster := cluster.DeepCopy()
 
 	sver, err := semver.NewSemver(update.Version.String())
 	if err != nil {
-		return fmt.Errorf("failed to parse version %q: %w", update.Version.String(), err)
+		return "", fmt.Errorf("failed to parse version %q: %w", update.Version.String(), err)
+	}
+
+	if !apply {
+		log.Debugw("Automatic control-plane upgrade is waiting for the maintenance window", "from", oldCluster.Spec.Version, "to", sver)
+		return sver.String(), nil
 	}
 
 	log.Infow("Applying automatic control-plane upgrade", "from", oldCluster.Spec.Version, "to", cluster.Spec.Version)
@@ -221,7 +268,7 @@ func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLo
 	// set here.
 	cluster.Spec.Version = *sver
 	if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
-		return fmt.Errorf("failed to update cluster: %w", err)
+		return "", fmt.Errorf("failed to update cluster: %w", err)
 	}
 
 	log.Infow("Applied automatic cluster upgrade", "from", oldCluster.Spec.Version, "to", cluster.Spec.Version)
@@ -234,8 +281,8 @@ func (r *Reconciler) controlPlaneUpgrade(ctx context.Context, log *zap.SugaredLo
 		c.Status.ExtendedHealth.Scheduler = kubermaticv1.HealthStatusDown
 	})
 	if err != nil {
-		return fmt.Errorf("failed to update cluster status: %w", err)
+		return "", fmt.Errorf("failed to update cluster status: %w", err)
 	}
 
-	return nil
+	return sver.String(), nil
 }
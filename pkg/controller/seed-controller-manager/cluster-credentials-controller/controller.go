@@ -28,6 +28,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/util/predicate"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider/secretstore"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 	"k8c.io/reconciler/pkg/reconciling"
@@ -52,10 +53,11 @@ const (
 type reconciler struct {
 	ctrlruntimeclient.Client
 
-	workerName string
-	recorder   events.EventRecorder
-	log        *zap.SugaredLogger
-	versions   kubermatic.Versions
+	workerName   string
+	recorder     events.EventRecorder
+	log          *zap.SugaredLogger
+	versions     kubermatic.Versions
+	secretStores *secretstore.Resolver
 }
 
 // Add creates a new cluster-credentials controller.
@@ -66,14 +68,16 @@ func Add(
 	log *zap.SugaredLogger,
 	versions kubermatic.Versions,
 	kkpNamespace string,
+	secretStores *secretstore.Resolver,
 ) error {
 	reconciler := &reconciler{
 		Client: mgr.GetClient(),
 
-		workerName: workerName,
-		recorder:   mgr.GetEventRecorder(ControllerName),
-		log:        log,
-		versions:   versions,
+		workerName:   workerName,
+		recorder:     mgr.GetEventRecorder(ControllerName),
+		log:          log,
+		versions:     versions,
+		secretStores: secretStores,
 	}
 
 	_, err := builder.ControllerManagedBy(mgr).
@@ -177,8 +181,24 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		return &reconcile.Result{Requeue: true}, nil
 	}
 
-	// make sure cluster credentials are placed in a dedicated Secret in the KKP namespace
-	if err := kubernetesprovider.CreateOrUpdateCredentialSecretForCluster(ctx, r, cluster); err != nil {
+	preset, err := r.secretStorePreset(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if preset != nil {
+		// the credentials are not part of the cluster, but need to be resolved from the
+		// external secret store of the preset the cluster was created from
+		data, err := r.secretStores.Resolve(ctx, preset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve credentials of preset %s: %w", preset.Name, err)
+		}
+
+		if err := kubernetesprovider.CreateOrUpdateCredentialSecretForClusterFromData(ctx, r, cluster, data); err != nil {
+			return nil, fmt.Errorf("failed to ensure Cluster credentials: %w", err)
+		}
+	} else if err := kubernetesprovider.CreateOrUpdateCredentialSecretForCluster(ctx, r, cluster); err != nil {
+		// make sure cluster credentials are placed in a dedicated Secret in the KKP namespace
 		return nil, fmt.Errorf("failed to migrate Cluster credentials: %w", err)
 	}
 
@@ -227,6 +247,36 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	return nil, nil
 }
 
+// secretStorePreset returns the preset the cluster was created from, if that preset
+// resolves its credentials from an external secret store. Clusters that already
+// reference a credential Secret are ignored, as the preset-controller takes care
+// of keeping their credentials up-to-date.
+func (r *reconciler) secretStorePreset(ctx context.Context, cluster *kubermaticv1.Cluster) (*kubermaticv1.Preset, error) {
+	presetName := cluster.Annotations[kubermaticv1.PresetNameAnnotation]
+	if presetName == "" || r.secretStores == nil {
+		return nil, nil
+	}
+
+	if ref, err := resources.GetCredentialsReference(cluster); err != nil || ref != nil {
+		return nil, nil
+	}
+
+	preset := &kubermaticv1.Preset{}
+	if err := r.Get(ctx, types.NamespacedName{Name: presetName}, preset); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get preset %s: %w", presetName, err)
+	}
+
+	if preset.Spec.SecretStore == nil {
+		return nil, nil
+	}
+
+	return preset, nil
+}
+
 func secretReconciler(original *corev1.Secret) reconciling.NamedSecretReconcilerFactory {
 	return func() (name string, create reconciling.SecretReconciler) {
 		return resources.ClusterCloudCredentialsSecretName, func(existing *corev1.Secret) (*corev1.Secret, error) {
@@ -22,6 +22,7 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/provider/secretstore"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/test/diff"
 	"k8c.io/kubermatic/v2/pkg/test/fake"
@@ -49,6 +50,9 @@ func TestReconcile(t *testing.T) {
 		cloudSpec     kubermaticv1.CloudSpec
 		kkpSecret     map[string][]byte
 		clusterSecret map[string][]byte
+		// storeSecret is the content of the secret store of the
+		// preset the cluster was created from
+		storeSecret map[string][]byte
 
 		expectedCloudSpec     kubermaticv1.CloudSpec
 		expectedKKPSecret     map[string][]byte
@@ -191,6 +195,33 @@ func TestReconcile(t *testing.T) {
 				resources.DigitaloceanToken: []byte("new-token"),
 			},
 		},
+
+		{
+			name: "new Cluster from a preset with secret store, credentials are resolved from the store",
+			cloudSpec: kubermaticv1.CloudSpec{
+				Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{},
+			},
+			storeSecret: map[string][]byte{
+				resources.DigitaloceanToken: []byte("token-from-store"),
+			},
+
+			expectedCloudSpec: kubermaticv1.CloudSpec{
+				Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{
+					CredentialsReference: &providerconfig.GlobalSecretKeySelector{
+						ObjectReference: corev1.ObjectReference{
+							Namespace: seedNamespace,
+							Name:      credentialName,
+						},
+					},
+				},
+			},
+			expectedKKPSecret: map[string][]byte{
+				resources.DigitaloceanToken: []byte("token-from-store"),
+			},
+			expectedClusterSecret: map[string][]byte{
+				resources.DigitaloceanToken: []byte("token-from-store"),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -206,7 +237,37 @@ func TestReconcile(t *testing.T) {
 			dummyCluster.Spec.Cloud = tc.cloudSpec
 			dummyCluster.Status.NamespaceName = "cluster-" + clusterName
 
-			builder := fake.NewClientBuilder().WithObjects(dummyCluster)
+			builder := fake.NewClientBuilder()
+
+			if tc.storeSecret != nil {
+				dummyCluster.Annotations = map[string]string{
+					kubermaticv1.PresetNameAnnotation: "my-preset",
+				}
+
+				builder.WithObjects(
+					&kubermaticv1.Preset{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "my-preset",
+						},
+						Spec: kubermaticv1.PresetSpec{
+							SecretStore: &kubermaticv1.PresetSecretStore{
+								Kubernetes: &kubermaticv1.KubernetesSecretStore{
+									SecretReference: corev1.SecretReference{Name: "my-preset-credentials"},
+								},
+							},
+						},
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "my-preset-credentials",
+							Namespace: seedNamespace,
+						},
+						Data: tc.storeSecret,
+					},
+				)
+			}
+
+			builder.WithObjects(dummyCluster)
 
 			if tc.kkpSecret != nil {
 				ref, err := resources.GetCredentialsReference(dummyCluster)
@@ -243,11 +304,12 @@ func TestReconcile(t *testing.T) {
 
 			ctx := context.Background()
 			r := &reconciler{
-				Client:     seedClient,
-				workerName: "",
-				recorder:   &events.FakeRecorder{},
-				log:        kubermaticlog.Logger,
-				versions:   kubermatic.GetFakeVersions(),
+				Client:       seedClient,
+				workerName:   "",
+				recorder:     &events.FakeRecorder{},
+				log:          kubermaticlog.Logger,
+				versions:     kubermatic.GetFakeVersions(),
+				secretStores: secretstore.NewResolver(seedClient, nil),
 			}
 
 			///////////////////////////////
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletiondryruncontroller
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcile(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster",
+			Annotations: map[string]string{
+				kubermaticv1.DeletionDryRunAnnotation: "",
+			},
+			Finalizers: []string{
+				kubermaticv1.InClusterLBCleanupFinalizer,
+			},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-cluster",
+		},
+	}
+
+	userClusterClient := fake.
+		NewClientBuilder().
+		WithObjects(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "lb", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}).
+		Build()
+
+	ctx := context.Background()
+	r := &Reconciler{
+		Client:   fake.NewClientBuilder().WithObjects(cluster).Build(),
+		recorder: &events.FakeRecorder{},
+		log:      kubermaticlog.Logger,
+		versions: kubermatic.GetFakeVersions(),
+
+		userClusterConnectionProvider: &fakeClientProvider{client: userClusterClient},
+	}
+
+	nName := types.NamespacedName{Name: cluster.Name}
+	if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: nName}); err != nil {
+		t.Fatalf("Reconciling failed: %v", err)
+	}
+
+	updated := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, nName, updated); err != nil {
+		t.Fatalf("Failed to get cluster: %v", err)
+	}
+
+	if _, ok := updated.Annotations[kubermaticv1.DeletionDryRunAnnotation]; ok {
+		t.Error("Expected dry-run annotation to be removed.")
+	}
+
+	if updated.Status.Deletion == nil || updated.Status.Deletion.DryRun == nil {
+		t.Fatal("Expected dry-run result in cluster status.")
+	}
+
+	steps := updated.Status.Deletion.DryRun.Steps
+	if len(steps) != 1 || steps[0].Name != kubermaticv1.ClusterDeletionStepLoadBalancers || steps[0].ResourceCount != 1 {
+		t.Errorf("Expected a single LoadBalancer to be listed, got %+v.", steps)
+	}
+
+	if updated.DeletionTimestamp != nil {
+		t.Error("A dry-run must not delete the cluster.")
+	}
+}
+
+type fakeClientProvider struct {
+	client ctrlruntimeclient.Client
+}
+
+func (f *fakeClientProvider) GetClient(ctx context.Context, c *kubermaticv1.Cluster, options ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+	return f.client, nil
+}
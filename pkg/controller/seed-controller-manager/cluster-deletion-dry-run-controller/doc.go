@@ -0,0 +1,26 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package clusterdeletiondryruncontroller contains a controller that performs a dry-run
+of the cluster deletion whenever the "kubermatic.k8c.io/deletion-dry-run" annotation
+is set on a Cluster. The resources that would be removed are written into the
+cluster status (status.deletion.dryRun) and the annotation is removed afterwards,
+so a new dry-run can be requested by setting the annotation again.
+
+The progress of an actual deletion is reported by pkg/clusterdeletion itself.
+*/
+package clusterdeletiondryruncontroller
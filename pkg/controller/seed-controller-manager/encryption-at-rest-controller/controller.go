@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"go.uber.org/zap"
@@ -49,6 +50,9 @@ import (
 const (
 	ControllerName = "kkp-encryption-at-rest-controller"
 	EARKeyLength   = 32
+
+	// kmsStatusInterval is the interval in which the readiness of KMS plugins is checked.
+	kmsStatusInterval = time.Minute
 )
 
 // userClusterConnectionProvider offers functions to retrieve clients for the given user clusters.
@@ -243,7 +247,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 			return &reconcile.Result{}, err
 		}
 
+		kmsStatus, err := getKMSStatus(ctx, r, cluster)
+		if err != nil {
+			return &reconcile.Result{}, err
+		}
+
 		if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+			c.Status.Encryption.KMS = kmsStatus
 			if c.Status.Encryption.ActiveKey != keyHint || !isEqualSlice(c.Status.Encryption.EncryptedResources, resourceList) {
 				// the active key as per the parsed EncryptionConfiguration has changed; we need to re-run encryption
 				c.Status.Encryption.Phase = kubermaticv1.ClusterEncryptionPhaseEncryptionNeeded
@@ -283,6 +293,26 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 			}
 		}
 
+		kmsStatus, err := getKMSStatus(ctx, r, cluster)
+		if err != nil {
+			return &reconcile.Result{}, err
+		}
+
+		if !reflect.DeepEqual(cluster.Status.Encryption.KMS, kmsStatus) {
+			if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+				c.Status.Encryption.KMS = kmsStatus
+			}); err != nil {
+				return &reconcile.Result{}, err
+			}
+		}
+
+		// the KMS plugin can become unavailable at any time, e.g. when the external key management
+		// service cannot be reached, so keep checking its readiness
+		var result *reconcile.Result
+		if kmsStatus != nil {
+			result = &reconcile.Result{RequeueAfter: kmsStatusInterval}
+		}
+
 		// encryption is set to "identity", thus secrets are unencrypted, and encryption is longer wished.
 		// This means we can fully reset the encryption status
 		if cluster.Status.Encryption.ActiveKey == encryptionresources.IdentityKey && !cluster.IsEncryptionEnabled() {
@@ -299,9 +329,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 			}); err != nil {
 				return &reconcile.Result{}, err
 			}
+
+			return &reconcile.Result{}, nil
 		}
 
-		return &reconcile.Result{}, nil
+		return result, nil
 
 	case kubermaticv1.ClusterEncryptionPhaseFailed:
 		// TODO: how to recover from a failed encryption? Can you even recover automatically?
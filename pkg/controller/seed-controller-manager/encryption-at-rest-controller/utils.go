@@ -111,6 +111,8 @@ func getActiveConfiguration(ctx context.Context, client ctrlruntimeclient.Client
 	switch {
 	case providerConfig.Secretbox != nil:
 		keyName = fmt.Sprintf("%s/%s", encryptionresources.SecretboxPrefix, providerConfig.Secretbox.Keys[0].Name)
+	case providerConfig.KMS != nil:
+		keyName = fmt.Sprintf("%s/%s", encryptionresources.KMSPrefix, providerConfig.KMS.Name)
 	case providerConfig.Identity != nil:
 		keyName = encryptionresources.IdentityKey
 	}
@@ -129,11 +131,60 @@ func getConfiguredKey(cluster *kubermaticv1.Cluster) (string, error) {
 	switch {
 	case cluster.Spec.EncryptionConfiguration.Secretbox != nil:
 		return fmt.Sprintf("%s/%s", encryptionresources.SecretboxPrefix, cluster.Spec.EncryptionConfiguration.Secretbox.Keys[0].Name), nil
+	case cluster.Spec.EncryptionConfiguration.KMS != nil:
+		return fmt.Sprintf("%s/%s", encryptionresources.KMSPrefix, cluster.Spec.EncryptionConfiguration.KMS.Name), nil
 	}
 
 	return "", errors.New("no supported encryption provider found")
 }
 
+// getKMSStatus returns the status of the KMS plugin as configured in the ClusterSpec, or nil if the
+// cluster does not use a KMS provider. If the provider has been removed from the ClusterSpec while
+// encryption is still active, the previously recorded configuration is kept, as the plugin is still
+// required for decryption. The plugin is considered ready if its sidecar is ready in all
+// kube-apiserver pods.
+func getKMSStatus(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (*kubermaticv1.ClusterKMSStatus, error) {
+	var kms *kubermaticv1.KMSEncryptionConfiguration
+
+	switch {
+	case cluster.Spec.EncryptionConfiguration != nil && cluster.Spec.EncryptionConfiguration.KMS != nil:
+		kms = cluster.Spec.EncryptionConfiguration.KMS
+	case cluster.IsEncryptionActive() && cluster.Status.Encryption != nil && cluster.Status.Encryption.KMS != nil:
+		kms = cluster.Status.Encryption.KMS.Configuration
+	}
+
+	if kms == nil {
+		return nil, nil
+	}
+
+	status := &kubermaticv1.ClusterKMSStatus{
+		Name:          kms.Name,
+		Endpoint:      kms.GetEndpoint(),
+		Configuration: kms.DeepCopy(),
+	}
+
+	var podList corev1.PodList
+	if err := client.List(ctx, &podList,
+		ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName),
+		ctrlruntimeclient.MatchingLabels{resources.AppLabelKey: "apiserver"},
+	); err != nil {
+		return nil, err
+	}
+
+	ready := 0
+	for _, pod := range podList.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.Name == resources.KMSPluginContainerName && containerStatus.Ready {
+				ready++
+			}
+		}
+	}
+
+	status.PluginReady = len(podList.Items) > 0 && ready == len(podList.Items)
+
+	return status, nil
+}
+
 func isEqualSlice(a []string, b []string) bool {
 	if len(a) != len(b) {
 		return false
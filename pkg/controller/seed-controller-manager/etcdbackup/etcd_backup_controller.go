@@ -75,6 +75,14 @@ const (
 
 	// maximum number of simultaneously running backup delete jobs per BackupConfig.
 	maxSimultaneousDeleteJobsPerConfig = 3
+
+	// maximum number of simultaneously running backup replication jobs per BackupConfig.
+	maxSimultaneousReplicationJobsPerConfig = 3
+
+	// maximum number of attempts to replicate a backup to a destination.
+	maxReplicationAttempts = 5
+	// time to wait before retrying a failed replication; doubled after every attempt.
+	replicationRetryBackoff = 5 * time.Minute
 )
 
 // Reconciler stores necessary components that are required to create etcd backups.
@@ -269,6 +277,18 @@ func (r *Reconciler) reconcile(
 
 	totalReconcile = minReconcile(totalReconcile, nextReconcile)
 
+	if nextReconcile, err = r.reconcileBackupVerification(ctx, data, backupConfig); err != nil {
+		return nil, fmt.Errorf("failed to verify backups: %w", err)
+	}
+
+	totalReconcile = minReconcile(totalReconcile, nextReconcile)
+
+	if nextReconcile, err = r.reconcileBackupReplication(ctx, data, backupConfig); err != nil {
+		return nil, fmt.Errorf("failed to replicate backups: %w", err)
+	}
+
+	totalReconcile = minReconcile(totalReconcile, nextReconcile)
+
 	if nextReconcile, err = r.startPendingBackupDeleteJobs(ctx, data, backupConfig); err != nil {
 		return nil, fmt.Errorf("failed to start pending backup delete jobs: %w", err)
 	}
@@ -544,7 +564,293 @@ func (r *Reconciler) startPendingBackupJobs(ctx context.Context, data *resources
 	return returnReconcile, nil
 }
 
-// create any backup delete jobs that can be created, i.e. for all completed backups older than the last backupConfig.GetKeptBackupsCount() ones.
+// update the status of running verification jobs, clean up finished ones and, if the verification
+// interval has passed, start verifying the latest completed backup.
+func (r *Reconciler) reconcileBackupVerification(ctx context.Context, data *resources.TemplateData, backupConfig *kubermaticv1.EtcdBackupConfig) (*reconcile.Result, error) {
+	if backupConfig.Spec.Verification == nil {
+		return nil, nil
+	}
+
+	var returnReconcile *reconcile.Result
+
+	oldBackupConfig := backupConfig.DeepCopy()
+
+	verificationRunning := false
+	var lastVerification time.Time
+	for i := range backupConfig.Status.CurrentBackups {
+		backup := &backupConfig.Status.CurrentBackups[i]
+
+		if backup.VerificationPhase == kubermaticv1.BackupStatusPhaseRunning {
+			job := &batchv1.Job{}
+			err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: backup.VerificationJobName}, job)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("error getting verification job for backup %s: %w", backup.BackupName, err)
+				}
+				// job not found. Apparently deleted externally.
+				backup.VerificationPhase = kubermaticv1.BackupStatusPhaseFailed
+				backup.VerificationMessage = "verification job deleted externally"
+				backup.VerifiedTime = metav1.NewTime(r.clock.Now())
+			} else {
+				if cond := getJobConditionIfTrue(job, batchv1.JobComplete); cond != nil {
+					backup.VerificationPhase = kubermaticv1.BackupStatusPhaseCompleted
+					backup.VerificationMessage = cond.Message
+					backup.VerifiedTime = cond.LastTransitionTime
+				} else if cond := getJobConditionIfTrue(job, batchv1.JobFailed); cond != nil {
+					backup.VerificationPhase = kubermaticv1.BackupStatusPhaseFailed
+					backup.VerificationMessage = cond.Message
+					backup.VerifiedTime = cond.LastTransitionTime
+					r.recorder.Eventf(backupConfig, nil, corev1.EventTypeWarning, "BackupVerificationFailed", "Reconciling", "verification of backup %s failed: %s", backup.BackupName, cond.Message)
+				} else {
+					// job still running
+					verificationRunning = true
+					returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: assumedJobRuntime})
+				}
+			}
+		}
+
+		// delete finished verification jobs once their retention time has passed
+		if backup.VerificationJobName != "" && backup.VerificationPhase != kubermaticv1.BackupStatusPhaseRunning {
+			retentionTime := failedJobRetentionTime
+			if backup.VerificationPhase == kubermaticv1.BackupStatusPhaseCompleted {
+				retentionTime = succeededJobRetentionTime
+			}
+
+			age := r.clock.Now().Sub(backup.VerifiedTime.Time)
+			if age < retentionTime {
+				returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: retentionTime - age})
+			} else {
+				if err := r.deleteJob(ctx, backup.VerificationJobName); err != nil {
+					return nil, fmt.Errorf("backup %s: failed to delete verification job %s: %w", backup.BackupName, backup.VerificationJobName, err)
+				}
+				backup.VerificationJobName = ""
+			}
+		}
+
+		if backup.VerifiedTime.After(lastVerification) {
+			lastVerification = backup.VerifiedTime.Time
+		}
+	}
+
+	if !verificationRunning && backupConfig.DeletionTimestamp == nil {
+		nextVerification := lastVerification.Add(backupConfig.GetVerificationInterval())
+
+		if now := r.clock.Now(); now.Before(nextVerification) {
+			returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: nextVerification.Sub(now)})
+		} else if backup := backupToVerify(backupConfig); backup != nil {
+			backup.VerificationJobName = r.limitNameLength(fmt.Sprintf("%s-backup-%s-verify-%s", data.Cluster().Name, backupConfig.Name, r.randStringGenerator()))
+
+			job := etcdbackup.VerificationJob(data, backupConfig, backup)
+			if err := r.Create(ctx, job); ctrlruntimeclient.IgnoreAlreadyExists(err) != nil {
+				return nil, fmt.Errorf("error creating verification job for backup %s: %w", backup.BackupName, err)
+			}
+
+			backup.VerificationPhase = kubermaticv1.BackupStatusPhaseRunning
+			returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: assumedJobRuntime})
+		}
+	}
+
+	if apiequality.Semantic.DeepEqual(oldBackupConfig.Status, backupConfig.Status) {
+		return returnReconcile, nil
+	}
+
+	if err := r.Status().Patch(ctx, backupConfig, ctrlruntimeclient.MergeFrom(oldBackupConfig)); err != nil {
+		return nil, fmt.Errorf("failed to update backup status: %w", err)
+	}
+
+	return returnReconcile, nil
+}
+
+// backupToVerify returns the latest completed backup, if it has not been verified
+// yet and is not about to be deleted.
+func backupToVerify(backupConfig *kubermaticv1.EtcdBackupConfig) *kubermaticv1.BackupStatus {
+	for i := len(backupConfig.Status.CurrentBackups) - 1; i >= 0; i-- {
+		backup := &backupConfig.Status.CurrentBackups[i]
+		if backup.BackupPhase != kubermaticv1.BackupStatusPhaseCompleted || backup.DeletePhase != "" {
+			continue
+		}
+
+		if backup.VerificationPhase != "" {
+			return nil
+		}
+
+		return backup
+	}
+
+	return nil
+}
+
+// update the status of running replication jobs, clean up finished ones and start replicating
+// completed backups to all of the backupConfig's replication destinations.
+func (r *Reconciler) reconcileBackupReplication(ctx context.Context, data *resources.TemplateData, backupConfig *kubermaticv1.EtcdBackupConfig) (*reconcile.Result, error) {
+	var returnReconcile *reconcile.Result
+
+	oldBackupConfig := backupConfig.DeepCopy()
+
+	runningReplicationJobsCount := 0
+	for i := range backupConfig.Status.CurrentBackups {
+		backup := &backupConfig.Status.CurrentBackups[i]
+
+		for j := range backup.Replications {
+			replication := &backup.Replications[j]
+
+			if replication.Phase == kubermaticv1.BackupStatusPhaseRunning {
+				job := &batchv1.Job{}
+				err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: replication.JobName}, job)
+				if err != nil {
+					if !apierrors.IsNotFound(err) {
+						return nil, fmt.Errorf("error getting replication job for backup %s: %w", backup.BackupName, err)
+					}
+					// job not found. Apparently deleted externally.
+					replication.Phase = kubermaticv1.BackupStatusPhaseFailed
+					replication.Message = "replication job deleted externally"
+					replication.FinishedTime = metav1.NewTime(r.clock.Now())
+				} else {
+					if cond := getJobConditionIfTrue(job, batchv1.JobComplete); cond != nil {
+						replication.Phase = kubermaticv1.BackupStatusPhaseCompleted
+						replication.Message = cond.Message
+						replication.FinishedTime = cond.LastTransitionTime
+					} else if cond := getJobConditionIfTrue(job, batchv1.JobFailed); cond != nil {
+						replication.Phase = kubermaticv1.BackupStatusPhaseFailed
+						replication.Message = cond.Message
+						replication.FinishedTime = cond.LastTransitionTime
+						r.recorder.Eventf(backupConfig, nil, corev1.EventTypeWarning, "BackupReplicationFailed", "Reconciling", "replication of backup %s to %s failed: %s", backup.BackupName, replication.Destination, cond.Message)
+					} else {
+						// job still running
+						runningReplicationJobsCount++
+						returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: assumedJobRuntime})
+					}
+				}
+			}
+
+			// delete finished replication jobs once their retention time has passed
+			if replication.JobName != "" && replication.Phase != kubermaticv1.BackupStatusPhaseRunning {
+				retentionTime := failedJobRetentionTime
+				if replication.Phase == kubermaticv1.BackupStatusPhaseCompleted {
+					retentionTime = succeededJobRetentionTime
+				}
+
+				age := r.clock.Now().Sub(replication.FinishedTime.Time)
+				if age < retentionTime {
+					returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: retentionTime - age})
+				} else {
+					if err := r.deleteJob(ctx, replication.JobName); err != nil {
+						return nil, fmt.Errorf("backup %s: failed to delete replication job %s: %w", backup.BackupName, replication.JobName, err)
+					}
+					replication.JobName = ""
+				}
+			}
+		}
+	}
+
+	if backupConfig.DeletionTimestamp == nil {
+		for i := range backupConfig.Status.CurrentBackups {
+			backup := &backupConfig.Status.CurrentBackups[i]
+			if backup.BackupPhase != kubermaticv1.BackupStatusPhaseCompleted || backup.DeletePhase != "" {
+				continue
+			}
+
+			for _, destinationName := range backupConfig.Spec.ReplicationDestinations {
+				if destinationName == backupConfig.Spec.Destination {
+					continue
+				}
+
+				// failed replications are retried with an exponential backoff, until the maximum number of attempts is reached
+				replication := getReplication(backup, destinationName)
+				if replication != nil {
+					if replication.Phase != kubermaticv1.BackupStatusPhaseFailed || replication.Attempts >= maxReplicationAttempts {
+						continue
+					}
+
+					if wait := replicationBackoff(replication) - r.clock.Now().Sub(replication.FinishedTime.Time); wait > 0 {
+						returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: wait})
+						continue
+					}
+				}
+
+				if runningReplicationJobsCount >= maxSimultaneousReplicationJobsPerConfig {
+					break
+				}
+
+				if replication == nil {
+					backup.Replications = append(backup.Replications, kubermaticv1.BackupReplicationStatus{
+						Destination: destinationName,
+					})
+					replication = &backup.Replications[len(backup.Replications)-1]
+				} else if replication.JobName != "" {
+					if err := r.deleteJob(ctx, replication.JobName); err != nil {
+						return nil, fmt.Errorf("backup %s: failed to delete replication job %s: %w", backup.BackupName, replication.JobName, err)
+					}
+					replication.JobName = ""
+				}
+
+				replication.Attempts++
+				replication.Message = ""
+				replication.FinishedTime = metav1.Time{}
+
+				destination := data.Seed().GetEtcdBackupDestination(destinationName)
+				if destination == nil || destination.Credentials == nil {
+					replication.Phase = kubermaticv1.BackupStatusPhaseFailed
+					replication.Message = fmt.Sprintf("backup destination %q does not exist or has no credentials", destinationName)
+					replication.FinishedTime = metav1.NewTime(r.clock.Now())
+					if replication.Attempts < maxReplicationAttempts {
+						returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: replicationBackoff(replication)})
+					}
+					continue
+				}
+
+				replication.JobName = r.limitNameLength(fmt.Sprintf("%s-backup-%s-replicate-%s", data.Cluster().Name, backupConfig.Name, r.randStringGenerator()))
+
+				job := etcdbackup.ReplicationJob(data, backupConfig, backup, replication, destination)
+				if err := r.Create(ctx, job); ctrlruntimeclient.IgnoreAlreadyExists(err) != nil {
+					return nil, fmt.Errorf("error creating replication job for backup %s: %w", backup.BackupName, err)
+				}
+
+				replication.Phase = kubermaticv1.BackupStatusPhaseRunning
+				runningReplicationJobsCount++
+				returnReconcile = minReconcile(returnReconcile, &reconcile.Result{RequeueAfter: assumedJobRuntime})
+			}
+		}
+	}
+
+	if apiequality.Semantic.DeepEqual(oldBackupConfig.Status, backupConfig.Status) {
+		return returnReconcile, nil
+	}
+
+	if err := r.Status().Patch(ctx, backupConfig, ctrlruntimeclient.MergeFrom(oldBackupConfig)); err != nil {
+		return nil, fmt.Errorf("failed to update backup status: %w", err)
+	}
+
+	return returnReconcile, nil
+}
+
+func getReplication(backup *kubermaticv1.BackupStatus, destination string) *kubermaticv1.BackupReplicationStatus {
+	for i := range backup.Replications {
+		if backup.Replications[i].Destination == destination {
+			return &backup.Replications[i]
+		}
+	}
+
+	return nil
+}
+
+// replicationBackoff returns the time to wait after the given failed replication before it is retried.
+func replicationBackoff(replication *kubermaticv1.BackupReplicationStatus) time.Duration {
+	return replicationRetryBackoff << max(replication.Attempts-1, 0)
+}
+
+func (r *Reconciler) deleteJob(ctx context.Context, name string) error {
+	job := &batchv1.Job{}
+	job.Name = name
+	job.Namespace = metav1.NamespaceSystem
+
+	err := r.Delete(ctx, job, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground))
+
+	return ctrlruntimeclient.IgnoreNotFound(err)
+}
+
+// create any backup delete jobs that can be created, i.e. for all failed backups and all completed backups that are not retained
+// according to the backupConfig's retention policy (see retainedBackups()).
 func (r *Reconciler) startPendingBackupDeleteJobs(ctx context.Context, data *resources.TemplateData, backupConfig *kubermaticv1.EtcdBackupConfig) (*reconcile.Result, error) {
 	// one-shot backups are not deleted until their backupConfig is deleted
 	if backupConfig.Spec.Schedule == "" && backupConfig.DeletionTimestamp == nil {
@@ -552,11 +858,7 @@ func (r *Reconciler) startPendingBackupDeleteJobs(ctx context.Context, data *res
 	}
 
 	var backupsToDelete []*kubermaticv1.BackupStatus
-	keepCount := backupConfig.GetKeptBackupsCount()
-	if backupConfig.DeletionTimestamp != nil {
-		keepCount = 0
-	}
-	kept := 0
+	retained := retainedBackups(backupConfig, r.clock.Now())
 	runningDeleteJobsCount := 0
 	for i := len(backupConfig.Status.CurrentBackups) - 1; i >= 0; i-- {
 		backup := &backupConfig.Status.CurrentBackups[i]
@@ -566,8 +868,7 @@ func (r *Reconciler) startPendingBackupDeleteJobs(ctx context.Context, data *res
 		if backup.BackupPhase == kubermaticv1.BackupStatusPhaseFailed && backup.DeletePhase == "" {
 			backupsToDelete = append(backupsToDelete, backup)
 		} else if backup.BackupPhase == kubermaticv1.BackupStatusPhaseCompleted {
-			kept++
-			if kept > keepCount && backup.DeletePhase == "" {
+			if !retained.Has(i) && backup.DeletePhase == "" {
 				backupsToDelete = append(backupsToDelete, backup)
 			}
 		}
@@ -769,6 +1070,20 @@ func (r *Reconciler) deleteFinishedBackupJobs(ctx context.Context, log *zap.Suga
 		}
 
 		if backupJobDeleted && deleteJobDeleted {
+			if backup.VerificationJobName != "" {
+				if err := r.deleteJob(ctx, backup.VerificationJobName); err != nil {
+					return nil, fmt.Errorf("backup %s: failed to delete verification job %s: %w", backup.BackupName, backup.VerificationJobName, err)
+				}
+			}
+
+			for _, replication := range backup.Replications {
+				if replication.JobName != "" {
+					if err := r.deleteJob(ctx, replication.JobName); err != nil {
+						return nil, fmt.Errorf("backup %s: failed to delete replication job %s: %w", backup.BackupName, replication.JobName, err)
+					}
+				}
+			}
+
 			// don't add backup to newBackups, which ends up deleting it from backupConfig.Status.CurrentBackups below
 			modified = true
 			continue
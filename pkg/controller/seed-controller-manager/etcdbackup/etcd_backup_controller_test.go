@@ -163,6 +163,39 @@ func genBackupDeleteJob(data *resources.TemplateData, backupName, jobName string
 	return job
 }
 
+func genVerificationJob(data *resources.TemplateData, backupName, jobName string) *batchv1.Job {
+	// same thing as genBackupJob, but for verification jobs
+	cluster := genTestCluster()
+	backupConfig := genBackupConfig(cluster, "testbackup")
+	backup := &kubermaticv1.BackupStatus{
+		BackupName:          backupName,
+		VerificationJobName: jobName,
+	}
+
+	job := etcdbackup.VerificationJob(data, backupConfig, backup)
+	job.ResourceVersion = "1"
+	job.Spec.Template.Spec.Containers[0].Env = nil
+	return job
+}
+
+func genReplicationJob(data *resources.TemplateData, backupName, destination, jobName string) *batchv1.Job {
+	// same thing as genBackupJob, but for replication jobs
+	cluster := genTestCluster()
+	backupConfig := genBackupConfig(cluster, "testbackup")
+	backup := &kubermaticv1.BackupStatus{
+		BackupName: backupName,
+	}
+	replication := &kubermaticv1.BackupReplicationStatus{
+		Destination: destination,
+		JobName:     jobName,
+	}
+
+	job := etcdbackup.ReplicationJob(data, backupConfig, backup, replication, genDefaultBackupDestination())
+	job.ResourceVersion = "1"
+	job.Spec.Template.Spec.Containers[0].Env = nil
+	return job
+}
+
 func jobAddCondition(j *batchv1.Job, jobType batchv1.JobConditionType, status corev1.ConditionStatus, lastTransitionTime time.Time, message string) *batchv1.Job {
 	j.Status.Conditions = append(j.Status.Conditions, batchv1.JobCondition{
 		Type:               jobType,
@@ -1612,6 +1645,525 @@ func TestDeleteFinishedBackupJobs(t *testing.T) {
 	}
 }
 
+func TestReconcileBackupVerification(t *testing.T) {
+	day := 24 * time.Hour
+	now := time.Unix(0, 0).Add(10 * day).UTC()
+
+	completedBackup := func(name string, finished time.Time) kubermaticv1.BackupStatus {
+		return kubermaticv1.BackupStatus{
+			BackupName:         name,
+			JobName:            "testcluster-backup-testbackup-create-" + name,
+			DeleteJobName:      "testcluster-backup-testbackup-delete-" + name,
+			BackupPhase:        kubermaticv1.BackupStatusPhaseCompleted,
+			BackupFinishedTime: metav1.NewTime(finished),
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		verification      *kubermaticv1.EtcdBackupVerification
+		existingBackups   []kubermaticv1.BackupStatus
+		existingJobs      jobFunc
+		expectedBackups   []kubermaticv1.BackupStatus
+		expectedReconcile *reconcile.Result
+		expectedJobNames  []string
+	}{
+		{
+			name: "nothing happens if verification is not configured",
+			existingBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", now.Add(-time.Hour)),
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", now.Add(-time.Hour)),
+			},
+			expectedReconcile: nil,
+			expectedJobNames:  []string{},
+		},
+		{
+			name:         "latest completed backup is verified once the interval has passed",
+			verification: &kubermaticv1.EtcdBackupVerification{},
+			existingBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("aaaa", now.Add(-2*day))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseCompleted
+					b.VerifiedTime = metav1.NewTime(now.Add(-2 * day))
+					return b
+				}(),
+				completedBackup("bbbb", now.Add(-time.Hour)),
+				{
+					BackupName:  "cccc",
+					BackupPhase: kubermaticv1.BackupStatusPhaseRunning,
+				},
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("aaaa", now.Add(-2*day))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseCompleted
+					b.VerifiedTime = metav1.NewTime(now.Add(-2 * day))
+					return b
+				}(),
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("bbbb", now.Add(-time.Hour))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseRunning
+					b.VerificationJobName = "testcluster-backup-testbackup-verify-xxxx"
+					return b
+				}(),
+				{
+					BackupName:  "cccc",
+					BackupPhase: kubermaticv1.BackupStatusPhaseRunning,
+				},
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: assumedJobRuntime},
+			expectedJobNames:  []string{"testcluster-backup-testbackup-verify-xxxx"},
+		},
+		{
+			name:         "no verification is started before the interval has passed",
+			verification: &kubermaticv1.EtcdBackupVerification{Interval: &metav1.Duration{Duration: 2 * day}},
+			existingBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("aaaa", now.Add(-day))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseFailed
+					b.VerifiedTime = metav1.NewTime(now.Add(-day))
+					return b
+				}(),
+				completedBackup("bbbb", now.Add(-time.Hour)),
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("aaaa", now.Add(-day))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseFailed
+					b.VerifiedTime = metav1.NewTime(now.Add(-day))
+					return b
+				}(),
+				completedBackup("bbbb", now.Add(-time.Hour)),
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: day},
+			expectedJobNames:  []string{},
+		},
+		{
+			name:         "finished verification job is recorded in the backup status",
+			verification: &kubermaticv1.EtcdBackupVerification{},
+			existingBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("aaaa", now.Add(-time.Hour))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseRunning
+					b.VerificationJobName = "testcluster-backup-testbackup-verify-aaaa"
+					return b
+				}(),
+			},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genVerificationJob(data, "aaaa", "testcluster-backup-testbackup-verify-aaaa"),
+						batchv1.JobFailed, corev1.ConditionTrue, now.Add(-30*time.Second), "Job has reached the specified backoff limit"),
+				}
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("aaaa", now.Add(-time.Hour))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseFailed
+					b.VerificationJobName = "testcluster-backup-testbackup-verify-aaaa"
+					b.VerificationMessage = "Job has reached the specified backoff limit"
+					b.VerifiedTime = metav1.NewTime(now.Add(-30 * time.Second))
+					return b
+				}(),
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: failedJobRetentionTime - 30*time.Second},
+			expectedJobNames:  []string{"testcluster-backup-testbackup-verify-aaaa"},
+		},
+		{
+			name:         "verification job is deleted after the retention time",
+			verification: &kubermaticv1.EtcdBackupVerification{},
+			existingBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("aaaa", now.Add(-time.Hour))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseCompleted
+					b.VerificationJobName = "testcluster-backup-testbackup-verify-aaaa"
+					b.VerifiedTime = metav1.NewTime(now.Add(-30 * time.Minute))
+					return b
+				}(),
+			},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genVerificationJob(data, "aaaa", "testcluster-backup-testbackup-verify-aaaa"),
+						batchv1.JobComplete, corev1.ConditionTrue, now.Add(-30*time.Minute), ""),
+				}
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				func() kubermaticv1.BackupStatus {
+					b := completedBackup("aaaa", now.Add(-time.Hour))
+					b.VerificationPhase = kubermaticv1.BackupStatusPhaseCompleted
+					b.VerifiedTime = metav1.NewTime(now.Add(-30 * time.Minute))
+					return b
+				}(),
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: day - 30*time.Minute},
+			expectedJobNames:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			cluster := genTestCluster()
+			backupConfig := genBackupConfig(cluster, "testbackup")
+			backupConfig.Spec.Schedule = "@every 1h"
+			backupConfig.Spec.Verification = tc.verification
+
+			clock := clocktesting.NewFakeClock(now)
+			backupConfig.SetCreationTimestamp(metav1.Time{Time: clock.Now()})
+			backupConfig.Status.CurrentBackups = tc.existingBackups
+
+			td := resources.NewTemplateDataBuilder().
+				WithContext(ctx).
+				WithCluster(cluster).
+				WithVersions(kubermatic.GetFakeVersions()).
+				WithEtcdLauncherImage(defaulting.DefaultEtcdLauncherImage).
+				WithEtcdBackupStoreContainer(genStoreContainer(), false).
+				WithEtcdBackupDeleteContainer(genDeleteContainer(), false).
+				WithEtcdBackupDestination(genDefaultBackupDestination()).
+				Build()
+
+			initObjs := []ctrlruntimeclient.Object{
+				cluster,
+				backupConfig,
+			}
+			if tc.existingJobs != nil {
+				for _, j := range tc.existingJobs(td) {
+					initObjs = append(initObjs, j.DeepCopy())
+				}
+			}
+
+			reconciler := Reconciler{
+				log:                 kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+				Client:              fake.NewClientBuilder().WithObjects(initObjs...).Build(),
+				scheme:              scheme.Scheme,
+				recorder:            events.NewFakeRecorder(10),
+				clock:               clock,
+				randStringGenerator: constRandStringGenerator("xxxx"),
+			}
+
+			reconcileAfter, err := reconciler.reconcileBackupVerification(ctx, td, backupConfig)
+			if err != nil {
+				t.Fatalf("reconcileBackupVerification returned an error: %v", err)
+			}
+
+			readbackBackupConfig := &kubermaticv1.EtcdBackupConfig{}
+			if err := reconciler.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(backupConfig), readbackBackupConfig); err != nil {
+				t.Fatalf("Error reading back backupConfig: %v", err)
+			}
+
+			if d := diff.ObjectDiff(tc.expectedBackups, readbackBackupConfig.Status.CurrentBackups); d != "" {
+				t.Errorf("backups differ from expected ones:\n%v", d)
+			}
+
+			jobNames := []string{}
+			for _, job := range getSortedJobs(t, reconciler) {
+				jobNames = append(jobNames, job.Name)
+			}
+
+			if d := diff.ObjectDiff(tc.expectedJobNames, jobNames); d != "" {
+				t.Errorf("jobs differ from expected ones:\n%v", d)
+			}
+
+			if !diff.SemanticallyEqual(reconcileAfter, tc.expectedReconcile) {
+				t.Errorf("reconcile time differs from expected, expected: %v, actual: %v", tc.expectedReconcile, reconcileAfter)
+			}
+		})
+	}
+}
+
+func TestReconcileBackupReplication(t *testing.T) {
+	now := time.Unix(0, 0).Add(24 * time.Hour).UTC()
+
+	completedBackup := func(name string, replications ...kubermaticv1.BackupReplicationStatus) kubermaticv1.BackupStatus {
+		return kubermaticv1.BackupStatus{
+			BackupName:         name,
+			JobName:            "testcluster-backup-testbackup-create-" + name,
+			DeleteJobName:      "testcluster-backup-testbackup-delete-" + name,
+			BackupPhase:        kubermaticv1.BackupStatusPhaseCompleted,
+			BackupFinishedTime: metav1.NewTime(now.Add(-time.Hour)),
+			Replications:       replications,
+		}
+	}
+
+	testCases := []struct {
+		name                    string
+		replicationDestinations []string
+		existingBackups         []kubermaticv1.BackupStatus
+		existingJobs            jobFunc
+		expectedBackups         []kubermaticv1.BackupStatus
+		expectedReconcile       *reconcile.Result
+		expectedJobNames        []string
+	}{
+		{
+			name: "nothing happens if no replication destinations are configured",
+			existingBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa"),
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa"),
+			},
+			expectedReconcile: nil,
+			expectedJobNames:  []string{},
+		},
+		{
+			name:                    "completed backups are replicated to all destinations",
+			replicationDestinations: []string{"dr", "no-credentials", "missing"},
+			existingBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa"),
+				{
+					BackupName:  "bbbb",
+					BackupPhase: kubermaticv1.BackupStatusPhaseRunning,
+				},
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa",
+					kubermaticv1.BackupReplicationStatus{
+						Destination: "dr",
+						JobName:     "testcluster-backup-testbackup-replicate-xxxx",
+						Phase:       kubermaticv1.BackupStatusPhaseRunning,
+						Attempts:    1,
+					},
+					kubermaticv1.BackupReplicationStatus{
+						Destination:  "no-credentials",
+						Phase:        kubermaticv1.BackupStatusPhaseFailed,
+						Message:      `backup destination "no-credentials" does not exist or has no credentials`,
+						FinishedTime: metav1.NewTime(now),
+						Attempts:     1,
+					},
+					kubermaticv1.BackupReplicationStatus{
+						Destination:  "missing",
+						Phase:        kubermaticv1.BackupStatusPhaseFailed,
+						Message:      `backup destination "missing" does not exist or has no credentials`,
+						FinishedTime: metav1.NewTime(now),
+						Attempts:     1,
+					},
+				),
+				{
+					BackupName:  "bbbb",
+					BackupPhase: kubermaticv1.BackupStatusPhaseRunning,
+				},
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: assumedJobRuntime},
+			expectedJobNames:  []string{"testcluster-backup-testbackup-replicate-xxxx"},
+		},
+		{
+			name:                    "finished replication job is recorded in the backup status",
+			replicationDestinations: []string{"dr"},
+			existingBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination: "dr",
+					JobName:     "testcluster-backup-testbackup-replicate-aaaa",
+					Phase:       kubermaticv1.BackupStatusPhaseRunning,
+				}),
+			},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genReplicationJob(data, "aaaa", "dr", "testcluster-backup-testbackup-replicate-aaaa"),
+						batchv1.JobComplete, corev1.ConditionTrue, now.Add(-30*time.Second), ""),
+				}
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination:  "dr",
+					JobName:      "testcluster-backup-testbackup-replicate-aaaa",
+					Phase:        kubermaticv1.BackupStatusPhaseCompleted,
+					FinishedTime: metav1.NewTime(now.Add(-30 * time.Second)),
+				}),
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: succeededJobRetentionTime - 30*time.Second},
+			expectedJobNames:  []string{"testcluster-backup-testbackup-replicate-aaaa"},
+		},
+		{
+			name:                    "replication job is deleted after the retention time",
+			replicationDestinations: []string{"dr"},
+			existingBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination:  "dr",
+					JobName:      "testcluster-backup-testbackup-replicate-aaaa",
+					Phase:        kubermaticv1.BackupStatusPhaseFailed,
+					FinishedTime: metav1.NewTime(now.Add(-30 * time.Minute)),
+					Attempts:     maxReplicationAttempts,
+				}),
+			},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genReplicationJob(data, "aaaa", "dr", "testcluster-backup-testbackup-replicate-aaaa"),
+						batchv1.JobFailed, corev1.ConditionTrue, now.Add(-30*time.Minute), ""),
+				}
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination:  "dr",
+					Phase:        kubermaticv1.BackupStatusPhaseFailed,
+					FinishedTime: metav1.NewTime(now.Add(-30 * time.Minute)),
+					Attempts:     maxReplicationAttempts,
+				}),
+			},
+			expectedReconcile: nil,
+			expectedJobNames:  []string{},
+		},
+		{
+			name:                    "failed replication is not retried before the backoff has passed",
+			replicationDestinations: []string{"dr"},
+			existingBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination:  "dr",
+					Phase:        kubermaticv1.BackupStatusPhaseFailed,
+					Message:      "upload failed",
+					FinishedTime: metav1.NewTime(now.Add(-5 * time.Minute)),
+					Attempts:     2,
+				}),
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination:  "dr",
+					Phase:        kubermaticv1.BackupStatusPhaseFailed,
+					Message:      "upload failed",
+					FinishedTime: metav1.NewTime(now.Add(-5 * time.Minute)),
+					Attempts:     2,
+				}),
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: 5 * time.Minute},
+			expectedJobNames:  []string{},
+		},
+		{
+			name:                    "failed replication is retried after the backoff",
+			replicationDestinations: []string{"dr"},
+			existingBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination:  "dr",
+					JobName:      "testcluster-backup-testbackup-replicate-aaaa",
+					Phase:        kubermaticv1.BackupStatusPhaseFailed,
+					Message:      "upload failed",
+					FinishedTime: metav1.NewTime(now.Add(-10 * time.Minute)),
+					Attempts:     2,
+				}),
+			},
+			existingJobs: func(data *resources.TemplateData) []batchv1.Job {
+				return []batchv1.Job{
+					*jobAddCondition(genReplicationJob(data, "aaaa", "dr", "testcluster-backup-testbackup-replicate-aaaa"),
+						batchv1.JobFailed, corev1.ConditionTrue, now.Add(-10*time.Minute), "upload failed"),
+				}
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination: "dr",
+					JobName:     "testcluster-backup-testbackup-replicate-xxxx",
+					Phase:       kubermaticv1.BackupStatusPhaseRunning,
+					Attempts:    3,
+				}),
+			},
+			expectedReconcile: &reconcile.Result{RequeueAfter: assumedJobRuntime},
+			expectedJobNames:  []string{"testcluster-backup-testbackup-replicate-xxxx"},
+		},
+		{
+			name:                    "failed replication is not retried after the maximum number of attempts",
+			replicationDestinations: []string{"dr"},
+			existingBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination:  "dr",
+					Phase:        kubermaticv1.BackupStatusPhaseFailed,
+					Message:      "upload failed",
+					FinishedTime: metav1.NewTime(now.Add(-24 * time.Hour)),
+					Attempts:     maxReplicationAttempts,
+				}),
+			},
+			expectedBackups: []kubermaticv1.BackupStatus{
+				completedBackup("aaaa", kubermaticv1.BackupReplicationStatus{
+					Destination:  "dr",
+					Phase:        kubermaticv1.BackupStatusPhaseFailed,
+					Message:      "upload failed",
+					FinishedTime: metav1.NewTime(now.Add(-24 * time.Hour)),
+					Attempts:     maxReplicationAttempts,
+				}),
+			},
+			expectedReconcile: nil,
+			expectedJobNames:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			cluster := genTestCluster()
+			backupConfig := genBackupConfig(cluster, "testbackup")
+			backupConfig.Spec.Schedule = "@every 1h"
+			backupConfig.Spec.Destination = "s3"
+			backupConfig.Spec.ReplicationDestinations = tc.replicationDestinations
+
+			clock := clocktesting.NewFakeClock(now)
+			backupConfig.SetCreationTimestamp(metav1.Time{Time: clock.Now()})
+			backupConfig.Status.CurrentBackups = tc.existingBackups
+
+			seed := &kubermaticv1.Seed{}
+			addSeedDestinations(seed)
+			seed.Spec.EtcdBackupRestore.Destinations["dr"] = genDefaultBackupDestination()
+
+			td := resources.NewTemplateDataBuilder().
+				WithContext(ctx).
+				WithCluster(cluster).
+				WithSeed(seed).
+				WithVersions(kubermatic.GetFakeVersions()).
+				WithEtcdLauncherImage(defaulting.DefaultEtcdLauncherImage).
+				WithEtcdBackupStoreContainer(genStoreContainer(), false).
+				WithEtcdBackupDeleteContainer(genDeleteContainer(), false).
+				WithEtcdBackupDestination(genDefaultBackupDestination()).
+				Build()
+
+			initObjs := []ctrlruntimeclient.Object{
+				cluster,
+				backupConfig,
+			}
+			if tc.existingJobs != nil {
+				for _, j := range tc.existingJobs(td) {
+					initObjs = append(initObjs, j.DeepCopy())
+				}
+			}
+
+			reconciler := Reconciler{
+				log:                 kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+				Client:              fake.NewClientBuilder().WithObjects(initObjs...).Build(),
+				scheme:              scheme.Scheme,
+				recorder:            events.NewFakeRecorder(10),
+				clock:               clock,
+				randStringGenerator: constRandStringGenerator("xxxx"),
+			}
+
+			reconcileAfter, err := reconciler.reconcileBackupReplication(ctx, td, backupConfig)
+			if err != nil {
+				t.Fatalf("reconcileBackupReplication returned an error: %v", err)
+			}
+
+			readbackBackupConfig := &kubermaticv1.EtcdBackupConfig{}
+			if err := reconciler.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(backupConfig), readbackBackupConfig); err != nil {
+				t.Fatalf("Error reading back backupConfig: %v", err)
+			}
+
+			if d := diff.ObjectDiff(tc.expectedBackups, readbackBackupConfig.Status.CurrentBackups); d != "" {
+				t.Errorf("backups differ from expected ones:\n%v", d)
+			}
+
+			jobNames := []string{}
+			for _, job := range getSortedJobs(t, reconciler) {
+				jobNames = append(jobNames, job.Name)
+			}
+
+			if d := diff.ObjectDiff(tc.expectedJobNames, jobNames); d != "" {
+				t.Errorf("jobs differ from expected ones:\n%v", d)
+			}
+
+			if !diff.SemanticallyEqual(reconcileAfter, tc.expectedReconcile) {
+				t.Errorf("reconcile time differs from expected, expected: %v, actual: %v", tc.expectedReconcile, reconcileAfter)
+			}
+		})
+	}
+}
+
 func getSortedJobs(t *testing.T, reconciler Reconciler) []batchv1.Job {
 	jobList := batchv1.JobList{}
 	if err := reconciler.List(context.Background(), &jobList); err != nil {
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackup
+
+import (
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// retentionTier keeps the latest backup of each of the last count periods.
+type retentionTier struct {
+	count  *int
+	period func(t time.Time) string
+}
+
+func retentionTiers(policy *kubermaticv1.EtcdBackupRetentionPolicy) []retentionTier {
+	return []retentionTier{
+		{
+			count:  policy.Hourly,
+			period: func(t time.Time) string { return t.Format("2006-01-02T15") },
+		},
+		{
+			count:  policy.Daily,
+			period: func(t time.Time) string { return t.Format("2006-01-02") },
+		},
+		{
+			count: policy.Weekly,
+			period: func(t time.Time) string {
+				year, week := t.ISOWeek()
+				return fmt.Sprintf("%d-W%02d", year, week)
+			},
+		},
+		{
+			count:  policy.Monthly,
+			period: func(t time.Time) string { return t.Format("2006-01") },
+		},
+	}
+}
+
+// retainedBackups returns the indices of all completed backups in backupConfig.Status.CurrentBackups
+// that must be kept according to the config's retention policy. If a tiered policy is configured, it
+// replaces the simple backup count. The latest completed backup is always retained, unless the
+// backupConfig is being deleted.
+func retainedBackups(backupConfig *kubermaticv1.EtcdBackupConfig, now time.Time) sets.Set[int] {
+	retained := sets.New[int]()
+	if backupConfig.DeletionTimestamp != nil {
+		return retained
+	}
+
+	// CurrentBackups is ordered by scheduled time, so collect the completed backups newest first
+	var completed []int
+	for i := len(backupConfig.Status.CurrentBackups) - 1; i >= 0; i-- {
+		if backupConfig.Status.CurrentBackups[i].BackupPhase == kubermaticv1.BackupStatusPhaseCompleted {
+			completed = append(completed, i)
+		}
+	}
+
+	if len(completed) == 0 {
+		return retained
+	}
+
+	policy := backupConfig.Spec.Retention
+
+	if policy.HasTiers() {
+		keepCount := backupConfig.GetKeptBackupsCount()
+
+		for _, tier := range retentionTiers(policy) {
+			if tier.count == nil || *tier.count <= 0 {
+				continue
+			}
+
+			periods := sets.New[string]()
+			for _, i := range completed {
+				period := tier.period(backupConfig.Status.CurrentBackups[i].ScheduledTime.UTC())
+				if periods.Has(period) {
+					continue
+				}
+
+				if periods.Len() >= *tier.count {
+					break
+				}
+
+				// never keep more than the overall limit, even if the tiers add up to more
+				if !retained.Has(i) && retained.Len() >= keepCount {
+					break
+				}
+
+				periods.Insert(period)
+				retained.Insert(i)
+			}
+		}
+	} else {
+		keepCount := backupConfig.GetKeptBackupsCount()
+		for n, i := range completed {
+			if n < keepCount {
+				retained.Insert(i)
+			}
+		}
+	}
+
+	if policy != nil && policy.MaxAge != nil && policy.MaxAge.Duration > 0 {
+		for _, i := range completed[1:] {
+			if now.Sub(backupConfig.Status.CurrentBackups[i].ScheduledTime.Time) > policy.MaxAge.Duration {
+				retained.Delete(i)
+			}
+		}
+	}
+
+	return retained
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackup
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+)
+
+func TestRetainedBackups(t *testing.T) {
+	// a Monday, in ISO week 12
+	now := time.Date(2026, 3, 16, 12, 0, 0, 0, time.UTC)
+
+	backup := func(name string, scheduled time.Time, phase kubermaticv1.BackupStatusPhase) kubermaticv1.BackupStatus {
+		return kubermaticv1.BackupStatus{
+			BackupName:    name,
+			ScheduledTime: metav1.NewTime(scheduled),
+			BackupPhase:   phase,
+		}
+	}
+
+	// ordered by scheduled time, like the controller does
+	backups := []kubermaticv1.BackupStatus{
+		backup("h", time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseCompleted),
+		backup("g", time.Date(2026, 2, 20, 12, 0, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseCompleted),
+		backup("f", time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseCompleted),
+		backup("e", time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseCompleted),
+		backup("d", time.Date(2026, 3, 15, 23, 0, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseCompleted),
+		backup("c", time.Date(2026, 3, 16, 10, 0, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseCompleted),
+		backup("b", time.Date(2026, 3, 16, 10, 30, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseCompleted),
+		backup("a", time.Date(2026, 3, 16, 11, 0, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseCompleted),
+		backup("failed", time.Date(2026, 3, 16, 11, 30, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseFailed),
+		backup("running", time.Date(2026, 3, 16, 12, 0, 0, 0, time.UTC), kubermaticv1.BackupStatusPhaseRunning),
+	}
+
+	tiers := &kubermaticv1.EtcdBackupRetentionPolicy{
+		Hourly:  ptr.To(2),
+		Daily:   ptr.To(2),
+		Weekly:  ptr.To(2),
+		Monthly: ptr.To(3),
+	}
+
+	testCases := []struct {
+		name      string
+		keep      *int
+		retention *kubermaticv1.EtcdBackupRetentionPolicy
+		deleting  bool
+		expected  sets.Set[string]
+	}{
+		{
+			name:     "simple count keeps the latest completed backups",
+			keep:     ptr.To(3),
+			expected: sets.New("a", "b", "c"),
+		},
+		{
+			name:      "tiered retention keeps the latest backup of each period",
+			keep:      ptr.To(3),
+			retention: tiers,
+			expected:  sets.New("a", "b", "d", "g", "h"),
+		},
+		{
+			name: "max age removes old backups from the tiers",
+			retention: func() *kubermaticv1.EtcdBackupRetentionPolicy {
+				p := tiers.DeepCopy()
+				p.MaxAge = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+				return p
+			}(),
+			expected: sets.New("a", "b", "d", "g"),
+		},
+		{
+			name: "latest backup is kept regardless of max age",
+			keep: ptr.To(3),
+			retention: &kubermaticv1.EtcdBackupRetentionPolicy{
+				MaxAge: &metav1.Duration{Duration: time.Minute},
+			},
+			expected: sets.New("a"),
+		},
+		{
+			name:      "nothing is retained when the config is being deleted",
+			retention: tiers,
+			deleting:  true,
+			expected:  sets.New[string](),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backupConfig := &kubermaticv1.EtcdBackupConfig{
+				Spec: kubermaticv1.EtcdBackupConfigSpec{
+					Keep:      tc.keep,
+					Retention: tc.retention,
+				},
+				Status: kubermaticv1.EtcdBackupConfigStatus{
+					CurrentBackups: backups,
+				},
+			}
+
+			if tc.deleting {
+				backupConfig.DeletionTimestamp = &metav1.Time{Time: now}
+			}
+
+			retained := sets.New[string]()
+			for i := range retainedBackups(backupConfig, now) {
+				retained.Insert(backups[i].BackupName)
+			}
+
+			if !retained.Equal(tc.expected) {
+				t.Fatalf("Expected backups %v to be retained, got %v.", sets.List(tc.expected), sets.List(retained))
+			}
+		})
+	}
+}
+
+func TestRetainedBackupsAreLimited(t *testing.T) {
+	now := time.Date(2026, 3, 16, 12, 0, 0, 0, time.UTC)
+
+	// one backup per day for the last 200 days, ordered by scheduled time
+	var backups []kubermaticv1.BackupStatus
+	for i := 199; i >= 0; i-- {
+		backups = append(backups, kubermaticv1.BackupStatus{
+			BackupName:    fmt.Sprintf("backup-%d", i),
+			ScheduledTime: metav1.NewTime(now.Add(-time.Duration(i) * 24 * time.Hour)),
+			BackupPhase:   kubermaticv1.BackupStatusPhaseCompleted,
+		})
+	}
+
+	backupConfig := &kubermaticv1.EtcdBackupConfig{
+		Spec: kubermaticv1.EtcdBackupConfigSpec{
+			Retention: &kubermaticv1.EtcdBackupRetentionPolicy{
+				Hourly:  ptr.To(50),
+				Daily:   ptr.To(50),
+				Weekly:  ptr.To(50),
+				Monthly: ptr.To(50),
+			},
+		},
+		Status: kubermaticv1.EtcdBackupConfigStatus{
+			CurrentBackups: backups,
+		},
+	}
+
+	if count := backupConfig.GetKeptBackupsCount(); count != kubermaticv1.MaxKeptBackupsCount {
+		t.Fatalf("Expected kept backups count to be limited to %d, got %d.", kubermaticv1.MaxKeptBackupsCount, count)
+	}
+
+	retained := retainedBackups(backupConfig, now)
+	if retained.Len() != kubermaticv1.MaxKeptBackupsCount {
+		t.Fatalf("Expected %d backups to be retained, got %d.", kubermaticv1.MaxKeptBackupsCount, retained.Len())
+	}
+
+	// the hourly tier takes precedence, so the latest backups must be retained
+	if !retained.Has(len(backups) - 1) {
+		t.Fatal("Expected the latest backup to be retained.")
+	}
+}
@@ -31,9 +31,12 @@ import (
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
+	"k8c.io/kubermatic/v2/pkg/resources/registry"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -59,6 +62,9 @@ const (
 	// being restored into the cluster, if any. This is also used for mutual exclusion, i.e. to make sure that not
 	// more than one EtcdRestore resource is active for the cluster at the same time.
 	ActiveRestoreAnnotationName = "kubermatic.k8c.io/active-restore"
+
+	// assumedJobRuntime is the time after which the status of a started verification job is checked.
+	assumedJobRuntime = 30 * time.Second
 )
 
 // Reconciler stores necessary components that are required to restore etcd backups.
@@ -70,6 +76,9 @@ type Reconciler struct {
 	recorder   events.EventRecorder
 	versions   kubermatic.Versions
 	seedGetter provider.SeedGetter
+
+	etcdLauncherImage string
+	overwriteRegistry string
 }
 
 // Add creates a new etcd restore controller that is responsible for
@@ -81,6 +90,8 @@ func Add(
 	workerName string,
 	versions kubermatic.Versions,
 	seedGetter provider.SeedGetter,
+	etcdLauncherImage string,
+	overwriteRegistry string,
 ) error {
 	log = log.Named(ControllerName)
 	client := mgr.GetClient()
@@ -93,6 +104,9 @@ func Add(
 		recorder:   mgr.GetEventRecorder(ControllerName),
 		versions:   versions,
 		seedGetter: seedGetter,
+
+		etcdLauncherImage: etcdLauncherImage,
+		overwriteRegistry: overwriteRegistry,
 	}
 
 	incompleteRestorePredicates := predicate.Funcs{
@@ -176,10 +190,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, rest
 		return nil, fmt.Errorf("etcdLauncher not enabled on cluster: %q", cluster.Name)
 	}
 
-	if restore.Status.Phase == kubermaticv1.EtcdRestorePhaseCompleted {
+	if restore.Status.Phase == kubermaticv1.EtcdRestorePhaseCompleted || restore.Status.Phase == kubermaticv1.EtcdRestorePhaseBackupVerificationFailed {
 		return nil, nil
 	}
 
+	if restore.Spec.BackupName == "" {
+		if err := r.selectBackupFromConfig(ctx, restore); err != nil {
+			return nil, err
+		}
+	}
+
 	log.Infof("performing etcd restore from backup %v", restore.Spec.BackupName)
 
 	if restore.DeletionTimestamp == nil {
@@ -214,6 +234,15 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, rest
 		return nil, fmt.Errorf("could not access backup object %s: %w", objectName, err)
 	}
 
+	// verify the backup before the cluster is paused and etcd is torn down, so that a
+	// corrupt backup never takes the cluster offline
+	if restore.Spec.VerifyBackup && restore.Status.Phase == "" && restore.Status.VerifiedTime.IsZero() {
+		result, err := r.reconcileBackupVerification(ctx, log, restore, cluster)
+		if err != nil || result != nil {
+			return result, err
+		}
+	}
+
 	// before proceeding, ensure restore's namespace/name is stored in the ActiveRestoreAnnotationName cluster annotation
 	// unless some other restore is already stored there
 	thisRestore := fmt.Sprintf("%s/%s", restore.Namespace, restore.Name)
@@ -357,6 +386,212 @@ func (r *Reconciler) rebuildEtcdStatefulset(ctx context.Context, log *zap.Sugare
 	return nil, nil
 }
 
+// selectBackupFromConfig picks the latest completed backup of the EtcdBackupConfig referenced by the restore
+// and records it in the restore's spec, so that the selection is stable for the remainder of the restore.
+func (r *Reconciler) selectBackupFromConfig(ctx context.Context, restore *kubermaticv1.EtcdRestore) error {
+	if restore.Spec.BackupConfigName == "" {
+		return errors.New("neither backupName nor backupConfigName are set")
+	}
+
+	backupConfig := &kubermaticv1.EtcdBackupConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: restore.Namespace, Name: restore.Spec.BackupConfigName}, backupConfig); err != nil {
+		return fmt.Errorf("failed to get EtcdBackupConfig %s: %w", restore.Spec.BackupConfigName, err)
+	}
+
+	backup := latestCompletedBackup(backupConfig, restore.Spec.RestoreBefore)
+	if backup == nil {
+		if restore.Spec.RestoreBefore != nil {
+			return fmt.Errorf("EtcdBackupConfig %s has no completed backup before %s", backupConfig.Name, restore.Spec.RestoreBefore.UTC().Format(time.RFC3339))
+		}
+		return fmt.Errorf("EtcdBackupConfig %s has no completed backup", backupConfig.Name)
+	}
+
+	oldRestore := restore.DeepCopy()
+	restore.Spec.BackupName = backup.BackupName
+	if restore.Spec.Destination == "" {
+		restore.Spec.Destination = backupConfig.Spec.Destination
+	}
+
+	if err := r.Patch(ctx, restore, ctrlruntimeclient.MergeFrom(oldRestore)); err != nil {
+		return fmt.Errorf("failed to record selected backup: %w", err)
+	}
+
+	return nil
+}
+
+// latestCompletedBackup returns the most recently finished backup that completed successfully and is
+// not being deleted. If before is set, only backups that finished before that time are considered.
+func latestCompletedBackup(backupConfig *kubermaticv1.EtcdBackupConfig, before *metav1.Time) *kubermaticv1.BackupStatus {
+	var latest *kubermaticv1.BackupStatus
+
+	for i, backup := range backupConfig.Status.CurrentBackups {
+		if backup.BackupPhase != kubermaticv1.BackupStatusPhaseCompleted || backup.DeletePhase != "" {
+			continue
+		}
+
+		if before != nil && !backup.BackupFinishedTime.Before(before) {
+			continue
+		}
+
+		if latest == nil || latest.BackupFinishedTime.Before(&backup.BackupFinishedTime) {
+			latest = &backupConfig.Status.CurrentBackups[i]
+		}
+	}
+
+	return latest
+}
+
+// reconcileBackupVerification runs a job that downloads the restore's backup and verifies that
+// it can be restored. A non-nil result is returned while the job is still running. Once the job
+// has succeeded, the restore's VerifiedTime is set and nil is returned.
+func (r *Reconciler) reconcileBackupVerification(ctx context.Context, log *zap.SugaredLogger, restore *kubermaticv1.EtcdRestore, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	jobName := etcdbackup.RestoreVerificationJobName(cluster, restore)
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: jobName}, job)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get verification job %s: %w", jobName, err)
+		}
+
+		credentials, err := r.ensureVerificationCredentials(ctx, restore, cluster, jobName)
+		if err != nil {
+			return nil, err
+		}
+
+		image := registry.Must(registry.RewriteImage(fmt.Sprintf("%s:%s", r.etcdLauncherImage, r.versions.KubermaticContainerTag), r.overwriteRegistry))
+		if err := r.Create(ctx, etcdbackup.RestoreVerificationJob(cluster, restore, image, credentials)); err != nil && !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create verification job %s: %w", jobName, err)
+		}
+
+		log.Infow("Started backup verification job", "job", jobName)
+
+		return &reconcile.Result{RequeueAfter: assumedJobRuntime}, nil
+	}
+
+	if getJobConditionIfTrue(job, batchv1.JobComplete) != nil {
+		if err := r.cleanupBackupVerification(ctx, jobName); err != nil {
+			return nil, err
+		}
+
+		if err := r.updateRestore(ctx, restore, func(restore *kubermaticv1.EtcdRestore) {
+			restore.Status.VerifiedTime = metav1.Now()
+		}); err != nil {
+			return nil, fmt.Errorf("failed to set EtcdRestore verified time: %w", err)
+		}
+
+		log.Infow("Verified backup", "backup", restore.Spec.BackupName)
+
+		return nil, nil
+	}
+
+	if cond := getJobConditionIfTrue(job, batchv1.JobFailed); cond != nil {
+		if err := r.cleanupBackupVerification(ctx, jobName); err != nil {
+			return nil, err
+		}
+
+		if err := r.updateRestore(ctx, restore, func(restore *kubermaticv1.EtcdRestore) {
+			restore.Status.Phase = kubermaticv1.EtcdRestorePhaseBackupVerificationFailed
+		}); err != nil {
+			return nil, fmt.Errorf("failed to set EtcdRestore verification failed phase: %w", err)
+		}
+
+		if err := kuberneteshelper.TryRemoveFinalizer(ctx, r, restore, FinishRestoreFinalizer); err != nil {
+			return nil, fmt.Errorf("failed to remove finalizer: %w", err)
+		}
+
+		return nil, fmt.Errorf("backup %s failed verification, refusing to restore: %s", restore.Spec.BackupName, cond.Message)
+	}
+
+	return &reconcile.Result{RequeueAfter: assumedJobRuntime}, nil
+}
+
+// ensureVerificationCredentials copies the restore's backup download credentials into the
+// namespace of the verification job, as the job cannot reference secrets in the cluster namespace.
+func (r *Reconciler) ensureVerificationCredentials(ctx context.Context, restore *kubermaticv1.EtcdRestore, cluster *kubermaticv1.Cluster, name string) (*corev1.Secret, error) {
+	source := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: restore.Spec.BackupDownloadCredentialsSecret}, source); err != nil {
+		return nil, fmt.Errorf("failed to get BackupDownloadCredentialsSecret credentials secret %v: %w", restore.Spec.BackupDownloadCredentialsSecret, err)
+	}
+
+	data := map[string][]byte{}
+	for _, key := range []string{
+		resources.EtcdBackupAndRestoreS3AccessKeyIDKey,
+		resources.EtcdBackupAndRestoreS3SecretKeyAccessKeyKey,
+		resources.EtcdRestoreS3BucketNameKey,
+		resources.EtcdRestoreS3EndpointKey,
+		resources.EtcdRestoreEncryptionKeyKey,
+	} {
+		data[key] = source.Data[key]
+	}
+
+	if len(data[resources.EtcdRestoreS3EndpointKey]) == 0 {
+		data[resources.EtcdRestoreS3EndpointKey] = []byte(resources.EtcdRestoreDefaultS3SEndpoint)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+			OwnerReferences: []metav1.OwnerReference{
+				resources.GetClusterRef(cluster),
+			},
+		},
+		Data: data,
+	}
+
+	if err := r.Create(ctx, secret); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create verification credentials secret %s: %w", name, err)
+		}
+
+		existing := &corev1.Secret{}
+		if err := r.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(secret), existing); err != nil {
+			return nil, fmt.Errorf("failed to get verification credentials secret %s: %w", name, err)
+		}
+
+		oldExisting := existing.DeepCopy()
+		existing.Data = data
+		if err := r.Patch(ctx, existing, ctrlruntimeclient.MergeFrom(oldExisting)); err != nil {
+			return nil, fmt.Errorf("failed to update verification credentials secret %s: %w", name, err)
+		}
+
+		secret = existing
+	}
+
+	return secret, nil
+}
+
+// cleanupBackupVerification removes the verification job and its credentials secret.
+func (r *Reconciler) cleanupBackupVerification(ctx context.Context, name string) error {
+	job := &batchv1.Job{}
+	job.Name = name
+	job.Namespace = metav1.NamespaceSystem
+
+	if err := r.Delete(ctx, job, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground)); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete verification job %s: %w", name, err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = name
+	secret.Namespace = metav1.NamespaceSystem
+
+	if err := r.Delete(ctx, secret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete verification credentials secret %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func getJobConditionIfTrue(job *batchv1.Job, condType batchv1.JobConditionType) *batchv1.JobCondition {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == condType && cond.Status == corev1.ConditionTrue {
+			return cond.DeepCopy()
+		}
+	}
+	return nil
+}
+
 func (r *Reconciler) updateCluster(ctx context.Context, cluster *kubermaticv1.Cluster, modify func(*kubermaticv1.Cluster)) error {
 	oldCluster := cluster.DeepCopy()
 	modify(cluster)
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdrestore
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	etcdbackup "k8c.io/kubermatic/v2/pkg/resources/etcd/backup"
+	kubermaticfake "k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestLatestCompletedBackup(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(hoursAgo int) metav1.Time {
+		return metav1.NewTime(now.Add(-time.Duration(hoursAgo) * time.Hour))
+	}
+
+	backupConfig := &kubermaticv1.EtcdBackupConfig{
+		Status: kubermaticv1.EtcdBackupConfigStatus{
+			CurrentBackups: []kubermaticv1.BackupStatus{
+				{BackupName: "deleted", BackupPhase: kubermaticv1.BackupStatusPhaseCompleted, BackupFinishedTime: at(6), DeletePhase: kubermaticv1.BackupStatusPhaseRunning},
+				{BackupName: "old", BackupPhase: kubermaticv1.BackupStatusPhaseCompleted, BackupFinishedTime: at(5)},
+				{BackupName: "middle", BackupPhase: kubermaticv1.BackupStatusPhaseCompleted, BackupFinishedTime: at(3)},
+				{BackupName: "failed", BackupPhase: kubermaticv1.BackupStatusPhaseFailed, BackupFinishedTime: at(2)},
+				{BackupName: "latest", BackupPhase: kubermaticv1.BackupStatusPhaseCompleted, BackupFinishedTime: at(1)},
+				{BackupName: "running", BackupPhase: kubermaticv1.BackupStatusPhaseRunning},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		before   *metav1.Time
+		expected string
+	}{
+		{
+			name:     "no point in time selects the latest completed backup",
+			expected: "latest",
+		},
+		{
+			name:     "failed backups are skipped",
+			before:   ptr.To(at(1)),
+			expected: "middle",
+		},
+		{
+			name:     "point in time between backups",
+			before:   ptr.To(at(4)),
+			expected: "old",
+		},
+		{
+			name:     "backups being deleted are skipped",
+			before:   ptr.To(at(5)),
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backup := latestCompletedBackup(backupConfig, tc.before)
+
+			name := ""
+			if backup != nil {
+				name = backup.BackupName
+			}
+
+			if name != tc.expected {
+				t.Fatalf("Expected backup %q, got %q.", tc.expected, name)
+			}
+		})
+	}
+}
+
+func TestReconcileBackupVerification(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "testcluster"},
+		Status:     kubermaticv1.ClusterStatus{NamespaceName: "cluster-testcluster"},
+	}
+
+	newRestore := func() *kubermaticv1.EtcdRestore {
+		return &kubermaticv1.EtcdRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "restore",
+				Namespace:  cluster.Status.NamespaceName,
+				Finalizers: []string{FinishRestoreFinalizer},
+			},
+			Spec: kubermaticv1.EtcdRestoreSpec{
+				Cluster:                         corev1.ObjectReference{Name: cluster.Name},
+				BackupName:                      "backup-1",
+				BackupDownloadCredentialsSecret: "restore-backupdownload",
+				VerifyBackup:                    true,
+			},
+		}
+	}
+
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "restore-backupdownload",
+			Namespace: cluster.Status.NamespaceName,
+		},
+		Data: map[string][]byte{
+			resources.EtcdBackupAndRestoreS3AccessKeyIDKey:        []byte("key"),
+			resources.EtcdBackupAndRestoreS3SecretKeyAccessKeyKey: []byte("secret"),
+			resources.EtcdRestoreS3BucketNameKey:                  []byte("bucket"),
+			resources.EtcdRestoreEncryptionKeyKey:                 []byte("0123456789abcdef0123456789abcdef"),
+		},
+	}
+
+	jobName := etcdbackup.RestoreVerificationJobName(cluster, newRestore())
+	finishedJob := func(condType batchv1.JobConditionType) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: metav1.NamespaceSystem},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: condType, Status: corev1.ConditionTrue, Message: "snapshot is corrupt"}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		job           *batchv1.Job
+		expectRequeue bool
+		expectErr     bool
+		validate      func(t *testing.T, client ctrlruntimeclient.Client, restore *kubermaticv1.EtcdRestore)
+	}{
+		{
+			name:          "job is created with a copy of the credentials",
+			expectRequeue: true,
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, restore *kubermaticv1.EtcdRestore) {
+				job := &batchv1.Job{}
+				if err := client.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: jobName}, job); err != nil {
+					t.Fatalf("Failed to get verification job: %v", err)
+				}
+
+				command := strings.Join(job.Spec.Template.Spec.Containers[0].Command, " ")
+				for _, arg := range []string{"verify-snapshot", "--cluster=testcluster", "--backup-name=backup-1", "--encryption-key-file="} {
+					if !strings.Contains(command, arg) {
+						t.Errorf("Expected job command to contain %q, got %q.", arg, command)
+					}
+				}
+
+				secret := &corev1.Secret{}
+				if err := client.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: jobName}, secret); err != nil {
+					t.Fatalf("Failed to get verification credentials: %v", err)
+				}
+
+				if endpoint := string(secret.Data[resources.EtcdRestoreS3EndpointKey]); endpoint != resources.EtcdRestoreDefaultS3SEndpoint {
+					t.Errorf("Expected default endpoint to be set, got %q.", endpoint)
+				}
+
+				if !restore.Status.VerifiedTime.IsZero() {
+					t.Error("Expected restore not to be verified yet.")
+				}
+			},
+		},
+		{
+			name:          "running job is waited for",
+			job:           &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: metav1.NamespaceSystem}},
+			expectRequeue: true,
+		},
+		{
+			name: "completed job marks the backup as verified",
+			job:  finishedJob(batchv1.JobComplete),
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, restore *kubermaticv1.EtcdRestore) {
+				if restore.Status.VerifiedTime.IsZero() {
+					t.Error("Expected restore to be verified.")
+				}
+
+				err := client.Get(context.Background(), types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: jobName}, &batchv1.Job{})
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("Expected verification job to be deleted, got %v.", err)
+				}
+			},
+		},
+		{
+			name:      "failed job fails the restore",
+			job:       finishedJob(batchv1.JobFailed),
+			expectErr: true,
+			validate: func(t *testing.T, client ctrlruntimeclient.Client, restore *kubermaticv1.EtcdRestore) {
+				if restore.Status.Phase != kubermaticv1.EtcdRestorePhaseBackupVerificationFailed {
+					t.Errorf("Expected phase %q, got %q.", kubermaticv1.EtcdRestorePhaseBackupVerificationFailed, restore.Status.Phase)
+				}
+
+				if len(restore.Finalizers) > 0 {
+					t.Errorf("Expected finalizer to be removed, got %v.", restore.Finalizers)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			restore := newRestore()
+
+			objects := []ctrlruntimeclient.Object{restore, credentials.DeepCopy()}
+			if tc.job != nil {
+				objects = append(objects, tc.job)
+			}
+
+			client := kubermaticfake.NewClientBuilder().WithObjects(objects...).Build()
+
+			r := &Reconciler{
+				Client:            client,
+				versions:          kubermatic.GetFakeVersions(),
+				etcdLauncherImage: "quay.io/kubermatic/etcd-launcher",
+			}
+
+			result, err := r.reconcileBackupVerification(context.Background(), zap.NewNop().Sugar(), restore, cluster)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("Expected error = %v, got %v.", tc.expectErr, err)
+			}
+
+			if (result != nil) != tc.expectRequeue {
+				t.Fatalf("Expected requeue = %v, got %v.", tc.expectRequeue, result)
+			}
+
+			if tc.validate != nil {
+				tc.validate(t, client, restore)
+			}
+		})
+	}
+}
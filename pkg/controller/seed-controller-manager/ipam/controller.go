@@ -19,6 +19,7 @@ package ipam
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"go.uber.org/zap"
 
@@ -109,8 +110,11 @@ func Add(
 		For(&kubermaticv1.Cluster{}).
 		Watches(&kubermaticv1.IPAMPool{}, enqueueClustersForIPAMPool).
 		Build(reconciler)
+	if err != nil {
+		return err
+	}
 
-	return err
+	return addPoolStatusController(mgr, log)
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -208,20 +212,24 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 }
 
 func (r *Reconciler) compileCurrentAllocationsForPoolInDatacenter(ctx context.Context, ipamPoolName, dc string, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings) (sets.Set[string], error) {
+	// IPv4 and IPv6 addresses/subnets cannot collide, so a single usage map is used for both families
 	dcIPAMPoolUsageMap := sets.New[string]()
+
 	// Check for exclusions in the configuration to mark them as "not free"
-	switch dcIPAMPoolCfg.Type {
-	case kubermaticv1.IPAMPoolAllocationTypeRange:
-		ipsToExclude, err := getIPsFromAddressRanges(dcIPAMPoolCfg.ExcludeRanges)
-		if err != nil {
-			return nil, err
-		}
-		for _, ipToExclude := range ipsToExclude {
-			dcIPAMPoolUsageMap.Insert(ipToExclude)
-		}
-	case kubermaticv1.IPAMPoolAllocationTypePrefix:
-		for _, subnetCIDRToExclude := range dcIPAMPoolCfg.ExcludePrefixes {
-			dcIPAMPoolUsageMap.Insert(string(subnetCIDRToExclude))
+	for _, family := range poolFamilies(dcIPAMPoolCfg) {
+		switch dcIPAMPoolCfg.Type {
+		case kubermaticv1.IPAMPoolAllocationTypeRange:
+			ipsToExclude, err := getIPsFromAddressRanges(family.ExcludeRanges)
+			if err != nil {
+				return nil, err
+			}
+			for _, ipToExclude := range ipsToExclude {
+				dcIPAMPoolUsageMap.Insert(ipToExclude)
+			}
+		case kubermaticv1.IPAMPoolAllocationTypePrefix:
+			for _, subnetCIDRToExclude := range family.ExcludePrefixes {
+				dcIPAMPoolUsageMap.Insert(string(subnetCIDRToExclude))
+			}
 		}
 	}
 
@@ -240,33 +248,73 @@ func (r *Reconciler) compileCurrentAllocationsForPoolInDatacenter(ctx context.Co
 			continue
 		}
 
-		switch ipamAllocation.Spec.Type {
-		case kubermaticv1.IPAMPoolAllocationTypeRange:
-			currentAllocatedIPs, err := getIPsFromAddressRanges(ipamAllocation.Spec.Addresses)
-			if err != nil {
-				return nil, err
-			}
-			// check if the current allocation is compatible with the IPAMPool being applied
-			err = checkRangeAllocation(currentAllocatedIPs, string(dcIPAMPoolCfg.PoolCIDR), dcIPAMPoolCfg.AllocationRange)
-			if err != nil {
-				return nil, err
-			}
-			for _, ip := range currentAllocatedIPs {
-				dcIPAMPoolUsageMap.Insert(ip)
+		err := markAllocationInUse(dcIPAMPoolUsageMap, ipamAllocation.Spec.Type, primaryAllocation(ipamAllocation.Spec), dcIPAMPoolCfg.PrimaryFamily())
+		if err != nil {
+			return nil, err
+		}
+
+		if ipamAllocation.Spec.IPv6 != nil {
+			if dcIPAMPoolCfg.IPv6 == nil {
+				return nil, errIncompatiblePool
 			}
-		case kubermaticv1.IPAMPoolAllocationTypePrefix:
-			// check if the current allocation is compatible with the IPAMPool being applied
-			err := checkPrefixAllocation(string(ipamAllocation.Spec.CIDR), string(dcIPAMPoolCfg.PoolCIDR), dcIPAMPoolCfg.ExcludePrefixes, dcIPAMPoolCfg.AllocationPrefix)
+
+			err := markAllocationInUse(dcIPAMPoolUsageMap, ipamAllocation.Spec.Type, *ipamAllocation.Spec.IPv6, *dcIPAMPoolCfg.IPv6)
 			if err != nil {
 				return nil, err
 			}
-			dcIPAMPoolUsageMap.Insert(string(ipamAllocation.Spec.CIDR))
 		}
 	}
 
 	return dcIPAMPoolUsageMap, nil
 }
 
+// markAllocationInUse checks that the given allocation of a single IP family is compatible with the
+// pool configuration of that family and marks its IPs (or subnet) as used.
+func markAllocationInUse(dcIPAMPoolUsageMap sets.Set[string], allocationType kubermaticv1.IPAMPoolAllocationType, allocation kubermaticv1.IPAMAllocationFamily, poolCfg kubermaticv1.IPAMPoolFamilySettings) error {
+	switch allocationType {
+	case kubermaticv1.IPAMPoolAllocationTypeRange:
+		currentAllocatedIPs, err := getIPsFromAddressRanges(allocation.Addresses)
+		if err != nil {
+			return err
+		}
+		// check if the current allocation is compatible with the IPAMPool being applied
+		err = checkRangeAllocation(currentAllocatedIPs, string(poolCfg.PoolCIDR), poolCfg.AllocationRange)
+		if err != nil {
+			return err
+		}
+		for _, ip := range currentAllocatedIPs {
+			dcIPAMPoolUsageMap.Insert(ip)
+		}
+	case kubermaticv1.IPAMPoolAllocationTypePrefix:
+		// check if the current allocation is compatible with the IPAMPool being applied
+		err := checkPrefixAllocation(string(allocation.CIDR), string(poolCfg.PoolCIDR), poolCfg.ExcludePrefixes, poolCfg.AllocationPrefix)
+		if err != nil {
+			return err
+		}
+		dcIPAMPoolUsageMap.Insert(string(allocation.CIDR))
+	}
+
+	return nil
+}
+
+// poolFamilies returns the settings of all IP families configured for a datacenter.
+func poolFamilies(dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings) []kubermaticv1.IPAMPoolFamilySettings {
+	families := []kubermaticv1.IPAMPoolFamilySettings{dcIPAMPoolCfg.PrimaryFamily()}
+	if dcIPAMPoolCfg.IPv6 != nil {
+		families = append(families, *dcIPAMPoolCfg.IPv6)
+	}
+
+	return families
+}
+
+// primaryAllocation returns the part of an allocation that belongs to the primary IP family.
+func primaryAllocation(spec kubermaticv1.IPAMAllocationSpec) kubermaticv1.IPAMAllocationFamily {
+	return kubermaticv1.IPAMAllocationFamily{
+		CIDR:      spec.CIDR,
+		Addresses: spec.Addresses,
+	}
+}
+
 func (r *Reconciler) ensureIPAMAllocation(ctx context.Context, cluster *kubermaticv1.Cluster, ipamPool *kubermaticv1.IPAMPool, dcIPAMPoolCfg kubermaticv1.IPAMPoolDatacenterSettings, dcIPAMPoolUsageMap sets.Set[string], ipamAllocation *kubermaticv1.IPAMAllocation) error {
 	creators := []reconciling.NamedIPAMAllocationReconcilerFactory{
 		IPAMAllocationReconciler(ipamAllocation, cluster, ipamPool, dcIPAMPoolCfg, dcIPAMPoolUsageMap),
@@ -291,29 +339,65 @@ func IPAMAllocationReconciler(ipamAllocation *kubermaticv1.IPAMAllocation, clust
 			ipamAllocation.Spec.Type = dcIPAMPoolCfg.Type
 			ipamAllocation.Spec.DC = cluster.Spec.Cloud.DatacenterName
 
-			switch dcIPAMPoolCfg.Type {
-			case kubermaticv1.IPAMPoolAllocationTypeRange:
-				ipsAllocated, err := getIPsFromAddressRanges(ipamAllocation.Spec.Addresses)
-				if err != nil {
-					return nil, err
-				}
-
-				newIPRangeToAllocate := dcIPAMPoolCfg.AllocationRange - len(ipsAllocated)
+			// allocate all families before changing the allocation, so that either all of them or none are updated
+			primary, err := allocateFromPool(ipamPool.Name, dcIPAMPoolCfg.Type, dcIPAMPoolCfg.PrimaryFamily(), primaryAllocation(ipamAllocation.Spec), dcIPAMPoolUsageMap)
+			if err != nil {
+				return nil, err
+			}
 
-				addresses, err := findFirstFreeRangesOfPool(ipamPool.Name, string(dcIPAMPoolCfg.PoolCIDR), newIPRangeToAllocate, dcIPAMPoolUsageMap)
-				if err != nil {
-					return nil, err
+			var ipv6 *kubermaticv1.IPAMAllocationFamily
+			if dcIPAMPoolCfg.IPv6 != nil && cluster.IsDualStack() {
+				current := kubermaticv1.IPAMAllocationFamily{}
+				if ipamAllocation.Spec.IPv6 != nil {
+					current = *ipamAllocation.Spec.IPv6
 				}
-				ipamAllocation.Spec.Addresses = append(ipamAllocation.Spec.Addresses, addresses...)
-			case kubermaticv1.IPAMPoolAllocationTypePrefix:
-				subnetCIDR, err := findFirstFreeSubnetOfPool(ipamPool.Name, string(dcIPAMPoolCfg.PoolCIDR), string(ipamAllocation.Spec.CIDR), dcIPAMPoolCfg.AllocationPrefix, dcIPAMPoolUsageMap)
+
+				allocated, err := allocateFromPool(ipamPool.Name, dcIPAMPoolCfg.Type, *dcIPAMPoolCfg.IPv6, current, dcIPAMPoolUsageMap)
 				if err != nil {
-					return nil, err
+					return nil, fmt.Errorf("failed to allocate from IPv6 pool: %w", err)
 				}
-				ipamAllocation.Spec.CIDR = kubermaticv1.SubnetCIDR(subnetCIDR)
+				ipv6 = &allocated
 			}
 
+			ipamAllocation.Spec.CIDR = primary.CIDR
+			ipamAllocation.Spec.Addresses = primary.Addresses
+			ipamAllocation.Spec.IPv6 = ipv6
+
 			return ipamAllocation, nil
 		}
 	}
 }
+
+// allocateFromPool ensures that the given allocation of a single IP family satisfies the pool
+// configuration of that family and returns the (possibly extended) allocation.
+func allocateFromPool(poolName string, allocationType kubermaticv1.IPAMPoolAllocationType, poolCfg kubermaticv1.IPAMPoolFamilySettings, current kubermaticv1.IPAMAllocationFamily, dcIPAMPoolUsageMap sets.Set[string]) (kubermaticv1.IPAMAllocationFamily, error) {
+	switch allocationType {
+	case kubermaticv1.IPAMPoolAllocationTypeRange:
+		ipsAllocated, err := getIPsFromAddressRanges(current.Addresses)
+		if err != nil {
+			return current, err
+		}
+
+		newIPRangeToAllocate := poolCfg.AllocationRange - len(ipsAllocated)
+
+		addresses, err := findFirstFreeRangesOfPool(poolName, string(poolCfg.PoolCIDR), newIPRangeToAllocate, dcIPAMPoolUsageMap)
+		if err != nil {
+			return current, err
+		}
+
+		return kubermaticv1.IPAMAllocationFamily{
+			Addresses: append(slices.Clone(current.Addresses), addresses...),
+		}, nil
+	case kubermaticv1.IPAMPoolAllocationTypePrefix:
+		subnetCIDR, err := findFirstFreeSubnetOfPool(poolName, string(poolCfg.PoolCIDR), string(current.CIDR), poolCfg.AllocationPrefix, dcIPAMPoolUsageMap)
+		if err != nil {
+			return current, err
+		}
+
+		return kubermaticv1.IPAMAllocationFamily{
+			CIDR: kubermaticv1.SubnetCIDR(subnetCIDR),
+		}, nil
+	}
+
+	return current, nil
+}
@@ -45,6 +45,13 @@ func generateTestCluster(clusterName, dc string) *kubermaticv1.Cluster {
 	}
 }
 
+func generateTestDualStackCluster(clusterName, dc string) *kubermaticv1.Cluster {
+	cluster := generateTestCluster(clusterName, dc)
+	cluster.Spec.ClusterNetwork.Pods.CIDRBlocks = []string{"172.25.0.0/16", "fd01::/48"}
+
+	return cluster
+}
+
 func TestReconcileCluster(t *testing.T) {
 	testCases := []struct {
 		name                       string
@@ -1047,6 +1054,181 @@ func TestReconcileCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "range: dual-stack allocation",
+			cluster: generateTestDualStackCluster("test-cluster-2", "test-dc-1"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:            "range",
+								PoolCIDR:        "192.168.1.0/28",
+								AllocationRange: 4,
+								IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+									PoolCIDR:        "2001:db8::/120",
+									AllocationRange: 8,
+									ExcludeRanges:   []string{"2001:db8::-2001:db8::1"},
+								},
+							},
+						},
+					},
+				},
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool-1",
+						Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-1"),
+						ResourceVersion: "1",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type:      kubermaticv1.IPAMPoolAllocationTypeRange,
+						DC:        "test-dc-1",
+						Addresses: []string{"192.168.1.0-192.168.1.3"},
+						IPv6: &kubermaticv1.IPAMAllocationFamily{
+							Addresses: []string{"2001:db8::2-2001:db8::9"},
+						},
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "test-pool-1",
+							Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-2"),
+							ResourceVersion: "1",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+						},
+						Spec: kubermaticv1.IPAMAllocationSpec{
+							Type:      kubermaticv1.IPAMPoolAllocationTypeRange,
+							DC:        "test-dc-1",
+							Addresses: []string{"192.168.1.4-192.168.1.7"},
+							IPv6: &kubermaticv1.IPAMAllocationFamily{
+								Addresses: []string{"2001:db8::a-2001:db8::11"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prefix: dual-stack allocation",
+			cluster: generateTestDualStackCluster("test-cluster-1", "test-dc-1"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:             "prefix",
+								PoolCIDR:         "192.168.1.0/27",
+								AllocationPrefix: 28,
+								IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+									PoolCIDR:         "2001:db8::/56",
+									AllocationPrefix: 64,
+									ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"2001:db8::/64"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "test-pool-1",
+							Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-1"),
+							ResourceVersion: "1",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+						},
+						Spec: kubermaticv1.IPAMAllocationSpec{
+							Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+							DC:   "test-dc-1",
+							CIDR: "192.168.1.0/28",
+							IPv6: &kubermaticv1.IPAMAllocationFamily{
+								CIDR: "2001:db8:0:1::/64",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prefix: single-stack cluster gets no IPv6 allocation",
+			cluster: generateTestCluster("test-cluster-1", "test-dc-1"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:             "prefix",
+								PoolCIDR:         "192.168.1.0/27",
+								AllocationPrefix: 28,
+								IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+									PoolCIDR:         "2001:db8::/56",
+									AllocationPrefix: 64,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "test-pool-1",
+							Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-1"),
+							ResourceVersion: "1",
+							OwnerReferences: []metav1.OwnerReference{{APIVersion: "kubermatic.k8c.io/v1", Kind: "IPAMPool", Name: "test-pool-1"}},
+						},
+						Spec: kubermaticv1.IPAMAllocationSpec{
+							Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+							DC:   "test-dc-1",
+							CIDR: "192.168.1.0/28",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "range: dual-stack allocation is not created if the IPv6 pool is exhausted",
+			cluster: generateTestDualStackCluster("test-cluster-1", "test-dc-1"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-pool-1",
+					},
+					Spec: kubermaticv1.IPAMPoolSpec{
+						Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+							"test-dc-1": {
+								Type:            "range",
+								PoolCIDR:        "192.168.1.0/28",
+								AllocationRange: 4,
+								IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+									PoolCIDR:        "2001:db8::/126",
+									AllocationRange: 8,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedClusterAllocations: &kubermaticv1.IPAMAllocationList{
+				Items: []kubermaticv1.IPAMAllocation{},
+			},
+			expectedError: errors.New("failed to ensure IPAM Pool Allocation for IPAM Pool test-pool-1 in cluster test-cluster-1: failed to ensure IPAMAllocation cluster-test-cluster-1/test-pool-1: failed to generate object: failed to allocate from IPv6 pool: there is no enough free IPs available for IPAM pool \"test-pool-1\""),
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
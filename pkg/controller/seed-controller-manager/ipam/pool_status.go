@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/util"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	PoolStatusControllerName = "kkp-ipam-pool-status-controller"
+)
+
+// PoolStatusReconciler keeps the utilization in the status of IPAMPools up to date.
+type PoolStatusReconciler struct {
+	ctrlruntimeclient.Client
+
+	log *zap.SugaredLogger
+}
+
+func addPoolStatusController(mgr manager.Manager, log *zap.SugaredLogger) error {
+	reconciler := &PoolStatusReconciler{
+		Client: mgr.GetClient(),
+		log:    log.Named(PoolStatusControllerName),
+	}
+
+	// allocations are named after the pool they are allocated from
+	enqueuePoolForAllocation := handler.EnqueueRequestsFromMapFunc(func(_ context.Context, a ctrlruntimeclient.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: a.GetName()}}}
+	})
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(PoolStatusControllerName).
+		// status updates must not trigger another reconciliation
+		For(&kubermaticv1.IPAMPool{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&kubermaticv1.IPAMAllocation{}, enqueuePoolForAllocation).
+		Build(reconciler)
+
+	return err
+}
+
+func (r *PoolStatusReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("pool", request.Name)
+	log.Debug("Processing")
+
+	pool := &kubermaticv1.IPAMPool{}
+	if err := r.Get(ctx, request.NamespacedName, pool); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if pool.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	ipamAllocationList := &kubermaticv1.IPAMAllocationList{}
+	if err := r.List(ctx, ipamAllocationList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to list IPAM allocations: %w", err)
+	}
+
+	clusterList := &kubermaticv1.ClusterList{}
+	if err := r.List(ctx, clusterList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	clusterNames := map[string]string{}
+	for _, cluster := range clusterList.Items {
+		clusterNames[cluster.Status.NamespaceName] = cluster.Name
+	}
+
+	datacenters, err := datacenterStatuses(pool, ipamAllocationList.Items, clusterNames)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	oldPool := pool.DeepCopy()
+	pool.Status.Datacenters = datacenters
+
+	var exhausted []string
+	for dc, status := range datacenters {
+		if status.Exhausted {
+			exhausted = append(exhausted, dc)
+		}
+	}
+	slices.Sort(exhausted)
+
+	if len(exhausted) > 0 {
+		util.SetIPAMPoolCondition(pool, kubermaticv1.IPAMPoolConditionPoolExhausted, corev1.ConditionTrue, "PoolExhausted", fmt.Sprintf("No further allocations possible in datacenters: %s", strings.Join(exhausted, ", ")))
+	} else {
+		util.SetIPAMPoolCondition(pool, kubermaticv1.IPAMPoolConditionPoolExhausted, corev1.ConditionFalse, "PoolAvailable", "")
+	}
+
+	if apiequality.Semantic.DeepEqual(oldPool.Status, pool.Status) {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.Status().Patch(ctx, pool, ctrlruntimeclient.MergeFrom(oldPool)); err != nil && !apierrors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// datacenterStatuses computes the utilization of the given pool in all of its datacenters.
+// clusterNames maps cluster namespaces to cluster names.
+func datacenterStatuses(pool *kubermaticv1.IPAMPool, allocations []kubermaticv1.IPAMAllocation, clusterNames map[string]string) (map[string]kubermaticv1.IPAMPoolDatacenterStatus, error) {
+	datacenters := map[string]kubermaticv1.IPAMPoolDatacenterStatus{}
+
+	for dc, dcIPAMPoolCfg := range pool.Spec.Datacenters {
+		var (
+			primary  []kubermaticv1.IPAMAllocationFamily
+			ipv6     []kubermaticv1.IPAMAllocationFamily
+			clusters []string
+		)
+
+		for _, ipamAllocation := range allocations {
+			if ipamAllocation.Name != pool.Name || ipamAllocation.Spec.DC != dc {
+				continue
+			}
+
+			primary = append(primary, primaryAllocation(ipamAllocation.Spec))
+			if ipamAllocation.Spec.IPv6 != nil {
+				ipv6 = append(ipv6, *ipamAllocation.Spec.IPv6)
+			}
+
+			clusterName, ok := clusterNames[ipamAllocation.Namespace]
+			if !ok {
+				clusterName = ipamAllocation.Namespace
+			}
+			clusters = append(clusters, clusterName)
+		}
+
+		slices.Sort(clusters)
+
+		utilization, exhausted, err := familyUtilization(dcIPAMPoolCfg.Type, dcIPAMPoolCfg.PrimaryFamily(), primary)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute utilization for datacenter %q: %w", dc, err)
+		}
+
+		status := kubermaticv1.IPAMPoolDatacenterStatus{
+			IPAMPoolUtilization: utilization,
+			Clusters:            clusters,
+			Exhausted:           exhausted,
+		}
+
+		if dcIPAMPoolCfg.IPv6 != nil {
+			utilization, exhausted, err := familyUtilization(dcIPAMPoolCfg.Type, *dcIPAMPoolCfg.IPv6, ipv6)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compute IPv6 utilization for datacenter %q: %w", dc, err)
+			}
+
+			status.IPv6 = &utilization
+			status.Exhausted = status.Exhausted || exhausted
+		}
+
+		datacenters[dc] = status
+	}
+
+	return datacenters, nil
+}
+
+// familyUtilization returns the utilization of the pool of a single IP family and whether it
+// is exhausted, i.e. it cannot satisfy another allocation.
+func familyUtilization(allocationType kubermaticv1.IPAMPoolAllocationType, poolCfg kubermaticv1.IPAMPoolFamilySettings, allocations []kubermaticv1.IPAMAllocationFamily) (kubermaticv1.IPAMPoolUtilization, bool, error) {
+	utilization := kubermaticv1.IPAMPoolUtilization{}
+
+	_, poolSubnet, err := net.ParseCIDR(string(poolCfg.PoolCIDR))
+	if err != nil {
+		return utilization, false, err
+	}
+	poolPrefix, bits := poolSubnet.Mask.Size()
+
+	// IPs or subnets that are either excluded or allocated
+	used := sets.New[string]()
+
+	switch allocationType {
+	case kubermaticv1.IPAMPoolAllocationTypeRange:
+		excludedIPs, err := getIPsFromAddressRanges(poolCfg.ExcludeRanges)
+		if err != nil {
+			return utilization, false, err
+		}
+		for _, ip := range excludedIPs {
+			if poolSubnet.Contains(net.ParseIP(ip)) {
+				used.Insert(ip)
+			}
+		}
+
+		for _, allocation := range allocations {
+			allocatedIPs, err := getIPsFromAddressRanges(allocation.Addresses)
+			if err != nil {
+				return utilization, false, err
+			}
+			for _, ip := range allocatedIPs {
+				if poolSubnet.Contains(net.ParseIP(ip)) && !used.Has(ip) {
+					used.Insert(ip)
+					utilization.Allocated++
+				}
+			}
+		}
+
+		utilization.Free = poolCapacity(bits-poolPrefix) - int64(used.Len())
+
+		return utilization, utilization.Free < int64(poolCfg.AllocationRange), nil
+
+	case kubermaticv1.IPAMPoolAllocationTypePrefix:
+		for _, excludedPrefix := range poolCfg.ExcludePrefixes {
+			excludedIP, _, err := net.ParseCIDR(string(excludedPrefix))
+			if err != nil {
+				return utilization, false, err
+			}
+			if poolSubnet.Contains(excludedIP) {
+				used.Insert(string(excludedPrefix))
+			}
+		}
+
+		for _, allocation := range allocations {
+			if allocation.CIDR != "" && !used.Has(string(allocation.CIDR)) {
+				used.Insert(string(allocation.CIDR))
+				utilization.Allocated++
+			}
+		}
+
+		utilization.Free = max(poolCapacity(poolCfg.AllocationPrefix-poolPrefix)-int64(used.Len()), 0)
+
+		return utilization, utilization.Free == 0, nil
+	}
+
+	return utilization, false, nil
+}
+
+// poolCapacity returns 2^hostBits, capped at the largest representable number.
+func poolCapacity(hostBits int) int64 {
+	if hostBits >= 63 {
+		return math.MaxInt64
+	}
+
+	return int64(1) << max(hostBits, 0)
+}
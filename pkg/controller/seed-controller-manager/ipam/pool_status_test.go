@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcilePoolStatus(t *testing.T) {
+	testCases := []struct {
+		name                string
+		pool                *kubermaticv1.IPAMPool
+		objects             []ctrlruntimeclient.Object
+		expectedDatacenters map[string]kubermaticv1.IPAMPoolDatacenterStatus
+		expectedExhausted   corev1.ConditionStatus
+	}{
+		{
+			name: "pool without allocations",
+			pool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool-1",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"test-dc-1": {
+							Type:            "range",
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 8,
+							ExcludeRanges:   []string{"192.168.1.0-192.168.1.1"},
+						},
+					},
+				},
+			},
+			expectedDatacenters: map[string]kubermaticv1.IPAMPoolDatacenterStatus{
+				"test-dc-1": {
+					IPAMPoolUtilization: kubermaticv1.IPAMPoolUtilization{Allocated: 0, Free: 14},
+				},
+			},
+			expectedExhausted: corev1.ConditionFalse,
+		},
+		{
+			name: "range: exhausted datacenter",
+			pool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool-1",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"test-dc-1": {
+							Type:            "range",
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 8,
+							ExcludeRanges:   []string{"192.168.1.15"},
+						},
+						"test-dc-2": {
+							Type:            "range",
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 8,
+						},
+					},
+				},
+			},
+			objects: []ctrlruntimeclient.Object{
+				generateTestCluster("test-cluster-1", "test-dc-1"),
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pool-1",
+						Namespace: "cluster-test-cluster-1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type:      kubermaticv1.IPAMPoolAllocationTypeRange,
+						DC:        "test-dc-1",
+						Addresses: []string{"192.168.1.0-192.168.1.7"},
+					},
+				},
+			},
+			expectedDatacenters: map[string]kubermaticv1.IPAMPoolDatacenterStatus{
+				"test-dc-1": {
+					IPAMPoolUtilization: kubermaticv1.IPAMPoolUtilization{Allocated: 8, Free: 7},
+					Clusters:            []string{"test-cluster-1"},
+					Exhausted:           true,
+				},
+				"test-dc-2": {
+					IPAMPoolUtilization: kubermaticv1.IPAMPoolUtilization{Allocated: 0, Free: 16},
+				},
+			},
+			expectedExhausted: corev1.ConditionTrue,
+		},
+		{
+			name: "prefix: dual-stack pool with exhausted IPv6 pool",
+			pool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool-1",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"test-dc-1": {
+							Type:             "prefix",
+							PoolCIDR:         "192.168.1.0/27",
+							AllocationPrefix: 28,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:         "2001:db8::/63",
+								AllocationPrefix: 64,
+								ExcludePrefixes:  []kubermaticv1.SubnetCIDR{"2001:db8::/64"},
+							},
+						},
+					},
+				},
+			},
+			objects: []ctrlruntimeclient.Object{
+				generateTestCluster("test-cluster-1", "test-dc-1"),
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pool-1",
+						Namespace: "cluster-test-cluster-1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "test-dc-1",
+						CIDR: "192.168.1.0/28",
+						IPv6: &kubermaticv1.IPAMAllocationFamily{
+							CIDR: "2001:db8:0:1::/64",
+						},
+					},
+				},
+				// allocations of other pools are ignored
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pool-2",
+						Namespace: "cluster-test-cluster-1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "test-dc-1",
+						CIDR: "192.168.1.16/28",
+					},
+				},
+			},
+			expectedDatacenters: map[string]kubermaticv1.IPAMPoolDatacenterStatus{
+				"test-dc-1": {
+					IPAMPoolUtilization: kubermaticv1.IPAMPoolUtilization{Allocated: 1, Free: 1},
+					IPv6:                &kubermaticv1.IPAMPoolUtilization{Allocated: 1, Free: 0},
+					Clusters:            []string{"test-cluster-1"},
+					Exhausted:           true,
+				},
+			},
+			expectedExhausted: corev1.ConditionTrue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			reconciler := &PoolStatusReconciler{
+				Client: fake.
+					NewClientBuilder().
+					WithObjects(append(tc.objects, tc.pool)...).
+					Build(),
+				log: zap.NewNop().Sugar(),
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.pool.Name}}
+			if _, err := reconciler.Reconcile(ctx, request); err != nil {
+				t.Fatalf("Failed to reconcile: %v", err)
+			}
+
+			pool := &kubermaticv1.IPAMPool{}
+			if err := reconciler.Get(ctx, request.NamespacedName, pool); err != nil {
+				t.Fatalf("Failed to get pool: %v", err)
+			}
+
+			assert.Equal(t, tc.expectedDatacenters, pool.Status.Datacenters)
+			assert.Equal(t, tc.expectedExhausted, pool.Status.Conditions[kubermaticv1.IPAMPoolConditionPoolExhausted].Status)
+		})
+	}
+}
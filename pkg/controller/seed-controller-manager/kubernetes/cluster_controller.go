@@ -288,6 +288,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// no need to log the error, controller-runtime does it for us
 	if err != nil {
 		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	} else {
+		controllerutil.RequeueForMaintenance(result, cluster, kubermaticv1.MaintenanceOperationEtcdRollout)
 	}
 
 	return *result, err
@@ -407,9 +407,10 @@ func (r *Reconciler) ensureNamespaceExists(ctx context.Context, log *zap.Sugared
 func GetServiceReconcilers(data *resources.TemplateData) []reconciling.NamedServiceReconcilerFactory {
 	extName := data.Cluster().Status.Address.ExternalName
 	apiServerServiceType := data.DC().Spec.APIServerServiceType
+	allowedIPRanges := apiserver.AllowedIPRanges(data)
 
 	creators := []reconciling.NamedServiceReconcilerFactory{
-		apiserver.ServiceReconciler(data.Cluster().Spec.ExposeStrategy, extName, apiServerServiceType),
+		apiserver.ServiceReconciler(data.Cluster().Spec.ExposeStrategy, extName, apiServerServiceType, data.Cluster().IsAPIServerProxyProtocolEnabled(), allowedIPRanges),
 		etcd.ServiceReconciler(data),
 		userclusterwebhook.ServiceReconciler(),
 		operatingsystemmanager.ServiceReconciler(),
@@ -420,7 +421,7 @@ func GetServiceReconcilers(data *resources.TemplateData) []reconciling.NamedServ
 	}
 
 	if data.IsKonnectivityEnabled() {
-		creators = append(creators, konnectivity.ServiceReconciler(data.Cluster().Spec.ExposeStrategy, extName))
+		creators = append(creators, konnectivity.ServiceReconciler(data.Cluster().Spec.ExposeStrategy, extName, data.Cluster().IsAPIServerProxyProtocolEnabled(), allowedIPRanges))
 	} else {
 		creators = append(creators,
 			openvpn.ServiceReconciler(data.Cluster().Spec.ExposeStrategy),
@@ -812,6 +813,25 @@ func (r *Reconciler) ensureNetworkPolicies(ctx context.Context, c *kubermaticv1.
 		}
 	}
 
+	// independent of the ApiserverNetworkPolicy feature, as the allowed IP ranges rely on the client
+	// addresses passed using the PROXY protocol
+	if c.IsAPIServerProxyProtocolEnabled() {
+		factories := []reconciling.NamedNetworkPolicyReconcilerFactory{
+			apiserver.ProxyProtocolAllowReconciler(data, cfg.Namespace),
+		}
+
+		if err := reconciling.ReconcileNetworkPolicies(ctx, factories, c.Status.NamespaceName, r); err != nil {
+			return fmt.Errorf("failed to ensure PROXY protocol Network Policy: %w", err)
+		}
+	} else if err := r.Delete(ctx, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.NetworkPolicyProxyProtocolAllow,
+			Namespace: c.Status.NamespaceName,
+		},
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to ensure PROXY protocol Network Policy is removed/not present: %w", err)
+	}
+
 	return nil
 }
 
@@ -888,6 +908,10 @@ func GetConfigMapReconcilers(data *resources.TemplateData) []reconciling.NamedCo
 		creators = append(creators, csi.ConfigMapsReconcilers(data)...)
 	}
 
+	if data.Cluster().IsAPIServerProxyProtocolEnabled() {
+		creators = append(creators, apiserver.ProxyProtocolConfigMapReconciler(data))
+	}
+
 	if data.IsKonnectivityEnabled() {
 		creators = append(creators, apiserver.EgressSelectorConfigReconciler())
 	} else {
@@ -1022,7 +1046,29 @@ func (r *Reconciler) ensureStatefulSets(ctx context.Context, c *kubermaticv1.Clu
 		modifier.RevisionHistoryLimit(2),
 	}
 
-	return reconciling.ReconcileStatefulSets(ctx, creators, c.Status.NamespaceName, r, modifiers...)
+	// Outside of the maintenance window, changes that would cause a rolling
+	// restart of etcd are held back until the window opens.
+	open, nextWindow, err := util.MaintenanceWindowOpen(c, time.Now())
+	if err != nil {
+		return err
+	}
+
+	held := false
+	if !open {
+		modifiers = append(modifiers, modifier.HoldPodTemplate(&held))
+	}
+
+	if err := reconciling.ReconcileStatefulSets(ctx, creators, c.Status.NamespaceName, r, modifiers...); err != nil {
+		return err
+	}
+
+	return util.UpdateClusterStatus(ctx, r, c, func(c *kubermaticv1.Cluster) {
+		if held {
+			util.SetPendingMaintenance(c, kubermaticv1.MaintenanceOperationEtcdRollout, "Rollout of etcd is waiting for the maintenance window.", nextWindow)
+		} else {
+			util.ClearPendingMaintenance(c, kubermaticv1.MaintenanceOperationEtcdRollout)
+		}
+	})
 }
 
 func (r *Reconciler) ensureEtcdBackupConfigs(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData,
@@ -19,20 +19,28 @@ package presetcontroller
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	"k8c.io/kubermatic/v2/pkg/provider/secretstore"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -47,6 +55,8 @@ type reconciler struct {
 	workerName              string
 	recorder                events.EventRecorder
 	seedClient              ctrlruntimeclient.Client
+	secretStores            *secretstore.Resolver
+	validateCredentials     CredentialsValidator
 }
 
 func Add(
@@ -54,6 +64,8 @@ func Add(
 	log *zap.SugaredLogger,
 	workerName string,
 	numWorkers int,
+	secretStores *secretstore.Resolver,
+	validateCredentials CredentialsValidator,
 ) error {
 	workerSelector, err := workerlabel.LabelSelector(workerName)
 	if err != nil {
@@ -66,6 +78,8 @@ func Add(
 		workerName:              workerName,
 		recorder:                mgr.GetEventRecorder(ControllerName),
 		seedClient:              mgr.GetClient(),
+		secretStores:            secretStores,
+		validateCredentials:     validateCredentials,
 	}
 
 	_, err = builder.ControllerManagedBy(mgr).
@@ -74,11 +88,21 @@ func Add(
 			MaxConcurrentReconciles: numWorkers,
 		}).
 		For(&kubermaticv1.Preset{}).
+		// keep the list of clusters in the preset status up-to-date
+		Watches(
+			&kubermaticv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(enqueuePresetForCluster),
+			builder.WithPredicates(predicateutil.ByAnnotation(kubermaticv1.PresetNameAnnotation, "", false)),
+		).
 		Build(reconciler)
 
 	return err
 }
 
+func enqueuePresetForCluster(_ context.Context, cluster ctrlruntimeclient.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: cluster.GetAnnotations()[kubermaticv1.PresetNameAnnotation]}}}
+}
+
 // Reconcile reconciles the kubermatic cluster template instance in the seed cluster.
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := r.log.With("request", request)
@@ -93,44 +117,190 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to get preset %s: %w", request.NamespacedName, err)
 	}
 
-	err := r.reconcile(ctx, preset, log)
+	result, err := r.reconcile(ctx, preset, log)
 	if err != nil {
 		r.recorder.Eventf(preset, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
 	}
 
-	return reconcile.Result{}, err
+	return result, err
 }
 
-func (r *reconciler) reconcile(ctx context.Context, preset *kubermaticv1.Preset, log *zap.SugaredLogger) error {
+func (r *reconciler) reconcile(ctx context.Context, preset *kubermaticv1.Preset, log *zap.SugaredLogger) (reconcile.Result, error) {
 	// handle deletion to change all cluster annotation
 	if !preset.DeletionTimestamp.IsZero() {
 		log.Debug("The preset was deleted")
-		workerNameLabelSelectorRequirements, _ := r.workerNameLabelSelector.Requirements()
-		presetLabelRequirement, err := labels.NewRequirement(kubermaticv1.IsCredentialPresetLabelKey, selection.Equals, []string{"true"})
-		if err != nil {
-			return fmt.Errorf("failed to construct label requirement for credential preset: %w", err)
-		}
 
-		listOpts := &ctrlruntimeclient.ListOptions{
-			LabelSelector: labels.NewSelector().Add(append(workerNameLabelSelectorRequirements, *presetLabelRequirement)...),
+		if r.secretStores != nil {
+			r.secretStores.Invalidate(preset.Name)
 		}
 
-		clusters := &kubermaticv1.ClusterList{}
-		if err := r.seedClient.List(ctx, clusters, listOpts); err != nil {
-			return fmt.Errorf("failed to get clusters %w", err)
+		clusters, err := r.presetClusters(ctx, preset)
+		if err != nil {
+			return reconcile.Result{}, err
 		}
+
 		log.Debug("Update clusters after preset deletion")
-		for _, cluster := range clusters.Items {
-			if cluster.Annotations != nil && cluster.Annotations[kubermaticv1.PresetNameAnnotation] == preset.Name {
-				log.Debugw("Update cluster", "cluster", cluster.Name)
-				copyCluster := cluster.DeepCopy()
-				copyCluster.Annotations[kubermaticv1.PresetInvalidatedAnnotation] = string(kubermaticv1.PresetDeleted)
-				if err := r.seedClient.Update(ctx, copyCluster); err != nil {
-					return err
-				}
+		for _, cluster := range clusters {
+			log.Debugw("Update cluster", "cluster", cluster.Name)
+			copyCluster := cluster.DeepCopy()
+			copyCluster.Annotations[kubermaticv1.PresetInvalidatedAnnotation] = string(kubermaticv1.PresetDeleted)
+			if err := r.seedClient.Update(ctx, copyCluster); err != nil {
+				return reconcile.Result{}, err
 			}
 		}
+
+		return reconcile.Result{}, nil
+	}
+
+	clusters, err := r.presetClusters(ctx, preset)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	now := time.Now()
+	if preset.Spec.IsExpired(now) {
+		if err := r.flagExpiredClusters(ctx, preset, clusters, log); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	result, rotations, err := r.reconcileRotations(ctx, preset, clusters, log)
+	if err != nil {
+		return result, err
+	}
+
+	// flag the clusters as soon as the preset expires
+	if preset.Spec.ValidUntil != nil && !preset.Spec.IsExpired(now) {
+		untilExpiry := preset.Spec.ValidUntil.Sub(now)
+		if result.RequeueAfter == 0 || result.RequeueAfter > untilExpiry {
+			result.RequeueAfter = untilExpiry
+		}
+	}
+
+	status := kubermaticv1.PresetStatus{
+		ClustersOnOtherSeeds: preset.Status.ClustersOnOtherSeeds,
+		Rotations:            rotations,
+	}
+
+	for _, cluster := range clusters {
+		if cluster.DeletionTimestamp == nil {
+			status.Clusters = append(status.Clusters, cluster.Name)
+		}
+	}
+
+	slices.Sort(status.Clusters)
+
+	if apiequality.Semantic.DeepEqual(preset.Status, status) {
+		return result, nil
+	}
+
+	oldPreset := preset.DeepCopy()
+	preset.Status = status
+
+	if err := r.seedClient.Status().Patch(ctx, preset, ctrlruntimeclient.MergeFrom(oldPreset)); err != nil {
+		return result, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return result, nil
+}
+
+// flagExpiredClusters marks all clusters created from the expired preset with the
+// preset invalidation annotation.
+func (r *reconciler) flagExpiredClusters(ctx context.Context, preset *kubermaticv1.Preset, clusters []kubermaticv1.Cluster, log *zap.SugaredLogger) error {
+	for _, cluster := range clusters {
+		if cluster.DeletionTimestamp != nil || cluster.Annotations[kubermaticv1.PresetInvalidatedAnnotation] != "" {
+			continue
+		}
+
+		log.Debugw("Flagging cluster of expired preset", "cluster", cluster.Name)
+
+		oldCluster := cluster.DeepCopy()
+		cluster.Annotations[kubermaticv1.PresetInvalidatedAnnotation] = string(kubermaticv1.PresetExpired)
+		if err := r.seedClient.Patch(ctx, &cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return fmt.Errorf("failed to flag cluster %s: %w", cluster.Name, err)
+		}
 	}
 
 	return nil
 }
+
+// reconcileRotations propagates the current credentials of the preset to all clusters
+// created from it and returns the state of the rotation for each of them.
+// Clusters whose credentials have not yet been moved into a Secret are left to the
+// cluster-credentials-controller.
+func (r *reconciler) reconcileRotations(ctx context.Context, preset *kubermaticv1.Preset, clusters []kubermaticv1.Cluster, log *zap.SugaredLogger) (reconcile.Result, map[string]kubermaticv1.PresetCredentialRotation, error) {
+	result := reconcile.Result{}
+
+	var storeData map[string][]byte
+	if preset.Spec.SecretStore != nil {
+		if r.secretStores == nil {
+			return result, preset.Status.Rotations, nil
+		}
+
+		data, err := r.secretStores.Resolve(ctx, preset)
+		if err != nil {
+			return result, nil, fmt.Errorf("failed to resolve credentials: %w", err)
+		}
+
+		storeData = data
+
+		// poll the secret store to pick up rotated credentials
+		result.RequeueAfter = secretstore.RefreshInterval(preset.Spec.SecretStore)
+	}
+
+	rotations := map[string]kubermaticv1.PresetCredentialRotation{}
+	for _, cluster := range clusters {
+		if cluster.DeletionTimestamp != nil || cluster.Status.NamespaceName == "" {
+			continue
+		}
+
+		ref, err := resources.GetCredentialsReference(&cluster)
+		if err != nil || ref == nil {
+			continue
+		}
+
+		rotation, err := r.rotateCredentials(ctx, log.With("cluster", cluster.Name), preset, storeData, &cluster, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name})
+		if err != nil {
+			return result, nil, err
+		}
+
+		if rotation.Phase == kubermaticv1.PresetCredentialRotationPending && (result.RequeueAfter == 0 || result.RequeueAfter > pendingRotationInterval) {
+			result.RequeueAfter = pendingRotationInterval
+		}
+
+		rotations[cluster.Name] = rotation
+	}
+
+	if len(rotations) == 0 {
+		rotations = nil
+	}
+
+	return result, rotations, nil
+}
+
+// presetClusters returns all clusters of this worker that were created from the given preset.
+func (r *reconciler) presetClusters(ctx context.Context, preset *kubermaticv1.Preset) ([]kubermaticv1.Cluster, error) {
+	workerNameLabelSelectorRequirements, _ := r.workerNameLabelSelector.Requirements()
+	presetLabelRequirement, err := labels.NewRequirement(kubermaticv1.IsCredentialPresetLabelKey, selection.Equals, []string{"true"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct label requirement for credential preset: %w", err)
+	}
+
+	listOpts := &ctrlruntimeclient.ListOptions{
+		LabelSelector: labels.NewSelector().Add(append(workerNameLabelSelectorRequirements, *presetLabelRequirement)...),
+	}
+
+	clusters := &kubermaticv1.ClusterList{}
+	if err := r.seedClient.List(ctx, clusters, listOpts); err != nil {
+		return nil, fmt.Errorf("failed to get clusters %w", err)
+	}
+
+	var result []kubermaticv1.Cluster
+	for _, cluster := range clusters.Items {
+		if cluster.Annotations != nil && cluster.Annotations[kubermaticv1.PresetNameAnnotation] == preset.Name {
+			result = append(result, cluster)
+		}
+	}
+
+	return result, nil
+}
@@ -18,17 +18,26 @@ package presetcontroller
 
 import (
 	"context"
+	"errors"
+	"maps"
+	"slices"
 	"testing"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/provider/secretstore"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/test/fake"
 	"k8c.io/kubermatic/v2/pkg/test/generator"
 	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
+	"k8c.io/machine-controller/sdk/providerconfig"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -152,3 +161,321 @@ func getPreset(deletionTimestamp *metav1.Time) *kubermaticv1.Preset {
 
 	return preset
 }
+
+func TestReconcileRotation(t *testing.T) {
+	workerSelector, err := workerlabel.LabelSelector("")
+	if err != nil {
+		t.Fatalf("failed to build worker-name selector: %v", err)
+	}
+
+	cluster := genCluster("ct2-0", "bob@acme.com", generator.TestFakeCredential)
+	cluster.Labels[kubermaticv1.ProjectIDLabelKey] = "my-project"
+	cluster.Status.NamespaceName = "cluster-ct2-0"
+	cluster.Spec.Cloud.Fake = nil
+	cluster.Spec.Cloud.Digitalocean = &kubermaticv1.DigitaloceanCloudSpec{}
+	cluster.Spec.Cloud.Digitalocean.CredentialsReference = &providerconfig.GlobalSecretKeySelector{
+		ObjectReference: corev1.ObjectReference{Name: cluster.GetSecretName(), Namespace: resources.KubermaticNamespace},
+	}
+
+	inlinePreset := func(token string) *kubermaticv1.Preset {
+		preset := getPreset(nil)
+		preset.Spec.Digitalocean = &kubermaticv1.Digitalocean{Token: token}
+		return preset
+	}
+
+	storePreset := getPreset(nil)
+	storePreset.Spec.SecretStore = &kubermaticv1.PresetSecretStore{
+		Kubernetes: &kubermaticv1.KubernetesSecretStore{
+			SecretReference: corev1.SecretReference{Name: "preset-credentials"},
+		},
+	}
+
+	tokenData := func(token string) map[string][]byte {
+		return map[string][]byte{resources.DigitaloceanToken: []byte(token)}
+	}
+
+	secret := func(namespace, name, token string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       tokenData(token),
+		}
+	}
+
+	testCases := []struct {
+		name                string
+		preset              *kubermaticv1.Preset
+		rotations           map[string]kubermaticv1.PresetCredentialRotation
+		objects             []ctrlruntimeclient.Object
+		validationErr       error
+		expectedToken       string
+		expectedHash        string
+		expectedPhase       kubermaticv1.PresetCredentialRotationPhase
+		expectedRequeue     time.Duration
+		expectedValidations int
+	}{
+		{
+			name:   "unchanged credentials are recorded without a validation",
+			preset: inlinePreset("token"),
+			objects: []ctrlruntimeclient.Object{
+				secret(resources.KubermaticNamespace, cluster.GetSecretName(), "token"),
+				secret(cluster.Status.NamespaceName, resources.ClusterCloudCredentialsSecretName, "token"),
+			},
+			expectedToken: "token",
+			expectedPhase: kubermaticv1.PresetCredentialRotationSucceeded,
+		},
+		{
+			name:   "changed credentials are validated and wait for the cluster namespace to be synchronized",
+			preset: inlinePreset("new-token"),
+			rotations: map[string]kubermaticv1.PresetCredentialRotation{
+				"ct2-0": {Phase: kubermaticv1.PresetCredentialRotationSucceeded, CredentialsHash: credentialsHash(tokenData("old-token")), AppliedCredentialsHash: credentialsHash(tokenData("old-token"))},
+			},
+			objects: []ctrlruntimeclient.Object{
+				secret(resources.KubermaticNamespace, cluster.GetSecretName(), "old-token"),
+				secret(cluster.Status.NamespaceName, resources.ClusterCloudCredentialsSecretName, "old-token"),
+			},
+			expectedToken:       "new-token",
+			expectedPhase:       kubermaticv1.PresetCredentialRotationPending,
+			expectedRequeue:     pendingRotationInterval,
+			expectedValidations: 1,
+		},
+		{
+			name:   "synchronized credentials complete the rotation",
+			preset: inlinePreset("new-token"),
+			rotations: map[string]kubermaticv1.PresetCredentialRotation{
+				"ct2-0": {Phase: kubermaticv1.PresetCredentialRotationPending, CredentialsHash: credentialsHash(tokenData("new-token")), AppliedCredentialsHash: credentialsHash(tokenData("new-token"))},
+			},
+			objects: []ctrlruntimeclient.Object{
+				secret(resources.KubermaticNamespace, cluster.GetSecretName(), "new-token"),
+				secret(cluster.Status.NamespaceName, resources.ClusterCloudCredentialsSecretName, "new-token"),
+			},
+			expectedToken: "new-token",
+			expectedPhase: kubermaticv1.PresetCredentialRotationSucceeded,
+		},
+		{
+			name:   "invalid credentials are not pushed",
+			preset: inlinePreset("new-token"),
+			rotations: map[string]kubermaticv1.PresetCredentialRotation{
+				"ct2-0": {Phase: kubermaticv1.PresetCredentialRotationSucceeded, CredentialsHash: credentialsHash(tokenData("old-token")), AppliedCredentialsHash: credentialsHash(tokenData("old-token"))},
+			},
+			objects: []ctrlruntimeclient.Object{
+				secret(resources.KubermaticNamespace, cluster.GetSecretName(), "old-token"),
+				secret(cluster.Status.NamespaceName, resources.ClusterCloudCredentialsSecretName, "old-token"),
+			},
+			validationErr:       errors.New("invalid token"),
+			expectedToken:       "old-token",
+			expectedHash:        credentialsHash(tokenData("new-token")),
+			expectedPhase:       kubermaticv1.PresetCredentialRotationFailed,
+			expectedValidations: 1,
+		},
+		{
+			name:   "credentials differing from the preset are not rotated the first time a cluster is seen",
+			preset: inlinePreset("new-token"),
+			objects: []ctrlruntimeclient.Object{
+				secret(resources.KubermaticNamespace, cluster.GetSecretName(), "custom-token"),
+				secret(cluster.Status.NamespaceName, resources.ClusterCloudCredentialsSecretName, "custom-token"),
+			},
+			expectedToken: "custom-token",
+			expectedHash:  credentialsHash(tokenData("new-token")),
+			expectedPhase: kubermaticv1.PresetCredentialRotationSkipped,
+		},
+		{
+			name:   "credentials changed independently of the preset are not rotated",
+			preset: inlinePreset("new-token"),
+			rotations: map[string]kubermaticv1.PresetCredentialRotation{
+				"ct2-0": {Phase: kubermaticv1.PresetCredentialRotationSucceeded, CredentialsHash: credentialsHash(tokenData("old-token")), AppliedCredentialsHash: credentialsHash(tokenData("old-token"))},
+			},
+			objects: []ctrlruntimeclient.Object{
+				secret(resources.KubermaticNamespace, cluster.GetSecretName(), "custom-token"),
+				secret(cluster.Status.NamespaceName, resources.ClusterCloudCredentialsSecretName, "custom-token"),
+			},
+			expectedToken: "custom-token",
+			expectedHash:  credentialsHash(tokenData("new-token")),
+			expectedPhase: kubermaticv1.PresetCredentialRotationSkipped,
+		},
+		{
+			name:   "credentials are rotated from the secret store",
+			preset: storePreset,
+			rotations: map[string]kubermaticv1.PresetCredentialRotation{
+				"ct2-0": {Phase: kubermaticv1.PresetCredentialRotationSucceeded, CredentialsHash: credentialsHash(tokenData("old-token")), AppliedCredentialsHash: credentialsHash(tokenData("old-token"))},
+			},
+			objects: []ctrlruntimeclient.Object{
+				secret(resources.KubermaticNamespace, "preset-credentials", "rotated-token"),
+				secret(resources.KubermaticNamespace, cluster.GetSecretName(), "old-token"),
+				secret(cluster.Status.NamespaceName, resources.ClusterCloudCredentialsSecretName, "old-token"),
+			},
+			expectedToken:       "rotated-token",
+			expectedPhase:       kubermaticv1.PresetCredentialRotationPending,
+			expectedRequeue:     pendingRotationInterval,
+			expectedValidations: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			preset := tc.preset.DeepCopy()
+			preset.Status.Rotations = tc.rotations
+
+			objects := append([]ctrlruntimeclient.Object{preset, cluster.DeepCopy()}, tc.objects...)
+
+			seedClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+
+			validations := 0
+			r := &reconciler{
+				log:                     kubermaticlog.Logger,
+				workerNameLabelSelector: workerSelector,
+				recorder:                &events.FakeRecorder{},
+				seedClient:              seedClient,
+				secretStores:            secretstore.NewResolver(seedClient, nil),
+				validateCredentials: func(_ context.Context, _ *kubermaticv1.Cluster, credentials map[string][]byte) error {
+					validations++
+
+					stored := &corev1.Secret{}
+					if err := seedClient.Get(context.Background(), types.NamespacedName{Name: cluster.GetSecretName(), Namespace: resources.KubermaticNamespace}, stored); err != nil {
+						t.Fatalf("failed to get credential secret: %v", err)
+					}
+
+					if maps.EqualFunc(stored.Data, credentials, slices.Equal) {
+						t.Error("expected credentials to be validated before they are pushed")
+					}
+
+					return tc.validationErr
+				},
+			}
+
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: preset.Name}})
+			if err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			if result.RequeueAfter != tc.expectedRequeue {
+				t.Errorf("expected requeue after %v, got %v", tc.expectedRequeue, result.RequeueAfter)
+			}
+
+			if validations != tc.expectedValidations {
+				t.Errorf("expected %d validations, got %d", tc.expectedValidations, validations)
+			}
+
+			credentials := &corev1.Secret{}
+			if err := seedClient.Get(ctx, types.NamespacedName{Name: cluster.GetSecretName(), Namespace: resources.KubermaticNamespace}, credentials); err != nil {
+				t.Fatalf("failed to get credential secret: %v", err)
+			}
+
+			if token := string(credentials.Data[resources.DigitaloceanToken]); token != tc.expectedToken {
+				t.Errorf("expected credential secret to contain %q, got %q", tc.expectedToken, token)
+			}
+
+			currentPreset := &kubermaticv1.Preset{}
+			if err := seedClient.Get(ctx, types.NamespacedName{Name: preset.Name}, currentPreset); err != nil {
+				t.Fatalf("failed to get preset: %v", err)
+			}
+
+			rotation := currentPreset.Status.Rotations["ct2-0"]
+			if rotation.Phase != tc.expectedPhase {
+				t.Errorf("expected rotation phase %q, got %q (%s)", tc.expectedPhase, rotation.Phase, rotation.Message)
+			}
+
+			expectedHash := tc.expectedHash
+			if expectedHash == "" {
+				expectedHash = credentialsHash(tokenData(tc.expectedToken))
+			}
+
+			if rotation.CredentialsHash != expectedHash {
+				t.Errorf("expected rotation to record the hash of the current credentials")
+			}
+		})
+	}
+}
+
+func TestReconcileUsage(t *testing.T) {
+	workerSelector, err := workerlabel.LabelSelector("")
+	if err != nil {
+		t.Fatalf("failed to build worker-name selector: %v", err)
+	}
+
+	deletedCluster := genCluster("ct2-3", "bob@acme.com", generator.TestFakeCredential)
+	deletedCluster.DeletionTimestamp = &now
+
+	testCases := []struct {
+		name             string
+		validUntil       time.Time
+		expectedClusters []string
+		expectedFlagged  bool
+		expectRequeue    bool
+	}{
+		{
+			name:             "active preset",
+			validUntil:       time.Now().Add(time.Hour),
+			expectedClusters: []string{"ct2-0", "ct2-2"},
+			expectRequeue:    true,
+		},
+		{
+			name:             "expired preset",
+			validUntil:       time.Now().Add(-time.Hour),
+			expectedClusters: []string{"ct2-0", "ct2-2"},
+			expectedFlagged:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			preset := getPreset(nil)
+			preset.Spec.ValidUntil = &metav1.Time{Time: tc.validUntil}
+			preset.Status.ClustersOnOtherSeeds = 3
+
+			seedClient := fake.
+				NewClientBuilder().
+				WithObjects(
+					preset,
+					genCluster("ct2-0", "bob@acme.com", generator.TestFakeCredential),
+					genCluster("ct2-1", "bob@acme.com", "test"),
+					genCluster("ct2-2", "bob@acme.com", generator.TestFakeCredential),
+					deletedCluster,
+				).
+				Build()
+
+			r := &reconciler{
+				log:                     kubermaticlog.Logger,
+				workerNameLabelSelector: workerSelector,
+				seedClient:              seedClient,
+			}
+
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: preset.Name}})
+			if err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			if requeue := result.RequeueAfter > 0; requeue != tc.expectRequeue {
+				t.Errorf("expected requeue = %v, got %v", tc.expectRequeue, result.RequeueAfter)
+			}
+
+			currentPreset := &kubermaticv1.Preset{}
+			if err := seedClient.Get(ctx, types.NamespacedName{Name: preset.Name}, currentPreset); err != nil {
+				t.Fatalf("failed to get preset: %v", err)
+			}
+
+			if !slices.Equal(currentPreset.Status.Clusters, tc.expectedClusters) {
+				t.Errorf("expected clusters %v, got %v", tc.expectedClusters, currentPreset.Status.Clusters)
+			}
+
+			if currentPreset.Status.ClustersOnOtherSeeds != 3 {
+				t.Errorf("expected the clusters on other seeds to be kept, got %d", currentPreset.Status.ClustersOnOtherSeeds)
+			}
+
+			for _, clusterName := range []string{"ct2-0", "ct2-1", "ct2-2"} {
+				cluster := &kubermaticv1.Cluster{}
+				if err := seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+					t.Fatalf("failed to get cluster: %v", err)
+				}
+
+				expected := tc.expectedFlagged && clusterName != "ct2-1"
+				if flagged := cluster.Annotations[kubermaticv1.PresetInvalidatedAnnotation] == string(kubermaticv1.PresetExpired); flagged != expected {
+					t.Errorf("expected cluster %s flagged = %v, got %v", clusterName, expected, flagged)
+				}
+			}
+		})
+	}
+}
@@ -18,5 +18,13 @@ limitations under the License.
 Package presetcontroller contains a controller that is responsible for managing presets.
 Preset deletion can affect all the clusters which were created with this preset. Setting `presetInvalidated`
 annotation for all those clusters will indicate a need to evaluate the credentials.
+Whenever the credentials of a preset change, the controller rotates them in all clusters created
+from it: the new credentials are validated against the cloud provider before the credential Secrets
+are updated, and the Deployments using them are rolled out via their related revisions once the
+Secrets have been synchronized into the cluster namespaces. The progress is reported per cluster in
+the preset status. For presets with an external secret store, the controller periodically resolves
+the credentials from the store to pick up rotated credentials.
+The controller also tracks the clusters using a preset in its status and flags all of them with the
+`presetInvalidated` annotation once the preset has expired.
 */
 package presetcontroller
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package presetcontroller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// pendingRotationInterval is the interval in which pending rotations are checked.
+	pendingRotationInterval = 10 * time.Second
+)
+
+// CredentialsValidator validates the given cloud credentials of a cluster against its
+// cloud provider, before they are stored in the credential Secret of the cluster.
+type CredentialsValidator func(ctx context.Context, cluster *kubermaticv1.Cluster, credentials map[string][]byte) error
+
+// rotateCredentials pushes the current credentials of the preset into the credential Secret
+// of the cluster, if it still contains the previous credentials of the preset. Changed credentials are validated against the cloud provider first and
+// are only pushed if they are valid. The Deployments using them are rolled out by their
+// related revisions once the credentials have been synchronized into the cluster namespace
+// by the cluster-credentials-controller.
+func (r *reconciler) rotateCredentials(
+	ctx context.Context,
+	log *zap.SugaredLogger,
+	preset *kubermaticv1.Preset,
+	storeData map[string][]byte,
+	cluster *kubermaticv1.Cluster,
+	ref types.NamespacedName,
+) (kubermaticv1.PresetCredentialRotation, error) {
+	current := preset.Status.Rotations[cluster.Name]
+
+	before, err := r.secretData(ctx, ref)
+	if err != nil {
+		return current, err
+	}
+
+	desired, err := r.desiredCredentials(ctx, preset, storeData, cluster, before)
+	if err != nil {
+		return rotationStatus(current, kubermaticv1.PresetCredentialRotationFailed, current.CredentialsHash, current.AppliedCredentialsHash, fmt.Sprintf("Failed to determine credentials: %v", err)), nil
+	}
+
+	hash := credentialsHash(desired)
+
+	if current.CredentialsHash == hash && current.Phase == kubermaticv1.PresetCredentialRotationSucceeded {
+		return current, nil
+	}
+
+	changed := !maps.EqualFunc(before, desired, slices.Equal)
+
+	// the first time a cluster is seen, the current credentials of the preset are
+	// recorded as its baseline, without rotating anything
+	applied := current.AppliedCredentialsHash
+	if applied == "" {
+		applied = hash
+		if !changed {
+			return rotationStatus(current, kubermaticv1.PresetCredentialRotationSucceeded, hash, applied, ""), nil
+		}
+	}
+
+	// credentials that were changed independently of the preset are never overwritten
+	if changed && credentialsHash(before) != applied {
+		return rotationStatus(current, kubermaticv1.PresetCredentialRotationSkipped, hash, applied, "The credentials of the cluster differ from the previous credentials of the preset."), nil
+	}
+
+	if changed {
+		if r.validateCredentials != nil {
+			if err := r.validateCredentials(ctx, cluster, desired); err != nil {
+				r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "CredentialRotationFailed", "Reconciling", "Credentials of preset %s are invalid: %v", preset.Name, err)
+				return rotationStatus(current, kubermaticv1.PresetCredentialRotationFailed, hash, applied, fmt.Sprintf("Credentials are invalid: %v", err)), nil
+			}
+		}
+
+		if err := kubernetesprovider.CreateOrUpdateCredentialSecretForClusterFromData(ctx, r.seedClient, cluster.DeepCopy(), desired); err != nil {
+			return rotationStatus(current, kubermaticv1.PresetCredentialRotationFailed, hash, applied, fmt.Sprintf("Failed to update credentials: %v", err)), nil
+		}
+	}
+
+	mirrored, err := r.secretData(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.ClusterCloudCredentialsSecretName})
+	if err != nil {
+		return current, err
+	}
+
+	if !maps.EqualFunc(mirrored, desired, slices.Equal) {
+		return rotationStatus(current, kubermaticv1.PresetCredentialRotationPending, hash, hash, "Waiting for the credentials to be synchronized into the cluster namespace."), nil
+	}
+
+	log.Infow("Rotated credentials", "cluster", cluster.Name)
+	r.recorder.Eventf(cluster, nil, corev1.EventTypeNormal, "CredentialsRotated", "Reconciling", "Rotated credentials of preset %s", preset.Name)
+
+	return rotationStatus(current, kubermaticv1.PresetCredentialRotationSucceeded, hash, hash, ""), nil
+}
+
+// desiredCredentials returns the current credentials of the preset, as they are stored
+// in the credential Secret of the cluster.
+func (r *reconciler) desiredCredentials(ctx context.Context, preset *kubermaticv1.Preset, storeData map[string][]byte, cluster *kubermaticv1.Cluster, currentData map[string][]byte) (map[string][]byte, error) {
+	if storeData != nil {
+		return storeData, nil
+	}
+
+	cluster = cluster.DeepCopy()
+
+	if err := kubernetesprovider.ApplyPresetCredentials(preset, &cluster.Spec.Cloud); err != nil {
+		return nil, err
+	}
+
+	// presets do not contain the vSphere infra management user, so the current one is kept
+	if vsphere := cluster.Spec.Cloud.VSphere; vsphere != nil {
+		vsphere.InfraManagementUser.Username = string(currentData[resources.VsphereInfraManagementUserUsername])
+		vsphere.InfraManagementUser.Password = string(currentData[resources.VsphereInfraManagementUserPassword])
+	}
+
+	data, err := kubernetesprovider.CredentialSecretDataForCluster(ctx, r.seedClient, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("preset %s does not contain any credentials", preset.Name)
+	}
+
+	return data, nil
+}
+
+// secretData returns the data of the given Secret, or nil if it does not exist.
+func (r *reconciler) secretData(ctx context.Context, key types.NamespacedName) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	if err := r.seedClient.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get Secret %s: %w", key, err)
+	}
+
+	return secret.Data, nil
+}
+
+// credentialsHash returns a stable hash of the given Secret data.
+func credentialsHash(data map[string][]byte) string {
+	hash := sha256.New()
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(data[key])
+		hash.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+func rotationStatus(current kubermaticv1.PresetCredentialRotation, phase kubermaticv1.PresetCredentialRotationPhase, hash, appliedHash string, message string) kubermaticv1.PresetCredentialRotation {
+	rotation := kubermaticv1.PresetCredentialRotation{
+		Phase:                  phase,
+		CredentialsHash:        hash,
+		AppliedCredentialsHash: appliedHash,
+		Message:                message,
+		LastTransitionTime:     current.LastTransitionTime,
+	}
+
+	if current.Phase != phase || current.CredentialsHash != hash || rotation.LastTransitionTime.IsZero() {
+		rotation.LastTransitionTime = metav1.Now()
+	}
+
+	return rotation
+}
@@ -19,6 +19,7 @@ package updatecontroller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -50,6 +51,8 @@ const (
 	ClusterConditionUpToDate    = "UpToDate"
 	ClusterConditionProgressing = "Progressing"
 	ClusterConditionOldNodes    = "OldNodes"
+
+	ClusterConditionMaintenancePending = "MaintenancePending"
 )
 
 type controlPlaneChecker func(context.Context, ctrlruntimeclient.Client, *zap.SugaredLogger, *kubermaticv1.Cluster) (*controlPlaneStatus, error)
@@ -134,6 +137,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if err != nil {
 		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ReconcilingError", "Reconciling", err.Error())
+	} else {
+		controllerutil.RequeueForMaintenance(result, cluster, kubermaticv1.MaintenanceOperationControlPlaneUpdate)
 	}
 
 	return *result, err
@@ -204,6 +209,10 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		// or in need of reconciling, but for this controller there is no further work to be done.
 		log.Debugw("Cluster control plane has reached the spec'ed version.", "spec", spec)
 
+		if err := r.clearPendingMaintenance(ctx, cluster); err != nil {
+			return err
+		}
+
 		return r.setClusterCondition(ctx, cluster, ClusterConditionUpToDate, "No update in progress, cluster has reached its desired version.")
 	}
 
@@ -300,6 +309,30 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		// Distance is at most 1 release, so the control plane is free to be updated at any time.
 	}
 
+	// Updating the apiserver begins a new, disruptive step towards the spec'ed version, so it
+	// must wait for the cluster's maintenance window. Steps that are already in progress (the
+	// scheduler/controller-manager catching up above) are always completed.
+	open, nextWindow, err := controllerutil.MaintenanceWindowOpen(cluster, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if !open {
+		log.Debugw("Cluster control plane is healthy but update has to wait for the maintenance window.", "nextWindow", nextWindow)
+
+		if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+			controllerutil.SetPendingMaintenance(c, kubermaticv1.MaintenanceOperationControlPlaneUpdate, fmt.Sprintf("Update to version %s is waiting for the maintenance window.", spec.String()), nextWindow)
+		}); err != nil {
+			return fmt.Errorf("failed to update pending maintenance: %w", err)
+		}
+
+		return r.setClusterCondition(ctx, cluster, ClusterConditionMaintenancePending, fmt.Sprintf("Update pending, waiting for the maintenance window starting at %s.", nextWindow.Format(time.RFC3339)))
+	}
+
+	if err := r.clearPendingMaintenance(ctx, cluster); err != nil {
+		return err
+	}
+
 	// At this point we know that the entire control plane is healthy, that scheduler/ctrlmgr versions
 	// are equal to the apiserver, but have still not reached the spec'ed version. It's now time to
 	// update the apiserver to the next minor release. The next minor will be the latest patch release
@@ -328,6 +361,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	return nil
 }
 
+func (r *Reconciler) clearPendingMaintenance(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	if err := controllerutil.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		controllerutil.ClearPendingMaintenance(c, kubermaticv1.MaintenanceOperationControlPlaneUpdate)
+	}); err != nil {
+		return fmt.Errorf("failed to update pending maintenance: %w", err)
+	}
+
+	return nil
+}
+
 // setInitialClusterVersions assumes that the cluster was never up and running and sets
 // the desired versions in the status to be equal to the version from the spec. The
 // status about currently running components is left empty and filled in during later
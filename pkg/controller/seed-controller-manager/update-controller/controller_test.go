@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -382,14 +383,22 @@ func TestReconcile(t *testing.T) {
 		},
 	}
 
+	// windows relative to the current time, as the controller does not use a fake clock
+	now := time.Now().UTC()
+	closedWindow := &kubermaticv1.UpdateWindow{Start: now.Add(2 * time.Hour).Format("15:04"), Length: "1h"}
+	openWindow := &kubermaticv1.UpdateWindow{Start: now.Add(-time.Hour).Format("15:04"), Length: "2h"}
+
 	testcases := []struct {
 		name           string
 		specVersion    semver.Semver
 		clusterStatus  kubermaticv1.ClusterVersionsStatus
 		currentStatus  controlPlaneStatus
 		healthy        bool
+		window         *kubermaticv1.UpdateWindow
 		expectedStatus kubermaticv1.ClusterVersionsStatus
 		expectedErr    bool
+		// expectedPending is true if the update is waiting for the maintenance window
+		expectedPending bool
 	}{
 		// ///////////////////////////////////////////////////////
 		// all of the following tests ignore the existence of nodes;
@@ -755,6 +764,80 @@ func TestReconcile(t *testing.T) {
 				Scheduler:         *semver.NewSemverOrDie("1.21.0"),
 			},
 		},
+
+		// //////////////////////////////////////////////////
+		// tests for maintenance windows
+
+		{
+			name:        "update has to wait for the maintenance window",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			window:      closedWindow,
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedPending: true,
+		},
+		{
+			name:        "update proceeds inside the maintenance window",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			window:      openWindow,
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.20.1"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.20.1"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.20.1"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+		},
+		{
+			name:        "update that is already in progress completes outside of the maintenance window",
+			specVersion: *semver.NewSemverOrDie("1.21.0"),
+			healthy:     true,
+			window:      closedWindow,
+			clusterStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.21.0"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.20.1"),
+				Scheduler:         *semver.NewSemverOrDie("1.20.1"),
+			},
+			currentStatus: controlPlaneStatus{
+				apiserver:         semver.NewSemverOrDie("1.21.0"),
+				controllerManager: semver.NewSemverOrDie("1.20.1"),
+				scheduler:         semver.NewSemverOrDie("1.20.1"),
+			},
+			expectedStatus: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane:      *semver.NewSemverOrDie("1.21.0"),
+				Apiserver:         *semver.NewSemverOrDie("1.21.0"),
+				ControllerManager: *semver.NewSemverOrDie("1.21.0"),
+				Scheduler:         *semver.NewSemverOrDie("1.21.0"),
+			},
+		},
 	}
 
 	for _, tt := range testcases {
@@ -768,6 +851,7 @@ func TestReconcile(t *testing.T) {
 					Cloud: kubermaticv1.CloudSpec{
 						ProviderName: string(kubermaticv1.AWSCloudProvider),
 					},
+					MaintenanceWindow: tt.window,
 				},
 				Status: kubermaticv1.ClusterStatus{
 					Versions: tt.clusterStatus,
@@ -837,6 +921,11 @@ func TestReconcile(t *testing.T) {
 				if !tt.expectedStatus.Scheduler.Equal(&newCluster.Status.Versions.Scheduler) {
 					t.Errorf("Expected scheduler to be %v, but is %v.", tt.expectedStatus.Scheduler, newCluster.Status.Versions.Scheduler)
 				}
+
+				_, pending := newCluster.Status.PendingMaintenance[kubermaticv1.MaintenanceOperationControlPlaneUpdate]
+				if pending != tt.expectedPending {
+					t.Errorf("Expected pending maintenance to be %v, but is %v.", tt.expectedPending, pending)
+				}
 			}
 		})
 	}
@@ -29,6 +29,7 @@ import (
 
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	"k8c.io/kubermatic/sdk/v2/apis/equality"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/applications"
 	applicationtemplates "k8c.io/kubermatic/v2/pkg/applications/providers/template"
 	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
@@ -84,11 +85,22 @@ type reconciler struct {
 	appInstaller         applications.ApplicationInstaller
 	seedClusterNamespace string
 	overwriteRegistry    string
+	// updateSchedule restricts automatic upgrades of applications. It is nil if no update window is configured.
+	updateSchedule *util.MaintenanceSchedule
 }
 
-func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.Manager, clusterIsPaused userclustercontrollermanager.IsPausedChecker, seedClusterNamespace, overwriteRegistry string, appInstaller applications.ApplicationInstaller) error {
+func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.Manager, clusterIsPaused userclustercontrollermanager.IsPausedChecker, seedClusterNamespace, overwriteRegistry string, updateWindow kubermaticv1.UpdateWindow, appInstaller applications.ApplicationInstaller) error {
 	log = log.Named(controllerName)
 
+	var updateSchedule *util.MaintenanceSchedule
+	if updateWindow.Start != "" && updateWindow.Length != "" {
+		schedule, err := util.ParseUpdateWindow(&updateWindow)
+		if err != nil {
+			return fmt.Errorf("invalid update window: %w", err)
+		}
+		updateSchedule = schedule
+	}
+
 	r := &reconciler{
 		log:                  log,
 		seedClient:           seedMgr.GetClient(),
@@ -98,9 +110,10 @@ func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.M
 		appInstaller:         appInstaller,
 		seedClusterNamespace: seedClusterNamespace,
 		overwriteRegistry:    overwriteRegistry,
+		updateSchedule:       updateSchedule,
 	}
 
-	_, err := builder.ControllerManagedBy(userMgr).
+	bldr := builder.ControllerManagedBy(userMgr).
 		Named(controllerName).
 		// update of the status with conditions or HelmInfo triggers an update event. To avoid reconciling in loop, we filter
 		// update event on generation. We also allow update events if annotations have changed so that the user can force a
@@ -112,10 +125,35 @@ func Add(ctx context.Context, log *zap.SugaredLogger, seedMgr, userMgr manager.M
 			seedMgr.GetCache(),
 			&appskubermaticv1.ApplicationDefinition{},
 			handler.TypedEnqueueRequestsFromMapFunc(enqueueAppInstallationForAppDef(r.userClient)),
-		)).
-		Build(r)
+		))
 
-	return err
+	// Values referenced in valuesFrom are read from Secrets and ConfigMaps in the user cluster or in the cluster namespace
+	// of the seed cluster. The application must be re-installed when their data changes.
+	valuesSourceTypes := []ctrlruntimeclient.Object{
+		&corev1.Secret{},
+		&corev1.ConfigMap{},
+	}
+	for _, t := range valuesSourceTypes {
+		bldr.Watches(t, handler.EnqueueRequestsFromMapFunc(enqueueAppInstallationForValuesReference(r.userClient, false)))
+		bldr.WatchesRawSource(source.Kind(
+			seedMgr.GetCache(),
+			t,
+			handler.EnqueueRequestsFromMapFunc(enqueueAppInstallationForValuesReference(r.userClient, true)),
+		))
+	}
+
+	// Applications are installed once their dependencies are ready and uninstalled once their dependents are removed.
+	bldr.Watches(
+		&appskubermaticv1.ApplicationInstallation{},
+		handler.EnqueueRequestsFromMapFunc(enqueueAppInstallationForDependency(r.userClient)),
+		builder.WithPredicates(dependencyChangedPredicate()),
+	)
+
+	if _, err := bldr.Build(r); err != nil {
+		return err
+	}
+
+	return addHealthController(log, userMgr, clusterIsPaused)
 }
 
 // Reconcile ApplicationInstallation (i.e. install / update or uninstall application into the user-cluster).
@@ -157,7 +195,10 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	log.Debug("Processed")
-	return reconcile.Result{RequeueAfter: appInstallation.Spec.ReconciliationInterval.Duration}, nil
+	result := reconcile.Result{RequeueAfter: appInstallation.Spec.ReconciliationInterval.Duration}
+	r.requeueForUpdateWindow(&result, appInstallation)
+	requeueForDriftDetection(&result, appInstallation)
+	return result, nil
 }
 
 func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation) error {
@@ -201,6 +242,11 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, appI
 		return fmt.Errorf("failed to sync reconciliation interval: %w", err)
 	}
 
+	// upgrade to the newest version allowed by the update policy before resolving the applicationVersion
+	if err := r.handleUpdatePolicy(ctx, log, applicationDef, appInstallation); err != nil {
+		return fmt.Errorf("failed to handle update policy: %w", err)
+	}
+
 	// get applicationVersion. If it can not be found, there are 2 cases:
 	//   1) KKP admin has removed the applicationVersion, and we have to remove the corresponding ApplicationInstallation(s)
 	//   2) User made a mistake, or applicationDefinition has not been synced yet on this seed. So we just notify the user.
@@ -224,6 +270,15 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, appI
 		}
 	}
 
+	// applications listed in dependsOn must be ready before installing this one
+	waiting, err := r.waitForDependencies(ctx, log, appInstallation)
+	if err != nil {
+		return fmt.Errorf("failed to check dependencies: %w", err)
+	}
+	if waiting {
+		return nil
+	}
+
 	// for addons migrated to ee default-application-catalog we need to purge resources before re-installing them via helm
 	if err := handleAddonCleanup(ctx, appInstallation.Name, r.seedClusterNamespace, r.seedClient, r.log); err != nil {
 		return err
@@ -338,10 +393,28 @@ func (r *reconciler) handleInstallation(ctx context.Context, log *zap.SugaredLog
 		return downloadErr
 	}
 	appInstallation.SetCondition(appskubermaticv1.ManifestsRetrieved, corev1.ConditionTrue, "DownloadSourceSuccessful", "application's source successfully downloaded")
-	appInstallation.SetCondition(appskubermaticv1.Ready, corev1.ConditionUnknown, "InstallationInProgress", "application is installing or upgrading")
+
+	// Drift is handled before the application is applied again, which would revert it. If self-healing is disabled,
+	// drifted resources are kept until the spec of the applicationInstallation or the inputs it is rendered from change.
+	inputsHash, inputsErr := r.inputsHash(ctx, appDefinition, appInstallation)
+	if inputsErr != nil {
+		log.Debugw("Failed to determine the inputs of the application", "error", inputsErr)
+	}
+	drifted, driftErr := r.handleDriftBeforeApply(ctx, log, appDefinition, appInstallation, appSourcePath)
+	keepDrift := drifted && readyConditionMatchesCurrentSpec(appInstallation) && inputsErr == nil && inputsHash == appInstallation.Status.AppliedInputsHash
+	if driftErr == nil && !keepDrift {
+		appInstallation.SetCondition(appskubermaticv1.Ready, corev1.ConditionUnknown, "InstallationInProgress", "application is installing or upgrading")
+	}
 	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
 		return fmt.Errorf("failed to update status: %w", err)
 	}
+	if driftErr != nil {
+		return driftErr
+	}
+	if keepDrift {
+		log.Infow("Not applying application again to keep drifted resources, as self-healing is disabled", "resources", len(appInstallation.Status.DriftedResources))
+		return r.assessResources(ctx, log, appDefinition, appInstallation, appSourcePath)
+	}
 
 	// Install or upgrade application.
 	oldAppInstallation = appInstallation.DeepCopy()
@@ -349,13 +422,21 @@ func (r *reconciler) handleInstallation(ctx context.Context, log *zap.SugaredLog
 
 	statusUpdater(&appInstallation.Status)
 	appInstallation.SetReadyCondition(installErr, hasLimitedRetries(appDefinition, appInstallation))
+	if installErr == nil {
+		appInstallation.Status.AppliedInputsHash = inputsHash
+	}
 
 	// we set condition in every case and condition update the LastHeartbeatTime. So patch will not be empty.
 	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
 		return fmt.Errorf("failed to update status: %w", err)
 	}
 
-	return installErr
+	if installErr != nil {
+		return installErr
+	}
+
+	// report remaining drift of the installed resources and assess their health
+	return r.assessResources(ctx, log, appDefinition, appInstallation, appSourcePath)
 }
 
 func hasLimitedRetries(appDefinition *appskubermaticv1.ApplicationDefinition, appInstallation *appskubermaticv1.ApplicationInstallation) bool {
@@ -434,6 +515,14 @@ func (r *reconciler) setFailures(ctx context.Context, appInstallation *appskuber
 // handleDeletion uninstalls the application in the user cluster.
 func (r *reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation) error {
 	if kuberneteshelper.HasFinalizer(appInstallation, appskubermaticv1.ApplicationInstallationCleanupFinalizer) {
+		waiting, err := r.waitForDependents(ctx, log, appInstallation)
+		if err != nil {
+			return fmt.Errorf("failed to check dependent applications: %w", err)
+		}
+		if waiting {
+			return nil
+		}
+
 		statusUpdater, uninstallErr := r.appInstaller.Delete(ctx, log, r.seedClient, r.userClient, appInstallation)
 		oldAppInstallation := appInstallation.DeepCopy()
 		if uninstallErr != nil {
@@ -512,6 +601,44 @@ func enqueueAppInstallationForAppDef(userClient ctrlruntimeclient.Client) func(c
 	}
 }
 
+// enqueueAppInstallationForValuesReference fan-out updates from Secrets and ConfigMaps to the ApplicationInstallations
+// that reference them in valuesFrom. seed indicates whether the object originates from the seed cluster.
+func enqueueAppInstallationForValuesReference(userClient ctrlruntimeclient.Client, seed bool) handler.MapFunc {
+	return func(ctx context.Context, obj ctrlruntimeclient.Object) []reconcile.Request {
+		var kind appskubermaticv1.ValuesReferenceKind
+		switch obj.(type) {
+		case *corev1.Secret:
+			kind = appskubermaticv1.ValuesReferenceKindSecret
+		case *corev1.ConfigMap:
+			kind = appskubermaticv1.ValuesReferenceKindConfigMap
+		default:
+			return nil
+		}
+
+		appList := &appskubermaticv1.ApplicationInstallationList{}
+		if err := userClient.List(ctx, appList); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to list applicationInstallation: %w", err))
+			return nil
+		}
+
+		var res []reconcile.Request
+		for _, appInstallation := range appList.Items {
+			// objects in the user cluster can only be referenced from the same namespace
+			if !seed && appInstallation.Namespace != obj.GetNamespace() {
+				continue
+			}
+
+			for _, ref := range appInstallation.Spec.ValuesFrom {
+				if ref.Kind == kind && ref.Seed == seed && ref.Name == obj.GetName() {
+					res = append(res, reconcile.Request{NamespacedName: types.NamespacedName{Name: appInstallation.Name, Namespace: appInstallation.Namespace}})
+					break
+				}
+			}
+		}
+		return res
+	}
+}
+
 func handleAddonCleanup(ctx context.Context, applicationName string, seedClusterNamespace string, seedClient ctrlruntimeclient.Client, log *zap.SugaredLogger) error {
 	return applicationtemplates.HandleAddonCleanup(ctx, applicationName, seedClusterNamespace, seedClient, log)
 }
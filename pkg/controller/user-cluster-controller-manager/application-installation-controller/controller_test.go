@@ -119,6 +119,71 @@ func TestEnqueueApplicationInstallation(t *testing.T) {
 	}
 }
 
+func TestEnqueueApplicationInstallationForValuesReference(t *testing.T) {
+	withValuesFrom := func(ai *appskubermaticv1.ApplicationInstallation, refs ...appskubermaticv1.ValuesReference) *appskubermaticv1.ApplicationInstallation {
+		ai.Spec.ValuesFrom = refs
+		return ai
+	}
+
+	userClient := kubermaticfake.
+		NewClientBuilder().
+		WithObjects(
+			withValuesFrom(genApplicationInstallation("appInstallation-1", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0),
+				appskubermaticv1.ValuesReference{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "values"}),
+			withValuesFrom(genApplicationInstallation("appInstallation-2", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0),
+				appskubermaticv1.ValuesReference{Kind: appskubermaticv1.ValuesReferenceKindConfigMap, Name: "values"}),
+			withValuesFrom(genApplicationInstallation("appInstallation-3", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0),
+				appskubermaticv1.ValuesReference{Kind: appskubermaticv1.ValuesReferenceKindConfigMap, Name: "other"},
+				appskubermaticv1.ValuesReference{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "values", Seed: true}),
+			genApplicationInstallation("appInstallation-4", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0)).
+		Build()
+
+	testCases := []struct {
+		name                      string
+		obj                       ctrlruntimeclient.Object
+		seed                      bool
+		expectedReconcileRequests []reconcile.Request
+	}{
+		{
+			name: "scenario 1: applications referencing the Secret in the user cluster are enqueued",
+			obj:  &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: applicationNamespaceName}},
+			expectedReconcileRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "appInstallation-1", Namespace: applicationNamespaceName}},
+			},
+		},
+		{
+			name: "scenario 2: applications referencing the ConfigMap in the user cluster are enqueued",
+			obj:  &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: applicationNamespaceName}},
+			expectedReconcileRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "appInstallation-2", Namespace: applicationNamespaceName}},
+			},
+		},
+		{
+			name: "scenario 3: applications referencing the Secret in the seed cluster are enqueued",
+			obj:  &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "cluster-abcd1234"}},
+			seed: true,
+			expectedReconcileRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "appInstallation-3", Namespace: applicationNamespaceName}},
+			},
+		},
+		{
+			name:                      "scenario 4: objects in another namespace of the user cluster are ignored",
+			obj:                       &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: "other"}},
+			expectedReconcileRequests: []reconcile.Request{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			actual := enqueueAppInstallationForValuesReference(userClient, tc.seed)(context.Background(), tc.obj)
+
+			g.Expect(actual).Should(gomega.ConsistOf(tc.expectedReconcileRequests))
+		})
+	}
+}
+
 func TestMaxRetriesOnInstallation(t *testing.T) {
 	installError := fmt.Errorf("an install error")
 
@@ -1018,3 +1083,203 @@ func TestSyncReconciliationInterval(t *testing.T) {
 		})
 	}
 }
+
+func withDependencies(appInstall *appskubermaticv1.ApplicationInstallation, names ...string) *appskubermaticv1.ApplicationInstallation {
+	for _, name := range names {
+		appInstall.Spec.DependsOn = append(appInstall.Spec.DependsOn, appskubermaticv1.ApplicationInstallationReference{Name: name})
+	}
+	return appInstall
+}
+
+func withReadyCondition(appInstall *appskubermaticv1.ApplicationInstallation, status corev1.ConditionStatus) *appskubermaticv1.ApplicationInstallation {
+	appInstall.SetCondition(appskubermaticv1.Ready, status, "", "")
+	return appInstall
+}
+
+func TestEnqueueApplicationInstallationForDependency(t *testing.T) {
+	userClient := kubermaticfake.
+		NewClientBuilder().
+		WithObjects(
+			genApplicationInstallation("cert-manager", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0),
+			withDependencies(genApplicationInstallation("ingress", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0), "cert-manager"),
+			withDependencies(genApplicationInstallation("dashboard", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0), "cert-manager", "ingress")).
+		Build()
+
+	deleting := func(appInstall *appskubermaticv1.ApplicationInstallation) *appskubermaticv1.ApplicationInstallation {
+		appInstall.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		return appInstall
+	}
+
+	testCases := []struct {
+		name                      string
+		appInstallation           *appskubermaticv1.ApplicationInstallation
+		expectedReconcileRequests []reconcile.Request
+	}{
+		{
+			name:            "scenario 1: dependents are enqueued",
+			appInstallation: genApplicationInstallation("cert-manager", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0),
+			expectedReconcileRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "ingress", Namespace: applicationNamespaceName}},
+				{NamespacedName: types.NamespacedName{Name: "dashboard", Namespace: applicationNamespaceName}},
+			},
+		},
+		{
+			name:                      "scenario 2: dependencies are not enqueued if the application is not being deleted",
+			appInstallation:           withDependencies(genApplicationInstallation("dashboard", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0), "cert-manager", "ingress"),
+			expectedReconcileRequests: []reconcile.Request{},
+		},
+		{
+			name:            "scenario 3: dependencies are enqueued if the application is being deleted",
+			appInstallation: deleting(withDependencies(genApplicationInstallation("ingress", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 0), "cert-manager")),
+			expectedReconcileRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "dashboard", Namespace: applicationNamespaceName}},
+				{NamespacedName: types.NamespacedName{Name: "cert-manager", Namespace: applicationNamespaceName}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			actual := enqueueAppInstallationForDependency(userClient)(context.Background(), tc.appInstallation)
+
+			g.Expect(actual).Should(gomega.ConsistOf(tc.expectedReconcileRequests))
+		})
+	}
+}
+
+func TestWaitForDependencies(t *testing.T) {
+	testCases := []struct {
+		name            string
+		dependencies    []ctrlruntimeclient.Object
+		expectedWaiting bool
+		expectedMessage string
+	}{
+		{
+			name:            "dependency does not exist",
+			expectedWaiting: true,
+			expectedMessage: "waiting for dependencies to be ready: apps/cert-manager (not found)",
+		},
+		{
+			name: "dependency is not ready",
+			dependencies: []ctrlruntimeclient.Object{
+				withReadyCondition(genApplicationInstallation("cert-manager", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1), corev1.ConditionFalse),
+			},
+			expectedWaiting: true,
+			expectedMessage: "waiting for dependencies to be ready: apps/cert-manager",
+		},
+		{
+			name: "dependency is ready for a previous generation",
+			dependencies: []ctrlruntimeclient.Object{
+				func() *appskubermaticv1.ApplicationInstallation {
+					appInstall := withReadyCondition(genApplicationInstallation("cert-manager", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1), corev1.ConditionTrue)
+					appInstall.Generation = 2
+					return appInstall
+				}(),
+			},
+			expectedWaiting: true,
+			expectedMessage: "waiting for dependencies to be ready: apps/cert-manager",
+		},
+		{
+			name: "dependency is ready",
+			dependencies: []ctrlruntimeclient.Object{
+				withReadyCondition(genApplicationInstallation("cert-manager", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1), corev1.ConditionTrue),
+			},
+			expectedWaiting: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			kubermaticlog.Logger = kubermaticlog.New(true, kubermaticlog.FormatJSON).Sugar()
+
+			appInstall := withDependencies(genApplicationInstallation("appInstallation-1", &defaultApplicationNamespace, "app-def-1", "1.0.0", maxRetries+1, 2, 1), "cert-manager")
+			userClient := kubermaticfake.NewClientBuilder().WithObjects(appInstall).WithObjects(tc.dependencies...).Build()
+
+			r := reconciler{log: kubermaticlog.Logger, userClient: userClient}
+			waiting, err := r.waitForDependencies(ctx, kubermaticlog.Logger, appInstall)
+			if err != nil {
+				t.Fatalf("expected waitForDependencies to succeed, got %v", err)
+			}
+			if waiting != tc.expectedWaiting {
+				t.Fatalf("expected waiting=%v, got %v", tc.expectedWaiting, waiting)
+			}
+			if !tc.expectedWaiting {
+				return
+			}
+
+			updatedAppInstall := &appskubermaticv1.ApplicationInstallation{}
+			if err := userClient.Get(ctx, types.NamespacedName{Name: "appInstallation-1", Namespace: applicationNamespaceName}, updatedAppInstall); err != nil {
+				t.Fatalf("failed to get application installation: %v", err)
+			}
+			condition := updatedAppInstall.Status.Conditions[appskubermaticv1.Ready]
+			if condition.Status != corev1.ConditionFalse || condition.Reason != dependenciesNotReadyReason || condition.Message != tc.expectedMessage {
+				t.Errorf("unexpected ready condition: %+v", condition)
+			}
+			// the spec changed since the last installation, so the retries must be reset before the generation is observed
+			if updatedAppInstall.Status.Failures != 0 {
+				t.Errorf("expected failures to be reset, got %d", updatedAppInstall.Status.Failures)
+			}
+		})
+	}
+}
+
+func TestHandleDeletionWaitsForDependents(t *testing.T) {
+	testCases := []struct {
+		name              string
+		dependents        []ctrlruntimeclient.Object
+		expectedUninstall bool
+	}{
+		{
+			name:              "no dependents",
+			expectedUninstall: true,
+		},
+		{
+			name: "dependent application exists",
+			dependents: []ctrlruntimeclient.Object{
+				withDependencies(genApplicationInstallation("ingress", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1), "cert-manager"),
+			},
+			expectedUninstall: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			kubermaticlog.Logger = kubermaticlog.New(true, kubermaticlog.FormatJSON).Sugar()
+
+			appInstall := genApplicationInstallation("cert-manager", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1)
+			appInstall.Finalizers = []string{appskubermaticv1.ApplicationInstallationCleanupFinalizer}
+			appInstall.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			userClient := kubermaticfake.NewClientBuilder().WithObjects(appInstall).WithObjects(tc.dependents...).Build()
+
+			uninstalled := false
+			appInstaller := fake.CustomApplicationInstaller{
+				DeleteFunc: func(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, applicationInstallation *appskubermaticv1.ApplicationInstallation) (util.StatusUpdater, error) {
+					uninstalled = true
+					return util.NoStatusUpdate, nil
+				},
+			}
+
+			r := reconciler{log: kubermaticlog.Logger, seedClient: userClient, userClient: userClient, appInstaller: appInstaller}
+			if err := r.handleDeletion(ctx, kubermaticlog.Logger, appInstall); err != nil {
+				t.Fatalf("expected handleDeletion to succeed, got %v", err)
+			}
+			if uninstalled != tc.expectedUninstall {
+				t.Fatalf("expected uninstall=%v, got %v", tc.expectedUninstall, uninstalled)
+			}
+
+			if !tc.expectedUninstall {
+				updatedAppInstall := &appskubermaticv1.ApplicationInstallation{}
+				if err := userClient.Get(ctx, types.NamespacedName{Name: "cert-manager", Namespace: applicationNamespaceName}, updatedAppInstall); err != nil {
+					t.Fatalf("failed to get application installation: %v", err)
+				}
+				if reason := updatedAppInstall.Status.Conditions[appskubermaticv1.Ready].Reason; reason != dependentsNotRemovedReason {
+					t.Errorf("expected ready condition reason %q, got %q", dependentsNotRemovedReason, reason)
+				}
+			}
+		})
+	}
+}
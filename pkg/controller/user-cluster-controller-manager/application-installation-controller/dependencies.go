@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	dependenciesNotReadyReason = "DependenciesNotReady"
+	dependentsNotRemovedReason = "DependentsNotRemoved"
+)
+
+// checkDependencies returns the dependencies of the applicationInstallation which are missing or not ready. An
+// application is ready when its Ready condition is true for its current generation.
+func (r *reconciler) checkDependencies(ctx context.Context, appInstallation *appskubermaticv1.ApplicationInstallation) ([]string, error) {
+	var notReady []string
+	for _, ref := range appInstallation.Spec.DependsOn {
+		key := ref.NamespacedName(appInstallation.Namespace)
+
+		dependency := &appskubermaticv1.ApplicationInstallation{}
+		if err := r.userClient.Get(ctx, key, dependency); err != nil {
+			if apierrors.IsNotFound(err) {
+				notReady = append(notReady, fmt.Sprintf("%s (not found)", key))
+				continue
+			}
+			return nil, fmt.Errorf("failed to get dependency %s: %w", key, err)
+		}
+
+		if !isApplicationReady(dependency) {
+			notReady = append(notReady, key.String())
+		}
+	}
+	return notReady, nil
+}
+
+// waitForDependencies sets the Ready condition to false if some dependencies of the applicationInstallation are not
+// ready yet. It returns true if the installation must wait. The applicationInstallation is enqueued again when the
+// dependencies become ready.
+func (r *reconciler) waitForDependencies(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation) (bool, error) {
+	notReady, err := r.checkDependencies(ctx, appInstallation)
+	if err != nil {
+		return false, err
+	}
+	if len(notReady) == 0 {
+		return false, nil
+	}
+
+	// Setting the condition marks the current generation as observed, so failures must be reset beforehand.
+	if err := r.resetFailuresIfSpecHasChanged(ctx, appInstallation); err != nil {
+		return false, err
+	}
+
+	log.Debugw("Waiting for dependencies to be ready", "dependencies", notReady)
+
+	oldAppInstallation := appInstallation.DeepCopy()
+	appInstallation.SetCondition(appskubermaticv1.Ready, corev1.ConditionFalse, dependenciesNotReadyReason, fmt.Sprintf("waiting for dependencies to be ready: %s", strings.Join(notReady, ", ")))
+	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return false, fmt.Errorf("failed to update status: %w", err)
+	}
+	return true, nil
+}
+
+// waitForDependents sets the Ready condition to false if other applicationInstallations still depend on the
+// applicationInstallation being deleted. It returns true if the uninstallation must wait, so that applications are
+// uninstalled in the reverse order of their installation.
+func (r *reconciler) waitForDependents(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation) (bool, error) {
+	dependents, err := getDependents(ctx, r.userClient, types.NamespacedName{Namespace: appInstallation.Namespace, Name: appInstallation.Name})
+	if err != nil {
+		return false, err
+	}
+	if len(dependents) == 0 {
+		return false, nil
+	}
+
+	var names []string
+	for _, dependent := range dependents {
+		names = append(names, types.NamespacedName{Namespace: dependent.Namespace, Name: dependent.Name}.String())
+	}
+
+	log.Debugw("Waiting for dependent applications to be removed before uninstalling", "dependents", names)
+
+	oldAppInstallation := appInstallation.DeepCopy()
+	appInstallation.SetCondition(appskubermaticv1.Ready, corev1.ConditionFalse, dependentsNotRemovedReason, fmt.Sprintf("waiting for dependent applications to be removed: %s", strings.Join(names, ", ")))
+	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return false, fmt.Errorf("failed to update status: %w", err)
+	}
+	return true, nil
+}
+
+// getDependents returns the applicationInstallations that depend on the applicationInstallation identified by key.
+func getDependents(ctx context.Context, userClient ctrlruntimeclient.Client, key types.NamespacedName) ([]appskubermaticv1.ApplicationInstallation, error) {
+	appList := &appskubermaticv1.ApplicationInstallationList{}
+	if err := userClient.List(ctx, appList); err != nil {
+		return nil, fmt.Errorf("failed to list applicationInstallations: %w", err)
+	}
+
+	var dependents []appskubermaticv1.ApplicationInstallation
+	for _, appInstallation := range appList.Items {
+		if dependsOn(&appInstallation, key) {
+			dependents = append(dependents, appInstallation)
+		}
+	}
+	return dependents, nil
+}
+
+func dependsOn(appInstallation *appskubermaticv1.ApplicationInstallation, key types.NamespacedName) bool {
+	for _, ref := range appInstallation.Spec.DependsOn {
+		if ref.NamespacedName(appInstallation.Namespace) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func isApplicationReady(appInstallation *appskubermaticv1.ApplicationInstallation) bool {
+	if !appInstallation.DeletionTimestamp.IsZero() {
+		return false
+	}
+	readyCondition, exists := appInstallation.Status.Conditions[appskubermaticv1.Ready]
+	return exists &&
+		readyCondition.Status == corev1.ConditionTrue &&
+		readyCondition.ObservedGeneration == appInstallation.Generation
+}
+
+// enqueueAppInstallationForDependency fan-out changes of an applicationInstallation to the applicationInstallations
+// depending on it, and to its own dependencies when it is being deleted so that their uninstallation can proceed.
+func enqueueAppInstallationForDependency(userClient ctrlruntimeclient.Client) handler.MapFunc {
+	return func(ctx context.Context, obj ctrlruntimeclient.Object) []reconcile.Request {
+		appInstallation, ok := obj.(*appskubermaticv1.ApplicationInstallation)
+		if !ok {
+			return nil
+		}
+
+		dependents, err := getDependents(ctx, userClient, types.NamespacedName{Namespace: appInstallation.Namespace, Name: appInstallation.Name})
+		if err != nil {
+			utilruntime.HandleError(err)
+			return nil
+		}
+
+		var res []reconcile.Request
+		for _, dependent := range dependents {
+			res = append(res, reconcile.Request{NamespacedName: types.NamespacedName{Name: dependent.Name, Namespace: dependent.Namespace}})
+		}
+		if !appInstallation.DeletionTimestamp.IsZero() {
+			for _, ref := range appInstallation.Spec.DependsOn {
+				res = append(res, reconcile.Request{NamespacedName: ref.NamespacedName(appInstallation.Namespace)})
+			}
+		}
+		return res
+	}
+}
+
+// dependencyChangedPredicate filters the events of applicationInstallations to the ones that can unblock the
+// installation or uninstallation of related applications: creation, deletion and changes of readiness.
+func dependencyChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldApp, ok := e.ObjectOld.(*appskubermaticv1.ApplicationInstallation)
+			if !ok {
+				return false
+			}
+			newApp, ok := e.ObjectNew.(*appskubermaticv1.ApplicationInstallation)
+			if !ok {
+				return false
+			}
+			return isApplicationReady(oldApp) != isApplicationReady(newApp) ||
+				oldApp.DeletionTimestamp.IsZero() != newApp.DeletionTimestamp.IsZero()
+		},
+	}
+}
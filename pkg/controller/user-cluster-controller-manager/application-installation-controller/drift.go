@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications"
+	applicationtemplates "k8c.io/kubermatic/v2/pkg/applications/providers/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// Event raised when resources of an applicationInstallation differ from their rendered state.
+	driftDetectedEvent = "DriftDetected"
+
+	// Event raised when drifted resources of an applicationInstallation have been applied again.
+	driftCorrectedEvent = "DriftCorrected"
+
+	// defaultDriftDetectionInterval is the interval at which drift is detected if the applicationInstallation does not
+	// specify one.
+	defaultDriftDetectionInterval = 10 * time.Minute
+)
+
+// handleDriftBeforeApply handles the drift of the resources of an installed application, if drift detection is
+// enabled. This must happen before the application is applied again, because applying kustomize and manifests
+// applications reverts all changes to their resources. It returns true if drifted resources are kept, because
+// self-healing is disabled.
+func (r *reconciler) handleDriftBeforeApply(ctx context.Context, log *zap.SugaredLogger, appDefinition *appskubermaticv1.ApplicationDefinition, appInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) (bool, error) {
+	if appInstallation.Spec.DriftDetection == nil {
+		return false, nil
+	}
+
+	// drift can only be detected for applications which have been installed successfully
+	if cond, ok := appInstallation.Status.Conditions[appskubermaticv1.Ready]; !ok || cond.Status != corev1.ConditionTrue {
+		return false, nil
+	}
+
+	objs, err := r.appInstaller.RenderResources(ctx, log, r.seedClient, r.userClient, appDefinition, appInstallation, appSourcePath)
+	if err != nil {
+		// the error is reported when assessing the health of the application after it has been applied
+		log.Debugw("Failed to render resources to detect drift", "error", err)
+		return false, nil
+	}
+
+	drifted, err := r.handleDrift(ctx, log, appInstallation, objs)
+	appInstallation.Status.DriftedResources = drifted
+
+	return len(drifted) > 0, err
+}
+
+// inputsHash returns a hash of the inputs the application is rendered from, if drift detection is enabled: its values,
+// including the ones referenced in ValuesFrom, and the installed version of its applicationDefinition. Changes of the
+// applicationInstallation itself are tracked by its generation instead.
+func (r *reconciler) inputsHash(ctx context.Context, appDefinition *appskubermaticv1.ApplicationDefinition, appInstallation *appskubermaticv1.ApplicationInstallation) (string, error) {
+	if appInstallation.Spec.DriftDetection == nil {
+		return "", nil
+	}
+
+	values, err := applicationtemplates.GetValues(ctx, r.seedClient, r.userClient, r.seedClusterNamespace, appInstallation)
+	if err != nil {
+		return "", err
+	}
+
+	// other versions of the applicationDefinition do not affect the installed application
+	definition := appDefinition.Spec.DeepCopy()
+	definition.Versions = slices.DeleteFunc(definition.Versions, func(version appskubermaticv1.ApplicationVersion) bool {
+		return version.Version != appInstallation.Spec.ApplicationRef.Version
+	})
+
+	data, err := json.Marshal(map[string]any{
+		"values":     values,
+		"definition": definition,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode inputs: %w", err)
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// assessResources renders the resources of the installed application to update the drifted resources, if drift
+// detection is enabled, and to set the Healthy condition according to the state of its Deployments, StatefulSets and
+// Jobs. The workloads are recorded in the status, so that the health reconciler can assess the health of unhealthy
+// applications again without rendering them.
+func (r *reconciler) assessResources(ctx context.Context, log *zap.SugaredLogger, appDefinition *appskubermaticv1.ApplicationDefinition, appInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) error {
+	oldAppInstallation := appInstallation.DeepCopy()
+
+	objs, err := r.appInstaller.RenderResources(ctx, log, r.seedClient, r.userClient, appDefinition, appInstallation, appSourcePath)
+	if err != nil {
+		setHealthyCondition(appInstallation, corev1.ConditionUnknown, "RenderResourcesFailed", err.Error())
+		if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+		return fmt.Errorf("failed to render resources: %w", err)
+	}
+
+	// drift has already been handled before applying the application, so it is only reported here
+	var driftErr error
+	if appInstallation.Spec.DriftDetection == nil {
+		appInstallation.Status.DriftedResources = nil
+	} else if drifted, err := applications.DetectDrift(ctx, r.userClient, objs); err != nil {
+		driftErr = fmt.Errorf("failed to detect drift: %w", err)
+	} else {
+		appInstallation.Status.DriftedResources = drifted
+	}
+
+	unhealthy, err := applications.AssessHealth(ctx, r.userClient, objs)
+	if err != nil {
+		return fmt.Errorf("failed to assess health: %w", err)
+	}
+	appInstallation.Status.Workloads = applications.Workloads(objs)
+	setHealth(appInstallation, unhealthy)
+
+	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return driftErr
+}
+
+// handleDrift returns the resources which differ from their rendered state if drift detection is enabled. If
+// self-healing is enabled, the drifted resources are applied again and nothing is returned, unless the correction failed.
+func (r *reconciler) handleDrift(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation, objs []*unstructured.Unstructured) ([]appskubermaticv1.DriftedResource, error) {
+	driftDetection := appInstallation.Spec.DriftDetection
+	if driftDetection == nil {
+		return nil, nil
+	}
+
+	drifted, err := applications.DetectDrift(ctx, r.userClient, objs)
+	if err != nil {
+		return appInstallation.Status.DriftedResources, fmt.Errorf("failed to detect drift: %w", err)
+	}
+	if len(drifted) == 0 {
+		return nil, nil
+	}
+
+	r.traceWarning(appInstallation, log, driftDetectedEvent, fmt.Sprintf("%d resources differ from their rendered state: %s", len(drifted), describeDrift(drifted)))
+	if !driftDetection.SelfHeal {
+		return drifted, nil
+	}
+
+	if err := applications.CorrectDrift(ctx, r.userClient, objs, drifted); err != nil {
+		return drifted, fmt.Errorf("failed to correct drift: %w", err)
+	}
+
+	log.Infow("Corrected drifted resources", "resources", len(drifted))
+	r.userRecorder.Eventf(appInstallation, nil, corev1.EventTypeNormal, driftCorrectedEvent, "Reconciling", "applied %d drifted resources again", len(drifted))
+
+	return nil, nil
+}
+
+func describeDrift(drifted []appskubermaticv1.DriftedResource) string {
+	descriptions := make([]string, 0, len(drifted))
+	for _, res := range drifted {
+		name := res.Name
+		if res.Namespace != "" {
+			name = res.Namespace + "/" + res.Name
+		}
+
+		description := fmt.Sprintf("%s %s (%s", res.Kind, name, res.Reason)
+		if res.Field != "" {
+			description += ": " + res.Field
+		}
+		descriptions = append(descriptions, description+")")
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// requeueForDriftDetection shortens the requeue interval of result so that drift is detected at the interval
+// configured in the applicationInstallation. The health of unhealthy applications is assessed again by the health
+// reconciler, which does not need a full reconciliation.
+func requeueForDriftDetection(result *reconcile.Result, appInstallation *appskubermaticv1.ApplicationInstallation) {
+	driftDetection := appInstallation.Spec.DriftDetection
+	if driftDetection == nil {
+		return
+	}
+
+	requeueAfter := driftDetection.Interval.Duration
+	if requeueAfter <= 0 {
+		requeueAfter = defaultDriftDetectionInterval
+	}
+
+	if result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter {
+		result.RequeueAfter = requeueAfter
+	}
+}
@@ -0,0 +1,423 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications"
+	"k8c.io/kubermatic/v2/pkg/applications/fake"
+	"k8c.io/kubermatic/v2/pkg/applications/providers/util"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	kubermaticfake "k8c.io/kubermatic/v2/pkg/test/fake"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func genRenderedResources() []*unstructured.Unstructured {
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "config", "namespace": defaultApplicationNamespace.Name},
+		"data":       map[string]any{"key": "rendered"},
+	}}
+	deployment := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "app", "namespace": defaultApplicationNamespace.Name},
+	}}
+	return []*unstructured.Unstructured{configMap, deployment}
+}
+
+func genConfigMap(value string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: defaultApplicationNamespace.Name},
+		Data:       map[string]string{"key": value},
+	}
+}
+
+func genDeployment(available corev1.ConditionStatus) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: defaultApplicationNamespace.Name},
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: available}},
+		},
+	}
+}
+
+func TestAssessResources(t *testing.T) {
+	testCases := []struct {
+		name            string
+		driftDetection  *appskubermaticv1.DriftDetection
+		renderErr       error
+		liveObjects     []ctrlruntimeclient.Object
+		expectedErr     bool
+		expectedHealthy corev1.ConditionStatus
+		expectedDrifted []appskubermaticv1.DriftedResource
+		expectedConfig  string
+	}{
+		{
+			name:            "scenario 1: drift is not reported when drift detection is disabled",
+			liveObjects:     []ctrlruntimeclient.Object{genConfigMap("edited"), genDeployment(corev1.ConditionTrue)},
+			expectedHealthy: corev1.ConditionTrue,
+			expectedConfig:  "edited",
+		},
+		{
+			name:            "scenario 2: unavailable deployment makes the application unhealthy",
+			liveObjects:     []ctrlruntimeclient.Object{genConfigMap("rendered"), genDeployment(corev1.ConditionFalse)},
+			expectedHealthy: corev1.ConditionFalse,
+			expectedConfig:  "rendered",
+		},
+		{
+			name:            "scenario 3: drifted resources are reported",
+			driftDetection:  &appskubermaticv1.DriftDetection{},
+			liveObjects:     []ctrlruntimeclient.Object{genConfigMap("edited"), genDeployment(corev1.ConditionTrue)},
+			expectedHealthy: corev1.ConditionTrue,
+			expectedDrifted: []appskubermaticv1.DriftedResource{{
+				AppliedResource: appskubermaticv1.AppliedResource{APIVersion: "v1", Kind: "ConfigMap", Namespace: defaultApplicationNamespace.Name, Name: "config"},
+				Reason:          appskubermaticv1.DriftReasonModified,
+				Field:           "data.key",
+			}},
+			expectedConfig: "edited",
+		},
+		{
+			name:            "scenario 4: drifted resources are not corrected, as this happens before applying the application",
+			driftDetection:  &appskubermaticv1.DriftDetection{SelfHeal: true},
+			liveObjects:     []ctrlruntimeclient.Object{genConfigMap("edited"), genDeployment(corev1.ConditionTrue)},
+			expectedHealthy: corev1.ConditionTrue,
+			expectedDrifted: []appskubermaticv1.DriftedResource{{
+				AppliedResource: appskubermaticv1.AppliedResource{APIVersion: "v1", Kind: "ConfigMap", Namespace: defaultApplicationNamespace.Name, Name: "config"},
+				Reason:          appskubermaticv1.DriftReasonModified,
+				Field:           "data.key",
+			}},
+			expectedConfig: "edited",
+		},
+		{
+			name:            "scenario 5: health is unknown when resources cannot be rendered",
+			renderErr:       errors.New("release not found"),
+			liveObjects:     []ctrlruntimeclient.Object{genConfigMap("rendered")},
+			expectedErr:     true,
+			expectedHealthy: corev1.ConditionUnknown,
+			expectedConfig:  "rendered",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			appInstall := genApplicationInstallation("appInstallation-1", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1)
+			appInstall.Spec.DriftDetection = tc.driftDetection
+
+			userClient := kubermaticfake.NewClientBuilder().
+				WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(kubermaticfake.NewScheme())).
+				WithObjects(append(tc.liveObjects, appInstall)...).
+				Build()
+
+			appInstaller := fake.CustomApplicationInstaller{
+				RenderResourcesFunc: func(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) ([]*unstructured.Unstructured, error) {
+					if tc.renderErr != nil {
+						return nil, tc.renderErr
+					}
+					return genRenderedResources(), nil
+				},
+			}
+
+			r := reconciler{log: kubermaticlog.Logger, seedClient: userClient, userClient: userClient, appInstaller: appInstaller}
+
+			err := r.assessResources(ctx, kubermaticlog.Logger, genApplicationDefinition("app-def-1", nil), appInstall, "")
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error: %v, got %v", tc.expectedErr, err)
+			}
+
+			updatedAppInstall := &appskubermaticv1.ApplicationInstallation{}
+			if err := userClient.Get(ctx, types.NamespacedName{Name: appInstall.Name, Namespace: appInstall.Namespace}, updatedAppInstall); err != nil {
+				t.Fatalf("failed to get application installation: %v", err)
+			}
+
+			if cond := updatedAppInstall.Status.Conditions[appskubermaticv1.Healthy]; cond.Status != tc.expectedHealthy {
+				t.Errorf("expected Healthy condition %q, got %q (%s)", tc.expectedHealthy, cond.Status, cond.Message)
+			}
+
+			if !tc.expectedErr {
+				expectedWorkloads := []appskubermaticv1.AppliedResource{{APIVersion: "apps/v1", Kind: "Deployment", Namespace: defaultApplicationNamespace.Name, Name: "app"}}
+				if workloads := updatedAppInstall.Status.Workloads; len(workloads) != 1 || workloads[0] != expectedWorkloads[0] {
+					t.Errorf("expected workloads %v, got %v", expectedWorkloads, workloads)
+				}
+			}
+
+			drifted := updatedAppInstall.Status.DriftedResources
+			if len(drifted) != len(tc.expectedDrifted) {
+				t.Fatalf("expected drifted resources %v, got %v", tc.expectedDrifted, drifted)
+			}
+			for i := range drifted {
+				if drifted[i] != tc.expectedDrifted[i] {
+					t.Errorf("expected drifted resource %v, got %v", tc.expectedDrifted[i], drifted[i])
+				}
+			}
+
+			configMap := &corev1.ConfigMap{}
+			if err := userClient.Get(ctx, types.NamespacedName{Name: "config", Namespace: defaultApplicationNamespace.Name}, configMap); err != nil {
+				t.Fatalf("failed to get ConfigMap: %v", err)
+			}
+			if configMap.Data["key"] != tc.expectedConfig {
+				t.Errorf("expected ConfigMap value %q, got %q", tc.expectedConfig, configMap.Data["key"])
+			}
+		})
+	}
+}
+
+func TestHandleInstallationDrift(t *testing.T) {
+	configDrifted := []appskubermaticv1.DriftedResource{{
+		AppliedResource: appskubermaticv1.AppliedResource{APIVersion: "v1", Kind: "ConfigMap", Namespace: defaultApplicationNamespace.Name, Name: "config"},
+		Reason:          appskubermaticv1.DriftReasonModified,
+		Field:           "data.key",
+	}}
+
+	testCases := []struct {
+		name               string
+		driftDetection     *appskubermaticv1.DriftDetection
+		installed          bool
+		specChanged        bool
+		valuesChanged      bool
+		expectedApplied    bool
+		expectedDrifted    []appskubermaticv1.DriftedResource
+		expectedConfig     string
+		expectedEventMsg   string
+		unexpectedEventMsg string
+	}{
+		{
+			name:               "scenario 1: drifted resources are kept when self-healing is disabled",
+			driftDetection:     &appskubermaticv1.DriftDetection{},
+			installed:          true,
+			expectedApplied:    false,
+			expectedDrifted:    configDrifted,
+			expectedConfig:     "edited",
+			expectedEventMsg:   driftDetectedEvent,
+			unexpectedEventMsg: driftCorrectedEvent,
+		},
+		{
+			name:             "scenario 2: drifted resources are corrected when self-healing is enabled",
+			driftDetection:   &appskubermaticv1.DriftDetection{SelfHeal: true},
+			installed:        true,
+			expectedApplied:  true,
+			expectedConfig:   "rendered",
+			expectedEventMsg: driftCorrectedEvent,
+		},
+		{
+			name:               "scenario 3: drifted resources are overwritten when the spec changed",
+			driftDetection:     &appskubermaticv1.DriftDetection{},
+			installed:          true,
+			specChanged:        true,
+			expectedApplied:    true,
+			expectedConfig:     "rendered",
+			expectedEventMsg:   driftDetectedEvent,
+			unexpectedEventMsg: driftCorrectedEvent,
+		},
+		{
+			name:               "scenario 4: drifted resources are overwritten when referenced values changed",
+			driftDetection:     &appskubermaticv1.DriftDetection{},
+			installed:          true,
+			valuesChanged:      true,
+			expectedApplied:    true,
+			expectedConfig:     "rendered",
+			expectedEventMsg:   driftDetectedEvent,
+			unexpectedEventMsg: driftCorrectedEvent,
+		},
+		{
+			name:               "scenario 5: drift is not detected before the application has been installed",
+			driftDetection:     &appskubermaticv1.DriftDetection{},
+			expectedApplied:    true,
+			expectedConfig:     "rendered",
+			unexpectedEventMsg: driftDetectedEvent,
+		},
+		{
+			name:               "scenario 6: resources are applied without drift detection",
+			installed:          true,
+			expectedApplied:    true,
+			expectedConfig:     "rendered",
+			unexpectedEventMsg: driftDetectedEvent,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			appDefinition := genApplicationDefinition("app-def-1", nil)
+			appInstall := genApplicationInstallation("appInstallation-1", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1)
+			appInstall.Spec.DriftDetection = tc.driftDetection
+			appInstall.Spec.ValuesFrom = []appskubermaticv1.ValuesReference{{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "values"}}
+
+			values := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "values", Namespace: appInstall.Namespace},
+				Data:       map[string][]byte{appskubermaticv1.DefaultValuesReferenceKey: []byte("replicas: 1")},
+			}
+
+			userClient := kubermaticfake.NewClientBuilder().
+				WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(kubermaticfake.NewScheme())).
+				WithObjects(genConfigMap("edited"), genDeployment(corev1.ConditionTrue), values).
+				Build()
+
+			recorder := events.NewFakeRecorder(10)
+			r := reconciler{log: kubermaticlog.Logger, seedClient: userClient, userClient: userClient, userRecorder: recorder}
+
+			if tc.installed {
+				inputsHash, err := r.inputsHash(ctx, appDefinition, appInstall)
+				if err != nil {
+					t.Fatalf("failed to determine the inputs of the application: %v", err)
+				}
+				appInstall.Status.AppliedInputsHash = inputsHash
+				appInstall.SetCondition(appskubermaticv1.Ready, corev1.ConditionTrue, "InstallationSuccessful", "application successfully installed or upgraded")
+			}
+			if tc.specChanged {
+				appInstall.Generation++
+			}
+			if tc.valuesChanged {
+				values.Data[appskubermaticv1.DefaultValuesReferenceKey] = []byte("replicas: 2")
+				if err := userClient.Update(ctx, values); err != nil {
+					t.Fatalf("failed to update values: %v", err)
+				}
+			}
+			if err := userClient.Create(ctx, appInstall); err != nil {
+				t.Fatalf("failed to create application installation: %v", err)
+			}
+
+			// like kustomize and manifests applications, all rendered resources are applied again
+			applied := false
+			appInstaller := fake.CustomApplicationInstaller{
+				ApplyFunc: func(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) (util.StatusUpdater, error) {
+					applied = true
+					return util.NoStatusUpdate, applications.CorrectDrift(ctx, userClient, genRenderedResources(), configDrifted)
+				},
+				RenderResourcesFunc: func(ctx context.Context, log *zap.SugaredLogger, seedClient ctrlruntimeclient.Client, userClient ctrlruntimeclient.Client, appDefinition *appskubermaticv1.ApplicationDefinition, applicationInstallation *appskubermaticv1.ApplicationInstallation, appSourcePath string) ([]*unstructured.Unstructured, error) {
+					return genRenderedResources(), nil
+				},
+			}
+
+			r.appInstaller = appInstaller
+
+			if err := r.handleInstallation(ctx, kubermaticlog.Logger, appDefinition, appInstall); err != nil {
+				t.Fatalf("failed to handle installation: %v", err)
+			}
+
+			if applied != tc.expectedApplied {
+				t.Errorf("expected application to be applied: %v, got %v", tc.expectedApplied, applied)
+			}
+
+			updatedAppInstall := &appskubermaticv1.ApplicationInstallation{}
+			if err := userClient.Get(ctx, types.NamespacedName{Name: appInstall.Name, Namespace: appInstall.Namespace}, updatedAppInstall); err != nil {
+				t.Fatalf("failed to get application installation: %v", err)
+			}
+
+			drifted := updatedAppInstall.Status.DriftedResources
+			if len(drifted) != len(tc.expectedDrifted) {
+				t.Fatalf("expected drifted resources %v, got %v", tc.expectedDrifted, drifted)
+			}
+			for i := range drifted {
+				if drifted[i] != tc.expectedDrifted[i] {
+					t.Errorf("expected drifted resource %v, got %v", tc.expectedDrifted[i], drifted[i])
+				}
+			}
+
+			if cond := updatedAppInstall.Status.Conditions[appskubermaticv1.Ready]; tc.installed && cond.Status != corev1.ConditionTrue {
+				t.Errorf("expected Ready condition to stay %q, got %q (%s)", corev1.ConditionTrue, cond.Status, cond.Message)
+			}
+
+			configMap := &corev1.ConfigMap{}
+			if err := userClient.Get(ctx, types.NamespacedName{Name: "config", Namespace: defaultApplicationNamespace.Name}, configMap); err != nil {
+				t.Fatalf("failed to get ConfigMap: %v", err)
+			}
+			if configMap.Data["key"] != tc.expectedConfig {
+				t.Errorf("expected ConfigMap value %q, got %q", tc.expectedConfig, configMap.Data["key"])
+			}
+
+			var recorded []string
+			for len(recorder.Events) > 0 {
+				recorded = append(recorded, <-recorder.Events)
+			}
+			if tc.expectedEventMsg != "" && !strings.Contains(strings.Join(recorded, "\n"), tc.expectedEventMsg) {
+				t.Errorf("expected a %s event, got %v", tc.expectedEventMsg, recorded)
+			}
+			if tc.unexpectedEventMsg != "" && strings.Contains(strings.Join(recorded, "\n"), tc.unexpectedEventMsg) {
+				t.Errorf("expected no %s event, got %v", tc.unexpectedEventMsg, recorded)
+			}
+		})
+	}
+}
+
+func TestRequeueForDriftDetection(t *testing.T) {
+	testCases := []struct {
+		name           string
+		driftDetection *appskubermaticv1.DriftDetection
+		requeueAfter   time.Duration
+		expected       time.Duration
+	}{
+		{
+			name:         "scenario 1: requeue interval is kept without drift detection",
+			requeueAfter: time.Hour,
+			expected:     time.Hour,
+		},
+		{
+			name:           "scenario 2: default drift detection interval is used",
+			driftDetection: &appskubermaticv1.DriftDetection{},
+			expected:       defaultDriftDetectionInterval,
+		},
+		{
+			name:           "scenario 3: shorter drift detection interval is used",
+			driftDetection: &appskubermaticv1.DriftDetection{Interval: metav1.Duration{Duration: time.Minute}},
+			requeueAfter:   time.Hour,
+			expected:       time.Minute,
+		},
+		{
+			name:           "scenario 4: shorter requeue interval is kept",
+			driftDetection: &appskubermaticv1.DriftDetection{Interval: metav1.Duration{Duration: time.Hour}},
+			requeueAfter:   time.Minute,
+			expected:       time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appInstall := genApplicationInstallation("appInstallation-1", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1)
+			appInstall.Spec.DriftDetection = tc.driftDetection
+			setHealthyCondition(appInstall, corev1.ConditionFalse, "", "")
+
+			result := reconcile.Result{RequeueAfter: tc.requeueAfter}
+			requeueForDriftDetection(&result, appInstall)
+
+			if result.RequeueAfter != tc.expected {
+				t.Errorf("expected requeue after %v, got %v", tc.expected, result.RequeueAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/applications"
+	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	healthControllerName = "kkp-app-health-controller"
+
+	// unhealthyRequeueDuration is the initial interval at which the health of an unhealthy application is assessed
+	// again. The interval grows with the time the application has been unhealthy, up to maxUnhealthyRequeueDuration.
+	unhealthyRequeueDuration = 30 * time.Second
+
+	// maxUnhealthyRequeueDuration is the maximum interval at which the health of an unhealthy application is assessed
+	// again.
+	maxUnhealthyRequeueDuration = 5 * time.Minute
+)
+
+// healthReconciler assesses the health of unhealthy applications again until they become healthy. Unlike the
+// installation reconciler, it neither downloads nor applies the application, but only checks the workloads recorded
+// in the status of the applicationInstallation.
+type healthReconciler struct {
+	log             *zap.SugaredLogger
+	userClient      ctrlruntimeclient.Client
+	clusterIsPaused userclustercontrollermanager.IsPausedChecker
+}
+
+func addHealthController(log *zap.SugaredLogger, userMgr manager.Manager, clusterIsPaused userclustercontrollermanager.IsPausedChecker) error {
+	r := &healthReconciler{
+		log:             log.Named("health"),
+		userClient:      userMgr.GetClient(),
+		clusterIsPaused: clusterIsPaused,
+	}
+
+	_, err := builder.ControllerManagedBy(userMgr).
+		Named(healthControllerName).
+		For(&appskubermaticv1.ApplicationInstallation{}, builder.WithPredicates(healthChangedPredicate())).
+		Build(r)
+
+	return err
+}
+
+// Reconcile assesses the health of an unhealthy applicationInstallation and requeues it with backoff as long as it
+// stays unhealthy.
+func (r *healthReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("applicationinstallation", request)
+	log.Debug("Processing")
+
+	paused, err := r.clusterIsPaused(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to check cluster pause status: %w", err)
+	}
+	if paused {
+		return reconcile.Result{}, nil
+	}
+
+	appInstallation := &appskubermaticv1.ApplicationInstallation{}
+	if err := r.userClient.Get(ctx, request.NamespacedName, appInstallation); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	// the installation reconciler assesses the health itself while the application is being installed or upgraded
+	if !isApplicationReady(appInstallation) || !isUnhealthy(appInstallation) {
+		return reconcile.Result{}, nil
+	}
+
+	unhealthy, err := applications.AssessHealth(ctx, r.userClient, applications.WorkloadObjects(appInstallation.Status.Workloads))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to assess health: %w", err)
+	}
+
+	oldAppInstallation := appInstallation.DeepCopy()
+	setHealth(appInstallation, unhealthy)
+	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	if len(unhealthy) == 0 {
+		log.Debug("Application has become healthy")
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: unhealthyBackoff(appInstallation)}, nil
+}
+
+// setHealth sets the Healthy condition according to the messages of the unhealthy workloads returned by
+// applications.AssessHealth.
+func setHealth(appInstallation *appskubermaticv1.ApplicationInstallation, unhealthy []string) {
+	if len(unhealthy) == 0 {
+		setHealthyCondition(appInstallation, corev1.ConditionTrue, "ResourcesHealthy", "all deployments are available, statefulsets are ready and jobs have succeeded")
+	} else {
+		setHealthyCondition(appInstallation, corev1.ConditionFalse, "ResourcesUnhealthy", strings.Join(unhealthy, "; "))
+	}
+}
+
+// setHealthyCondition sets the Healthy condition. Unlike for other conditions, the transition time is also set when the
+// condition is added, because it is used to back off assessing the health of unhealthy applications.
+func setHealthyCondition(appInstallation *appskubermaticv1.ApplicationInstallation, status corev1.ConditionStatus, reason, message string) {
+	appInstallation.SetCondition(appskubermaticv1.Healthy, status, reason, message)
+
+	if cond := appInstallation.Status.Conditions[appskubermaticv1.Healthy]; cond.LastTransitionTime.IsZero() {
+		cond.LastTransitionTime = cond.LastHeartbeatTime
+		appInstallation.Status.Conditions[appskubermaticv1.Healthy] = cond
+	}
+}
+
+func isUnhealthy(appInstallation *appskubermaticv1.ApplicationInstallation) bool {
+	cond, ok := appInstallation.Status.Conditions[appskubermaticv1.Healthy]
+	return ok && cond.Status == corev1.ConditionFalse
+}
+
+// unhealthyBackoff returns the interval after which the health of an unhealthy application is assessed again. It
+// grows with the time the application has been unhealthy.
+func unhealthyBackoff(appInstallation *appskubermaticv1.ApplicationInstallation) time.Duration {
+	cond := appInstallation.Status.Conditions[appskubermaticv1.Healthy]
+	return min(max(time.Since(cond.LastTransitionTime.Time), unhealthyRequeueDuration), maxUnhealthyRequeueDuration)
+}
+
+// healthChangedPredicate only lets updates through which change whether an applicationInstallation is ready or
+// unhealthy. Updates which only refresh the conditions, e.g. by the health reconciler itself, are filtered.
+func healthChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldApp, ok := e.ObjectOld.(*appskubermaticv1.ApplicationInstallation)
+			if !ok {
+				return false
+			}
+			newApp, ok := e.ObjectNew.(*appskubermaticv1.ApplicationInstallation)
+			if !ok {
+				return false
+			}
+			return isApplicationReady(oldApp) != isApplicationReady(newApp) || isUnhealthy(oldApp) != isUnhealthy(newApp)
+		},
+	}
+}
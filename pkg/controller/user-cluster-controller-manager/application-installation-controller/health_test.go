@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	kubermaticfake "k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestHealthReconciler(t *testing.T) {
+	testCases := []struct {
+		name            string
+		ready           corev1.ConditionStatus
+		healthy         corev1.ConditionStatus
+		unhealthyFor    time.Duration
+		liveObjects     []ctrlruntimeclient.Object
+		expectedHealthy corev1.ConditionStatus
+		expectedRequeue time.Duration
+	}{
+		{
+			name:            "scenario 1: application becomes healthy once its deployment is available",
+			ready:           corev1.ConditionTrue,
+			healthy:         corev1.ConditionFalse,
+			liveObjects:     []ctrlruntimeclient.Object{genDeployment(corev1.ConditionTrue)},
+			expectedHealthy: corev1.ConditionTrue,
+		},
+		{
+			name:            "scenario 2: recently unhealthy application is requeued soon",
+			ready:           corev1.ConditionTrue,
+			healthy:         corev1.ConditionFalse,
+			liveObjects:     []ctrlruntimeclient.Object{genDeployment(corev1.ConditionFalse)},
+			expectedHealthy: corev1.ConditionFalse,
+			expectedRequeue: unhealthyRequeueDuration,
+		},
+		{
+			name:            "scenario 3: application unhealthy for a long time is requeued with backoff",
+			ready:           corev1.ConditionTrue,
+			healthy:         corev1.ConditionFalse,
+			unhealthyFor:    time.Hour,
+			expectedHealthy: corev1.ConditionFalse,
+			expectedRequeue: maxUnhealthyRequeueDuration,
+		},
+		{
+			name:            "scenario 4: health of an application being installed is left to the installation reconciler",
+			ready:           corev1.ConditionUnknown,
+			healthy:         corev1.ConditionFalse,
+			liveObjects:     []ctrlruntimeclient.Object{genDeployment(corev1.ConditionTrue)},
+			expectedHealthy: corev1.ConditionFalse,
+		},
+		{
+			name:            "scenario 5: healthy application is not assessed",
+			ready:           corev1.ConditionTrue,
+			healthy:         corev1.ConditionTrue,
+			liveObjects:     []ctrlruntimeclient.Object{genDeployment(corev1.ConditionFalse)},
+			expectedHealthy: corev1.ConditionTrue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			appInstall := genApplicationInstallation("appInstallation-1", &defaultApplicationNamespace, "app-def-1", "1.0.0", 0, 1, 1)
+			appInstall.SetCondition(appskubermaticv1.Ready, tc.ready, "", "")
+			setHealthyCondition(appInstall, tc.healthy, "", "")
+			cond := appInstall.Status.Conditions[appskubermaticv1.Healthy]
+			cond.LastTransitionTime = metav1.NewTime(time.Now().Add(-tc.unhealthyFor))
+			appInstall.Status.Conditions[appskubermaticv1.Healthy] = cond
+			appInstall.Status.Workloads = []appskubermaticv1.AppliedResource{
+				{APIVersion: "apps/v1", Kind: "Deployment", Namespace: defaultApplicationNamespace.Name, Name: "app"},
+			}
+
+			userClient := kubermaticfake.NewClientBuilder().WithObjects(append(tc.liveObjects, appInstall)...).Build()
+
+			r := healthReconciler{
+				log:             kubermaticlog.Logger,
+				userClient:      userClient,
+				clusterIsPaused: func(context.Context) (bool, error) { return false, nil },
+			}
+
+			key := types.NamespacedName{Name: appInstall.Name, Namespace: appInstall.Namespace}
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+			if err != nil {
+				t.Fatalf("expected reconcile to succeed, got %v", err)
+			}
+			if result.RequeueAfter != tc.expectedRequeue {
+				t.Errorf("expected requeue after %v, got %v", tc.expectedRequeue, result.RequeueAfter)
+			}
+
+			updatedAppInstall := &appskubermaticv1.ApplicationInstallation{}
+			if err := userClient.Get(ctx, key, updatedAppInstall); err != nil {
+				t.Fatalf("failed to get application installation: %v", err)
+			}
+			if cond := updatedAppInstall.Status.Conditions[appskubermaticv1.Healthy]; cond.Status != tc.expectedHealthy {
+				t.Errorf("expected Healthy condition %q, got %q (%s)", tc.expectedHealthy, cond.Status, cond.Message)
+			}
+			if cond := updatedAppInstall.Status.Conditions[appskubermaticv1.Ready]; cond.Status != tc.ready {
+				t.Errorf("expected Ready condition to stay %q, got %q", tc.ready, cond.Status)
+			}
+		})
+	}
+}
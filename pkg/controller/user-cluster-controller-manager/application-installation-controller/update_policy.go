@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	semverlib "github.com/Masterminds/semver/v3"
+	"go.uber.org/zap"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// Event raised when an applicationInstallation has been upgraded automatically according to its update policy.
+	applicationUpdatedEvent = "ApplicationUpdated"
+
+	// Event raised when the update policy of an applicationInstallation cannot be evaluated.
+	applicationUpdateSkippedEvent = "ApplicationUpdateSkipped"
+
+	// maxUpdateHistory is the number of automatic upgrades kept in the status of an applicationInstallation.
+	maxUpdateHistory = 10
+)
+
+// handleUpdatePolicy looks for the newest version of the applicationDefinition matching the update policy of the
+// applicationInstallation. If the policy mode is automatic and the cluster update window is open, the
+// applicationInstallation is upgraded to this version, otherwise the version is reported in the status. If the policy
+// cannot be evaluated, e.g. because the installed version is not valid semver, the upgrade is skipped and the reason is
+// reported in the status instead of failing the reconciliation.
+func (r *reconciler) handleUpdatePolicy(ctx context.Context, log *zap.SugaredLogger, applicationDef *appskubermaticv1.ApplicationDefinition, appInstallation *appskubermaticv1.ApplicationInstallation) error {
+	availableVersion := ""
+	policyError := ""
+	if policy := appInstallation.Spec.UpdatePolicy; policy != nil {
+		version, err := newestMatchingVersion(applicationDef, appInstallation.Spec.ApplicationRef.Version, policy.VersionConstraint)
+		if err != nil {
+			policyError = fmt.Sprintf("skipping automatic upgrade: %v", err)
+		} else {
+			availableVersion = version
+		}
+	}
+
+	// only warn once per reason, not on every reconciliation
+	if policyError != "" && policyError != appInstallation.Status.UpdatePolicyError {
+		r.traceWarning(appInstallation, log, applicationUpdateSkippedEvent, policyError)
+	}
+
+	if availableVersion != "" && appInstallation.Spec.UpdatePolicy.Mode != appskubermaticv1.ApplicationUpdateModeManual {
+		if open, next := r.updateWindowOpen(time.Now()); !open {
+			log.Debugw("Waiting for the update window to upgrade application", "version", availableVersion, "nextWindow", next)
+		} else {
+			return r.upgradeApplication(ctx, log, appInstallation, availableVersion)
+		}
+	}
+
+	if appInstallation.Status.AvailableVersion != availableVersion || appInstallation.Status.UpdatePolicyError != policyError {
+		oldAppInstallation := appInstallation.DeepCopy()
+		appInstallation.Status.AvailableVersion = availableVersion
+		appInstallation.Status.UpdatePolicyError = policyError
+		if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+			return fmt.Errorf("failed to update status with available version: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// upgradeApplication changes the version of the applicationInstallation and records the upgrade in its status.
+func (r *reconciler) upgradeApplication(ctx context.Context, log *zap.SugaredLogger, appInstallation *appskubermaticv1.ApplicationInstallation, version string) error {
+	previousVersion := appInstallation.Spec.ApplicationRef.Version
+	log.Infow("Upgrading application according to its update policy", "from", previousVersion, "to", version)
+
+	oldAppInstallation := appInstallation.DeepCopy()
+	appInstallation.Spec.ApplicationRef.Version = version
+	if err := r.userClient.Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return fmt.Errorf("failed to update application version: %w", err)
+	}
+
+	oldAppInstallation = appInstallation.DeepCopy()
+	appInstallation.Status.AvailableVersion = ""
+	appInstallation.Status.UpdatePolicyError = ""
+	appInstallation.Status.UpdateHistory = append(appInstallation.Status.UpdateHistory, appskubermaticv1.ApplicationUpdateRecord{
+		FromVersion: previousVersion,
+		ToVersion:   version,
+		Time:        metav1.Now(),
+	})
+	if overflow := len(appInstallation.Status.UpdateHistory) - maxUpdateHistory; overflow > 0 {
+		appInstallation.Status.UpdateHistory = appInstallation.Status.UpdateHistory[overflow:]
+	}
+	if err := r.userClient.Status().Patch(ctx, appInstallation, ctrlruntimeclient.MergeFrom(oldAppInstallation)); err != nil {
+		return fmt.Errorf("failed to update status with update history: %w", err)
+	}
+
+	r.userRecorder.Eventf(appInstallation, nil, corev1.EventTypeNormal, applicationUpdatedEvent, "Reconciling", "Application upgraded from version %s to %s", previousVersion, version)
+	return nil
+}
+
+// newestMatchingVersion returns the newest version of the applicationDefinition that matches the constraint and is
+// newer than the current version, or an empty string if there is none. Versions that are not valid semver are ignored.
+func newestMatchingVersion(applicationDef *appskubermaticv1.ApplicationDefinition, currentVersion, versionConstraint string) (string, error) {
+	constraint, err := semverlib.NewConstraint(versionConstraint)
+	if err != nil {
+		return "", fmt.Errorf("invalid version constraint %q: %w", versionConstraint, err)
+	}
+
+	current, err := semverlib.NewVersion(currentVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid application version %q: %w", currentVersion, err)
+	}
+
+	var newest *semverlib.Version
+	newestVersion := ""
+	for _, version := range applicationDef.Spec.Versions {
+		candidate, err := semverlib.NewVersion(version.Version)
+		if err != nil {
+			continue
+		}
+		if !candidate.GreaterThan(current) || !constraint.Check(candidate) {
+			continue
+		}
+		if newest == nil || candidate.GreaterThan(newest) {
+			newest = candidate
+			newestVersion = version.Version
+		}
+	}
+
+	return newestVersion, nil
+}
+
+// updateWindowOpen returns whether applications can be upgraded at the given time and, if not, when the next update
+// window opens. Without an update window, upgrades can be done at any time.
+func (r *reconciler) updateWindowOpen(now time.Time) (bool, time.Time) {
+	if r.updateSchedule == nil {
+		return true, time.Time{}
+	}
+	return r.updateSchedule.Open(now)
+}
+
+// requeueForUpdateWindow makes sure that an applicationInstallation with a pending automatic upgrade is reconciled
+// again once the update window opens. Earlier requeues are left alone.
+func (r *reconciler) requeueForUpdateWindow(result *reconcile.Result, appInstallation *appskubermaticv1.ApplicationInstallation) {
+	policy := appInstallation.Spec.UpdatePolicy
+	if policy == nil || policy.Mode == appskubermaticv1.ApplicationUpdateModeManual || appInstallation.Status.AvailableVersion == "" {
+		return
+	}
+
+	open, next := r.updateWindowOpen(time.Now())
+	if open {
+		return
+	}
+
+	requeueAfter := max(time.Until(next), time.Second)
+	if result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter {
+		result.RequeueAfter = requeueAfter
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationinstallationcontroller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/util"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	kubermaticfake "k8c.io/kubermatic/v2/pkg/test/fake"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func genVersionedApplicationDefinition(versions ...string) *appskubermaticv1.ApplicationDefinition {
+	appDef := genApplicationDefinition("app-def-1", nil)
+	appDef.Spec.Versions = nil
+	for _, version := range versions {
+		appDef.Spec.Versions = append(appDef.Spec.Versions, appskubermaticv1.ApplicationVersion{Version: version})
+	}
+	return appDef
+}
+
+// genUpdateSchedule returns a daily schedule starting at the given offset from now.
+func genUpdateSchedule(t *testing.T, offset time.Duration) *util.MaintenanceSchedule {
+	start := time.Now().UTC().Add(offset)
+	schedule, err := util.ParseUpdateWindow(&kubermaticv1.UpdateWindow{Start: start.Format("15:04"), Length: "2h"})
+	if err != nil {
+		t.Fatalf("failed to parse update window: %v", err)
+	}
+	return schedule
+}
+
+func TestNewestMatchingVersion(t *testing.T) {
+	appDef := genVersionedApplicationDefinition("1.13.2", "1.14.0", "1.14.3", "v1.14.10", "1.15.0", "2.0.0", "not-semver")
+
+	testCases := []struct {
+		name            string
+		currentVersion  string
+		constraint      string
+		expectedVersion string
+		wantErr         bool
+	}{
+		{
+			name:            "newest patch version",
+			currentVersion:  "1.14.0",
+			constraint:      "~1.14",
+			expectedVersion: "v1.14.10",
+		},
+		{
+			name:            "newest minor version",
+			currentVersion:  "1.13.2",
+			constraint:      "^1.13",
+			expectedVersion: "1.15.0",
+		},
+		{
+			name:            "already on the newest matching version",
+			currentVersion:  "1.15.0",
+			constraint:      "< 2",
+			expectedVersion: "",
+		},
+		{
+			name:            "no downgrade to a matching version",
+			currentVersion:  "2.0.0",
+			constraint:      "~1.14",
+			expectedVersion: "",
+		},
+		{
+			name:           "invalid constraint",
+			currentVersion: "1.14.0",
+			constraint:     "latest",
+			wantErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := newestMatchingVersion(appDef, tc.currentVersion, tc.constraint)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error=%v, got %v", tc.wantErr, err)
+			}
+			if version != tc.expectedVersion {
+				t.Fatalf("expected version %q, got %q", tc.expectedVersion, version)
+			}
+		})
+	}
+}
+
+func TestHandleUpdatePolicy(t *testing.T) {
+	appDef := genVersionedApplicationDefinition("1.0.0", "1.0.1", "1.1.0")
+
+	testCases := []struct {
+		name                     string
+		installedVersion         string
+		mode                     appskubermaticv1.ApplicationUpdateMode
+		updateSchedule           *util.MaintenanceSchedule
+		expectedVersion          string
+		expectedAvailableVersion string
+		expectedRequeue          bool
+	}{
+		{
+			name:            "upgrade without update window",
+			mode:            appskubermaticv1.ApplicationUpdateModeAutomatic,
+			expectedVersion: "1.0.1",
+		},
+		{
+			name:            "upgrade within update window",
+			updateSchedule:  genUpdateSchedule(t, -time.Hour),
+			expectedVersion: "1.0.1",
+		},
+		{
+			name:                     "upgrade waits for update window",
+			mode:                     appskubermaticv1.ApplicationUpdateModeAutomatic,
+			updateSchedule:           genUpdateSchedule(t, 3*time.Hour),
+			expectedVersion:          "1.0.0",
+			expectedAvailableVersion: "1.0.1",
+			expectedRequeue:          true,
+		},
+		{
+			name:                     "manual mode only reports the available version",
+			mode:                     appskubermaticv1.ApplicationUpdateModeManual,
+			expectedVersion:          "1.0.0",
+			expectedAvailableVersion: "1.0.1",
+		},
+		{
+			name:             "non-semver installed version skips the upgrade",
+			installedVersion: "latest",
+			mode:             appskubermaticv1.ApplicationUpdateModeAutomatic,
+			expectedVersion:  "latest",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			kubermaticlog.Logger = kubermaticlog.New(true, kubermaticlog.FormatJSON).Sugar()
+
+			installedVersion := tc.installedVersion
+			if installedVersion == "" {
+				installedVersion = "1.0.0"
+			}
+			appInstall := genApplicationInstallation("appInstallation-1", &defaultApplicationNamespace, "app-def-1", installedVersion, 0, 1, 1)
+			appInstall.Spec.UpdatePolicy = &appskubermaticv1.ApplicationUpdatePolicy{VersionConstraint: "~1.0", Mode: tc.mode}
+			appInstall.Status.UpdateHistory = make([]appskubermaticv1.ApplicationUpdateRecord, maxUpdateHistory)
+			userClient := kubermaticfake.NewClientBuilder().WithObjects(appInstall).Build()
+
+			r := reconciler{log: kubermaticlog.Logger, userClient: userClient, userRecorder: events.NewFakeRecorder(10), updateSchedule: tc.updateSchedule}
+			if err := r.handleUpdatePolicy(ctx, kubermaticlog.Logger, appDef, appInstall); err != nil {
+				t.Fatalf("expected handleUpdatePolicy to succeed, got %v", err)
+			}
+
+			updatedAppInstall := &appskubermaticv1.ApplicationInstallation{}
+			if err := userClient.Get(ctx, types.NamespacedName{Name: "appInstallation-1", Namespace: applicationNamespaceName}, updatedAppInstall); err != nil {
+				t.Fatalf("failed to get application installation: %v", err)
+			}
+			if version := updatedAppInstall.Spec.ApplicationRef.Version; version != tc.expectedVersion {
+				t.Errorf("expected version %q, got %q", tc.expectedVersion, version)
+			}
+			if version := updatedAppInstall.Status.AvailableVersion; version != tc.expectedAvailableVersion {
+				t.Errorf("expected available version %q, got %q", tc.expectedAvailableVersion, version)
+			}
+
+			history := updatedAppInstall.Status.UpdateHistory
+			if len(history) != maxUpdateHistory {
+				t.Fatalf("expected update history to be capped at %d entries, got %d", maxUpdateHistory, len(history))
+			}
+			upgraded := tc.expectedVersion != installedVersion
+			if last := history[len(history)-1]; upgraded != (last.FromVersion == installedVersion && last.ToVersion == tc.expectedVersion) {
+				t.Errorf("unexpected last update history entry: %+v", last)
+			}
+
+			result := reconcile.Result{}
+			r.requeueForUpdateWindow(&result, updatedAppInstall)
+			if requeue := result.RequeueAfter > 0; requeue != tc.expectedRequeue {
+				t.Errorf("expected requeue=%v, got %v", tc.expectedRequeue, fmt.Sprint(result))
+			}
+		})
+	}
+}
+
+func TestHandleUpdatePolicyReportsSkippedUpgradeOnce(t *testing.T) {
+	ctx := context.Background()
+	kubermaticlog.Logger = kubermaticlog.New(true, kubermaticlog.FormatJSON).Sugar()
+
+	appDef := genVersionedApplicationDefinition("1.0.0", "1.0.1")
+	appInstall := genApplicationInstallation("appInstallation-1", &defaultApplicationNamespace, "app-def-1", "latest", 0, 1, 1)
+	appInstall.Spec.UpdatePolicy = &appskubermaticv1.ApplicationUpdatePolicy{VersionConstraint: "~1.0", Mode: appskubermaticv1.ApplicationUpdateModeManual}
+	userClient := kubermaticfake.NewClientBuilder().WithObjects(appInstall).Build()
+
+	recorder := events.NewFakeRecorder(10)
+	r := reconciler{log: kubermaticlog.Logger, userClient: userClient, userRecorder: recorder}
+
+	reconcileUpdatePolicy := func() *appskubermaticv1.ApplicationInstallation {
+		current := &appskubermaticv1.ApplicationInstallation{}
+		if err := userClient.Get(ctx, types.NamespacedName{Name: "appInstallation-1", Namespace: applicationNamespaceName}, current); err != nil {
+			t.Fatalf("failed to get application installation: %v", err)
+		}
+		if err := r.handleUpdatePolicy(ctx, kubermaticlog.Logger, appDef, current); err != nil {
+			t.Fatalf("expected handleUpdatePolicy to succeed, got %v", err)
+		}
+		return current
+	}
+
+	for range 3 {
+		if updated := reconcileUpdatePolicy(); updated.Status.UpdatePolicyError == "" {
+			t.Fatal("expected the skipped upgrade to be reported in the status")
+		}
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("expected a single warning event, got %d", len(recorder.Events))
+	}
+
+	current := &appskubermaticv1.ApplicationInstallation{}
+	if err := userClient.Get(ctx, types.NamespacedName{Name: "appInstallation-1", Namespace: applicationNamespaceName}, current); err != nil {
+		t.Fatalf("failed to get application installation: %v", err)
+	}
+	current.Spec.ApplicationRef.Version = "1.0.0"
+	if err := userClient.Update(ctx, current); err != nil {
+		t.Fatalf("failed to update application installation: %v", err)
+	}
+
+	updated := reconcileUpdatePolicy()
+	if updated.Status.UpdatePolicyError != "" {
+		t.Errorf("expected the update policy error to be cleared, got %q", updated.Status.UpdatePolicyError)
+	}
+	if updated.Status.AvailableVersion != "1.0.1" {
+		t.Errorf("expected available version %q, got %q", "1.0.1", updated.Status.AvailableVersion)
+	}
+}
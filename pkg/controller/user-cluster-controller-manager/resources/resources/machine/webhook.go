@@ -35,7 +35,7 @@ const (
 	machineValidatingWebhookConfigurationName = "kubermatic-machine-validation"
 )
 
-// ValidatingWebhookConfigurationReconciler returns the ValidatingWebhookConfiguration for the machine CRD.
+// ValidatingWebhookConfigurationReconciler returns the ValidatingWebhookConfiguration for the machine and machinedeployment CRDs.
 func ValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespace string) reconciling.NamedValidatingWebhookConfigurationReconcilerFactory {
 	return func() (string, reconciling.ValidatingWebhookConfigurationReconciler) {
 		return machineValidatingWebhookConfigurationName, func(hook *admissionregistrationv1.ValidatingWebhookConfiguration) (*admissionregistrationv1.ValidatingWebhookConfiguration, error) {
@@ -50,6 +50,12 @@ func ValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespac
 				resources.UserClusterWebhookUserListenPort,
 			)
 
+			mdURL := fmt.Sprintf("https://%s.%s.svc.cluster.local.:%d/validate-cluster-k8s-io-v1alpha1-machinedeployment",
+				resources.UserClusterWebhookServiceName,
+				namespace,
+				resources.UserClusterWebhookUserListenPort,
+			)
+
 			hook.Webhooks = []admissionregistrationv1.ValidatingWebhook{
 				{
 					Name:                    "machines.cluster.k8c.io", // this should be a FQDN
@@ -78,6 +84,34 @@ func ValidatingWebhookConfigurationReconciler(caCert *x509.Certificate, namespac
 						},
 					},
 				},
+				{
+					Name:                    "machinedeployments.cluster.k8c.io", // this should be a FQDN
+					AdmissionReviewVersions: []string{admissionregistrationv1.SchemeGroupVersion.Version, admissionregistrationv1beta1.SchemeGroupVersion.Version},
+					MatchPolicy:             &matchPolicy,
+					FailurePolicy:           &failurePolicy,
+					SideEffects:             &sideEffects,
+					TimeoutSeconds:          ptr.To[int32](3),
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						CABundle: triple.EncodeCertPEM(caCert),
+						URL:      &mdURL,
+					},
+					ObjectSelector:    &metav1.LabelSelector{},
+					NamespaceSelector: &metav1.LabelSelector{},
+					Rules: []admissionregistrationv1.RuleWithOperations{
+						{
+							Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{clusterv1alpha1.SchemeGroupVersion.Group},
+								APIVersions: []string{clusterv1alpha1.SchemeGroupVersion.Version},
+								Resources:   []string{"machinedeployments"},
+								Scope:       &scope,
+							},
+							Operations: []admissionregistrationv1.OperationType{
+								admissionregistrationv1.Create,
+								admissionregistrationv1.Update,
+							},
+						},
+					},
+				},
 			}
 			return hook, nil
 		}
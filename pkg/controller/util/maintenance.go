@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// minMaintenanceRequeue prevents hot loops if a pending operation's next window
+// is already in the past.
+const minMaintenanceRequeue = 10 * time.Second
+
+var weekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// MaintenanceSchedule is the parsed form of an UpdateWindow.
+type MaintenanceSchedule struct {
+	// weekday is nil for daily windows.
+	weekday *time.Weekday
+	hour    int
+	minute  int
+	length  time.Duration
+}
+
+// ParseUpdateWindow parses and validates the given window.
+func ParseUpdateWindow(window *kubermaticv1.UpdateWindow) (*MaintenanceSchedule, error) {
+	schedule := &MaintenanceSchedule{}
+
+	length, err := time.ParseDuration(window.Length)
+	if err != nil {
+		return nil, fmt.Errorf("invalid length: %w", err)
+	}
+	if length <= 0 {
+		return nil, errors.New("length must be positive")
+	}
+	schedule.length = length
+
+	timeOfDay := window.Start
+	if day, t, found := strings.Cut(window.Start, " "); found {
+		weekday, ok := weekdays[day]
+		if !ok {
+			return nil, fmt.Errorf("invalid day of week %q", day)
+		}
+
+		schedule.weekday = &weekday
+		timeOfDay = t
+	}
+
+	start, err := time.Parse("15:04", timeOfDay)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time: %w", err)
+	}
+
+	schedule.hour = start.Hour()
+	schedule.minute = start.Minute()
+
+	return schedule, nil
+}
+
+// Open returns whether the maintenance window is open at the given time. If it
+// is not, the start of the next window is returned as well.
+func (s *MaintenanceSchedule) Open(now time.Time) (bool, time.Time) {
+	now = now.UTC()
+
+	period := 1
+	start := time.Date(now.Year(), now.Month(), now.Day(), s.hour, s.minute, 0, 0, time.UTC)
+	if s.weekday != nil {
+		period = 7
+		start = start.AddDate(0, 0, int(*s.weekday-now.Weekday()))
+	}
+
+	// find the most recent window start
+	if start.After(now) {
+		start = start.AddDate(0, 0, -period)
+	}
+
+	if now.Before(start.Add(s.length)) {
+		return true, time.Time{}
+	}
+
+	return false, start.AddDate(0, 0, period)
+}
+
+// MaintenanceWindowOpen returns whether disruptive operations may be performed
+// on the cluster at the given time and, if not, when the next maintenance
+// window opens. Clusters without a maintenance window are always open.
+func MaintenanceWindowOpen(cluster *kubermaticv1.Cluster, now time.Time) (bool, time.Time, error) {
+	if cluster.Spec.MaintenanceWindow == nil {
+		return true, time.Time{}, nil
+	}
+
+	schedule, err := ParseUpdateWindow(cluster.Spec.MaintenanceWindow)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid maintenance window: %w", err)
+	}
+
+	open, next := schedule.Open(now)
+
+	return open, next, nil
+}
+
+// SetPendingMaintenance records in the cluster status that the given operation
+// is waiting for the maintenance window. It is meant to be used in a
+// ClusterPatchFunc.
+func SetPendingMaintenance(c *kubermaticv1.Cluster, operation kubermaticv1.MaintenanceOperation, message string, nextWindow time.Time) {
+	pending, exists := c.Status.PendingMaintenance[operation]
+	if !exists {
+		pending.Since = metav1.Now()
+	}
+
+	pending.Message = message
+	pending.NextWindow = metav1.NewTime(nextWindow)
+
+	if c.Status.PendingMaintenance == nil {
+		c.Status.PendingMaintenance = map[kubermaticv1.MaintenanceOperation]kubermaticv1.PendingMaintenance{}
+	}
+	c.Status.PendingMaintenance[operation] = pending
+}
+
+// ClearPendingMaintenance removes the given operation from the cluster status.
+// It is meant to be used in a ClusterPatchFunc.
+func ClearPendingMaintenance(c *kubermaticv1.Cluster, operation kubermaticv1.MaintenanceOperation) {
+	delete(c.Status.PendingMaintenance, operation)
+}
+
+// RequeueForMaintenance makes sure that a cluster with the given pending
+// operation is reconciled again once its maintenance window opens. Results
+// that already requeue the cluster are left alone.
+func RequeueForMaintenance(result *reconcile.Result, cluster *kubermaticv1.Cluster, operation kubermaticv1.MaintenanceOperation) {
+	pending, exists := cluster.Status.PendingMaintenance[operation]
+	if !exists || !result.IsZero() {
+		return
+	}
+
+	result.RequeueAfter = max(time.Until(pending.NextWindow.Time), minMaintenanceRequeue)
+}
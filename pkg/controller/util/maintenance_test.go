@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+)
+
+func TestMaintenanceWindowOpen(t *testing.T) {
+	// 2026-10-14 is a Wednesday
+	wednesday := func(hour, minute int) time.Time {
+		return time.Date(2026, time.October, 14, hour, minute, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name         string
+		window       *kubermaticv1.UpdateWindow
+		now          time.Time
+		expectedOpen bool
+		expectedNext time.Time
+		expectedErr  bool
+	}{
+		{
+			name:         "no window is always open",
+			now:          wednesday(12, 0),
+			expectedOpen: true,
+		},
+		{
+			name:         "inside daily window",
+			window:       &kubermaticv1.UpdateWindow{Start: "11:30", Length: "1h"},
+			now:          wednesday(12, 0),
+			expectedOpen: true,
+		},
+		{
+			name:         "before daily window",
+			window:       &kubermaticv1.UpdateWindow{Start: "22:00", Length: "2h"},
+			now:          wednesday(12, 0),
+			expectedOpen: false,
+			expectedNext: wednesday(22, 0),
+		},
+		{
+			name:         "after daily window",
+			window:       &kubermaticv1.UpdateWindow{Start: "02:00", Length: "2h"},
+			now:          wednesday(12, 0),
+			expectedOpen: false,
+			expectedNext: wednesday(2, 0).AddDate(0, 0, 1),
+		},
+		{
+			name:         "daily window spanning midnight",
+			window:       &kubermaticv1.UpdateWindow{Start: "23:00", Length: "3h"},
+			now:          wednesday(1, 30),
+			expectedOpen: true,
+		},
+		{
+			name:         "inside weekly window",
+			window:       &kubermaticv1.UpdateWindow{Start: "Wed 10:00", Length: "4h"},
+			now:          wednesday(12, 0),
+			expectedOpen: true,
+		},
+		{
+			name:         "weekly window later this week",
+			window:       &kubermaticv1.UpdateWindow{Start: "Sat 21:00", Length: "4h"},
+			now:          wednesday(12, 0),
+			expectedOpen: false,
+			expectedNext: wednesday(21, 0).AddDate(0, 0, 3),
+		},
+		{
+			name:         "weekly window earlier this week",
+			window:       &kubermaticv1.UpdateWindow{Start: "Mon 21:00", Length: "4h"},
+			now:          wednesday(12, 0),
+			expectedOpen: false,
+			expectedNext: wednesday(21, 0).AddDate(0, 0, 5),
+		},
+		{
+			name:         "weekly window spanning the end of the week",
+			window:       &kubermaticv1.UpdateWindow{Start: "Sun 22:00", Length: "72h"},
+			now:          wednesday(12, 0).AddDate(0, 0, -2),
+			expectedOpen: true,
+		},
+		{
+			name:         "window longer than its period is always open",
+			window:       &kubermaticv1.UpdateWindow{Start: "13:00", Length: "25h"},
+			now:          wednesday(12, 30),
+			expectedOpen: true,
+		},
+		{
+			name:        "invalid day of week",
+			window:      &kubermaticv1.UpdateWindow{Start: "Monday 21:00", Length: "1h"},
+			now:         wednesday(12, 0),
+			expectedErr: true,
+		},
+		{
+			name:        "invalid length",
+			window:      &kubermaticv1.UpdateWindow{Start: "21:00", Length: "-1h"},
+			now:         wednesday(12, 0),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{}
+			cluster.Spec.MaintenanceWindow = tc.window
+
+			open, next, err := MaintenanceWindowOpen(cluster, tc.now)
+			if err != nil {
+				if !tc.expectedErr {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if tc.expectedErr {
+				t.Fatal("Expected an error, but got none.")
+			}
+
+			if open != tc.expectedOpen {
+				t.Fatalf("Expected open=%v, got %v.", tc.expectedOpen, open)
+			}
+
+			if !next.Equal(tc.expectedNext) {
+				t.Fatalf("Expected next window at %v, got %v.", tc.expectedNext, next)
+			}
+		})
+	}
+}
+
+func TestSetPendingMaintenance(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{}
+	next := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	SetPendingMaintenance(cluster, kubermaticv1.MaintenanceOperationEtcdRollout, "first", next)
+	since := cluster.Status.PendingMaintenance[kubermaticv1.MaintenanceOperationEtcdRollout].Since
+
+	SetPendingMaintenance(cluster, kubermaticv1.MaintenanceOperationEtcdRollout, "second", next)
+
+	pending := cluster.Status.PendingMaintenance[kubermaticv1.MaintenanceOperationEtcdRollout]
+	if pending.Message != "second" {
+		t.Errorf("Expected message to be updated, got %q.", pending.Message)
+	}
+	if !pending.Since.Equal(&since) {
+		t.Errorf("Expected since to remain %v, got %v.", since, pending.Since)
+	}
+
+	ClearPendingMaintenance(cluster, kubermaticv1.MaintenanceOperationEtcdRollout)
+	if len(cluster.Status.PendingMaintenance) != 0 {
+		t.Errorf("Expected no pending maintenance, got %v.", cluster.Status.PendingMaintenance)
+	}
+}
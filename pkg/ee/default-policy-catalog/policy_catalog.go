@@ -40,6 +40,8 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -57,6 +59,26 @@ func DeployDefaultPolicyTemplateCatalog(ctx context.Context, logger *logrus.Entr
 		return fmt.Errorf("failed to fetch PolicyTemplates: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		for _, policyTemplate := range policyTemplates {
+			fields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&policyTemplate)
+			if err != nil {
+				return fmt.Errorf("failed to convert PolicyTemplate %s: %w", policyTemplate.Name, err)
+			}
+
+			object := &unstructured.Unstructured{Object: fields}
+			object.SetGroupVersionKind(kubermaticv1.SchemeGroupVersion.WithKind("PolicyTemplate"))
+
+			// the target of an existing PolicyTemplate is kept, see policyTemplateReconcilerFactory
+			unstructured.RemoveNestedField(object.Object, "spec", "target")
+			unstructured.RemoveNestedField(object.Object, "metadata", "creationTimestamp")
+
+			opt.DryRun.Objects = append(opt.DryRun.Objects, stack.ObjectSource{Object: object})
+		}
+
+		return nil
+	}
+
 	// Wait for webhook to be ready
 	webhook := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
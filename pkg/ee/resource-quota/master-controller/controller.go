@@ -27,12 +27,14 @@ package mastercontroller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 
 	k8cequality "k8c.io/kubermatic/sdk/v2/apis/equality"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/controller/util"
+	resourcequotavalidation "k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -132,16 +134,7 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 			}
 			return fmt.Errorf("error getting seed %q resource quota: %w", seed, err)
 		}
-		localUsage := seedResourceQuota.Status.LocalUsage
-		if localUsage.CPU != nil {
-			globalUsage.CPU.Add(*localUsage.CPU)
-		}
-		if localUsage.Memory != nil {
-			globalUsage.Memory.Add(*localUsage.Memory)
-		}
-		if localUsage.Storage != nil {
-			globalUsage.Storage.Add(*localUsage.Storage)
-		}
+		globalUsage.Add(seedResourceQuota.Status.LocalUsage)
 	}
 
 	if err := r.ensureGlobalUsage(ctx, log, resourceQuota, globalUsage); err != nil {
@@ -153,7 +146,12 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 
 func (r *reconciler) ensureGlobalUsage(ctx context.Context, log *zap.SugaredLogger, resourceQuota *kubermaticv1.ResourceQuota,
 	globalUsage *kubermaticv1.ResourceDetails) error {
-	if k8cequality.Semantic.DeepEqual(*globalUsage, resourceQuota.Status.GlobalUsage) {
+	// the warning thresholds might have changed even if the usage did not
+	updated := resourceQuota.DeepCopy()
+	updated.Status.GlobalUsage = *globalUsage
+	setQuotaWarningCondition(updated)
+
+	if k8cequality.Semantic.DeepEqual(updated.Status, resourceQuota.Status) {
 		log.Debugw("global usage for resource quota is the same, not updating",
 			"cpu", globalUsage.CPU.String(),
 			"memory", globalUsage.Memory.String(),
@@ -165,7 +163,36 @@ func (r *reconciler) ensureGlobalUsage(ctx context.Context, log *zap.SugaredLogg
 		"memory", globalUsage.Memory.String(),
 		"storage", globalUsage.Storage.String())
 
-	return util.UpdateResourceQuotaStatus(ctx, r.masterClient, resourceQuota, func(rq *kubermaticv1.ResourceQuota) {
+	reachedLimits := resourcequotavalidation.ReachedLimits(resourceQuota)
+
+	err := util.UpdateResourceQuotaStatus(ctx, r.masterClient, resourceQuota, func(rq *kubermaticv1.ResourceQuota) {
 		rq.Status.GlobalUsage = *globalUsage
+		setQuotaWarningCondition(rq)
 	})
+	if err != nil {
+		return err
+	}
+
+	// notify only when a soft limit is reached or the quota is exceeded, not on every change of the usage
+	if resourcequotavalidation.ReachedLimits(resourceQuota).Difference(reachedLimits).Len() > 0 {
+		condition := resourceQuota.Status.Conditions[kubermaticv1.ResourceQuotaConditionQuotaWarning]
+		r.recorder.Eventf(resourceQuota, nil, corev1.EventTypeWarning, condition.Reason, "Reconciling",
+			"Project %s: %s", resourceQuota.Spec.Subject.Name, condition.Message)
+	}
+
+	return nil
+}
+
+func setQuotaWarningCondition(resourceQuota *kubermaticv1.ResourceQuota) {
+	warnings, exceeded := resourcequotavalidation.UsageWarnings(resourceQuota)
+	message := strings.Join(warnings, "; ")
+
+	switch {
+	case exceeded:
+		util.SetResourceQuotaCondition(resourceQuota, kubermaticv1.ResourceQuotaConditionQuotaWarning, corev1.ConditionTrue, "QuotaExceeded", message)
+	case len(warnings) > 0:
+		util.SetResourceQuotaCondition(resourceQuota, kubermaticv1.ResourceQuotaConditionQuotaWarning, corev1.ConditionTrue, "SoftLimitReached", message)
+	default:
+		util.SetResourceQuotaCondition(resourceQuota, kubermaticv1.ResourceQuotaConditionQuotaWarning, corev1.ConditionFalse, "WithinLimits", "")
+	}
 }
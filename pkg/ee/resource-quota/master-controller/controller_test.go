@@ -34,9 +34,11 @@ import (
 	"k8c.io/kubermatic/v2/pkg/test/fake"
 	"k8c.io/kubermatic/v2/pkg/test/generator"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -48,8 +50,10 @@ func TestReconcile(t *testing.T) {
 		name          string
 		requestName   string
 		expectedUsage kubermaticv1.ResourceDetails
-		masterClient  ctrlruntimeclient.Client
-		seedClients   map[string]ctrlruntimeclient.Client
+		// expectedWarningReason is the reason of the QuotaWarning condition, if it is true
+		expectedWarningReason string
+		masterClient          ctrlruntimeclient.Client
+		seedClients           map[string]ctrlruntimeclient.Client
 	}{
 		{
 			name:          "scenario 1: calculate rq global usage",
@@ -70,6 +74,38 @@ func TestReconcile(t *testing.T) {
 					Build(),
 			},
 		},
+		{
+			name:                  "scenario 2: global usage crossing a soft limit",
+			requestName:           rqName,
+			expectedUsage:         *genResourceDetails("7", "7G", "18G"),
+			expectedWarningReason: "SoftLimitReached",
+			masterClient: fake.
+				NewClientBuilder().
+				WithObjects(genResourceQuotaWithThresholds(rqName, "8", 80), generator.GenTestSeed()).
+				Build(),
+			seedClients: map[string]ctrlruntimeclient.Client{
+				"first": fake.
+					NewClientBuilder().
+					WithObjects(genResourceQuota(rqName, *genResourceDetails("7", "7G", "18G"))).
+					Build(),
+			},
+		},
+		{
+			name:                  "scenario 3: global usage exceeding the quota",
+			requestName:           rqName,
+			expectedUsage:         *genResourceDetails("7", "7G", "18G"),
+			expectedWarningReason: "QuotaExceeded",
+			masterClient: fake.
+				NewClientBuilder().
+				WithObjects(genResourceQuotaWithThresholds(rqName, "6", 80), generator.GenTestSeed()).
+				Build(),
+			seedClients: map[string]ctrlruntimeclient.Client{
+				"first": fake.
+					NewClientBuilder().
+					WithObjects(genResourceQuota(rqName, *genResourceDetails("7", "7G", "18G"))).
+					Build(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -97,6 +133,15 @@ func TestReconcile(t *testing.T) {
 			if !diff.SemanticallyEqual(tc.expectedUsage, rq.Status.GlobalUsage) {
 				t.Fatalf("Objects differ:\n%v", diff.ObjectDiff(tc.expectedUsage, rq.Status.GlobalUsage))
 			}
+
+			condition := rq.Status.Conditions[kubermaticv1.ResourceQuotaConditionQuotaWarning]
+			if tc.expectedWarningReason == "" {
+				if condition.Status != corev1.ConditionFalse {
+					t.Fatalf("Expected QuotaWarning condition to be false, got %q (%s)", condition.Status, condition.Message)
+				}
+			} else if condition.Status != corev1.ConditionTrue || condition.Reason != tc.expectedWarningReason {
+				t.Fatalf("Expected QuotaWarning condition to be true with reason %q, got %q with reason %q", tc.expectedWarningReason, condition.Status, condition.Reason)
+			}
 		})
 	}
 }
@@ -115,6 +160,98 @@ func genResourceQuota(name string, localUsage kubermaticv1.ResourceDetails) *kub
 	return rq
 }
 
+func genResourceQuotaWithThresholds(name string, cpuQuota string, cpuThreshold int32) *kubermaticv1.ResourceQuota {
+	rq := genResourceQuota(name, kubermaticv1.ResourceDetails{})
+	rq.Spec.Quota.CPU = ptr.To(resource.MustParse(cpuQuota))
+	rq.Spec.WarningThresholds = &kubermaticv1.ResourceThresholds{CPU: ptr.To(cpuThreshold)}
+
+	return rq
+}
+
 func genResourceDetails(cpu, mem, storage string) *kubermaticv1.ResourceDetails {
 	return kubermaticv1.NewResourceDetails(resource.MustParse(cpu), resource.MustParse(mem), resource.MustParse(storage))
 }
+
+func TestReconcileQuotaWarningEvents(t *testing.T) {
+	ctx := context.Background()
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: rqName}}
+
+	seedClient := fake.
+		NewClientBuilder().
+		WithObjects(genResourceQuota(rqName, *genResourceDetails("7", "7G", "18G"))).
+		Build()
+
+	recorder := events.NewFakeRecorder(10)
+	r := &reconciler{
+		log:      kubermaticlog.Logger,
+		recorder: recorder,
+		masterClient: fake.
+			NewClientBuilder().
+			WithObjects(genResourceQuotaWithThresholds(rqName, "10", 70), generator.GenTestSeed()).
+			Build(),
+		seedClients: map[string]ctrlruntimeclient.Client{"first": seedClient},
+	}
+
+	steps := []struct {
+		name          string
+		cpuUsage      string
+		expectedEvent bool
+	}{
+		{
+			name:          "soft limit reached",
+			cpuUsage:      "7",
+			expectedEvent: true,
+		},
+		{
+			name:          "usage changed above the soft limit",
+			cpuUsage:      "8",
+			expectedEvent: false,
+		},
+		{
+			name:          "usage unchanged",
+			cpuUsage:      "8",
+			expectedEvent: false,
+		},
+		{
+			name:          "quota exceeded",
+			cpuUsage:      "11",
+			expectedEvent: true,
+		},
+		{
+			name:          "usage back within limits",
+			cpuUsage:      "2",
+			expectedEvent: false,
+		},
+		{
+			name:          "soft limit reached again",
+			cpuUsage:      "9",
+			expectedEvent: true,
+		},
+	}
+
+	for _, step := range steps {
+		seedResourceQuota := &kubermaticv1.ResourceQuota{}
+		if err := seedClient.Get(ctx, request.NamespacedName, seedResourceQuota); err != nil {
+			t.Fatalf("failed to get seed resource quota: %v", err)
+		}
+		seedResourceQuota.Status.LocalUsage.CPU = ptr.To(resource.MustParse(step.cpuUsage))
+		if err := seedClient.Status().Update(ctx, seedResourceQuota); err != nil {
+			t.Fatalf("failed to update seed resource quota: %v", err)
+		}
+
+		if _, err := r.Reconcile(ctx, request); err != nil {
+			t.Fatalf("%s: reconciling failed: %v", step.name, err)
+		}
+
+		select {
+		case event := <-recorder.Events:
+			if !step.expectedEvent {
+				t.Fatalf("%s: expected no event, got %q", step.name, event)
+			}
+		default:
+			if step.expectedEvent {
+				t.Fatalf("%s: expected a warning event", step.name)
+			}
+		}
+	}
+}
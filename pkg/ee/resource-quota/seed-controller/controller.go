@@ -140,18 +140,10 @@ func (r *reconciler) reconcile(ctx context.Context, resourceQuota *kubermaticv1.
 	localUsage := kubermaticv1.NewResourceDetails(resource.Quantity{}, resource.Quantity{}, resource.Quantity{})
 	for _, cluster := range clusterList.Items {
 		if cluster.Status.ResourceUsage != nil {
-			clusterUsage := cluster.Status.ResourceUsage
-			if clusterUsage.CPU != nil {
-				localUsage.CPU.Add(*clusterUsage.CPU)
-			}
-			if clusterUsage.Memory != nil {
-				localUsage.Memory.Add(*clusterUsage.Memory)
-			}
-			if clusterUsage.Storage != nil {
-				localUsage.Storage.Add(*clusterUsage.Storage)
-			}
+			localUsage.Add(*cluster.Status.ResourceUsage)
 		}
 	}
+	localUsage.Clusters = resource.NewQuantity(int64(len(clusterList.Items)), resource.DecimalSI)
 
 	if err = r.ensureLocalUsage(ctx, log, resourceQuota, localUsage); err != nil {
 		return err
@@ -166,13 +158,15 @@ func (r *reconciler) ensureLocalUsage(ctx context.Context, log *zap.SugaredLogge
 		log.Debugw("local usage for resource quota is the same, not updating",
 			"cpu", localUsage.CPU.String(),
 			"memory", localUsage.Memory.String(),
-			"storage", localUsage.Storage.String())
+			"storage", localUsage.Storage.String(),
+			"clusters", localUsage.Clusters.String())
 		return nil
 	}
 	log.Debugw("local usage for resource quota needs update",
 		"cpu", localUsage.CPU.String(),
 		"memory", localUsage.Memory.String(),
-		"storage", localUsage.Storage.String())
+		"storage", localUsage.Storage.String(),
+		"clusters", localUsage.Clusters.String())
 
 	return util.UpdateResourceQuotaStatus(ctx, r.seedClient, resourceQuota, func(rq *kubermaticv1.ResourceQuota) {
 		rq.Status.LocalUsage = *localUsage
@@ -181,9 +175,9 @@ func (r *reconciler) ensureLocalUsage(ctx context.Context, log *zap.SugaredLogge
 
 func withClusterEventFilter() predicate.Predicate {
 	return predicate.Funcs{
-		// when cluster is created, no point to calculate yet as the machines are not created
+		// new clusters do not use any resources yet, but count towards the cluster quota
 		CreateFunc: func(e event.CreateEvent) bool {
-			return false
+			return true
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oldCluster, ok := e.ObjectOld.(*kubermaticv1.Cluster)
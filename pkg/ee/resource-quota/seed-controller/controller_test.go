@@ -62,7 +62,11 @@ func TestReconcile(t *testing.T) {
 					genCluster("c2", projectID, "5", "2G", "8G"),
 					genCluster("notSameProjectCluster", "impostor", "3", "3G", "3G")).
 				Build(),
-			expectedUsage: *genResourceDetails("7", "7G", "18G"),
+			expectedUsage: func() kubermaticv1.ResourceDetails {
+				usage := genResourceDetails("7", "7G", "18G")
+				usage.Clusters = resource.NewQuantity(2, resource.DecimalSI)
+				return *usage
+			}(),
 		},
 	}
 
@@ -45,7 +45,10 @@ import (
 	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -78,6 +81,7 @@ func Add(log *zap.SugaredLogger, seedMgr, userMgr manager.Manager, clusterName s
 	_, err := builder.ControllerManagedBy(userMgr).
 		Named(controllerName).
 		For(&clusterv1alpha1.Machine{}, builder.WithPredicates(predicate.ByNamespace(metav1.NamespaceSystem))).
+		Watches(&corev1.Service{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(loadBalancerServicePredicate())).
 		Build(r)
 
 	return err
@@ -107,7 +111,12 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to get cluster: %w", err)
 	}
 
-	err = r.reconcile(ctx, cluster, machines)
+	services := &corev1.ServiceList{}
+	if err := r.userClient.List(ctx, services); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get services: %w", err)
+	}
+
+	err = r.reconcile(ctx, cluster, machines, services)
 	if err != nil {
 		r.recorder.Eventf(cluster, nil, corev1.EventTypeWarning, "ClusterResourceUsageReconcileFailed", "Reconciling", err.Error())
 	}
@@ -115,18 +124,27 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{}, err
 }
 
-func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluster, machines *clusterv1alpha1.MachineList) error {
+func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluster, machines *clusterv1alpha1.MachineList, services *corev1.ServiceList) error {
 	resourceUsage := kubermaticv1.NewResourceDetails(resource.Quantity{}, resource.Quantity{}, resource.Quantity{})
+	resourceUsage.GPUs = &resource.Quantity{}
+	resourceUsage.Nodes = &resource.Quantity{}
+
 	for _, machine := range machines.Items {
 		resourceDetails, err := machinevalidation.GetMachineResourceUsage(ctx, r.userClient, &machine, r.caBundle)
 		if err != nil {
 			return fmt.Errorf("error getting machine resource usage for machine %q: %w", machine.Name, err)
 		}
 
-		resourceUsage.CPU.Add(*resourceDetails.CPU())
-		resourceUsage.Memory.Add(*resourceDetails.Memory())
-		resourceUsage.Storage.Add(*resourceDetails.Storage())
+		resourceUsage.Add(resourceDetails.NodeUsage())
+	}
+
+	var loadBalancers int64
+	for _, service := range services.Items {
+		if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			loadBalancers++
+		}
 	}
+	resourceUsage.LoadBalancers = resource.NewQuantity(loadBalancers, resource.DecimalSI)
 
 	cluster.Status.ResourceUsage = resourceUsage
 
@@ -134,3 +152,26 @@ func (r *reconciler) reconcile(ctx context.Context, cluster *kubermaticv1.Cluste
 		c.Status.ResourceUsage = resourceUsage
 	})
 }
+
+// loadBalancerServicePredicate filters for Services that are or were of type LoadBalancer.
+func loadBalancerServicePredicate() ctrlpredicate.Predicate {
+	isLoadBalancer := func(obj ctrlruntimeclient.Object) bool {
+		service, ok := obj.(*corev1.Service)
+		return ok && service.Spec.Type == corev1.ServiceTypeLoadBalancer
+	}
+
+	return ctrlpredicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isLoadBalancer(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return isLoadBalancer(e.ObjectOld) != isLoadBalancer(e.ObjectNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isLoadBalancer(e.Object)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -36,7 +36,9 @@ import (
 	"k8c.io/kubermatic/v2/pkg/test/generator"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/events"
@@ -48,6 +50,7 @@ func TestReconcile(t *testing.T) {
 		name                  string
 		cluster               *kubermaticv1.Cluster
 		machines              []*clusterv1alpha1.Machine
+		services              []*corev1.Service
 		expectedResourceUsage *kubermaticv1.ResourceDetails
 	}{
 		{
@@ -55,9 +58,12 @@ func TestReconcile(t *testing.T) {
 			cluster:  generator.GenDefaultCluster(),
 			machines: []*clusterv1alpha1.Machine{genFakeMachine("m1", "5", "5G", "10G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("5"),
-				Memory:  getQuantity("5G"),
-				Storage: getQuantity("10G"),
+				CPU:           getQuantity("5"),
+				Memory:        getQuantity("5G"),
+				Storage:       getQuantity("10G"),
+				GPUs:          getQuantity("0"),
+				Nodes:         getQuantity("1"),
+				LoadBalancers: getQuantity("0"),
 			},
 		},
 		{
@@ -69,9 +75,12 @@ func TestReconcile(t *testing.T) {
 			}(),
 			machines: []*clusterv1alpha1.Machine{genFakeMachine("m1", "5", "5G", "10G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("5"),
-				Memory:  getQuantity("5G"),
-				Storage: getQuantity("10G"),
+				CPU:           getQuantity("5"),
+				Memory:        getQuantity("5G"),
+				Storage:       getQuantity("10G"),
+				GPUs:          getQuantity("0"),
+				Nodes:         getQuantity("1"),
+				LoadBalancers: getQuantity("0"),
 			},
 		},
 		{
@@ -81,9 +90,12 @@ func TestReconcile(t *testing.T) {
 				genFakeMachine("m1", "5", "5G", "10G"),
 				genFakeMachine("m2", "2", "3G", "5G")},
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("7"),
-				Memory:  getQuantity("8G"),
-				Storage: getQuantity("15G"),
+				CPU:           getQuantity("7"),
+				Memory:        getQuantity("8G"),
+				Storage:       getQuantity("15G"),
+				GPUs:          getQuantity("0"),
+				Nodes:         getQuantity("2"),
+				LoadBalancers: getQuantity("0"),
 			},
 		},
 		{
@@ -94,9 +106,30 @@ func TestReconcile(t *testing.T) {
 				return c
 			}(),
 			expectedResourceUsage: &kubermaticv1.ResourceDetails{
-				CPU:     getQuantity("0"),
-				Memory:  getQuantity("0"),
-				Storage: getQuantity("0"),
+				CPU:           getQuantity("0"),
+				Memory:        getQuantity("0"),
+				Storage:       getQuantity("0"),
+				GPUs:          getQuantity("0"),
+				Nodes:         getQuantity("0"),
+				LoadBalancers: getQuantity("0"),
+			},
+		},
+		{
+			name:     "scenario 5: count GPUs and load balancers",
+			cluster:  generator.GenDefaultCluster(),
+			machines: []*clusterv1alpha1.Machine{genFakeGPUMachine("m1", "4", "16G", "50G", "2")},
+			services: []*corev1.Service{
+				genService("lb1", corev1.ServiceTypeLoadBalancer),
+				genService("lb2", corev1.ServiceTypeLoadBalancer),
+				genService("internal", corev1.ServiceTypeClusterIP),
+			},
+			expectedResourceUsage: &kubermaticv1.ResourceDetails{
+				CPU:           getQuantity("4"),
+				Memory:        getQuantity("16G"),
+				Storage:       getQuantity("50G"),
+				GPUs:          getQuantity("2"),
+				Nodes:         getQuantity("1"),
+				LoadBalancers: getQuantity("2"),
 			},
 		},
 	}
@@ -115,6 +148,9 @@ func TestReconcile(t *testing.T) {
 			for _, m := range tc.machines {
 				userClientBuilder.WithObjects(m)
 			}
+			for _, s := range tc.services {
+				userClientBuilder.WithObjects(s)
+			}
 
 			seedClient := seedClientBuilder.Build()
 			userClient := userClientBuilder.Build()
@@ -156,6 +192,24 @@ func genFakeMachine(name, cpu, memory, storage string) *clusterv1alpha1.Machine
 		nil, nil)
 }
 
+func genFakeGPUMachine(name, cpu, memory, storage, gpus string) *clusterv1alpha1.Machine {
+	return generator.GenTestMachine(name,
+		fmt.Sprintf(`{"cloudProvider":"fake", "cloudProviderSpec":{"cpu":"%s","memory":"%s","storage":"%s","gpus":"%s"}}`, cpu, memory, storage, gpus),
+		nil, nil)
+}
+
+func genService(name string, serviceType corev1.ServiceType) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: serviceType,
+		},
+	}
+}
+
 func getQuantity(q string) *resource.Quantity {
 	res := resource.MustParse(q)
 	return &res
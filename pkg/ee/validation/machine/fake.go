@@ -53,11 +53,22 @@ func getFakeQuotaRequest(config *providerconfig.Config) (*ResourceDetails, error
 		return nil, fmt.Errorf("error parsing quantity: %w", err)
 	}
 
-	return NewResourceDetails(cpu, mem, storage), nil
+	details := NewResourceDetails(cpu, mem, storage)
+
+	if spec.GPUs != "" {
+		gpus, err := resource.ParseQuantity(spec.GPUs)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing quantity: %w", err)
+		}
+		details.gpu = gpus
+	}
+
+	return details, nil
 }
 
 type FakeProviderSpec struct {
 	CPU     string `json:"cpu"`
 	Memory  string `json:"memory"`
 	Storage string `json:"storage"`
+	GPUs    string `json:"gpus,omitempty"`
 }
@@ -32,77 +32,44 @@ import (
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // ValidateQuota validates if the requested Machine resource consumption fits in the quota of the clusters project.
+// The returned warnings list the resources whose soft limit would be reached by the Machine.
 func ValidateQuota(ctx context.Context,
 	log *zap.SugaredLogger,
 	userClient ctrlruntimeclient.Client,
 	machine *clusterv1alpha1.Machine,
 	caBundle *certificates.CABundle,
 	resourceQuota *kubermaticv1.ResourceQuota,
-) error {
+) ([]string, error) {
 	machineResourceUsage, err := GetMachineResourceUsage(ctx, userClient, machine, caBundle)
 	if err != nil {
-		return fmt.Errorf("error getting machine resource request: %w", err)
+		return nil, fmt.Errorf("error getting machine resource request: %w", err)
 	}
 
-	var currentCPU = resource.Quantity{}
-	if resourceQuota.Status.GlobalUsage.CPU != nil {
-		currentCPU = *resourceQuota.Status.GlobalUsage.CPU
-	}
-
-	var currentMem = resource.Quantity{}
-	if resourceQuota.Status.GlobalUsage.Memory != nil {
-		currentMem = *resourceQuota.Status.GlobalUsage.Memory
-	}
-
-	var currentStorage = resource.Quantity{}
-	if resourceQuota.Status.GlobalUsage.Storage != nil {
-		currentStorage = *resourceQuota.Status.GlobalUsage.Storage
-	}
-
-	// add requested resources to current usage and compare
-	combinedUsage := NewResourceDetails(currentCPU, currentMem, currentStorage)
-	combinedUsage.CPU().Add(*machineResourceUsage.CPU())
-	combinedUsage.Memory().Add(*machineResourceUsage.Memory())
-	combinedUsage.Storage().Add(*machineResourceUsage.Storage())
-
-	quota := resourceQuota.Spec.Quota
-	if quota.CPU != nil && quota.CPU.Cmp(*combinedUsage.CPU()) < 0 {
-		log.Debugw("requested CPU would exceed current quota", "request",
-			machineResourceUsage.CPU(), "quota", quota.CPU, "used", currentCPU.String())
-		return fmt.Errorf("requested CPU %q would exceed current quota (quota/used %q/%q)",
-			machineResourceUsage.CPU(), quota.CPU, currentCPU.String())
-	}
-
-	if quota.Memory != nil && quota.Memory.Cmp(*combinedUsage.Memory()) < 0 {
-		log.Debugw("requested Memory would exceed current quota", "request",
-			machineResourceUsage.Memory(), "quota", quota.Memory, "used", currentMem.String())
-		return fmt.Errorf("requested Memory %q would exceed current quota (quota/used %q/%q)",
-			machineResourceUsage.Memory(), quota.Memory, currentMem.String())
-	}
-
-	if quota.Storage != nil && quota.Storage.Cmp(*combinedUsage.Storage()) < 0 {
-		log.Debugw("requested disk size would exceed current quota", "request",
-			machineResourceUsage.Storage(), "quota", quota.Storage, "used", currentStorage.String())
-		return fmt.Errorf("requested disk size %q would exceed current quota (quota/used %q/%q)",
-			machineResourceUsage.Storage(), quota.Storage, currentStorage.String())
+	warnings, err := resourcequota.ValidateRequest(resourceQuota, machineResourceUsage.NodeUsage())
+	if err != nil {
+		log.Debugw("requested resources would exceed current quota", zap.Error(err))
+		return nil, err
 	}
 
-	return nil
+	return warnings, nil
 }
 
 type ResourceDetails struct {
 	cpu     resource.Quantity
 	mem     resource.Quantity
 	storage resource.Quantity
+	gpu     resource.Quantity
 }
 
 func NewResourceDetails(cpu resource.Quantity, mem resource.Quantity, storage resource.Quantity) *ResourceDetails {
@@ -126,11 +93,17 @@ func NewResourceDetailsFromCapacity(capacity *provider.NodeCapacity) (*ResourceD
 		return nil, errors.New("storage must not be nil")
 	}
 
-	return &ResourceDetails{
+	details := &ResourceDetails{
 		cpu:     *capacity.CPUCores,
 		mem:     *capacity.Memory,
 		storage: *capacity.Storage,
-	}, nil
+	}
+
+	if capacity.GPUs != nil {
+		details.gpu = *capacity.GPUs
+	}
+
+	return details, nil
 }
 
 func (r *ResourceDetails) CPU() *resource.Quantity {
@@ -144,3 +117,18 @@ func (r *ResourceDetails) Memory() *resource.Quantity {
 func (r *ResourceDetails) Storage() *resource.Quantity {
 	return &r.storage
 }
+
+func (r *ResourceDetails) GPUs() *resource.Quantity {
+	return &r.gpu
+}
+
+// NodeUsage returns the quota usage of a single node with these resources.
+func (r *ResourceDetails) NodeUsage() kubermaticv1.ResourceDetails {
+	return kubermaticv1.ResourceDetails{
+		CPU:     ptr.To(r.cpu.DeepCopy()),
+		Memory:  ptr.To(r.mem.DeepCopy()),
+		Storage: ptr.To(r.storage.DeepCopy()),
+		GPUs:    ptr.To(r.gpu.DeepCopy()),
+		Nodes:   resource.NewQuantity(1, resource.DecimalSI),
+	}
+}
@@ -36,15 +36,17 @@ import (
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 )
 
 func TestResourceQuotaValidation(t *testing.T) {
 	l := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
 
 	testCases := []struct {
-		name        string
-		machine     *clusterv1alpha1.Machine
-		expectedErr bool
+		name          string
+		machine       *clusterv1alpha1.Machine
+		resourceQuota *kubermaticv1.ResourceQuota
+		expectedErr   bool
 	}{
 		{
 			name:        "quota that fits should succeed",
@@ -66,11 +68,36 @@ func TestResourceQuotaValidation(t *testing.T) {
 			machine:     genFakeMachine("2", "2G", "5000G"),
 			expectedErr: true,
 		},
+		{
+			name:        "GPUs that fit should succeed",
+			machine:     genFakeGPUMachine("2", "2G", "10G", "1"),
+			expectedErr: false,
+		},
+		{
+			name:        "should fail with GPU quota exceeded",
+			machine:     genFakeGPUMachine("2", "2G", "10G", "2"),
+			expectedErr: true,
+		},
+		{
+			name:    "should fail with node quota exceeded",
+			machine: genFakeMachine("2", "2G", "10G"),
+			resourceQuota: func() *kubermaticv1.ResourceQuota {
+				rq := genResourceQuota()
+				rq.Status.GlobalUsage.Nodes = ptr.To(resource.MustParse("10"))
+				return rq
+			}(),
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := machine.ValidateQuota(context.Background(), l, nil, tc.machine, nil, genResourceQuota())
+			resourceQuota := tc.resourceQuota
+			if resourceQuota == nil {
+				resourceQuota = genResourceQuota()
+			}
+
+			_, err := machine.ValidateQuota(context.Background(), l, nil, tc.machine, nil, resourceQuota)
 			if err != nil {
 				if !tc.expectedErr {
 					t.Fatalf("unexpected error: %v", err)
@@ -90,10 +117,20 @@ func genFakeMachine(cpu, memory, storage string) *clusterv1alpha1.Machine {
 		nil, nil)
 }
 
+func genFakeGPUMachine(cpu, memory, storage, gpus string) *clusterv1alpha1.Machine {
+	return generator.GenTestMachine("fake",
+		fmt.Sprintf(`{"cloudProvider":"fake", "cloudProviderSpec":{"cpu":"%s","memory":"%s","storage":"%s","gpus":"%s"}}`, cpu, memory, storage, gpus),
+		nil, nil)
+}
+
 func genResourceQuota() *kubermaticv1.ResourceQuota {
 	rq := &kubermaticv1.ResourceQuota{}
 	rq.Spec.Quota = *kubermaticv1.NewResourceDetails(resource.MustParse("50"), resource.MustParse("50G"), resource.MustParse("1000G"))
+	rq.Spec.Quota.GPUs = ptr.To(resource.MustParse("2"))
+	rq.Spec.Quota.Nodes = ptr.To(resource.MustParse("10"))
 	rq.Status.GlobalUsage = *kubermaticv1.NewResourceDetails(resource.MustParse("3"), resource.MustParse("3G"), resource.MustParse("60G"))
+	rq.Status.GlobalUsage.GPUs = ptr.To(resource.MustParse("1"))
+	rq.Status.GlobalUsage.Nodes = ptr.To(resource.MustParse("3"))
 
 	return rq
 }
@@ -0,0 +1,244 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// quotaDimension holds the quota, the soft limit and a quantity of a single resource.
+type quotaDimension struct {
+	name      string
+	quota     *resource.Quantity
+	threshold *int32
+	value     *resource.Quantity
+}
+
+func quotaDimensions(resourceQuota *kubermaticv1.ResourceQuota, details kubermaticv1.ResourceDetails) []quotaDimension {
+	quota := resourceQuota.Spec.Quota
+
+	thresholds := kubermaticv1.ResourceThresholds{}
+	if resourceQuota.Spec.WarningThresholds != nil {
+		thresholds = *resourceQuota.Spec.WarningThresholds
+	}
+
+	return []quotaDimension{
+		{name: "CPU", quota: quota.CPU, threshold: thresholds.CPU, value: details.CPU},
+		{name: "Memory", quota: quota.Memory, threshold: thresholds.Memory, value: details.Memory},
+		{name: "disk size", quota: quota.Storage, threshold: thresholds.Storage, value: details.Storage},
+		{name: "GPUs", quota: quota.GPUs, threshold: thresholds.GPUs, value: details.GPUs},
+		{name: "nodes", quota: quota.Nodes, threshold: thresholds.Nodes, value: details.Nodes},
+		{name: "load balancers", quota: quota.LoadBalancers, threshold: thresholds.LoadBalancers, value: details.LoadBalancers},
+		{name: "clusters", quota: quota.Clusters, threshold: thresholds.Clusters, value: details.Clusters},
+	}
+}
+
+// percentOfQuota returns the usage in percent of the quota.
+func (d quotaDimension) percentOfQuota() float64 {
+	if d.quota.IsZero() {
+		return math.Inf(1)
+	}
+
+	return d.value.AsApproximateFloat64() / d.quota.AsApproximateFloat64() * 100
+}
+
+// softLimitReached returns true if the quantity of the dimension reached its soft limit.
+func (d quotaDimension) softLimitReached() bool {
+	return d.quota != nil && d.threshold != nil && d.value != nil && d.percentOfQuota() >= float64(*d.threshold)
+}
+
+// ValidateRequest validates if the requested resources fit in the quota, taking the current global usage
+// into account. Only resources that are both limited by the quota and part of the request are checked.
+// The returned warnings list the resources whose soft limit would be reached by the request.
+func ValidateRequest(resourceQuota *kubermaticv1.ResourceQuota, request kubermaticv1.ResourceDetails) ([]string, error) {
+	combinedUsage := *resourceQuota.Status.GlobalUsage.DeepCopy()
+	combinedUsage.Add(request)
+
+	used := quotaDimensions(resourceQuota, resourceQuota.Status.GlobalUsage)
+	combined := quotaDimensions(resourceQuota, combinedUsage)
+
+	var warnings []string
+	for i, requested := range quotaDimensions(resourceQuota, request) {
+		if requested.quota == nil || requested.value == nil || requested.value.IsZero() {
+			continue
+		}
+
+		current := resource.Quantity{}
+		if used[i].value != nil {
+			current = used[i].value.DeepCopy()
+		}
+
+		if requested.quota.Cmp(*combined[i].value) < 0 {
+			return nil, fmt.Errorf("requested %s %q would exceed current quota (quota/used %q/%q)",
+				requested.name, requested.value.String(), requested.quota.String(), current.String())
+		}
+
+		if combined[i].softLimitReached() {
+			warnings = append(warnings, fmt.Sprintf("requested %s %q would use %.0f%% of the quota %q, crossing the soft limit of %d%%",
+				requested.name, requested.value.String(), combined[i].percentOfQuota(), requested.quota.String(), *requested.threshold))
+		}
+	}
+
+	return warnings, nil
+}
+
+// UsageWarnings returns a message for every resource whose global usage reached its soft limit or
+// exceeds the quota. exceeded is true if the usage of at least one resource exceeds the quota.
+func UsageWarnings(resourceQuota *kubermaticv1.ResourceQuota) (warnings []string, exceeded bool) {
+	for _, d := range quotaDimensions(resourceQuota, resourceQuota.Status.GlobalUsage) {
+		if d.quota == nil || d.value == nil {
+			continue
+		}
+
+		switch {
+		case d.quota.Cmp(*d.value) < 0:
+			warnings = append(warnings, fmt.Sprintf("%s usage %q exceeds the quota %q", d.name, d.value.String(), d.quota.String()))
+			exceeded = true
+		case d.softLimitReached():
+			warnings = append(warnings, fmt.Sprintf("%s usage %q is at %.0f%% of the quota %q, crossing the soft limit of %d%%",
+				d.name, d.value.String(), d.percentOfQuota(), d.quota.String(), *d.threshold))
+		}
+	}
+
+	return warnings, exceeded
+}
+
+// ReachedLimits returns the names of all resources whose global usage reached the soft limit or exceeds
+// the quota, each suffixed with the limit. Unlike the messages of UsageWarnings, the result only changes
+// when a limit is crossed, not with every change of the usage.
+func ReachedLimits(resourceQuota *kubermaticv1.ResourceQuota) sets.Set[string] {
+	reached := sets.New[string]()
+	for _, d := range quotaDimensions(resourceQuota, resourceQuota.Status.GlobalUsage) {
+		if d.quota == nil || d.value == nil {
+			continue
+		}
+
+		switch {
+		case d.quota.Cmp(*d.value) < 0:
+			reached.Insert(d.name + " quota")
+		case d.softLimitReached():
+			reached.Insert(d.name + " soft limit")
+		}
+	}
+
+	return reached
+}
+
+// ValidateMachineDeploymentQuota validates if the Machines that are added by creating or scaling up a
+// MachineDeployment fit in the quota. machineUsage is the resource usage of a single Machine of the
+// MachineDeployment, oldMachineDeployment is nil if the MachineDeployment is being created.
+func ValidateMachineDeploymentQuota(resourceQuota *kubermaticv1.ResourceQuota, oldMachineDeployment, newMachineDeployment *clusterv1alpha1.MachineDeployment,
+	machineUsage kubermaticv1.ResourceDetails) ([]string, error) {
+	addedReplicas := machineDeploymentReplicas(newMachineDeployment)
+	if oldMachineDeployment != nil {
+		addedReplicas -= machineDeploymentReplicas(oldMachineDeployment)
+	}
+
+	if addedReplicas <= 0 {
+		return nil, nil
+	}
+
+	return ValidateRequest(resourceQuota, multiplyResourceDetails(machineUsage, addedReplicas))
+}
+
+// ValidateClusterQuota validates if creating the given cluster fits in the cluster quota of its project.
+func ValidateClusterQuota(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) ([]string, error) {
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	if projectID == "" {
+		return nil, nil
+	}
+
+	resourceQuota, err := GetProjectResourceQuota(ctx, client, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if resourceQuota == nil {
+		return nil, nil
+	}
+
+	return ValidateRequest(resourceQuota, kubermaticv1.ResourceDetails{
+		Clusters: resource.NewQuantity(1, resource.DecimalSI),
+	})
+}
+
+// GetProjectResourceQuota returns the resource quota of the given project or nil if the project has none.
+func GetProjectResourceQuota(ctx context.Context, client ctrlruntimeclient.Client, projectID string) (*kubermaticv1.ResourceQuota, error) {
+	quotaList := &kubermaticv1.ResourceQuotaList{}
+	if err := client.List(ctx, quotaList, ctrlruntimeclient.MatchingLabels{
+		kubermaticv1.ResourceQuotaSubjectNameLabelKey: projectID,
+		kubermaticv1.ResourceQuotaSubjectKindLabelKey: kubermaticv1.ProjectSubjectKind,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+
+	if len(quotaList.Items) == 0 {
+		return nil, nil
+	}
+
+	return &quotaList.Items[0], nil
+}
+
+func machineDeploymentReplicas(md *clusterv1alpha1.MachineDeployment) int64 {
+	// MachineDeployments without replicas are defaulted to a single replica
+	if md.Spec.Replicas == nil {
+		return 1
+	}
+
+	return int64(*md.Spec.Replicas)
+}
+
+func multiplyResourceDetails(details kubermaticv1.ResourceDetails, factor int64) kubermaticv1.ResourceDetails {
+	return kubermaticv1.ResourceDetails{
+		CPU:           multiplyQuantity(details.CPU, factor),
+		Memory:        multiplyQuantity(details.Memory, factor),
+		Storage:       multiplyQuantity(details.Storage, factor),
+		GPUs:          multiplyQuantity(details.GPUs, factor),
+		Nodes:         multiplyQuantity(details.Nodes, factor),
+		LoadBalancers: multiplyQuantity(details.LoadBalancers, factor),
+		Clusters:      multiplyQuantity(details.Clusters, factor),
+	}
+}
+
+// multiplyQuantity returns a copy of q scaled by factor. Quantity.Mul falls back to
+// arbitrary precision on int64 overflow, so the result is exact for any replica count.
+func multiplyQuantity(q *resource.Quantity, factor int64) *resource.Quantity {
+	if q == nil {
+		return nil
+	}
+
+	result := q.DeepCopy()
+	result.Mul(factor)
+
+	return &result
+}
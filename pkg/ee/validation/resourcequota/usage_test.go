@@ -0,0 +1,268 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota_test
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateMachineDeploymentQuota(t *testing.T) {
+	machineUsage := kubermaticv1.ResourceDetails{
+		CPU:     ptr.To(resource.MustParse("2")),
+		Memory:  ptr.To(resource.MustParse("4G")),
+		Storage: ptr.To(resource.MustParse("10G")),
+		GPUs:    ptr.To(resource.MustParse("1")),
+		Nodes:   ptr.To(resource.MustParse("1")),
+	}
+
+	testCases := []struct {
+		name                 string
+		oldMachineDeployment *clusterv1alpha1.MachineDeployment
+		newMachineDeployment *clusterv1alpha1.MachineDeployment
+		errExpected          bool
+	}{
+		{
+			name:                 "creating a MachineDeployment that fits in the quota",
+			newMachineDeployment: genMachineDeployment(2),
+			errExpected:          false,
+		},
+		{
+			name:                 "creating a MachineDeployment that exceeds the GPU quota",
+			newMachineDeployment: genMachineDeployment(3),
+			errExpected:          true,
+		},
+		{
+			name:                 "scaling up a MachineDeployment within the quota",
+			oldMachineDeployment: genMachineDeployment(5),
+			newMachineDeployment: genMachineDeployment(7),
+			errExpected:          false,
+		},
+		{
+			name:                 "scaling up a MachineDeployment beyond the quota",
+			oldMachineDeployment: genMachineDeployment(5),
+			newMachineDeployment: genMachineDeployment(8),
+			errExpected:          true,
+		},
+		{
+			name:                 "scaling down is always allowed",
+			oldMachineDeployment: genMachineDeployment(20),
+			newMachineDeployment: genMachineDeployment(10),
+			errExpected:          false,
+		},
+		{
+			name:                 "creating a MachineDeployment with a huge replica count",
+			newMachineDeployment: genMachineDeployment(math.MaxInt32),
+			errExpected:          true,
+		},
+		{
+			name:                 "scaling up a MachineDeployment to a huge replica count",
+			oldMachineDeployment: genMachineDeployment(1),
+			newMachineDeployment: genMachineDeployment(math.MaxInt32),
+			errExpected:          true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := resourcequota.ValidateMachineDeploymentQuota(genUsageResourceQuota("project1"), tc.oldMachineDeployment, tc.newMachineDeployment, machineUsage)
+			if (err != nil) != tc.errExpected {
+				t.Fatalf("Expected err: %t, but got err: %v", tc.errExpected, err)
+			}
+		})
+	}
+}
+
+func TestValidateClusterQuota(t *testing.T) {
+	testCases := []struct {
+		name         string
+		clusterCount string
+		cluster      *kubermaticv1.Cluster
+		errExpected  bool
+	}{
+		{
+			name:         "cluster that fits in the quota",
+			clusterCount: "2",
+			cluster:      genProjectCluster("project1"),
+			errExpected:  false,
+		},
+		{
+			name:         "cluster that exceeds the quota",
+			clusterCount: "3",
+			cluster:      genProjectCluster("project1"),
+			errExpected:  true,
+		},
+		{
+			name:         "cluster in a project without quota",
+			clusterCount: "3",
+			cluster:      genProjectCluster("project2"),
+			errExpected:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceQuota := genUsageResourceQuota("project1")
+			resourceQuota.Status.GlobalUsage.Clusters = ptr.To(resource.MustParse(tc.clusterCount))
+
+			client := fake.NewClientBuilder().WithObjects(resourceQuota).Build()
+
+			_, err := resourcequota.ValidateClusterQuota(context.Background(), client, tc.cluster)
+			if (err != nil) != tc.errExpected {
+				t.Fatalf("Expected err: %t, but got err: %v", tc.errExpected, err)
+			}
+		})
+	}
+}
+
+func genUsageResourceQuota(projectID string) *kubermaticv1.ResourceQuota {
+	return &kubermaticv1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "project-" + projectID,
+			Labels: map[string]string{
+				kubermaticv1.ResourceQuotaSubjectNameLabelKey: projectID,
+				kubermaticv1.ResourceQuotaSubjectKindLabelKey: kubermaticv1.ProjectSubjectKind,
+			},
+		},
+		Spec: kubermaticv1.ResourceQuotaSpec{
+			Subject: kubermaticv1.Subject{
+				Name: projectID,
+				Kind: kubermaticv1.ProjectSubjectKind,
+			},
+			Quota: kubermaticv1.ResourceDetails{
+				CPU:      ptr.To(resource.MustParse("100")),
+				GPUs:     ptr.To(resource.MustParse("4")),
+				Nodes:    ptr.To(resource.MustParse("10")),
+				Clusters: ptr.To(resource.MustParse("3")),
+			},
+		},
+		Status: kubermaticv1.ResourceQuotaStatus{
+			GlobalUsage: kubermaticv1.ResourceDetails{
+				CPU:   ptr.To(resource.MustParse("10")),
+				GPUs:  ptr.To(resource.MustParse("2")),
+				Nodes: ptr.To(resource.MustParse("5")),
+			},
+		},
+	}
+}
+
+func genMachineDeployment(replicas int32) *clusterv1alpha1.MachineDeployment {
+	return &clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "md",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: clusterv1alpha1.MachineDeploymentSpec{
+			Replicas: ptr.To(replicas),
+		},
+	}
+}
+
+func genProjectCluster(projectID string) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "cluster",
+			Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: projectID},
+		},
+	}
+}
+
+func TestValidateRequestWarnings(t *testing.T) {
+	testCases := []struct {
+		name             string
+		thresholds       *kubermaticv1.ResourceThresholds
+		request          kubermaticv1.ResourceDetails
+		expectedWarnings []string
+	}{
+		{
+			name:    "no warnings without thresholds",
+			request: kubermaticv1.ResourceDetails{GPUs: ptr.To(resource.MustParse("2"))},
+		},
+		{
+			name:       "request below the soft limit",
+			thresholds: &kubermaticv1.ResourceThresholds{GPUs: ptr.To[int32](80)},
+			request:    kubermaticv1.ResourceDetails{GPUs: ptr.To(resource.MustParse("1"))},
+		},
+		{
+			name:       "request crossing the soft limit",
+			thresholds: &kubermaticv1.ResourceThresholds{GPUs: ptr.To[int32](80), CPU: ptr.To[int32](80)},
+			request: kubermaticv1.ResourceDetails{
+				CPU:  ptr.To(resource.MustParse("2")),
+				GPUs: ptr.To(resource.MustParse("2")),
+			},
+			expectedWarnings: []string{`requested GPUs "2" would use 100% of the quota "4", crossing the soft limit of 80%`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceQuota := genUsageResourceQuota("project1")
+			resourceQuota.Spec.WarningThresholds = tc.thresholds
+
+			warnings, err := resourcequota.ValidateRequest(resourceQuota, tc.request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(warnings, tc.expectedWarnings) {
+				t.Fatalf("Expected warnings %v, got %v", tc.expectedWarnings, warnings)
+			}
+		})
+	}
+}
+
+func TestUsageWarnings(t *testing.T) {
+	resourceQuota := genUsageResourceQuota("project1")
+	resourceQuota.Spec.WarningThresholds = &kubermaticv1.ResourceThresholds{
+		CPU:   ptr.To[int32](80),
+		Nodes: ptr.To[int32](50),
+	}
+	resourceQuota.Status.GlobalUsage.GPUs = ptr.To(resource.MustParse("5"))
+
+	warnings, exceeded := resourcequota.UsageWarnings(resourceQuota)
+
+	expectedWarnings := []string{
+		`GPUs usage "5" exceeds the quota "4"`,
+		`nodes usage "5" is at 50% of the quota "10", crossing the soft limit of 50%`,
+	}
+	if !reflect.DeepEqual(warnings, expectedWarnings) {
+		t.Fatalf("Expected warnings %v, got %v", expectedWarnings, warnings)
+	}
+
+	if !exceeded {
+		t.Fatal("Expected quota to be exceeded")
+	}
+}
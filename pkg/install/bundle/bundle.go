@@ -0,0 +1,431 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bundle implements the offline bundles used for air-gapped
+// installations. A bundle is a gzipped tarball containing a manifest and
+// all files required to install KKP (images, binaries, Helm charts, addons
+// and the installer itself), each of which is checksummed in the manifest.
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// ManifestFile is the name of the manifest at the root of every bundle.
+	ManifestFile = "bundle.json"
+
+	// ImagesArchive is the image archive (as created by crane) within a bundle.
+	ImagesArchive = "images.tar"
+	// BinariesDirectory contains the mirrored binaries, in the same layout
+	// as created by the mirror-binaries command.
+	BinariesDirectory = "binaries"
+	// ChartsDirectory contains the KKP Helm charts, including their dependencies
+	// and CRDs.
+	ChartsDirectory = "charts"
+	// AddonsDirectory contains the addon manifests.
+	AddonsDirectory = "addons"
+	// InstallerBinary is the kubermatic-installer that created the bundle.
+	InstallerBinary = "kubermatic-installer"
+
+	// FormatVersion is incremented whenever the bundle layout changes in an
+	// incompatible way.
+	FormatVersion = 1
+)
+
+// Manifest describes the contents of a bundle.
+type Manifest struct {
+	FormatVersion     int       `json:"formatVersion"`
+	KubermaticVersion string    `json:"kubermaticVersion"`
+	KubermaticEdition string    `json:"kubermaticEdition"`
+	CreatedAt         time.Time `json:"createdAt"`
+
+	// VersionFilter and ProviderFilter are the filters that were used to
+	// collect the images in the bundle.
+	VersionFilter  string   `json:"versionFilter,omitempty"`
+	ProviderFilter []string `json:"providerFilter,omitempty"`
+	Architectures  []string `json:"architectures,omitempty"`
+
+	Files []File `json:"files"`
+}
+
+// File is a single file in a bundle.
+type File struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Write checksums all files in sourceDir, adds them to the manifest and
+// writes the bundle to archivePath. Only regular files and directories are
+// supported.
+func Write(sourceDir string, archivePath string, manifest *Manifest) error {
+	manifest.FormatVersion = FormatVersion
+	manifest.Files = nil
+
+	err := filepath.WalkDir(sourceDir, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		if !entry.Type().IsRegular() {
+			return fmt.Errorf("%s is not a regular file", filename)
+		}
+
+		relPath, err := relativePath(sourceDir, filename)
+		if err != nil {
+			return err
+		}
+
+		if relPath == ManifestFile {
+			return fmt.Errorf("%s is reserved for the bundle manifest", ManifestFile)
+		}
+
+		size, checksum, err := checksumFile(filename)
+		if err != nil {
+			return err
+		}
+
+		manifest.Files = append(manifest.Files, File{
+			Path:   relPath,
+			Size:   size,
+			SHA256: checksum,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to collect files: %w", err)
+	}
+
+	// WalkDir is lexical already, but be explicit about the order being part
+	// of the format
+	slices.SortFunc(manifest.Files, func(a, b File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	f, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", archivePath, err)
+	}
+	defer f.Close()
+
+	gzipWriter := gzip.NewWriter(f)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	encodedManifest, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:    ManifestFile,
+		Mode:    0o644,
+		Size:    int64(len(encodedManifest)),
+		ModTime: manifest.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	if _, err := tarWriter.Write(encodedManifest); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	for _, file := range manifest.Files {
+		if err := addFile(tarWriter, sourceDir, file); err != nil {
+			return fmt.Errorf("failed to add %s: %w", file.Path, err)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to finish compression: %w", err)
+	}
+
+	return f.Close()
+}
+
+func addFile(tarWriter *tar.Writer, sourceDir string, file File) error {
+	filename := filepath.Join(sourceDir, filepath.FromSlash(file.Path))
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+
+	if info.Size() != file.Size {
+		return errors.New("file was modified while creating the bundle")
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = file.Path
+
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(tarWriter, f)
+
+	return err
+}
+
+// Extract unpacks the bundle at archivePath into targetDir and verifies all
+// files against the checksums in the bundle's manifest. targetDir must not
+// exist or be empty.
+func Extract(archivePath string, targetDir string) (*Manifest, error) {
+	entries, err := os.ReadDir(targetDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read %s: %w", targetDir, err)
+	}
+	if len(entries) > 0 {
+		return nil, fmt.Errorf("%s is not empty", targetDir)
+	}
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", archivePath, err)
+	}
+	defer f.Close()
+
+	gzipReader, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress bundle: %w", err)
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+
+	var manifest *Manifest
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle: %w", err)
+		}
+
+		// prevent path traversal (https://cwe.mitre.org/data/definitions/22.html)
+		name := path.Clean(header.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("bundle contains invalid path %q", header.Name)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("bundle contains unsupported entry %q", header.Name)
+		}
+
+		if name == ManifestFile {
+			manifest = &Manifest{}
+			if err := json.NewDecoder(tarReader).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("failed to decode manifest: %w", err)
+			}
+
+			if manifest.FormatVersion != FormatVersion {
+				return nil, fmt.Errorf("unsupported bundle format version %d, this installer supports version %d", manifest.FormatVersion, FormatVersion)
+			}
+
+			continue
+		}
+
+		filename := filepath.Join(targetDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+
+		if err := extractFile(tarReader, filename, os.FileMode(header.Mode).Perm()); err != nil {
+			return nil, fmt.Errorf("failed to extract %s: %w", header.Name, err)
+		}
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("bundle does not contain a %s", ManifestFile)
+	}
+
+	if err := Verify(targetDir, manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func extractFile(reader io.Reader, filename string, mode os.FileMode) error {
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, reader); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// Verify checks that dir contains exactly the files listed in the manifest
+// and that their checksums match.
+func Verify(dir string, manifest *Manifest) error {
+	expected := map[string]File{}
+	for _, file := range manifest.Files {
+		expected[file.Path] = file
+	}
+
+	err := filepath.WalkDir(dir, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		relPath, err := relativePath(dir, filename)
+		if err != nil {
+			return err
+		}
+
+		file, ok := expected[relPath]
+		if !ok {
+			return fmt.Errorf("%s is not part of the bundle manifest", relPath)
+		}
+		delete(expected, relPath)
+
+		size, checksum, err := checksumFile(filename)
+		if err != nil {
+			return err
+		}
+
+		if size != file.Size || checksum != file.SHA256 {
+			return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", relPath, file.SHA256, checksum)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify bundle: %w", err)
+	}
+
+	if len(expected) > 0 {
+		missing := make([]string, 0, len(expected))
+		for filename := range expected {
+			missing = append(missing, filename)
+		}
+		slices.Sort(missing)
+
+		return fmt.Errorf("failed to verify bundle: missing files %v", missing)
+	}
+
+	return nil
+}
+
+func relativePath(root, filename string) (string, error) {
+	relPath, err := filepath.Rel(root, filename)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.ToSlash(relPath), nil
+}
+
+func checksumFile(filename string) (int64, string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to checksum %s: %w", filename, err)
+	}
+
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// CopyDirectory recursively copies the files in src into dst. Symlinks are
+// resolved, so that the copy only contains regular files.
+func CopyDirectory(src string, dst string) error {
+	return filepath.WalkDir(src, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(src, filename)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dst, relPath)
+
+		info, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if entry.Type()&fs.ModeSymlink != 0 {
+				return CopyDirectory(filename, target)
+			}
+
+			return os.MkdirAll(target, 0o755)
+		}
+
+		source, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer source.Close()
+
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+
+		if err := extractFile(source, target, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to copy %s: %w", filename, err)
+		}
+
+		return nil
+	})
+}
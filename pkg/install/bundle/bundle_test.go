@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, filename string, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+}
+
+func TestWriteAndExtract(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeFile(t, filepath.Join(sourceDir, ImagesArchive), "images")
+	writeFile(t, filepath.Join(sourceDir, ChartsDirectory, "test", "Chart.yaml"), "name: test\n")
+	writeFile(t, filepath.Join(sourceDir, AddonsDirectory, "test", "addon.yaml"), "kind: ConfigMap\n")
+
+	archivePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+
+	manifest := &Manifest{
+		KubermaticVersion: "v2.30.0",
+		CreatedAt:         time.Now().UTC().Truncate(time.Second),
+	}
+
+	if err := Write(sourceDir, archivePath, manifest); err != nil {
+		t.Fatalf("Failed to write bundle: %v", err)
+	}
+
+	if len(manifest.Files) != 3 {
+		t.Fatalf("Expected 3 files in manifest, got %d.", len(manifest.Files))
+	}
+
+	targetDir := filepath.Join(t.TempDir(), "extracted")
+
+	extracted, err := Extract(archivePath, targetDir)
+	if err != nil {
+		t.Fatalf("Failed to extract bundle: %v", err)
+	}
+
+	if extracted.KubermaticVersion != manifest.KubermaticVersion {
+		t.Errorf("Expected version %q, got %q.", manifest.KubermaticVersion, extracted.KubermaticVersion)
+	}
+
+	content, err := os.ReadFile(filepath.Join(targetDir, ChartsDirectory, "test", "Chart.yaml"))
+	if err != nil {
+		t.Fatalf("Failed to read extracted file: %v", err)
+	}
+
+	if string(content) != "name: test\n" {
+		t.Errorf("Unexpected content of extracted file: %q", string(content))
+	}
+
+	// extracting into a non-empty directory must fail
+	if _, err := Extract(archivePath, targetDir); err == nil {
+		t.Error("Expected extracting into a non-empty directory to fail.")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	sourceDir := t.TempDir()
+	writeFile(t, filepath.Join(sourceDir, "a.txt"), "a")
+	writeFile(t, filepath.Join(sourceDir, "b", "b.txt"), "b")
+
+	manifest := &Manifest{}
+	if err := Write(sourceDir, filepath.Join(t.TempDir(), "bundle.tar.gz"), manifest); err != nil {
+		t.Fatalf("Failed to write bundle: %v", err)
+	}
+
+	testCases := []struct {
+		name          string
+		modify        func(dir string)
+		expectedError string
+	}{
+		{
+			name:   "unmodified",
+			modify: func(dir string) {},
+		},
+		{
+			name: "modified file",
+			modify: func(dir string) {
+				writeFile(t, filepath.Join(dir, "a.txt"), "x")
+			},
+			expectedError: "checksum mismatch for a.txt",
+		},
+		{
+			name: "missing file",
+			modify: func(dir string) {
+				if err := os.Remove(filepath.Join(dir, "b", "b.txt")); err != nil {
+					t.Fatalf("Failed to remove file: %v", err)
+				}
+			},
+			expectedError: "missing files [b/b.txt]",
+		},
+		{
+			name: "additional file",
+			modify: func(dir string) {
+				writeFile(t, filepath.Join(dir, "c.txt"), "c")
+			},
+			expectedError: "c.txt is not part of the bundle manifest",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := CopyDirectory(sourceDir, dir); err != nil {
+				t.Fatalf("Failed to copy directory: %v", err)
+			}
+
+			tc.modify(dir)
+
+			err := Verify(dir, manifest)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v.", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("Expected error containing %q, got %v.", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestExtractRejectsPathTraversal(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+
+	f, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("Failed to create archive: %v", err)
+	}
+
+	gzipWriter := gzip.NewWriter(f)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	content := []byte("evil")
+	if err := tarWriter.WriteHeader(&tar.Header{Name: "../evil.txt", Mode: 0o644, Size: int64(len(content))}); err != nil {
+		t.Fatalf("Failed to write header: %v", err)
+	}
+	if _, err := tarWriter.Write(content); err != nil {
+		t.Fatalf("Failed to write content: %v", err)
+	}
+
+	tarWriter.Close()
+	gzipWriter.Close()
+	f.Close()
+
+	targetDir := filepath.Join(t.TempDir(), "extracted")
+
+	if _, err := Extract(archivePath, targetDir); err == nil || !strings.Contains(err.Error(), "invalid path") {
+		t.Fatalf("Expected path traversal to be rejected, got %v.", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(filepath.Dir(targetDir), "evil.txt")); err == nil {
+		t.Fatal("File outside of the target directory was created.")
+	}
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/validation"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	certutil "k8s.io/client-go/util/cert"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// checkCertificates reports expired and soon to expire certificates in all
+// TLS Secrets in the KKP namespace.
+func checkCertificates(ctx context.Context, report *Report, target string, client ctrlruntimeclient.Client, namespace string, doctorOpt Options) {
+	secrets := &corev1.SecretList{}
+	if err := client.List(ctx, secrets, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		report.add(CheckCertificates, target, StatusError, "failed to list Secrets: %v", err)
+		return
+	}
+
+	checked := 0
+	problems := 0
+
+	for _, secret := range secrets.Items {
+		if secret.Type != corev1.SecretTypeTLS {
+			continue
+		}
+
+		key := namespace + "/" + secret.Name
+
+		certs, err := certutil.ParseCertsPEM(secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			report.add(CheckCertificates, target, StatusWarning, "Secret %s does not contain a valid certificate: %v", key, err)
+			problems++
+			continue
+		}
+
+		checked++
+
+		// the first certificate is the leaf, the remaining ones are intermediates
+		notAfter := certs[0].NotAfter
+		remaining := notAfter.Sub(doctorOpt.Now)
+
+		switch {
+		case remaining <= 0:
+			report.add(CheckCertificates, target, StatusError, "certificate in Secret %s expired on %s", key, notAfter.Format(time.RFC3339))
+			problems++
+		case remaining < doctorOpt.CertificateWarningPeriod:
+			report.add(CheckCertificates, target, StatusWarning, "certificate in Secret %s expires on %s", key, notAfter.Format(time.RFC3339))
+			problems++
+		}
+	}
+
+	if problems == 0 {
+		report.add(CheckCertificates, target, StatusOK, "%d certificates are valid", checked)
+	}
+}
+
+// checkCRDVersions compares the version annotation of all KKP CRDs with the
+// installer's version.
+func checkCRDVersions(ctx context.Context, report *Report, target string, client ctrlruntimeclient.Client, version string) {
+	crds := &metav1.PartialObjectMetadataList{}
+	crds.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinitionList"))
+
+	if err := client.List(ctx, crds); err != nil {
+		report.add(CheckCRDVersions, target, StatusError, "failed to list CRDs: %v", err)
+		return
+	}
+
+	crdVersions := map[string]int{}
+	for _, crd := range crds.Items {
+		if !strings.HasSuffix(crd.Name, "."+kubermaticv1.GroupName) && !strings.HasSuffix(crd.Name, "."+appskubermaticv1.GroupName) {
+			continue
+		}
+
+		crdVersion := crd.Annotations[resources.VersionLabel]
+		if crdVersion == "" {
+			crdVersion = "unknown"
+		}
+
+		crdVersions[crdVersion]++
+	}
+
+	switch len(crdVersions) {
+	case 0:
+		report.add(CheckCRDVersions, target, StatusWarning, "no KKP CRDs found")
+
+	case 1:
+		for crdVersion, count := range crdVersions {
+			if crdVersion == version {
+				report.add(CheckCRDVersions, target, StatusOK, "%d CRDs match the installer version %s", count, version)
+			} else {
+				report.add(CheckCRDVersions, target, StatusWarning, "%d CRDs are at version %s, but the installer is %s", count, crdVersion, version)
+			}
+		}
+
+	default:
+		keys := make([]string, 0, len(crdVersions))
+		for crdVersion := range crdVersions {
+			keys = append(keys, crdVersion)
+		}
+		slices.Sort(keys)
+
+		details := make([]string, 0, len(keys))
+		for _, crdVersion := range keys {
+			details = append(details, fmt.Sprintf("%s (%d)", crdVersion, crdVersions[crdVersion]))
+		}
+
+		report.add(CheckCRDVersions, target, StatusError, "CRDs have mixed versions: %s", strings.Join(details, ", "))
+	}
+}
+
+// checkWebhooks verifies that all Services used by admission webhooks in the
+// KKP namespace exist and have ready endpoints.
+func checkWebhooks(ctx context.Context, report *Report, target string, client ctrlruntimeclient.Client, namespace string) {
+	services := map[types.NamespacedName][]string{}
+
+	addService := func(webhook string, clientConfig admissionregistrationv1.WebhookClientConfig) {
+		if service := clientConfig.Service; service != nil && service.Namespace == namespace {
+			key := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}
+			services[key] = append(services[key], webhook)
+		}
+	}
+
+	validating := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := client.List(ctx, validating); err != nil {
+		report.add(CheckWebhooks, target, StatusError, "failed to list ValidatingWebhookConfigurations: %v", err)
+		return
+	}
+
+	for _, config := range validating.Items {
+		for _, webhook := range config.Webhooks {
+			addService(webhook.Name, webhook.ClientConfig)
+		}
+	}
+
+	mutating := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := client.List(ctx, mutating); err != nil {
+		report.add(CheckWebhooks, target, StatusError, "failed to list MutatingWebhookConfigurations: %v", err)
+		return
+	}
+
+	for _, config := range mutating.Items {
+		for _, webhook := range config.Webhooks {
+			addService(webhook.Name, webhook.ClientConfig)
+		}
+	}
+
+	keys := make([]types.NamespacedName, 0, len(services))
+	for key := range services {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	problems := 0
+	for _, key := range keys {
+		webhooks := strings.Join(services[key], ", ")
+
+		if err := client.Get(ctx, key, &corev1.Service{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				report.add(CheckWebhooks, target, StatusError, "Service %s used by %s does not exist", key, webhooks)
+			} else {
+				report.add(CheckWebhooks, target, StatusError, "failed to get Service %s: %v", key, err)
+			}
+			problems++
+			continue
+		}
+
+		endpointSlices := &discoveryv1.EndpointSliceList{}
+		if err := client.List(ctx, endpointSlices, ctrlruntimeclient.InNamespace(key.Namespace), ctrlruntimeclient.MatchingLabels{discoveryv1.LabelServiceName: key.Name}); err != nil {
+			report.add(CheckWebhooks, target, StatusError, "failed to list EndpointSlices for Service %s: %v", key, err)
+			problems++
+			continue
+		}
+
+		if readyEndpoints(endpointSlices.Items) == 0 {
+			report.add(CheckWebhooks, target, StatusError, "Service %s used by %s has no ready endpoints", key, webhooks)
+			problems++
+		}
+	}
+
+	if problems == 0 {
+		report.add(CheckWebhooks, target, StatusOK, "%d webhook Services have ready endpoints", len(keys))
+	}
+}
+
+func readyEndpoints(endpointSlices []discoveryv1.EndpointSlice) int {
+	ready := 0
+	for _, slice := range endpointSlices {
+		for _, endpoint := range slice.Endpoints {
+			// nil must be interpreted as ready
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				ready++
+			}
+		}
+	}
+
+	return ready
+}
+
+// checkClusters reports user clusters that are stuck in deletion or whose
+// control plane has not become healthy.
+func checkClusters(ctx context.Context, report *Report, target string, client ctrlruntimeclient.Client, doctorOpt Options) {
+	clusters := &kubermaticv1.ClusterList{}
+	if err := client.List(ctx, clusters); err != nil {
+		report.add(CheckClusters, target, StatusError, "failed to list Clusters: %v", err)
+		return
+	}
+
+	problems := 0
+	for _, cluster := range clusters.Items {
+		switch {
+		case cluster.DeletionTimestamp != nil:
+			if doctorOpt.Now.Sub(cluster.DeletionTimestamp.Time) > doctorOpt.StuckClusterThreshold {
+				report.add(CheckClusters, target, StatusError, "cluster %s has been in deletion since %s (finalizers: %s)", cluster.Name, cluster.DeletionTimestamp.Format(time.RFC3339), strings.Join(cluster.Finalizers, ", "))
+				problems++
+			}
+
+		case cluster.Spec.Pause:
+			continue
+
+		case doctorOpt.Now.Sub(cluster.CreationTimestamp.Time) > doctorOpt.StuckClusterThreshold && !cluster.Status.ExtendedHealth.ControlPlaneHealthy():
+			report.add(CheckClusters, target, StatusWarning, "control plane of cluster %s is unhealthy (phase %q)", cluster.Name, cluster.Status.Phase)
+			problems++
+		}
+	}
+
+	if problems == 0 {
+		report.add(CheckClusters, target, StatusOK, "%d clusters are healthy", len(clusters.Items))
+	}
+}
+
+// checkEtcdBackups reports scheduled etcd backups whose last successful run
+// is older than the configured number of backup intervals.
+func checkEtcdBackups(ctx context.Context, report *Report, target string, client ctrlruntimeclient.Client, doctorOpt Options) {
+	configs := &kubermaticv1.EtcdBackupConfigList{}
+	if err := client.List(ctx, configs); err != nil {
+		report.add(CheckEtcdBackups, target, StatusError, "failed to list EtcdBackupConfigs: %v", err)
+		return
+	}
+
+	parser := validation.GetCronExpressionParser()
+
+	checked := 0
+	problems := 0
+
+	for _, config := range configs.Items {
+		// one-off backups have no schedule that could be missed
+		if config.Spec.Schedule == "" || config.DeletionTimestamp != nil {
+			continue
+		}
+
+		key := config.Namespace + "/" + config.Name
+
+		schedule, err := parser.Parse(config.Spec.Schedule)
+		if err != nil {
+			report.add(CheckEtcdBackups, target, StatusWarning, "EtcdBackupConfig %s has an invalid schedule: %v", key, err)
+			problems++
+			continue
+		}
+
+		checked++
+
+		next := schedule.Next(doctorOpt.Now)
+		maxAge := time.Duration(doctorOpt.BackupTolerance) * schedule.Next(next).Sub(next)
+
+		var latest *kubermaticv1.BackupStatus
+		for i, backup := range config.Status.CurrentBackups {
+			if backup.BackupPhase != kubermaticv1.BackupStatusPhaseCompleted {
+				continue
+			}
+
+			if latest == nil || backup.BackupFinishedTime.After(latest.BackupFinishedTime.Time) {
+				latest = &config.Status.CurrentBackups[i]
+			}
+		}
+
+		switch {
+		case latest == nil:
+			if doctorOpt.Now.Sub(config.CreationTimestamp.Time) > maxAge {
+				report.add(CheckEtcdBackups, target, StatusError, "EtcdBackupConfig %s has no successful backup", key)
+				problems++
+			}
+
+		case doctorOpt.Now.Sub(latest.BackupFinishedTime.Time) > maxAge:
+			report.add(CheckEtcdBackups, target, StatusError, "last successful backup of EtcdBackupConfig %s finished at %s", key, latest.BackupFinishedTime.Format(time.RFC3339))
+			problems++
+
+		case latest.VerificationPhase == kubermaticv1.BackupStatusPhaseFailed:
+			report.add(CheckEtcdBackups, target, StatusWarning, "verification of the last backup of EtcdBackupConfig %s failed: %s", key, latest.VerificationMessage)
+			problems++
+		}
+	}
+
+	if problems == 0 {
+		report.add(CheckEtcdBackups, target, StatusOK, "%d scheduled backups are up-to-date", checked)
+	}
+}
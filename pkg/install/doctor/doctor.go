@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package doctor examines an existing KKP installation without changing it.
+// It runs the preflight validations of an installer stack and a number of
+// additional health checks against the master and all seed clusters.
+package doctor
+
+import (
+	"context"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/install/stack"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Names of the checks, as used in reports.
+const (
+	CheckConfiguration    = "configuration"
+	CheckState            = "state"
+	CheckSeedConnectivity = "seed-connectivity"
+	CheckCertificates     = "certificates"
+	CheckCRDVersions      = "crd-versions"
+	CheckWebhooks         = "webhooks"
+	CheckClusters         = "clusters"
+	CheckEtcdBackups      = "etcd-backups"
+)
+
+const (
+	defaultKKPNamespace = "kubermatic"
+	masterTarget        = "master"
+	seedTargetPrefix    = "seed/"
+
+	defaultCertificateWarningPeriod = 30 * 24 * time.Hour
+	defaultStuckClusterThreshold    = time.Hour
+	defaultBackupTolerance          = 2
+)
+
+// Options configure the thresholds used by the health checks.
+type Options struct {
+	// CertificateWarningPeriod is the remaining validity below which a
+	// certificate is reported as a warning.
+	CertificateWarningPeriod time.Duration
+	// StuckClusterThreshold is the time after which a cluster that is still
+	// being deleted or whose control plane is unhealthy is reported.
+	StuckClusterThreshold time.Duration
+	// BackupTolerance is the number of backup intervals an etcd backup may be
+	// late before it is reported.
+	BackupTolerance int
+	// Now is used instead of the current time, if set.
+	Now time.Time
+}
+
+func (o *Options) setDefaults() {
+	if o.CertificateWarningPeriod == 0 {
+		o.CertificateWarningPeriod = defaultCertificateWarningPeriod
+	}
+	if o.StuckClusterThreshold == 0 {
+		o.StuckClusterThreshold = defaultStuckClusterThreshold
+	}
+	if o.BackupTolerance == 0 {
+		o.BackupTolerance = defaultBackupTolerance
+	}
+	if o.Now.IsZero() {
+		o.Now = time.Now()
+	}
+}
+
+// Run examines the installation described by opt. Unlike a deployment, it
+// does not stop at the first failed check, but collects all findings.
+func Run(ctx context.Context, kubermaticStack stack.Stack, opt stack.DeployOptions, doctorOpt Options) *Report {
+	doctorOpt.setDefaults()
+
+	report := &Report{
+		Stack:   kubermaticStack.Name(),
+		Results: []Result{},
+	}
+
+	logger := opt.Logger
+	if logger == nil {
+		discard := logrus.New()
+		discard.SetOutput(io.Discard)
+		logger = logrus.NewEntry(discard)
+	}
+
+	config, helmValues, errs := kubermaticStack.ValidateConfiguration(opt.KubermaticConfiguration, opt.HelmValues, opt, logger)
+	if len(errs) == 0 {
+		report.add(CheckConfiguration, masterTarget, StatusOK, "configuration is valid")
+
+		opt.KubermaticConfiguration = config
+		opt.HelmValues = helmValues
+	}
+	for _, err := range errs {
+		report.add(CheckConfiguration, masterTarget, StatusError, "%v", err)
+	}
+
+	if errs := kubermaticStack.ValidateState(ctx, opt); len(errs) == 0 {
+		report.add(CheckState, masterTarget, StatusOK, "preflight checks passed")
+	} else {
+		for _, err := range errs {
+			report.add(CheckState, masterTarget, StatusError, "%v", err)
+		}
+	}
+
+	namespace := defaultKKPNamespace
+	if opt.KubermaticConfiguration != nil && opt.KubermaticConfiguration.Namespace != "" {
+		namespace = opt.KubermaticConfiguration.Namespace
+	}
+
+	checkCluster(ctx, report, masterTarget, opt.KubeClient, namespace, opt.Versions.GitVersion, doctorOpt)
+
+	if opt.SeedsGetter == nil || opt.SeedClientGetter == nil {
+		return report
+	}
+
+	seeds, err := opt.SeedsGetter()
+	if err != nil {
+		report.add(CheckSeedConnectivity, masterTarget, StatusError, "failed to list seeds: %v", err)
+		return report
+	}
+
+	names := make([]string, 0, len(seeds))
+	for name := range seeds {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		seed := seeds[name]
+		target := seedTargetPrefix + name
+
+		seedClient, ok := checkSeedConnectivity(ctx, report, target, seed, opt.SeedClientGetter)
+		if !ok {
+			continue
+		}
+
+		checkCluster(ctx, report, target, seedClient, seed.Namespace, opt.Versions.GitVersion, doctorOpt)
+		checkClusters(ctx, report, target, seedClient, doctorOpt)
+		checkEtcdBackups(ctx, report, target, seedClient, doctorOpt)
+	}
+
+	return report
+}
+
+// checkCluster runs the checks that apply to both master and seed clusters.
+func checkCluster(ctx context.Context, report *Report, target string, client ctrlruntimeclient.Client, namespace string, version string, doctorOpt Options) {
+	if client == nil {
+		return
+	}
+
+	checkCertificates(ctx, report, target, client, namespace, doctorOpt)
+	checkCRDVersions(ctx, report, target, client, version)
+	checkWebhooks(ctx, report, target, client, namespace)
+}
+
+func checkSeedConnectivity(ctx context.Context, report *Report, target string, seed *kubermaticv1.Seed, getter provider.SeedClientGetter) (ctrlruntimeclient.Client, bool) {
+	switch seed.Status.Phase {
+	case kubermaticv1.SeedInvalidPhase, kubermaticv1.SeedUnhealthyPhase:
+		report.add(CheckSeedConnectivity, target, StatusError, "seed is %s: %s", seed.Status.Phase, seedConditionMessage(seed))
+	case kubermaticv1.SeedPausedPhase, kubermaticv1.SeedTerminatingPhase:
+		report.add(CheckSeedConnectivity, target, StatusWarning, "seed is %s", seed.Status.Phase)
+	}
+
+	seedClient, err := getter(seed)
+	if err != nil {
+		report.add(CheckSeedConnectivity, target, StatusError, "failed to create client: %v", err)
+		return nil, false
+	}
+
+	if err := seedClient.List(ctx, &corev1.NamespaceList{}, ctrlruntimeclient.Limit(1)); err != nil {
+		report.add(CheckSeedConnectivity, target, StatusError, "seed cluster is not reachable: %v", err)
+		return nil, false
+	}
+
+	report.add(CheckSeedConnectivity, target, StatusOK, "seed cluster is reachable")
+
+	return seedClient, true
+}
+
+func seedConditionMessage(seed *kubermaticv1.Seed) string {
+	for _, conditionType := range []kubermaticv1.SeedConditionType{
+		kubermaticv1.SeedConditionKubeconfigValid,
+		kubermaticv1.SeedConditionClusterInitialized,
+		kubermaticv1.SeedConditionResourcesReconciled,
+	} {
+		if condition, ok := seed.Status.Conditions[conditionType]; ok && condition.Status != corev1.ConditionTrue && condition.Message != "" {
+			return condition.Message
+		}
+	}
+
+	return "no details available"
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/install/stack"
+	"k8c.io/kubermatic/v2/pkg/util/yamled"
+	kubermaticversion "k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testVersion = "v2.30.0"
+
+var now = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+type fakeStack struct {
+	configErrors []error
+	stateErrors  []error
+}
+
+var _ stack.Stack = &fakeStack{}
+
+func (*fakeStack) Name() string {
+	return "test stack"
+}
+
+func (s *fakeStack) ValidateConfiguration(config *kubermaticv1.KubermaticConfiguration, helmValues *yamled.Document, opt stack.DeployOptions, logger logrus.FieldLogger) (*kubermaticv1.KubermaticConfiguration, *yamled.Document, []error) {
+	return config, helmValues, s.configErrors
+}
+
+func (s *fakeStack) ValidateState(ctx context.Context, opt stack.DeployOptions) []error {
+	return s.stateErrors
+}
+
+func (*fakeStack) Deploy(ctx context.Context, opt stack.DeployOptions) error {
+	panic("doctor must not deploy stacks")
+}
+
+func (*fakeStack) Plan(_ context.Context, opt stack.DeployOptions) (*stack.DeploymentPlan, error) {
+	panic("doctor must not plan stacks")
+}
+
+func TestRun(t *testing.T) {
+	master := newFakeClient(
+		tlsSecret(t, "kubermatic", "valid", now.Add(365*24*time.Hour)),
+		tlsSecret(t, "kubermatic", "expired", now.Add(-time.Hour)),
+		crd("clusters.kubermatic.k8c.io", testVersion),
+		crd("seeds.kubermatic.k8c.io", testVersion),
+		webhookConfiguration("kubermatic-webhook", "kubermatic", "kubermatic-webhook"),
+	)
+
+	seedClient := newFakeClient(
+		crd("clusters.kubermatic.k8c.io", "v2.29.0"),
+		crd("seeds.kubermatic.k8c.io", testVersion),
+		&kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "stuck",
+				CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+				DeletionTimestamp: ptr.To(metav1.NewTime(now.Add(-2 * time.Hour))),
+				Finalizers:        []string{"kubermatic.k8c.io/cleanup"},
+			},
+		},
+	)
+
+	seeds := map[string]*kubermaticv1.Seed{
+		"europe": {
+			ObjectMeta: metav1.ObjectMeta{Name: "europe", Namespace: "kubermatic"},
+		},
+		"asia": {
+			ObjectMeta: metav1.ObjectMeta{Name: "asia", Namespace: "kubermatic"},
+			Status:     kubermaticv1.SeedStatus{Phase: kubermaticv1.SeedInvalidPhase},
+		},
+	}
+
+	opt := stack.DeployOptions{
+		KubeClient:              master,
+		KubermaticConfiguration: &kubermaticv1.KubermaticConfiguration{ObjectMeta: metav1.ObjectMeta{Namespace: "kubermatic"}},
+		Versions:                kubermaticversion.Versions{GitVersion: testVersion},
+		SeedsGetter: func() (map[string]*kubermaticv1.Seed, error) {
+			return seeds, nil
+		},
+		SeedClientGetter: func(seed *kubermaticv1.Seed) (ctrlruntimeclient.Client, error) {
+			if seed.Name == "asia" {
+				return nil, errors.New("invalid kubeconfig")
+			}
+			return seedClient, nil
+		},
+	}
+
+	report := Run(context.Background(), &fakeStack{stateErrors: []error{errors.New("something is wrong")}}, opt, Options{Now: now})
+
+	expected := []Result{
+		{Check: CheckConfiguration, Target: "master", Status: StatusOK},
+		{Check: CheckState, Target: "master", Status: StatusError},
+		{Check: CheckCertificates, Target: "master", Status: StatusError},
+		{Check: CheckCRDVersions, Target: "master", Status: StatusOK},
+		{Check: CheckWebhooks, Target: "master", Status: StatusError},
+		{Check: CheckSeedConnectivity, Target: "seed/asia", Status: StatusError},
+		{Check: CheckSeedConnectivity, Target: "seed/asia", Status: StatusError},
+		{Check: CheckSeedConnectivity, Target: "seed/europe", Status: StatusOK},
+		{Check: CheckCertificates, Target: "seed/europe", Status: StatusOK},
+		{Check: CheckCRDVersions, Target: "seed/europe", Status: StatusError},
+		{Check: CheckWebhooks, Target: "seed/europe", Status: StatusOK},
+		{Check: CheckClusters, Target: "seed/europe", Status: StatusError},
+		{Check: CheckEtcdBackups, Target: "seed/europe", Status: StatusOK},
+	}
+
+	if len(report.Results) != len(expected) {
+		t.Fatalf("Expected %d results, got %d: %+v", len(expected), len(report.Results), report.Results)
+	}
+
+	for i, result := range report.Results {
+		if result.Check != expected[i].Check || result.Target != expected[i].Target || result.Status != expected[i].Status {
+			t.Errorf("Result %d: expected %s/%s to be %s, got %+v", i, expected[i].Target, expected[i].Check, expected[i].Status, result)
+		}
+	}
+
+	if !report.HasErrors() {
+		t.Error("Expected report to have errors.")
+	}
+}
+
+func TestCheckWebhooks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		objects  []ctrlruntimeclient.Object
+		expected Status
+	}{
+		{
+			name: "service with ready endpoints",
+			objects: []ctrlruntimeclient.Object{
+				service("kubermatic", "webhook"),
+				endpointSlice("kubermatic", "webhook", nil),
+			},
+			expected: StatusOK,
+		},
+		{
+			name: "service without ready endpoints",
+			objects: []ctrlruntimeclient.Object{
+				service("kubermatic", "webhook"),
+				endpointSlice("kubermatic", "webhook", ptr.To(false)),
+			},
+			expected: StatusError,
+		},
+		{
+			name:     "missing service",
+			expected: StatusError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := append(tc.objects, webhookConfiguration("webhook", "kubermatic", "webhook"))
+			report := &Report{}
+
+			checkWebhooks(context.Background(), report, "master", newFakeClient(objects...), "kubermatic")
+
+			if len(report.Results) != 1 || report.Results[0].Status != tc.expected {
+				t.Fatalf("Expected a single %s result, got %+v", tc.expected, report.Results)
+			}
+		})
+	}
+}
+
+func TestCheckEtcdBackups(t *testing.T) {
+	backup := func(finished time.Time, verification kubermaticv1.BackupStatusPhase) kubermaticv1.BackupStatus {
+		return kubermaticv1.BackupStatus{
+			BackupFinishedTime: metav1.NewTime(finished),
+			BackupPhase:        kubermaticv1.BackupStatusPhaseCompleted,
+			VerificationPhase:  verification,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		schedule string
+		created  time.Time
+		backups  []kubermaticv1.BackupStatus
+		expected Status
+	}{
+		{
+			name:     "recent backup",
+			schedule: "@every 1h",
+			created:  now.Add(-24 * time.Hour),
+			backups:  []kubermaticv1.BackupStatus{backup(now.Add(-30*time.Minute), "")},
+			expected: StatusOK,
+		},
+		{
+			name:     "overdue backup",
+			schedule: "@every 1h",
+			created:  now.Add(-24 * time.Hour),
+			backups: []kubermaticv1.BackupStatus{
+				backup(now.Add(-5*time.Hour), ""),
+				backup(now.Add(-3*time.Hour), ""),
+			},
+			expected: StatusError,
+		},
+		{
+			name:     "new config without backups",
+			schedule: "@every 1h",
+			created:  now.Add(-time.Hour),
+			expected: StatusOK,
+		},
+		{
+			name:     "old config without backups",
+			schedule: "@every 1h",
+			created:  now.Add(-24 * time.Hour),
+			expected: StatusError,
+		},
+		{
+			name:     "failed verification",
+			schedule: "@every 1h",
+			created:  now.Add(-24 * time.Hour),
+			backups:  []kubermaticv1.BackupStatus{backup(now.Add(-30*time.Minute), kubermaticv1.BackupStatusPhaseFailed)},
+			expected: StatusWarning,
+		},
+		{
+			name:     "one-off backup",
+			created:  now.Add(-24 * time.Hour),
+			expected: StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &kubermaticv1.EtcdBackupConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "backup",
+					Namespace:         "cluster-test",
+					CreationTimestamp: metav1.NewTime(tc.created),
+				},
+				Spec: kubermaticv1.EtcdBackupConfigSpec{
+					Schedule: tc.schedule,
+				},
+				Status: kubermaticv1.EtcdBackupConfigStatus{
+					CurrentBackups: tc.backups,
+				},
+			}
+
+			report := &Report{}
+			doctorOpt := Options{Now: now}
+			doctorOpt.setDefaults()
+
+			checkEtcdBackups(context.Background(), report, "seed/test", newFakeClient(config), doctorOpt)
+
+			if len(report.Results) != 1 || report.Results[0].Status != tc.expected {
+				t.Fatalf("Expected a single %s result, got %+v", tc.expected, report.Results)
+			}
+		})
+	}
+}
+
+func newFakeClient(objects ...ctrlruntimeclient.Object) ctrlruntimeclient.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(discoveryv1.AddToScheme(scheme))
+	utilruntime.Must(admissionregistrationv1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+	utilruntime.Must(kubermaticv1.AddToScheme(scheme))
+
+	return ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		Build()
+}
+
+func tlsSecret(t *testing.T, namespace, name string, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: der}),
+		},
+	}
+}
+
+func crd(name, version string) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{"app.kubernetes.io/version": version},
+		},
+	}
+}
+
+func webhookConfiguration(name, namespace, serviceName string) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name: name + ".kubermatic.k8c.io",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{
+					Namespace: namespace,
+					Name:      serviceName,
+				},
+			},
+		}},
+	}
+}
+
+func service(namespace, name string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+}
+
+func endpointSlice(namespace, serviceName string, ready *bool) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName + "-abcde",
+			Namespace: namespace,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: serviceName},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{{
+			Addresses:  []string{"10.0.0.1"},
+			Conditions: discoveryv1.EndpointConditions{Ready: ready},
+		}},
+	}
+}
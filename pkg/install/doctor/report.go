@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Status is the outcome of a single check.
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusWarning Status = "warning"
+	StatusError   Status = "error"
+)
+
+// Report is the result of examining an installation.
+type Report struct {
+	Stack   string   `json:"stack"`
+	Results []Result `json:"results"`
+}
+
+// Result is a single finding of a check. A check can produce multiple
+// results, e.g. one per expired certificate.
+type Result struct {
+	Check   string `json:"check"`
+	Target  string `json:"target"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
+
+// HasErrors returns true if any check has failed.
+func (r *Report) HasErrors() bool {
+	for _, result := range r.Results {
+		if result.Status == StatusError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count returns the number of results with the given status.
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (r *Report) add(check string, target string, status Status, format string, args ...any) {
+	r.Results = append(r.Results, Result{
+		Check:   check,
+		Target:  target,
+		Status:  status,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// WriteText writes the report in a human readable form, grouped by target.
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Diagnosis for %s:\n", r.Stack)
+
+	target := ""
+	for i, result := range r.Results {
+		if i == 0 || result.Target != target {
+			target = result.Target
+			fmt.Fprintf(&b, "\n%s:\n", target)
+		}
+
+		fmt.Fprintf(&b, "  %s %-24s %s\n", statusSymbol(result.Status), result.Check, result.Message)
+	}
+
+	fmt.Fprintf(&b, "\n%d ok, %d warnings, %d errors\n", r.Count(StatusOK), r.Count(StatusWarning), r.Count(StatusError))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func statusSymbol(status Status) string {
+	switch status {
+	case StatusOK:
+		return "✓"
+	case StatusWarning:
+		return "!"
+	default:
+		return "✗"
+	}
+}
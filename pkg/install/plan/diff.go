@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const redactedDiff = "(Secret data is not shown)"
+
+// planObject compares a desired object with its live counterpart. Only the fields
+// that are set in the desired object are compared, so that fields defaulted by
+// the API server or managed by controllers do not show up as changes. nil is
+// returned if the object is up-to-date.
+func planObject(ctx context.Context, client ctrlruntimeclient.Client, desired *unstructured.Unstructured, defaultNamespace string) (*ObjectChange, error) {
+	change := &ObjectChange{
+		APIVersion: desired.GetAPIVersion(),
+		Kind:       desired.GetKind(),
+		Name:       desired.GetName(),
+	}
+
+	if desired.GetNamespace() == "" && defaultNamespace != "" {
+		namespaced, err := client.IsObjectNamespaced(desired)
+		if err != nil {
+			// the resource type does not exist yet, e.g. because its CRD is
+			// part of the same chart
+			if meta.IsNoMatchError(err) {
+				change.Namespace = defaultNamespace
+				change.Action = ActionCreate
+				return change, nil
+			}
+
+			return nil, fmt.Errorf("failed to determine scope of %s: %w", desired.GroupVersionKind(), err)
+		}
+
+		if namespaced {
+			desired = desired.DeepCopy()
+			desired.SetNamespace(defaultNamespace)
+		}
+	}
+
+	change.Namespace = desired.GetNamespace()
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(desired.GroupVersionKind())
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(desired), live); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			change.Action = ActionCreate
+			return change, nil
+		}
+
+		return nil, fmt.Errorf("failed to get %s %s: %w", change.Kind, ctrlruntimeclient.ObjectKeyFromObject(desired), err)
+	}
+
+	desiredFields, err := normalize(desired.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	liveFields, err := normalize(live.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredFields = prune(desiredFields)
+	if isSecret(desired) {
+		desiredFields = mergeStringData(desiredFields)
+	}
+
+	projected := project(liveFields, desiredFields)
+	if reflect.DeepEqual(projected, desiredFields) {
+		return nil, nil
+	}
+
+	change.Action = ActionUpdate
+
+	if isSecret(desired) {
+		change.Diff = redactedDiff
+	} else {
+		change.Diff, err = objectDiff(projected, desiredFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return change, nil
+}
+
+// normalize round-trips the object through JSON, so that values decoded
+// from YAML and from the API server have the same types.
+func normalize(object map[string]any) (any, error) {
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode object: %w", err)
+	}
+
+	var result any
+	if err := json.Unmarshal(encoded, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode object: %w", err)
+	}
+
+	return result, nil
+}
+
+// prune removes nil values and empty maps and lists, which are dropped by
+// the API server and would otherwise always be reported as changes.
+func prune(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			item = prune(item)
+			if !isEmpty(item) {
+				result[key] = item
+			}
+		}
+		return result
+
+	case []any:
+		result := make([]any, 0, len(v))
+		for _, item := range v {
+			result = append(result, prune(item))
+		}
+		return result
+
+	default:
+		return value
+	}
+}
+
+func isEmpty(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// project returns the parts of live that are also set in desired.
+func project(live, desired any) any {
+	switch d := desired.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return live
+		}
+
+		result := map[string]any{}
+		for key, item := range d {
+			if liveItem, exists := l[key]; exists {
+				result[key] = project(liveItem, item)
+			}
+		}
+		return result
+
+	case []any:
+		l, ok := live.([]any)
+		if !ok || len(l) != len(d) {
+			return live
+		}
+
+		result := make([]any, len(l))
+		for i := range l {
+			result[i] = project(l[i], d[i])
+		}
+		return result
+
+	default:
+		return live
+	}
+}
+
+func isSecret(object *unstructured.Unstructured) bool {
+	gvk := object.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == "Secret"
+}
+
+// mergeStringData moves the stringData of a Secret into its data, just
+// like the API server does.
+func mergeStringData(secret any) any {
+	fields, ok := secret.(map[string]any)
+	if !ok {
+		return secret
+	}
+
+	stringData, ok := fields["stringData"].(map[string]any)
+	if !ok {
+		return secret
+	}
+
+	data, ok := fields["data"].(map[string]any)
+	if !ok {
+		data = map[string]any{}
+	}
+
+	for key, value := range stringData {
+		if s, ok := value.(string); ok {
+			data[key] = base64.StdEncoding.EncodeToString([]byte(s))
+		}
+	}
+
+	fields["data"] = data
+	delete(fields, "stringData")
+
+	return fields
+}
+
+func objectDiff(live, desired any) (string, error) {
+	liveYAML, err := yaml.Marshal(live)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode live object: %w", err)
+	}
+
+	desiredYAML, err := yaml.Marshal(desired)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode desired object: %w", err)
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(liveYAML)),
+		B:        difflib.SplitLines(string(desiredYAML)),
+		FromFile: "live",
+		ToFile:   "desired",
+		Context:  3,
+	})
+}
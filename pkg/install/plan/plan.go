@@ -0,0 +1,336 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plan determines the changes that deploying an installer stack
+// would make to a cluster, without performing any of them.
+package plan
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"k8c.io/kubermatic/v2/pkg/install/helm"
+	"k8c.io/kubermatic/v2/pkg/install/stack"
+	"k8c.io/kubermatic/v2/pkg/install/util"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/util/crd"
+	yamlutil "k8c.io/kubermatic/v2/pkg/util/yaml"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Build renders everything the given stack would deploy and compares it
+// against the current state of the cluster. Neither Helm releases nor
+// Kubernetes objects are modified.
+func Build(ctx context.Context, kubermaticStack stack.Stack, opt stack.DeployOptions) (*Report, error) {
+	deploymentPlan, err := kubermaticStack.Plan(ctx, opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine deployment plan: %w", err)
+	}
+
+	report := &Report{
+		Stack:      kubermaticStack.Name(),
+		Releases:   []ReleaseChange{},
+		CRDs:       []ObjectChange{},
+		Objects:    []ObjectChange{},
+		Migrations: []MigrationStep{},
+	}
+
+	for _, source := range deploymentPlan.CRDs {
+		changes, err := planCRDs(ctx, opt, source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan CRDs in %s: %w", source.Directory, err)
+		}
+
+		report.CRDs = append(report.CRDs, changes...)
+	}
+
+	for _, release := range deploymentPlan.Releases {
+		change, migrations, err := planRelease(ctx, opt, release)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan release %s/%s: %w", release.Namespace, release.ReleaseName, err)
+		}
+
+		report.Releases = append(report.Releases, *change)
+		report.Migrations = append(report.Migrations, migrations...)
+	}
+
+	for _, release := range deploymentPlan.RemovedReleases {
+		existing, err := opt.HelmClient.GetRelease(release.Namespace, release.ReleaseName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for release %s/%s: %w", release.Namespace, release.ReleaseName, err)
+		}
+
+		if existing != nil {
+			report.Releases = append(report.Releases, ReleaseChange{
+				Namespace:        release.Namespace,
+				ReleaseName:      release.ReleaseName,
+				Action:           ActionUninstall,
+				Reason:           "release is no longer part of the stack",
+				InstalledVersion: versionString(existing),
+			})
+		}
+	}
+
+	for _, source := range deploymentPlan.Objects {
+		change, err := planObject(ctx, opt.KubeClient, source.Object, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if change == nil || (source.CreateOnly && change.Action != ActionCreate) {
+			continue
+		}
+
+		report.Objects = append(report.Objects, *change)
+	}
+
+	for _, removed := range deploymentPlan.RemovedObjects {
+		change, err := planRemovedObject(ctx, opt.KubeClient, removed)
+		if err != nil {
+			return nil, err
+		}
+
+		if change != nil {
+			report.Objects = append(report.Objects, *change)
+		}
+	}
+
+	return report, nil
+}
+
+// planRemovedObject returns a deletion if the object exists and would be
+// removed by the stack, and nil otherwise.
+func planRemovedObject(ctx context.Context, client ctrlruntimeclient.Client, removed stack.RemovedObject) (*ObjectChange, error) {
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(removed.Object.GroupVersionKind())
+
+	key := ctrlruntimeclient.ObjectKeyFromObject(removed.Object)
+	if err := client.Get(ctx, key, live); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get %s %s: %w", removed.Object.GetKind(), key, err)
+	}
+
+	if removed.Removable != nil && !removed.Removable(live) {
+		return nil, nil
+	}
+
+	return &ObjectChange{
+		APIVersion: removed.Object.GetAPIVersion(),
+		Kind:       removed.Object.GetKind(),
+		Namespace:  removed.Object.GetNamespace(),
+		Name:       removed.Object.GetName(),
+		Action:     ActionDelete,
+	}, nil
+}
+
+// planRelease mirrors the decisions made by util.CheckHelmRelease and
+// util.DeployHelmChart. If the release would be changed, the chart is
+// rendered and its objects are compared to the live objects.
+func planRelease(ctx context.Context, opt stack.DeployOptions, release stack.HelmRelease) (*ReleaseChange, []MigrationStep, error) {
+	chart, err := helm.LoadChart(release.ChartDirectory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load Helm chart: %w", err)
+	}
+
+	existing, err := opt.HelmClient.GetRelease(release.Namespace, release.ReleaseName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check for an existing release: %w", err)
+	}
+
+	change := &ReleaseChange{
+		Namespace:    release.Namespace,
+		ReleaseName:  release.ReleaseName,
+		ChartVersion: chart.Version.String(),
+	}
+
+	if existing != nil {
+		change.InstalledVersion = versionString(existing)
+	}
+
+	var migrations []MigrationStep
+
+	switch {
+	case existing == nil:
+		change.Action = ActionInstall
+
+	case util.StatusRequiresPurge(existing.Status):
+		change.Action = ActionInstall
+		change.Reason = fmt.Sprintf("release is %s and will be uninstalled first", existing.Status)
+
+	case existing.Version.GreaterThan(chart.Version):
+		change.Action = ActionDowngrade
+
+	case existing.Version.LessThan(chart.Version):
+		change.Action = ActionUpgrade
+
+		for _, migration := range release.Migrations {
+			if migration.Triggered(existing.Version, chart.Version) {
+				migrations = append(migrations, MigrationStep{
+					Namespace:   release.Namespace,
+					ReleaseName: release.ReleaseName,
+					From:        existing.Version.String(),
+					To:          chart.Version.String(),
+					Description: migration.Description,
+					Flag:        migration.Flag,
+				})
+			}
+		}
+
+	case opt.ForceHelmReleaseUpgrade:
+		change.Action = ActionReinstall
+		change.Reason = "--force is set"
+
+	case release.VersionChangesOnly:
+		change.Action = ActionNone
+
+	default:
+		appliedValues, err := opt.HelmClient.GetValues(release.Namespace, release.ReleaseName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to retrieve Helm values used for release: %w", err)
+		}
+
+		if util.HelmValuesChanged(appliedValues, opt.HelmValues) {
+			change.Action = ActionReinstall
+			change.Reason = "values have been changed"
+		} else {
+			change.Action = ActionNone
+		}
+	}
+
+	if change.Action == ActionNone {
+		return change, migrations, nil
+	}
+
+	objects, err := renderChart(opt, chart, release, existing != nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, object := range objects {
+		objectChange, err := planObject(ctx, opt.KubeClient, object, release.Namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if objectChange != nil {
+			change.Resources = append(change.Resources, *objectChange)
+		}
+	}
+
+	return change, migrations, nil
+}
+
+func renderChart(opt stack.DeployOptions, chart *helm.Chart, release stack.HelmRelease, upgrade bool) ([]*unstructured.Unstructured, error) {
+	if !opt.DisableDependencyUpdate {
+		if err := opt.HelmClient.BuildChartDependencies(chart.Directory, nil); err != nil {
+			return nil, fmt.Errorf("failed to download dependencies: %w", err)
+		}
+	}
+
+	valuesFile, err := util.DumpHelmValues(opt.HelmValues)
+	if valuesFile != "" {
+		defer os.Remove(valuesFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var flags []string
+	if upgrade {
+		flags = append(flags, "--is-upgrade")
+	}
+
+	rendered, err := opt.HelmClient.RenderChart(release.Namespace, release.ReleaseName, chart.Directory, valuesFile, nil, flags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render Helm chart: %w", err)
+	}
+
+	manifests, err := yamlutil.ParseMultipleDocuments(bytes.NewReader(rendered))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode rendered manifests: %w", err)
+	}
+
+	objects := []*unstructured.Unstructured{}
+	for _, manifest := range manifests {
+		object := &unstructured.Unstructured{}
+		if err := object.UnmarshalJSON(manifest.Raw); err != nil {
+			return nil, fmt.Errorf("failed to decode rendered manifest: %w", err)
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, nil
+}
+
+func planCRDs(ctx context.Context, opt stack.DeployOptions, source stack.CRDSource) ([]ObjectChange, error) {
+	crds, err := crd.LoadFromDirectory(source.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load CRDs: %w", err)
+	}
+
+	changes := []ObjectChange{}
+	for _, crdObject := range crds {
+		if crd.SkipCRDOnCluster(crdObject, source.Kind) {
+			continue
+		}
+
+		if source.Versions != nil {
+			// util.DeployCRDs injects the current KKP version
+			annotations := crdObject.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[resources.VersionLabel] = source.Versions.GitVersion
+			crdObject.SetAnnotations(annotations)
+		}
+
+		object, ok := crdObject.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("unexpected CRD type %T", crdObject)
+		}
+
+		change, err := planObject(ctx, opt.KubeClient, object, "")
+		if err != nil {
+			return nil, err
+		}
+
+		if change == nil || (source.CreateOnly && change.Action != ActionCreate) {
+			continue
+		}
+
+		changes = append(changes, *change)
+	}
+
+	return changes, nil
+}
+
+func versionString(release *helm.Release) string {
+	if release.Version == nil {
+		return ""
+	}
+
+	return release.Version.String()
+}
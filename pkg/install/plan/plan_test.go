@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	semverlib "github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/install/helm"
+	"k8c.io/kubermatic/v2/pkg/install/stack"
+	"k8c.io/kubermatic/v2/pkg/util/yamled"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+data:
+  key: value
+`
+
+type fakeHelmClient struct {
+	release  *helm.Release
+	values   string
+	rendered string
+}
+
+var _ helm.Client = &fakeHelmClient{}
+
+func (c *fakeHelmClient) BuildChartDependencies(chartDirectory string, flags []string) error {
+	return nil
+}
+
+func (c *fakeHelmClient) InstallChart(namespace string, releaseName string, chartDirectory string, valuesFile string, values map[string]string, flags []string) error {
+	panic("plans must not install charts")
+}
+
+func (c *fakeHelmClient) GetRelease(namespace string, name string) (*helm.Release, error) {
+	return c.release, nil
+}
+
+func (c *fakeHelmClient) ListReleases(namespace string) ([]helm.Release, error) {
+	return nil, nil
+}
+
+func (c *fakeHelmClient) UninstallRelease(namespace string, name string) error {
+	panic("plans must not uninstall releases")
+}
+
+func (c *fakeHelmClient) RenderChart(namespace string, releaseName string, chartDirectory string, valuesFile string, values map[string]string, flags []string) ([]byte, error) {
+	return []byte(c.rendered), nil
+}
+
+func (c *fakeHelmClient) GetValues(namespace string, releaseName string) (*yamled.Document, error) {
+	return yamled.Load(strings.NewReader(c.values))
+}
+
+type fakeStack struct {
+	plan *stack.DeploymentPlan
+}
+
+var _ stack.Stack = &fakeStack{}
+
+func (*fakeStack) Name() string {
+	return "test stack"
+}
+
+func (*fakeStack) ValidateConfiguration(config *kubermaticv1.KubermaticConfiguration, helmValues *yamled.Document, opt stack.DeployOptions, logger logrus.FieldLogger) (*kubermaticv1.KubermaticConfiguration, *yamled.Document, []error) {
+	return config, helmValues, nil
+}
+
+func (*fakeStack) ValidateState(ctx context.Context, opt stack.DeployOptions) []error {
+	return nil
+}
+
+func (*fakeStack) Deploy(ctx context.Context, opt stack.DeployOptions) error {
+	panic("plans must not deploy stacks")
+}
+
+func (s *fakeStack) Plan(_ context.Context, opt stack.DeployOptions) (*stack.DeploymentPlan, error) {
+	return s.plan, nil
+}
+
+func TestBuild(t *testing.T) {
+	chartDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(chartDir, "Chart.yaml"), []byte("name: test\nversion: 2.0.0\n"), 0o644); err != nil {
+		t.Fatalf("failed to write Chart.yaml: %v", err)
+	}
+
+	liveConfigMap := func(value string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test",
+				Annotations: map[string]string{
+					"meta.helm.sh/release-name": "test",
+				},
+			},
+			Data: map[string]string{"key": value},
+		}
+	}
+
+	release := func(version string) *helm.Release {
+		return &helm.Release{
+			Name:      "test",
+			Namespace: "test",
+			Version:   semverlib.MustParse(version),
+			Status:    helm.ReleaseStatusDeployed,
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		release            *helm.Release
+		appliedValues      string
+		liveObjects        []ctrlruntimeclient.Object
+		expectedAction     Action
+		expectedResources  []Action
+		expectedMigrations int
+		expectedDrift      bool
+	}{
+		{
+			name:              "new release",
+			expectedAction:    ActionInstall,
+			expectedResources: []Action{ActionCreate},
+			expectedDrift:     true,
+		},
+		{
+			name:           "up-to-date release",
+			release:        release("2.0.0"),
+			appliedValues:  "foo: bar\n",
+			liveObjects:    []ctrlruntimeclient.Object{liveConfigMap("changed-by-hand")},
+			expectedAction: ActionNone,
+			expectedDrift:  false,
+		},
+		{
+			name:              "changed values",
+			release:           release("2.0.0"),
+			appliedValues:     "foo: baz\n",
+			liveObjects:       []ctrlruntimeclient.Object{liveConfigMap("value")},
+			expectedAction:    ActionReinstall,
+			expectedResources: nil,
+			expectedDrift:     true,
+		},
+		{
+			name:               "upgrade with migration",
+			release:            release("1.0.0"),
+			liveObjects:        []ctrlruntimeclient.Object{liveConfigMap("old-value")},
+			expectedAction:     ActionUpgrade,
+			expectedResources:  []Action{ActionUpdate},
+			expectedMigrations: 1,
+			expectedDrift:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kubeClient := newFakeClient(tc.liveObjects...)
+
+			values, err := yamled.Load(strings.NewReader("foo: bar\n"))
+			if err != nil {
+				t.Fatalf("failed to load values: %v", err)
+			}
+
+			kubermaticStack := &fakeStack{
+				plan: &stack.DeploymentPlan{
+					Releases: []stack.HelmRelease{{
+						Namespace:      "test",
+						ReleaseName:    "test",
+						ChartDirectory: chartDir,
+						Migrations: []stack.Migration{{
+							Version:     semverlib.MustParse("1.5.0"),
+							Description: "something is migrated",
+						}},
+					}},
+				},
+			}
+
+			report, err := Build(context.Background(), kubermaticStack, stack.DeployOptions{
+				HelmClient: &fakeHelmClient{
+					release:  tc.release,
+					values:   tc.appliedValues,
+					rendered: testManifest,
+				},
+				HelmValues: values,
+				KubeClient: kubeClient,
+			})
+			if err != nil {
+				t.Fatalf("Failed to build plan: %v", err)
+			}
+
+			if len(report.Releases) != 1 {
+				t.Fatalf("Expected 1 release, got %d.", len(report.Releases))
+			}
+
+			change := report.Releases[0]
+			if change.Action != tc.expectedAction {
+				t.Errorf("Expected action %q, got %q.", tc.expectedAction, change.Action)
+			}
+
+			var resourceActions []Action
+			for _, resource := range change.Resources {
+				resourceActions = append(resourceActions, resource.Action)
+
+				if resource.Action == ActionUpdate && !strings.Contains(resource.Diff, "+  key: value") {
+					t.Errorf("Expected diff to contain the changed value, got:\n%s", resource.Diff)
+				}
+			}
+
+			if strings.Join(toStrings(resourceActions), ",") != strings.Join(toStrings(tc.expectedResources), ",") {
+				t.Errorf("Expected resource actions %v, got %v.", tc.expectedResources, resourceActions)
+			}
+
+			if len(report.Migrations) != tc.expectedMigrations {
+				t.Errorf("Expected %d migrations, got %d.", tc.expectedMigrations, len(report.Migrations))
+			}
+
+			if report.HasDrift() != tc.expectedDrift {
+				t.Errorf("Expected drift to be %v.", tc.expectedDrift)
+			}
+		})
+	}
+}
+
+func TestPlanObjectSecret(t *testing.T) {
+	live := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Data: map[string][]byte{"password": []byte("hunter2")},
+		Type: corev1.SecretTypeOpaque,
+	}
+
+	kubeClient := newFakeClient(live)
+
+	render := func(password string) string {
+		return "apiVersion: v1\nkind: Secret\nmetadata:\n  name: test\nstringData:\n  password: " + password + "\n"
+	}
+
+	values, err := yamled.Load(strings.NewReader("---\n"))
+	if err != nil {
+		t.Fatalf("failed to load values: %v", err)
+	}
+
+	opt := stack.DeployOptions{
+		KubeClient: kubeClient,
+		HelmClient: &fakeHelmClient{rendered: render("hunter2")},
+		HelmValues: values,
+	}
+
+	objects, err := renderChart(opt, &helm.Chart{}, stack.HelmRelease{Namespace: "test", ReleaseName: "test"}, false)
+	if err != nil {
+		t.Fatalf("Failed to render chart: %v", err)
+	}
+
+	change, err := planObject(context.Background(), kubeClient, objects[0], "test")
+	if err != nil {
+		t.Fatalf("Failed to plan object: %v", err)
+	}
+
+	if change != nil {
+		t.Fatalf("Expected stringData to match the live data, got %+v.", change)
+	}
+
+	opt.HelmClient = &fakeHelmClient{rendered: render("hunter3")}
+
+	objects, err = renderChart(opt, &helm.Chart{}, stack.HelmRelease{Namespace: "test", ReleaseName: "test"}, false)
+	if err != nil {
+		t.Fatalf("Failed to render chart: %v", err)
+	}
+
+	change, err = planObject(context.Background(), kubeClient, objects[0], "test")
+	if err != nil {
+		t.Fatalf("Failed to plan object: %v", err)
+	}
+
+	if change == nil || change.Action != ActionUpdate {
+		t.Fatalf("Expected Secret to be updated, got %+v.", change)
+	}
+
+	if strings.Contains(change.Diff, "hunter") {
+		t.Fatalf("Expected Secret data to be redacted, got:\n%s", change.Diff)
+	}
+}
+
+func newFakeClient(objects ...ctrlruntimeclient.Object) ctrlruntimeclient.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+
+	return ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+		WithObjects(objects...).
+		Build()
+}
+
+func toStrings(actions []Action) []string {
+	result := make([]string, 0, len(actions))
+	for _, action := range actions {
+		result = append(result, string(action))
+	}
+
+	return result
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Action describes what the installer would do to a release or object.
+type Action string
+
+const (
+	ActionNone      Action = "none"
+	ActionInstall   Action = "install"
+	ActionUpgrade   Action = "upgrade"
+	ActionDowngrade Action = "downgrade"
+	ActionReinstall Action = "reinstall"
+	ActionUninstall Action = "uninstall"
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionDelete    Action = "delete"
+)
+
+// Report is the result of planning a stack deployment.
+type Report struct {
+	Stack      string          `json:"stack"`
+	Releases   []ReleaseChange `json:"releases"`
+	CRDs       []ObjectChange  `json:"crds"`
+	Objects    []ObjectChange  `json:"objects"`
+	Migrations []MigrationStep `json:"migrations,omitempty"`
+}
+
+// ReleaseChange describes how a single Helm release would be changed.
+type ReleaseChange struct {
+	Namespace        string         `json:"namespace"`
+	ReleaseName      string         `json:"releaseName"`
+	Action           Action         `json:"action"`
+	Reason           string         `json:"reason,omitempty"`
+	InstalledVersion string         `json:"installedVersion,omitempty"`
+	ChartVersion     string         `json:"chartVersion,omitempty"`
+	Resources        []ObjectChange `json:"resources,omitempty"`
+}
+
+// ObjectChange describes how a single Kubernetes object would be changed.
+// Unchanged objects are not part of a report.
+type ObjectChange struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	Action     Action `json:"action"`
+	Diff       string `json:"diff,omitempty"`
+}
+
+// MigrationStep is a migration that the installer would perform while
+// upgrading a release.
+type MigrationStep struct {
+	Namespace   string `json:"namespace"`
+	ReleaseName string `json:"releaseName"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Description string `json:"description"`
+	Flag        string `json:"flag,omitempty"`
+}
+
+// HasDrift returns true if deploying the stack would change anything in the cluster.
+func (r *Report) HasDrift() bool {
+	for _, release := range r.Releases {
+		if release.Action != ActionNone || len(release.Resources) > 0 {
+			return true
+		}
+	}
+
+	return len(r.CRDs) > 0 || len(r.Objects) > 0 || len(r.Migrations) > 0
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// WriteText writes the report in a human readable form, including the
+// diffs of all changed objects.
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Plan for %s:\n\n", r.Stack)
+
+	b.WriteString("Helm releases:\n")
+	for _, release := range r.Releases {
+		fmt.Fprintf(&b, "  %s %s/%s", actionSymbol(release.Action), release.Namespace, release.ReleaseName)
+
+		switch {
+		case release.InstalledVersion != "" && release.ChartVersion != "" && release.InstalledVersion != release.ChartVersion:
+			fmt.Fprintf(&b, " (%s → %s)", release.InstalledVersion, release.ChartVersion)
+		case release.ChartVersion != "":
+			fmt.Fprintf(&b, " (%s)", release.ChartVersion)
+		case release.InstalledVersion != "":
+			fmt.Fprintf(&b, " (%s)", release.InstalledVersion)
+		}
+
+		fmt.Fprintf(&b, ": %s", release.Action)
+		if release.Reason != "" {
+			fmt.Fprintf(&b, ", %s", release.Reason)
+		}
+		b.WriteString("\n")
+
+		for _, object := range release.Resources {
+			writeObjectChange(&b, object, "      ")
+		}
+	}
+
+	b.WriteString("\nCustom Resource Definitions:\n")
+	if len(r.CRDs) == 0 {
+		b.WriteString("  (no changes)\n")
+	}
+	for _, object := range r.CRDs {
+		writeObjectChange(&b, object, "  ")
+	}
+
+	b.WriteString("\nObjects:\n")
+	if len(r.Objects) == 0 {
+		b.WriteString("  (no changes)\n")
+	}
+	for _, object := range r.Objects {
+		writeObjectChange(&b, object, "  ")
+	}
+
+	if len(r.Migrations) > 0 {
+		b.WriteString("\nMigrations:\n")
+		for _, migration := range r.Migrations {
+			fmt.Fprintf(&b, "  ! %s/%s (%s → %s): %s", migration.Namespace, migration.ReleaseName, migration.From, migration.To, migration.Description)
+			if migration.Flag != "" {
+				fmt.Fprintf(&b, " (requires %s)", migration.Flag)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeObjectChange(b *strings.Builder, object ObjectChange, indent string) {
+	name := object.Name
+	if object.Namespace != "" {
+		name = object.Namespace + "/" + object.Name
+	}
+
+	fmt.Fprintf(b, "%s%s %s %s: %s\n", indent, actionSymbol(object.Action), object.Kind, name, object.Action)
+
+	if object.Diff != "" {
+		for _, line := range strings.Split(strings.TrimRight(object.Diff, "\n"), "\n") {
+			fmt.Fprintf(b, "%s    %s\n", indent, line)
+		}
+	}
+}
+
+func actionSymbol(action Action) string {
+	switch action {
+	case ActionNone:
+		return "="
+	case ActionInstall, ActionCreate:
+		return "+"
+	case ActionUninstall, ActionDelete:
+		return "-"
+	default:
+		return "~"
+	}
+}
@@ -71,7 +71,9 @@ func DeployEnvoyGatewayController(ctx context.Context, logger *logrus.Entry, kub
 		return nil
 	}
 
-	chart, err := helm.LoadChart(filepath.Join(opt.ChartsDirectory, EnvoyGatewayControllerChartName))
+	chartDir := filepath.Join(opt.ChartsDirectory, EnvoyGatewayControllerChartName)
+
+	chart, err := helm.LoadChart(chartDir)
 	if err != nil {
 		return fmt.Errorf("failed to load Helm chart: %w", err)
 	}
@@ -80,6 +82,16 @@ func DeployEnvoyGatewayController(ctx context.Context, logger *logrus.Entry, kub
 		return err
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.Releases = append(opt.DryRun.Releases, stack.HelmRelease{
+			Namespace:      EnvoyGatewayControllerNamespace,
+			ReleaseName:    EnvoyGatewayControllerReleaseName,
+			ChartDirectory: chartDir,
+		})
+
+		return nil
+	}
+
 	err = util.EnsureNamespace(ctx, sublogger, kubeClient, EnvoyGatewayControllerNamespace)
 	if err != nil {
 		return fmt.Errorf("failed to create namespace: %w", err)
@@ -120,6 +132,15 @@ func DeployGatewayAPICRDs(ctx context.Context, logger *logrus.Entry, kubeClient
 		return nil
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.CRDs = append(opt.DryRun.CRDs, stack.CRDSource{
+			Directory: gatewayAPICRDDirectory(opt),
+			Kind:      crd.MasterCluster,
+		})
+
+		return nil
+	}
+
 	sublogger := log.Prefix(logger, "   ")
 	sublogger.Info("Deploying Gateway API Custom Resource Definitions...")
 
@@ -162,6 +183,16 @@ func EnsureGatewayAPICRDs(ctx context.Context, logger *logrus.Entry, kubeClient
 		return fmt.Errorf("failed to load Gateway API CRDs from bundled %s chart directory %q; this chart must be present even when the controller deployment is skipped: no CRD manifests found", EnvoyGatewayControllerChartName, crdDirectory)
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.CRDs = append(opt.DryRun.CRDs, stack.CRDSource{
+			Directory:  crdDirectory,
+			Kind:       crd.MasterCluster,
+			CreateOnly: true,
+		})
+
+		return nil
+	}
+
 	for _, crdObject := range crds {
 		logger := sublogger.WithField("name", crdObject.GetName())
 		if crd.SkipCRDOnCluster(crdObject, crd.MasterCluster) {
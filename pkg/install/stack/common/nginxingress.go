@@ -48,6 +48,14 @@ const (
 	NginxIngressControllerNamespace   = NginxIngressControllerChartName
 )
 
+// nginxIngressControllerMigration replaces the old controller Deployment when upgrading
+// from a chart older than 1.3.0.
+var nginxIngressControllerMigration = stack.Migration{
+	Version:     semverlib.MustParse("1.3.0"),
+	Description: "the old controller Deployment is backed up and removed before the upgrade",
+	Flag:        "--migrate-upstream-nginx-ingress",
+}
+
 func DeployNginxIngressController(ctx context.Context, logger *logrus.Entry, kubeClient ctrlruntimeclient.Client, helmClient helm.Client, opt stack.DeployOptions) error {
 	if slices.Contains(opt.SkipCharts, NginxIngressControllerChartName) {
 		logger.Infof("⭕ Skipping %s deployment.", NginxIngressControllerChartName)
@@ -62,11 +70,24 @@ func DeployNginxIngressController(ctx context.Context, logger *logrus.Entry, kub
 		return nil
 	}
 
-	chart, err := helm.LoadChart(filepath.Join(opt.ChartsDirectory, NginxIngressControllerChartName))
+	chartDir := filepath.Join(opt.ChartsDirectory, NginxIngressControllerChartName)
+
+	chart, err := helm.LoadChart(chartDir)
 	if err != nil {
 		return fmt.Errorf("failed to load Helm chart: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.Releases = append(opt.DryRun.Releases, stack.HelmRelease{
+			Namespace:      NginxIngressControllerNamespace,
+			ReleaseName:    NginxIngressControllerReleaseName,
+			ChartDirectory: chartDir,
+			Migrations:     []stack.Migration{nginxIngressControllerMigration},
+		})
+
+		return nil
+	}
+
 	if err := util.EnsureNamespace(ctx, sublogger, kubeClient, NginxIngressControllerNamespace); err != nil {
 		return fmt.Errorf("failed to create namespace: %w", err)
 	}
@@ -78,19 +99,18 @@ func DeployNginxIngressController(ctx context.Context, logger *logrus.Entry, kub
 
 	// if version older than 1.3.0 is installed, we must perform a migration
 	// by deleting the old deployment object for the controller
-	v13 := semverlib.MustParse("1.3.0")
 	backupTS := time.Now().Format("2006-01-02T150405")
 
 	isUpgrading := false
 
-	if release != nil && release.Version.LessThan(v13) && !chart.Version.LessThan(v13) {
+	if release != nil && nginxIngressControllerMigration.Triggered(release.Version, chart.Version) {
 		if !opt.EnableNginxIngressMigration {
 			sublogger.Warnf("To upgrade %s to a new version, the installer", NginxIngressControllerChartName)
 			sublogger.Warn("will remove the old deployment object before proceeding with the upgrade.")
-			sublogger.Warn("Rerun the installer with --migrate-upstream-nginx-ingress to enable the migration process.")
+			sublogger.Warnf("Rerun the installer with %s to enable the migration process.", nginxIngressControllerMigration.Flag)
 			sublogger.Warn("Please refer to the KKP 2.19 upgrade notes for more information.")
 
-			return fmt.Errorf("user must acknowledge the migration using --migrate-upstream-nginx-ingress")
+			return fmt.Errorf("user must acknowledge the migration using %s", nginxIngressControllerMigration.Flag)
 		}
 
 		isUpgrading = true
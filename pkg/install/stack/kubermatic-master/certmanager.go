@@ -49,6 +49,24 @@ import (
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var (
+	// certManagerV2Migration migrates the cert-manager CRDs from v1alpha2 to v1 when upgrading
+	// from a pre-2.0 chart (cert-manager 0.16 to 1.x).
+	certManagerV2Migration = stack.Migration{
+		Version:     semverlib.MustParse("2.0.0"),
+		Description: "cert-manager resources are backed up, removed and recreated to migrate their CRDs from v1alpha2 to v1",
+		Flag:        "--migrate-cert-manager",
+	}
+
+	// certManagerUpstreamMigration removes the old Deployments when upgrading to the 2.1 chart,
+	// which is based on the upstream chart and uses different label selectors.
+	certManagerUpstreamMigration = stack.Migration{
+		Version:     semverlib.MustParse("2.1.0"),
+		Description: "the old cert-manager Deployments are removed before upgrading to the upstream chart",
+		Flag:        "--migrate-upstream-cert-manager",
+	}
+)
+
 func deployCertManager(ctx context.Context, logger *logrus.Entry, kubeClient ctrlruntimeclient.Client, helmClient helm.Client, opt stack.DeployOptions) error {
 	if slices.Contains(opt.SkipCharts, CertManagerChartName) {
 		logger.Infof("⭕ Skipping %s deployment.", CertManagerChartName)
@@ -75,6 +93,23 @@ func deployCertManager(ctx context.Context, logger *logrus.Entry, kubeClient ctr
 		return fmt.Errorf("failed to load Helm chart: %w", err)
 	}
 
+	// the migrations are part of the planned release and only reported
+	if opt.DryRun != nil {
+		opt.DryRun.CRDs = append(opt.DryRun.CRDs, stack.CRDSource{
+			Directory: filepath.Join(chartDir, "crd"),
+			Kind:      crd.MasterCluster,
+		})
+
+		opt.DryRun.Releases = append(opt.DryRun.Releases, stack.HelmRelease{
+			Namespace:      CertManagerNamespace,
+			ReleaseName:    CertManagerReleaseName,
+			ChartDirectory: chartDir,
+			Migrations:     []stack.Migration{certManagerV2Migration, certManagerUpstreamMigration},
+		})
+
+		return nil
+	}
+
 	if err := util.EnsureNamespace(ctx, sublogger, kubeClient, CertManagerNamespace); err != nil {
 		return fmt.Errorf("failed to create namespace: %w", err)
 	}
@@ -87,17 +122,14 @@ func deployCertManager(ctx context.Context, logger *logrus.Entry, kubeClient ctr
 	// if a pre-2.0 version of the chart is installed, we must perform a
 	// larger migration to bring the cluster from cert-manager 0.16 to 1.x
 	// (and its CRD from v1alpha2 to v1)
-	v2 := semverlib.MustParse("2.0.0")  // New CRDs - migration required
-	v21 := semverlib.MustParse("2.1.0") // Updated to use upstream chart - different label selectors
-
-	if release != nil && release.Version.LessThan(v2) && !chart.Version.LessThan(v2) {
+	if release != nil && certManagerV2Migration.Triggered(release.Version, chart.Version) {
 		if !opt.EnableCertManagerV2Migration {
 			sublogger.Warn("cert-manager CRDs need to be migrated. This requires to temporarily remove and recreate")
 			sublogger.Warn("all related resources (like Certificates, Issuers, etc.). Rerun the installer with")
-			sublogger.Warn("--migrate-cert-manager to enable this mandatory migration.")
+			sublogger.Warnf("%s to enable this mandatory migration.", certManagerV2Migration.Flag)
 			sublogger.Warn("Please refer to the KKP 2.17 upgrade notes for more information.")
 
-			return fmt.Errorf("user must acknowledge the migration using --migrate-cert-manager")
+			return fmt.Errorf("user must acknowledge the migration using %s", certManagerV2Migration.Flag)
 		}
 
 		if err := migrateCertManagerV2(ctx, sublogger, kubeClient, helmClient, opt, chart, release); err != nil {
@@ -110,14 +142,14 @@ func deployCertManager(ctx context.Context, logger *logrus.Entry, kubeClient ctr
 		}
 	}
 
-	if release != nil && release.Version.LessThan(v21) && !chart.Version.LessThan(v21) {
+	if release != nil && certManagerUpstreamMigration.Triggered(release.Version, chart.Version) {
 		if !opt.EnableCertManagerUpstreamMigration {
 			sublogger.Warn("To upgrade cert-manager to a new version, the installer will")
 			sublogger.Warn("remove the old deployment objects before proceeding with the upgrade.")
-			sublogger.Warn("Rerun the installer with --migrate-upstream-cert-manager to enable the migration process.")
+			sublogger.Warnf("Rerun the installer with %s to enable the migration process.", certManagerUpstreamMigration.Flag)
 			sublogger.Warn("Please refer to the KKP 2.19 upgrade notes for more information.")
 
-			return fmt.Errorf("user must acknowledge the migration using --migrate-upstream-cert-manager")
+			return fmt.Errorf("user must acknowledge the migration using %s", certManagerUpstreamMigration.Flag)
 		}
 
 		if err := preparePreV21CertManagerDeployment(ctx, sublogger, kubeClient, helmClient, opt, chart, release); err != nil {
@@ -36,7 +36,7 @@ import (
 )
 
 func deployDex(ctx context.Context, logger *logrus.Entry, kubeClient ctrlruntimeclient.Client, helmClient helm.Client, opt stack.DeployOptions) error {
-	if slices.Contains(opt.SkipCharts, "dex") {
+	if slices.Contains(opt.SkipCharts, DexChartName) {
 		logger.Info("⭕ Skipping Dex deployment.")
 		return nil
 	}
@@ -53,11 +53,23 @@ func deployDex(ctx context.Context, logger *logrus.Entry, kubeClient ctrlruntime
 		return nil
 	}
 
-	chart, err := helm.LoadChart(filepath.Join(opt.ChartsDirectory, chartName))
+	chartDir := filepath.Join(opt.ChartsDirectory, chartName)
+
+	chart, err := helm.LoadChart(chartDir)
 	if err != nil {
 		return fmt.Errorf("failed to load Helm chart: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.Releases = append(opt.DryRun.Releases, stack.HelmRelease{
+			Namespace:      namespace,
+			ReleaseName:    releaseName,
+			ChartDirectory: chartDir,
+		})
+
+		return nil
+	}
+
 	if err := util.EnsureNamespace(ctx, sublogger, kubeClient, namespace); err != nil {
 		return fmt.Errorf("failed to create namespace: %w", err)
 	}
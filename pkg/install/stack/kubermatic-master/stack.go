@@ -42,6 +42,9 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -119,69 +122,130 @@ func (*MasterStack) Name() string {
 	return "KKP master stack"
 }
 
+// deployStep is a single step of deploying the master stack. Plan runs the
+// same steps in dry-run mode, so that a plan covers everything that is
+// deployed.
+type deployStep struct {
+	name   string
+	deploy func(ctx context.Context, opt stack.DeployOptions) error
+}
+
+func (s *MasterStack) steps() []deployStep {
+	return []deployStep{
+		{
+			name: "deploy StorageClass",
+			deploy: func(ctx context.Context, opt stack.DeployOptions) error {
+				return deployStorageClass(ctx, opt.Logger, opt.KubeClient, opt)
+			},
+		},
+		{
+			name:   "deploy L7 ingress",
+			deploy: deployL7Ingress,
+		},
+		{
+			name: "deploy cert-manager",
+			deploy: func(ctx context.Context, opt stack.DeployOptions) error {
+				return deployCertManager(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt)
+			},
+		},
+		{
+			name: "deploy Dex",
+			deploy: func(ctx context.Context, opt stack.DeployOptions) error {
+				return deployDex(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt)
+			},
+		},
+		{
+			name: "deploy Kubermatic Operator",
+			deploy: func(ctx context.Context, opt stack.DeployOptions) error {
+				return s.deployKubermaticOperator(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt)
+			},
+		},
+		{
+			name: "apply Kubermatic Configuration",
+			deploy: func(ctx context.Context, opt stack.DeployOptions) error {
+				return applyKubermaticConfiguration(ctx, opt.Logger, opt.KubeClient, opt)
+			},
+		},
+		{
+			// once Kubermatic Operator is up and running, it will create the managed Gateway object if needed.
+			// so, cleanup old resources depending on the mode.
+			name:   "clean up L7 ingress resources",
+			deploy: l7IngressResourceCleanup,
+		},
+		{
+			name: "deploy Telemetry",
+			deploy: func(ctx context.Context, opt stack.DeployOptions) error {
+				return deployTelemetry(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt)
+			},
+		},
+		{
+			name: "deploy default Policy Template catalog",
+			deploy: func(ctx context.Context, opt stack.DeployOptions) error {
+				return deployDefaultPolicyTemplateCatalog(ctx, opt.Logger, opt.KubeClient, opt)
+			},
+		},
+		{
+			name: "show DNS settings",
+			deploy: func(ctx context.Context, opt stack.DeployOptions) error {
+				// only informs the user and does not change anything
+				if s.showDNSHelp && opt.DryRun == nil {
+					showDNSSettings(ctx, opt.Logger, opt.KubeClient, opt)
+				}
+				return nil
+			},
+		},
+	}
+}
+
 func (s *MasterStack) Deploy(ctx context.Context, opt stack.DeployOptions) error {
 	if opt.KubermaticConfiguration == nil {
 		return errors.New("kubermatic configuration is nil")
 	}
 
-	if err := deployStorageClass(ctx, opt.Logger, opt.KubeClient, opt); err != nil {
-		return fmt.Errorf("failed to deploy StorageClass: %w", err)
-	}
-
-	if opt.MigrateToGatewayAPI {
-		if opt.KubermaticConfiguration.Spec.Ingress.Gateway.UsesExternalGateway() {
-			if err := common.EnsureGatewayAPICRDs(ctx, opt.Logger, opt.KubeClient, opt); err != nil {
-				return fmt.Errorf("failed to ensure Gateway API CRDs: %w", err)
-			}
-			if err := validateExternalGatewayNotOperatorOwned(ctx, opt.KubeClient, opt.KubermaticConfiguration); err != nil {
-				return fmt.Errorf("invalid external Gateway configuration: %w", err)
-			}
-			opt.Logger.Info("⭕ Skipping envoy-gateway-controller deployment because spec.ingress.gateway.externalGateway is configured.")
-		} else {
-			err := common.DeployEnvoyGatewayController(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt)
-			if err != nil {
-				return fmt.Errorf("failed to deploy envoy-gateway-controller: %w", err)
-			}
-		}
-	} else {
-		err := common.DeployNginxIngressController(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt)
-		if err != nil {
-			return fmt.Errorf("failed to deploy nginx-ingress-controller: %w", err)
+	for _, step := range s.steps() {
+		if err := step.deploy(ctx, opt); err != nil {
+			return fmt.Errorf("failed to %s: %w", step.name, err)
 		}
 	}
 
-	if err := deployCertManager(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt); err != nil {
-		return fmt.Errorf("failed to deploy cert-manager: %w", err)
-	}
+	return nil
+}
 
-	if err := deployDex(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt); err != nil {
-		return fmt.Errorf("failed to deploy Dex: %w", err)
-	}
+// Plan runs Deploy in dry-run mode.
+func (s *MasterStack) Plan(ctx context.Context, opt stack.DeployOptions) (*stack.DeploymentPlan, error) {
+	opt.DryRun = &stack.DeploymentPlan{}
+	opt.Logger = opt.Logger.WithField("dry-run", true)
 
-	if err := s.deployKubermaticOperator(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt); err != nil {
-		return fmt.Errorf("failed to deploy Kubermatic Operator: %w", err)
+	if err := s.Deploy(ctx, opt); err != nil {
+		return nil, err
 	}
 
-	if err := applyKubermaticConfiguration(ctx, opt.Logger, opt.KubeClient, opt); err != nil {
-		return fmt.Errorf("failed to apply Kubermatic Configuration: %w", err)
-	}
+	return opt.DryRun, nil
+}
 
-	// once Kubermatic Operator is up and running, it will create the managed Gateway object if needed.
-	// so, cleanup old resources depending on the mode.
-	if err := l7IngressResourceCleanup(ctx, opt); err != nil {
-		return fmt.Errorf("L7 ingress resource cleanup failed: %w", err)
-	}
+func deployL7Ingress(ctx context.Context, opt stack.DeployOptions) error {
+	if !opt.MigrateToGatewayAPI {
+		if err := common.DeployNginxIngressController(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt); err != nil {
+			return fmt.Errorf("failed to deploy nginx-ingress-controller: %w", err)
+		}
 
-	if err := deployTelemetry(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt); err != nil {
-		return fmt.Errorf("failed to deploy Telemetry: %w", err)
+		return nil
 	}
 
-	if err := deployDefaultPolicyTemplateCatalog(ctx, opt.Logger, opt.KubeClient, opt); err != nil {
-		return fmt.Errorf("failed to deploy default Policy Template catalog: %w", err)
+	if opt.KubermaticConfiguration.Spec.Ingress.Gateway.UsesExternalGateway() {
+		if err := common.EnsureGatewayAPICRDs(ctx, opt.Logger, opt.KubeClient, opt); err != nil {
+			return fmt.Errorf("failed to ensure Gateway API CRDs: %w", err)
+		}
+		if err := validateExternalGatewayNotOperatorOwned(ctx, opt.KubeClient, opt.KubermaticConfiguration); err != nil {
+			return fmt.Errorf("invalid external Gateway configuration: %w", err)
+		}
+		opt.Logger.Info("⭕ Skipping envoy-gateway-controller deployment because spec.ingress.gateway.externalGateway is configured.")
+
+		return nil
 	}
 
-	if s.showDNSHelp {
-		showDNSSettings(ctx, opt.Logger, opt.KubeClient, opt)
+	if err := common.DeployEnvoyGatewayController(ctx, opt.Logger, opt.KubeClient, opt.HelmClient, opt); err != nil {
+		return fmt.Errorf("failed to deploy envoy-gateway-controller: %w", err)
 	}
 
 	return nil
@@ -196,11 +260,23 @@ func deployTelemetry(ctx context.Context, logger *logrus.Entry, kubeClient ctrlr
 		return nil
 	}
 
-	chart, err := helm.LoadChart(filepath.Join(opt.ChartsDirectory, "telemetry"))
+	chartDir := filepath.Join(opt.ChartsDirectory, TelemetryChartName)
+
+	chart, err := helm.LoadChart(chartDir)
 	if err != nil {
 		return fmt.Errorf("failed to load Helm chart: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.Releases = append(opt.DryRun.Releases, stack.HelmRelease{
+			Namespace:      TelemetryNamespace,
+			ReleaseName:    TelemetryReleaseName,
+			ChartDirectory: chartDir,
+		})
+
+		return nil
+	}
+
 	if err := util.EnsureNamespace(ctx, sublogger, kubeClient, TelemetryNamespace); err != nil {
 		return fmt.Errorf("failed to create namespace: %w", err)
 	}
@@ -281,6 +357,10 @@ func deployStorageClass(ctx context.Context, logger *logrus.Entry, kubeClient ct
 		return fmt.Errorf("failed to define StorageClass: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		return opt.DryRun.AddObject(&storageClass, storagev1.SchemeGroupVersion.WithKind("StorageClass"))
+	}
+
 	if err := kubeClient.Create(ctx, &storageClass); err != nil {
 		return fmt.Errorf("failed to create StorageClass: %w", err)
 	}
@@ -294,7 +374,9 @@ func (s *MasterStack) deployKubermaticOperator(ctx context.Context, logger *logr
 	logger.Info("📦 Deploying Kubermatic Operator…")
 	sublogger := log.Prefix(logger, "   ")
 
-	chart, err := helm.LoadChart(filepath.Join(opt.ChartsDirectory, "kubermatic-operator"))
+	chartDir := filepath.Join(opt.ChartsDirectory, KubermaticOperatorChartName)
+
+	chart, err := helm.LoadChart(chartDir)
 	if err != nil {
 		return fmt.Errorf("failed to load Helm chart: %w", err)
 	}
@@ -304,6 +386,16 @@ func (s *MasterStack) deployKubermaticOperator(ctx context.Context, logger *logr
 		return fmt.Errorf("failed to deploy CRDs: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.Releases = append(opt.DryRun.Releases, stack.HelmRelease{
+			Namespace:      KubermaticOperatorNamespace,
+			ReleaseName:    KubermaticOperatorReleaseName,
+			ChartDirectory: chartDir,
+		})
+
+		return nil
+	}
+
 	if err := util.EnsureNamespace(ctx, sublogger, kubeClient, KubermaticOperatorNamespace); err != nil {
 		return fmt.Errorf("failed to create namespace: %w", err)
 	}
@@ -324,7 +416,23 @@ func (s *MasterStack) deployKubermaticOperator(ctx context.Context, logger *logr
 }
 
 func (*MasterStack) InstallKubermaticCRDs(ctx context.Context, client ctrlruntimeclient.Client, logger logrus.FieldLogger, opt stack.DeployOptions) error {
-	crdDirectory := filepath.Join(opt.ChartsDirectory, "kubermatic-operator", "crd")
+	crdDirectory := filepath.Join(opt.ChartsDirectory, KubermaticOperatorChartName, "crd")
+
+	if opt.DryRun != nil {
+		opt.DryRun.CRDs = append(opt.DryRun.CRDs,
+			stack.CRDSource{
+				Directory: filepath.Join(crdDirectory, "k8c.io"),
+				Kind:      crd.MasterCluster,
+				Versions:  &opt.Versions,
+			},
+			stack.CRDSource{
+				Directory: filepath.Join(crdDirectory, "k8s.io"),
+				Kind:      crd.MasterCluster,
+			},
+		)
+
+		return nil
+	}
 
 	// install KKP CRDs
 	if err := util.DeployCRDs(ctx, client, logger, filepath.Join(crdDirectory, "k8c.io"), &opt.Versions, crd.MasterCluster); err != nil {
@@ -351,6 +459,22 @@ func applyKubermaticConfiguration(ctx context.Context, logger *logrus.Entry, kub
 
 	logger.Info("📝 Applying Kubermatic Configuration…")
 
+	// the metadata of an existing configuration is kept, so only the spec is planned
+	if opt.DryRun != nil {
+		config := &unstructured.Unstructured{}
+		config.SetGroupVersionKind(opt.RawKubermaticConfiguration.GroupVersionKind())
+		config.SetName(opt.KubermaticConfiguration.Name)
+		config.SetNamespace(opt.KubermaticConfiguration.Namespace)
+
+		if spec, ok := opt.RawKubermaticConfiguration.Object["spec"]; ok {
+			config.Object["spec"] = runtime.DeepCopyJSONValue(spec)
+		}
+
+		opt.DryRun.Objects = append(opt.DryRun.Objects, stack.ObjectSource{Object: config})
+
+		return nil
+	}
+
 	existingConfig := &kubermaticv1.KubermaticConfiguration{}
 	name := types.NamespacedName{
 		Name:      opt.KubermaticConfiguration.Name,
@@ -532,23 +656,28 @@ func gatewayObjectKey(config *kubermaticv1.KubermaticConfiguration) types.Namesp
 }
 
 // cleanupGatewayAPIResources removes the Gateway and HTTPRoute when switching from Gateway API to Ingress.
-func cleanupGatewayAPIResources(ctx context.Context, l *logrus.Entry, c ctrlruntimeclient.Client, config *kubermaticv1.KubermaticConfiguration) error {
+func cleanupGatewayAPIResources(ctx context.Context, l *logrus.Entry, c ctrlruntimeclient.Client, opt stack.DeployOptions) error {
 	l.Info("Removing existing Gateway API resources (if any) since Ingress is enabled for Kubermatic")
+	config := opt.KubermaticConfiguration
 	if config == nil {
 		return errors.New("kubermatic configuration is nil")
 	}
 
 	gtw := &gatewayapiv1.Gateway{}
 
-	err := c.Get(ctx, types.NamespacedName{Namespace: config.Namespace, Name: defaulting.DefaultGatewayName}, gtw)
+	gatewayKey := types.NamespacedName{Namespace: config.Namespace, Name: defaulting.DefaultGatewayName}
+	err := c.Get(ctx, gatewayKey, gtw)
 	if err == nil {
 		if isGatewayOwnedByKubermaticConfiguration(gtw) {
-			err = c.Delete(ctx, gtw)
-			if err != nil && !apierrors.IsNotFound(err) {
+			if opt.DryRun != nil {
+				opt.DryRun.RemovedObjects = append(opt.DryRun.RemovedObjects, stack.RemovedObject{
+					Object: removedObject(gatewayapiv1.SchemeGroupVersion.WithKind("Gateway"), gatewayKey),
+				})
+			} else if err := c.Delete(ctx, gtw); err != nil && !apierrors.IsNotFound(err) {
 				return fmt.Errorf("failed to delete Gateway: %w", err)
 			}
 		} else {
-			l.WithField("gateway", types.NamespacedName{Namespace: config.Namespace, Name: defaulting.DefaultGatewayName}.String()).Debug("Leaving non-operator-owned Gateway untouched during cleanup")
+			l.WithField("gateway", gatewayKey.String()).Debug("Leaving non-operator-owned Gateway untouched during cleanup")
 		}
 	} else if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
 		return fmt.Errorf("failed to get Gateway: %w", err)
@@ -560,8 +689,11 @@ func cleanupGatewayAPIResources(ctx context.Context, l *logrus.Entry, c ctrlrunt
 	err = c.Get(ctx, httpRouteKey, hr)
 	if err == nil {
 		if isHTTPRouteOwnedByKubermaticConfiguration(hr) {
-			err = c.Delete(ctx, hr)
-			if err != nil && !apierrors.IsNotFound(err) {
+			if opt.DryRun != nil {
+				opt.DryRun.RemovedObjects = append(opt.DryRun.RemovedObjects, stack.RemovedObject{
+					Object: removedObject(gatewayapiv1.SchemeGroupVersion.WithKind("HTTPRoute"), httpRouteKey),
+				})
+			} else if err := c.Delete(ctx, hr); err != nil && !apierrors.IsNotFound(err) {
 				return fmt.Errorf("failed to delete HTTPRoute: %w", err)
 			}
 		} else {
@@ -633,13 +765,30 @@ func cleanupIngressWithPollConfig(ctx context.Context, l *logrus.Entry, c ctrlru
 		return errors.New("kubermatic configuration is nil")
 	}
 
+	kubermaticIngressName := types.NamespacedName{Namespace: config.Namespace, Name: defaulting.DefaultIngressName}
+	dexIngressName := types.NamespacedName{Namespace: DexNamespace, Name: DexChartName}
+
+	// the Ingresses are only deleted once the HTTPRoutes replacing them have been
+	// accepted, which cannot be waited for without deploying
+	if opt.DryRun != nil {
+		ingresses := []types.NamespacedName{kubermaticIngressName}
+		if !slices.Contains(opt.SkipCharts, DexChartName) {
+			ingresses = append(ingresses, dexIngressName)
+		}
+
+		for _, name := range ingresses {
+			opt.DryRun.RemovedObjects = append(opt.DryRun.RemovedObjects, stack.RemovedObject{
+				Object: removedObject(networkingv1.SchemeGroupVersion.WithKind("Ingress"), name),
+			})
+		}
+
+		return nil
+	}
+
 	if err := waitForExternalGatewayHTTPRoutesWithPollConfig(ctx, l, c, opt, pollConfig); err != nil {
 		return err
 	}
 
-	kubermaticIngressName := types.NamespacedName{Namespace: config.Namespace, Name: defaulting.DefaultIngressName}
-	dexIngressName := types.NamespacedName{Namespace: DexNamespace, Name: DexChartName}
-
 	kubermaticIngressExists, err := ingressExists(ctx, c, kubermaticIngressName)
 	if err != nil {
 		return err
@@ -993,7 +1142,7 @@ func l7IngressResourceCleanup(ctx context.Context, opt stack.DeployOptions) erro
 		return nil
 	}
 
-	err := cleanupGatewayAPIResources(ctx, opt.Logger, opt.KubeClient, opt.KubermaticConfiguration)
+	err := cleanupGatewayAPIResources(ctx, opt.Logger, opt.KubeClient, opt)
 	if err != nil {
 		return fmt.Errorf("cleanup Gateway API resources failed: %w", err)
 	}
@@ -1002,3 +1151,12 @@ func l7IngressResourceCleanup(ctx context.Context, opt stack.DeployOptions) erro
 
 	return nil
 }
+
+func removedObject(gvk schema.GroupVersionKind, name types.NamespacedName) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(gvk)
+	object.SetNamespace(name.Namespace)
+	object.SetName(name.Name)
+
+	return object
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubermaticmaster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	semverlib "github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/features"
+	"k8c.io/kubermatic/v2/pkg/install/helm"
+	"k8c.io/kubermatic/v2/pkg/install/plan"
+	"k8c.io/kubermatic/v2/pkg/install/stack"
+	"k8c.io/kubermatic/v2/pkg/install/stack/common"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/kubermatic/v2/pkg/util/yamled"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// upToDateHelmClient reports every release as deployed with the chart version
+// and values that are being planned.
+type upToDateHelmClient struct {
+	version *semverlib.Version
+	values  *yamled.Document
+}
+
+var _ helm.Client = &upToDateHelmClient{}
+
+func (c *upToDateHelmClient) BuildChartDependencies(chartDirectory string, flags []string) error {
+	return nil
+}
+
+func (c *upToDateHelmClient) InstallChart(namespace string, releaseName string, chartDirectory string, valuesFile string, values map[string]string, flags []string) error {
+	panic("plans must not install charts")
+}
+
+func (c *upToDateHelmClient) GetRelease(namespace string, name string) (*helm.Release, error) {
+	return &helm.Release{
+		Name:      name,
+		Namespace: namespace,
+		Version:   c.version,
+		Status:    helm.ReleaseStatusDeployed,
+	}, nil
+}
+
+func (c *upToDateHelmClient) ListReleases(namespace string) ([]helm.Release, error) {
+	return nil, nil
+}
+
+func (c *upToDateHelmClient) UninstallRelease(namespace string, name string) error {
+	panic("plans must not uninstall releases")
+}
+
+func (c *upToDateHelmClient) RenderChart(namespace string, releaseName string, chartDirectory string, valuesFile string, values map[string]string, flags []string) ([]byte, error) {
+	panic("up-to-date releases must not be rendered")
+}
+
+func (c *upToDateHelmClient) GetValues(namespace string, releaseName string) (*yamled.Document, error) {
+	return c.values, nil
+}
+
+func TestPlanKubermaticConfiguration(t *testing.T) {
+	chartsDir := t.TempDir()
+	operatorChartDir := filepath.Join(chartsDir, KubermaticOperatorChartName)
+	if err := os.MkdirAll(operatorChartDir, 0o755); err != nil {
+		t.Fatalf("failed to create chart directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(operatorChartDir, "Chart.yaml"), []byte("name: kubermatic-operator\nversion: 1.0.0\n"), 0o644); err != nil {
+		t.Fatalf("failed to write Chart.yaml: %v", err)
+	}
+
+	config := func(domain string) *kubermaticv1.KubermaticConfiguration {
+		return &kubermaticv1.KubermaticConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+				Kind:       "KubermaticConfiguration",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubermatic",
+				Namespace: KubermaticOperatorNamespace,
+			},
+			Spec: kubermaticv1.KubermaticConfigurationSpec{
+				Ingress: kubermaticv1.KubermaticIngressConfiguration{
+					Domain: domain,
+				},
+				FeatureGates: map[string]bool{
+					features.HeadlessInstallation: true,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		domain         string
+		expectedAction plan.Action
+	}{
+		{
+			name:   "unchanged configuration",
+			domain: "example.com",
+		},
+		{
+			name:           "changed configuration",
+			domain:         "changed.example.com",
+			expectedAction: plan.ActionUpdate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			liveConfig := config("example.com")
+			liveConfig.Annotations = map[string]string{"kubermatic.k8c.io/test": "kept"}
+
+			storageClass := &storagev1.StorageClass{}
+			storageClass.Name = common.StorageClassName
+
+			kubeClient := fake.NewClientBuilder().WithObjects(liveConfig, storageClass).Build()
+
+			rawFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(config(tc.domain))
+			if err != nil {
+				t.Fatalf("failed to convert configuration: %v", err)
+			}
+
+			values, err := yamled.Load(strings.NewReader("foo: bar\n"))
+			if err != nil {
+				t.Fatalf("failed to load values: %v", err)
+			}
+
+			opt := stack.DeployOptions{
+				KubeClient:                 kubeClient,
+				HelmClient:                 &upToDateHelmClient{version: semverlib.MustParse("1.0.0"), values: values},
+				HelmValues:                 values,
+				KubermaticConfiguration:    config(tc.domain),
+				RawKubermaticConfiguration: &unstructured.Unstructured{Object: rawFields},
+				ChartsDirectory:            chartsDir,
+				DisableTelemetry:           true,
+				DisableDependencyUpdate:    true,
+				Logger:                     logrus.NewEntry(logrus.New()),
+			}
+
+			report, err := plan.Build(context.Background(), NewStack(false), opt)
+			if err != nil {
+				t.Fatalf("Failed to build plan: %v", err)
+			}
+
+			var configChanges []plan.ObjectChange
+			for _, object := range report.Objects {
+				if object.Kind == "KubermaticConfiguration" {
+					configChanges = append(configChanges, object)
+				}
+			}
+
+			if tc.expectedAction == "" {
+				if len(configChanges) > 0 {
+					t.Fatalf("Expected no change to the KubermaticConfiguration, got %+v.", configChanges)
+				}
+				return
+			}
+
+			if len(configChanges) != 1 {
+				t.Fatalf("Expected 1 change to the KubermaticConfiguration, got %+v.", configChanges)
+			}
+
+			change := configChanges[0]
+			if change.Action != tc.expectedAction {
+				t.Errorf("Expected action %q, got %q.", tc.expectedAction, change.Action)
+			}
+
+			if !strings.Contains(change.Diff, "+    domain: "+tc.domain) {
+				t.Errorf("Expected diff to contain the changed domain, got:\n%s", change.Diff)
+			}
+
+			if !report.HasDrift() {
+				t.Error("Expected the changed configuration to be reported as drift.")
+			}
+		})
+	}
+}
+
+func TestPlanDoesNotChangeTheCluster(t *testing.T) {
+	config := &kubermaticv1.KubermaticConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+			Kind:       "KubermaticConfiguration",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: KubermaticOperatorNamespace,
+		},
+		Spec: kubermaticv1.KubermaticConfigurationSpec{
+			Ingress: kubermaticv1.KubermaticIngressConfiguration{
+				Domain: "example.com",
+				CertificateIssuer: corev1.TypedLocalObjectReference{
+					Name: "letsencrypt-prod",
+				},
+			},
+		},
+	}
+
+	rawFields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(config)
+	if err != nil {
+		t.Fatalf("failed to convert configuration: %v", err)
+	}
+
+	values, err := yamled.Load(strings.NewReader("foo: bar\n"))
+	if err != nil {
+		t.Fatalf("failed to load values: %v", err)
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	storageClass.Name = common.StorageClassName
+
+	mutate := func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object) error {
+		return fmt.Errorf("plans must not change %T %s", obj, obj.GetName())
+	}
+
+	kubeClient := fake.NewClientBuilder().
+		WithObjects(storageClass).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.CreateOption) error {
+				return mutate(ctx, client, obj)
+			},
+			Update: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.UpdateOption) error {
+				return mutate(ctx, client, obj)
+			},
+			Patch: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, patch ctrlruntimeclient.Patch, opts ...ctrlruntimeclient.PatchOption) error {
+				return mutate(ctx, client, obj)
+			},
+			Delete: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.DeleteOption) error {
+				return mutate(ctx, client, obj)
+			},
+		}).
+		Build()
+
+	opt := stack.DeployOptions{
+		KubeClient:                 kubeClient,
+		HelmClient:                 &upToDateHelmClient{version: semverlib.MustParse("1.0.0"), values: values},
+		HelmValues:                 values,
+		KubermaticConfiguration:    config,
+		RawKubermaticConfiguration: &unstructured.Unstructured{Object: rawFields},
+		ChartsDirectory:            "../../../../charts",
+		Logger:                     logrus.NewEntry(logrus.New()),
+	}
+
+	deploymentPlan, err := NewStack(true).Plan(context.Background(), opt)
+	if err != nil {
+		t.Fatalf("Failed to plan: %v", err)
+	}
+
+	var releases []string
+	for _, release := range deploymentPlan.Releases {
+		releases = append(releases, release.ReleaseName)
+	}
+
+	expected := []string{
+		common.NginxIngressControllerReleaseName,
+		CertManagerReleaseName,
+		DexReleaseName,
+		KubermaticOperatorReleaseName,
+		TelemetryReleaseName,
+	}
+
+	if !slices.Equal(releases, expected) {
+		t.Errorf("Expected releases %v, got %v.", expected, releases)
+	}
+}
@@ -573,7 +573,7 @@ func TestCleanupGatewayAPIResourcesRespectsHTTPRouteOwnership(t *testing.T) {
 			}
 
 			client := fake.NewClientBuilder().WithObjects(route).Build()
-			if err := cleanupGatewayAPIResources(ctx, logrus.NewEntry(logrus.New()), client, cfg); err != nil {
+			if err := cleanupGatewayAPIResources(ctx, logrus.NewEntry(logrus.New()), client, stack.DeployOptions{KubermaticConfiguration: cfg}); err != nil {
 				t.Fatalf("expected no error, got %v", err)
 			}
 
@@ -99,11 +99,26 @@ func (s *SeedStack) Deploy(ctx context.Context, opt stack.DeployOptions) error {
 		return fmt.Errorf("failed to deploy S3 Exporter: %w", err)
 	}
 
-	showDNSSettings(ctx, opt.Logger, opt.KubeClient, opt)
+	// only informs the user and does not change anything
+	if opt.DryRun == nil {
+		showDNSSettings(ctx, opt.Logger, opt.KubeClient, opt)
+	}
 
 	return nil
 }
 
+// Plan runs Deploy in dry-run mode.
+func (s *SeedStack) Plan(ctx context.Context, opt stack.DeployOptions) (*stack.DeploymentPlan, error) {
+	opt.DryRun = &stack.DeploymentPlan{}
+	opt.Logger = opt.Logger.WithField("dry-run", true)
+
+	if err := s.Deploy(ctx, opt); err != nil {
+		return nil, err
+	}
+
+	return opt.DryRun, nil
+}
+
 func deployStorageClass(ctx context.Context, logger *logrus.Entry, kubeClient ctrlruntimeclient.Client, opt stack.DeployOptions) error {
 	logger.Infof("💾 Deploying %s StorageClass…", common.StorageClassName)
 	sublogger := log.Prefix(logger, "   ")
@@ -166,6 +181,10 @@ func deployStorageClass(ctx context.Context, logger *logrus.Entry, kubeClient ct
 		return fmt.Errorf("failed to define StorageClass: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		return opt.DryRun.AddObject(&storageClass, storagev1.SchemeGroupVersion.WithKind("StorageClass"))
+	}
+
 	if err := kubeClient.Create(ctx, &storageClass); err != nil {
 		return fmt.Errorf("failed to create StorageClass: %w", err)
 	}
@@ -221,11 +240,23 @@ func deployMinio(ctx context.Context, logger *logrus.Entry, kubeClient ctrlrunti
 	logger.Info("📦 Deploying Minio…")
 	sublogger := log.Prefix(logger, "   ")
 
-	chart, err := helm.LoadChart(filepath.Join(opt.ChartsDirectory, MinioChartName))
+	chartDir := filepath.Join(opt.ChartsDirectory, MinioChartName)
+
+	chart, err := helm.LoadChart(chartDir)
 	if err != nil {
 		return fmt.Errorf("failed to load Helm chart: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.Releases = append(opt.DryRun.Releases, stack.HelmRelease{
+			Namespace:      MinioNamespace,
+			ReleaseName:    MinioReleaseName,
+			ChartDirectory: chartDir,
+		})
+
+		return nil
+	}
+
 	if err := util.EnsureNamespace(ctx, sublogger, kubeClient, MinioNamespace); err != nil {
 		return fmt.Errorf("failed to create namespace: %w", err)
 	}
@@ -253,11 +284,23 @@ func deployS3Exporter(ctx context.Context, logger *logrus.Entry, kubeClient ctrl
 	logger.Info("📦 Deploying S3 Exporter…")
 	sublogger := log.Prefix(logger, "   ")
 
-	chart, err := helm.LoadChart(filepath.Join(opt.ChartsDirectory, S3ExporterChartName))
+	chartDir := filepath.Join(opt.ChartsDirectory, S3ExporterChartName)
+
+	chart, err := helm.LoadChart(chartDir)
 	if err != nil {
 		return fmt.Errorf("failed to load Helm chart: %w", err)
 	}
 
+	if opt.DryRun != nil {
+		opt.DryRun.Releases = append(opt.DryRun.Releases, stack.HelmRelease{
+			Namespace:      S3ExporterNamespace,
+			ReleaseName:    S3ExporterReleaseName,
+			ChartDirectory: chartDir,
+		})
+
+		return nil
+	}
+
 	if err := util.EnsureNamespace(ctx, sublogger, kubeClient, S3ExporterNamespace); err != nil {
 		return fmt.Errorf("failed to create namespace: %w", err)
 	}
@@ -17,11 +17,20 @@ limitations under the License.
 package kubermaticseed
 
 import (
+	"context"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/sirupsen/logrus"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/install/stack"
+	"k8c.io/kubermatic/v2/pkg/install/stack/common"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
 	"k8c.io/kubermatic/v2/pkg/util/yamled"
+
+	storagev1 "k8s.io/api/storage/v1"
 )
 
 func TestSeedHTTPRouteGateway(t *testing.T) {
@@ -123,3 +132,77 @@ migrateGatewayAPI: true
 		})
 	}
 }
+
+func TestSeedStackPlan(t *testing.T) {
+	testCases := []struct {
+		name             string
+		values           string
+		separateSeed     bool
+		migrateToGateway bool
+		skipCharts       []string
+		wantReleases     []string
+		wantCreateOnly   bool
+	}{
+		{
+			name:         "shared seed only deploys the seed components",
+			wantReleases: []string{MinioReleaseName, S3ExporterReleaseName},
+		},
+		{
+			name:         "separate seed deploys nginx-ingress-controller",
+			separateSeed: true,
+			wantReleases: []string{common.NginxIngressControllerReleaseName, MinioReleaseName, S3ExporterReleaseName},
+		},
+		{
+			name: "separate seed with external Gateway only ensures Gateway API CRDs",
+			values: `
+migrateGatewayAPI: true
+httpRoute:
+  externalGateway: true
+`,
+			separateSeed:     true,
+			migrateToGateway: true,
+			skipCharts:       []string{S3ExporterChartName},
+			wantReleases:     []string{MinioReleaseName},
+			wantCreateOnly:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := yamled.Load(strings.NewReader("---\n" + tc.values))
+			if err != nil {
+				t.Fatalf("failed to load Helm values: %v", err)
+			}
+
+			storageClass := &storagev1.StorageClass{}
+			storageClass.Name = common.StorageClassName
+
+			plan, err := NewStack().Plan(context.Background(), stack.DeployOptions{
+				KubeClient:              fake.NewClientBuilder().WithObjects(storageClass).Build(),
+				Logger:                  logrus.NewEntry(logrus.New()),
+				ChartsDirectory:         "../../../../charts",
+				HelmValues:              doc,
+				KubermaticConfiguration: &kubermaticv1.KubermaticConfiguration{},
+				SeparateSeed:            tc.separateSeed,
+				MigrateToGatewayAPI:     tc.migrateToGateway,
+				SkipCharts:              tc.skipCharts,
+			})
+			if err != nil {
+				t.Fatalf("Plan() returned error: %v", err)
+			}
+
+			var releases []string
+			for _, release := range plan.Releases {
+				releases = append(releases, release.ReleaseName)
+			}
+
+			if !slices.Equal(releases, tc.wantReleases) {
+				t.Fatalf("Plan() releases = %v, want %v", releases, tc.wantReleases)
+			}
+
+			if tc.wantCreateOnly && (len(plan.CRDs) != 1 || !plan.CRDs[0].CreateOnly) {
+				t.Fatalf("Plan() CRDs = %+v, want a single create-only source", plan.CRDs)
+			}
+		})
+	}
+}
@@ -95,6 +95,22 @@ const (
 	AlloyNamespace   = LoggingNamespace
 )
 
+// Upgrading these charts to 2.28 or newer requires their workloads to be removed
+// and recreated.
+var (
+	nodeExporterMigration     = recreateMigration("Node Exporter DaemonSet")
+	kubeStateMetricsMigration = recreateMigration("kube-state-metrics Deployment")
+	blackboxExporterMigration = recreateMigration("Blackbox Exporter Deployment")
+	alertManagerMigration     = recreateMigration("Alertmanager StatefulSet")
+)
+
+func recreateMigration(resource string) stack.Migration {
+	return stack.Migration{
+		Version:     semverlib.MustParse("2.28.0"),
+		Description: fmt.Sprintf("the %s is temporarily removed and then recreated", resource),
+	}
+}
+
 type MonitoringStack struct{}
 
 func NewStack() stack.Stack {
@@ -161,6 +177,67 @@ func (s *MonitoringStack) Deploy(ctx context.Context, opt stack.DeployOptions) e
 	return nil
 }
 
+func (*MonitoringStack) Plan(_ context.Context, opt stack.DeployOptions) (*stack.DeploymentPlan, error) {
+	monitoringRelease := func(chartName, namespace, releaseName string, migrations ...stack.Migration) stack.HelmRelease {
+		return stack.HelmRelease{
+			Namespace:      namespace,
+			ReleaseName:    releaseName,
+			ChartDirectory: filepath.Join(opt.ChartsDirectory, MonitoringChartsPrefix, chartName),
+			Migrations:     migrations,
+		}
+	}
+
+	candidates := []struct {
+		chartName string
+		skip      bool
+		release   stack.HelmRelease
+	}{
+		{NodeExporterChartName, false, monitoringRelease(NodeExporterChartName, NodeExporterNamespace, NodeExporterReleaseName, nodeExporterMigration)},
+		{KubeStateMetricsChartName, false, monitoringRelease(KubeStateMetricsChartName, KubeStateMetricsNamespace, KubeStateMetricsReleaseName, kubeStateMetricsMigration)},
+		{GrafanaChartName, false, monitoringRelease(GrafanaChartName, GrafanaNamespace, GrafanaReleaseName)},
+		{BlackboxExporterChartName, false, monitoringRelease(BlackboxExporterChartName, BlackboxExporterNamespace, BlackboxExporterReleaseName, blackboxExporterMigration)},
+		{AlertManagerChartName, false, monitoringRelease(AlertManagerChartName, AlertManagerNamespace, AlertManagerReleaseName, alertManagerMigration)},
+		{PrometheusChartName, false, monitoringRelease(PrometheusChartName, PrometheusNamespace, PrometheusReleaseName)},
+		{HelmExporterChartName, false, monitoringRelease(HelmExporterChartName, HelmExporterNamespace, HelmExporterReleaseName)},
+		{KarmaChartName, false, monitoringRelease(KarmaChartName, KarmaNamespace, KarmaReleaseName)},
+		{MonitoringIAPChartName, !opt.MLAIncludeIap, stack.HelmRelease{
+			Namespace:      IAPNamespace,
+			ReleaseName:    IAPReleaseName,
+			ChartDirectory: filepath.Join(opt.ChartsDirectory, MonitoringIAPChartName),
+		}},
+		{LokiChartName, opt.MLASkipLogging, stack.HelmRelease{
+			Namespace:      LokiNamespace,
+			ReleaseName:    LokiReleaseName,
+			ChartDirectory: filepath.Join(opt.ChartsDirectory, LoggingChartsPrefix, LokiChartName),
+		}},
+		{AlloyChartName, opt.MLASkipLogging, stack.HelmRelease{
+			Namespace:      AlloyNamespace,
+			ReleaseName:    AlloyReleaseName,
+			ChartDirectory: filepath.Join(opt.ChartsDirectory, LoggingChartsPrefix, AlloyChartName),
+		}},
+	}
+
+	plan := &stack.DeploymentPlan{}
+
+	for _, candidate := range candidates {
+		// IAP is only controlled by --mla-include-iap, just like in Deploy()
+		if candidate.skip || (candidate.chartName != MonitoringIAPChartName && slices.Contains(opt.SkipCharts, candidate.chartName)) {
+			continue
+		}
+
+		plan.Releases = append(plan.Releases, candidate.release)
+	}
+
+	if !slices.Contains(opt.SkipCharts, PromtailChartName) && !opt.MLASkipLogging {
+		plan.RemovedReleases = append(plan.RemovedReleases, stack.HelmRelease{
+			Namespace:   PromtailNamespace,
+			ReleaseName: PromtailReleaseName,
+		})
+	}
+
+	return plan, nil
+}
+
 func deployNodeExporter(ctx context.Context, logger *logrus.Entry, kubeClient ctrlruntimeclient.Client, helmClient helm.Client, opt stack.DeployOptions) error {
 	if slices.Contains(opt.SkipCharts, NodeExporterChartName) {
 		logger.Info("⭕ Skipping Node Exporter deployment.")
@@ -183,9 +260,7 @@ func deployNodeExporter(ctx context.Context, logger *logrus.Entry, kubeClient ct
 		return fmt.Errorf("failed to check to Helm release: %w", err)
 	}
 
-	v28 := semverlib.MustParse("2.28.0")
-
-	if release != nil && release.Version.LessThan(v28) && !chart.Version.LessThan(v28) {
+	if release != nil && nodeExporterMigration.Triggered(release.Version, chart.Version) {
 		sublogger.Warn("Installation process will temporarily remove and then upgrade DaemonSet used by Node Exporter.")
 
 		err = upgradeNodeExporterDaemonSet(ctx, sublogger, kubeClient, helmClient, opt, chart, release)
@@ -225,9 +300,7 @@ func deployKubeStateMetrics(ctx context.Context, logger *logrus.Entry, kubeClien
 		return fmt.Errorf("failed to check to Helm release: %w", err)
 	}
 
-	v28 := semverlib.MustParse("2.28.0")
-
-	if release != nil && release.Version.LessThan(v28) && !chart.Version.LessThan(v28) {
+	if release != nil && kubeStateMetricsMigration.Triggered(release.Version, chart.Version) {
 		sublogger.Warn("Installation process will temporarily remove and then upgrade the deployment set used by kube-state-metrics.")
 
 		err = upgradeKubeStateMetricsDeployment(ctx, sublogger, kubeClient, helmClient, opt, chart, release)
@@ -298,9 +371,7 @@ func deployBlackboxExporter(ctx context.Context, logger *logrus.Entry, kubeClien
 		return fmt.Errorf("failed to check to Helm release: %w", err)
 	}
 
-	v28 := semverlib.MustParse("2.28.0")
-
-	if release != nil && release.Version.LessThan(v28) && !chart.Version.LessThan(v28) {
+	if release != nil && blackboxExporterMigration.Triggered(release.Version, chart.Version) {
 		sublogger.Warn("Installation process will temporarily remove and then upgrade the deployment set used by blackbox-exporter.")
 
 		err = upgradeBlackboxExporterDeployment(ctx, sublogger, kubeClient, helmClient, opt, chart, release)
@@ -340,9 +411,7 @@ func deployAlertManager(ctx context.Context, logger *logrus.Entry, kubeClient ct
 		return fmt.Errorf("failed to check to Helm release: %w", err)
 	}
 
-	v28 := semverlib.MustParse("2.28.0")
-
-	if release != nil && release.Version.LessThan(v28) && !chart.Version.LessThan(v28) {
+	if release != nil && alertManagerMigration.Triggered(release.Version, chart.Version) {
 		sublogger.Warn("Installation process will temporarily remove and then upgrade Alertmanager Statefulset.")
 
 		err = upgradeAlertmanagerStatefulset(ctx, sublogger, kubeClient, helmClient, opt, chart, release)
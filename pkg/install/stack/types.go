@@ -18,16 +18,21 @@ package stack
 
 import (
 	"context"
+	"fmt"
 
+	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/sirupsen/logrus"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/install/helm"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/util/crd"
 	"k8c.io/kubermatic/v2/pkg/util/yamled"
 	kubermaticversion "k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -77,6 +82,11 @@ type DeployOptions struct {
 	// When false (default), old resources are cleaned up automatically during migration.
 	// When true, both old and new resources may coexist, allowing manual verification before cleanup.
 	SkipIngressCleanup bool
+
+	// DryRun makes Deploy record the Helm releases, CRDs and objects it would
+	// install or remove in the given plan instead of changing the cluster.
+	// The cluster is still read to decide what needs to be deployed.
+	DryRun *DeploymentPlan
 }
 
 type Stack interface {
@@ -84,4 +94,92 @@ type Stack interface {
 	ValidateConfiguration(config *kubermaticv1.KubermaticConfiguration, helmValues *yamled.Document, opt DeployOptions, logger logrus.FieldLogger) (*kubermaticv1.KubermaticConfiguration, *yamled.Document, []error)
 	ValidateState(ctx context.Context, opt DeployOptions) []error
 	Deploy(ctx context.Context, opt DeployOptions) error
+	// Plan returns the Helm releases, CRDs and objects that Deploy would
+	// install with the given options, without changing the cluster.
+	Plan(ctx context.Context, opt DeployOptions) (*DeploymentPlan, error)
+}
+
+// DeploymentPlan describes everything a stack would deploy into a cluster.
+type DeploymentPlan struct {
+	Releases []HelmRelease
+	// RemovedReleases are releases that the stack uninstalls if they exist.
+	RemovedReleases []HelmRelease
+	CRDs            []CRDSource
+	// Objects are applied by the stack directly, outside of Helm releases.
+	Objects []ObjectSource
+	// RemovedObjects are objects that the stack deletes if they exist.
+	RemovedObjects []RemovedObject
+}
+
+// AddObject adds an object that is applied by the stack directly to the plan.
+func (p *DeploymentPlan) AddObject(obj runtime.Object, gvk schema.GroupVersionKind) error {
+	fields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return fmt.Errorf("failed to convert %s: %w", gvk.Kind, err)
+	}
+
+	object := &unstructured.Unstructured{Object: fields}
+	object.SetGroupVersionKind(gvk)
+	unstructured.RemoveNestedField(object.Object, "metadata", "creationTimestamp")
+
+	p.Objects = append(p.Objects, ObjectSource{Object: object})
+
+	return nil
+}
+
+// HelmRelease is a single Helm chart that is deployed by a stack.
+type HelmRelease struct {
+	Namespace      string
+	ReleaseName    string
+	ChartDirectory string
+	// Migrations are the migration steps the installer performs when upgrading
+	// an existing release of this chart.
+	Migrations []Migration
+	// VersionChangesOnly is set for releases that are only re-installed when
+	// the chart version changes, regardless of their values (e.g. charts
+	// that generate random secrets).
+	VersionChangesOnly bool
+}
+
+// Migration is a migration step that is triggered when an existing release
+// is upgraded from a version below Version to Version or newer.
+type Migration struct {
+	Version     *semverlib.Version
+	Description string
+	// Flag is the installer flag that must be given to acknowledge the
+	// migration, if any.
+	Flag string
+}
+
+// Triggered returns true if upgrading a release from the installed to
+// the target version would perform the migration.
+func (m *Migration) Triggered(installed, target *semverlib.Version) bool {
+	return installed != nil && installed.LessThan(m.Version) && !target.LessThan(m.Version)
+}
+
+// CRDSource is a directory of CRDs that is deployed by a stack.
+type CRDSource struct {
+	Directory string
+	Kind      crd.ClusterKind
+	// Versions is set if the current KKP version is injected into the CRDs.
+	Versions *kubermaticversion.Versions
+	// CreateOnly is set if existing CRDs are left untouched.
+	CreateOnly bool
+}
+
+// ObjectSource is a single object that is applied by a stack.
+type ObjectSource struct {
+	Object *unstructured.Unstructured
+	// CreateOnly is set if an existing object is left untouched, e.g. because
+	// its content is only determined while deploying.
+	CreateOnly bool
+}
+
+// RemovedObject is a single object that is deleted by a stack.
+type RemovedObject struct {
+	// Object identifies the object by its kind, namespace and name.
+	Object *unstructured.Unstructured
+	// Removable decides based on the live object whether it is deleted. If
+	// nil, every existing object is deleted.
+	Removable func(live *unstructured.Unstructured) bool
 }
@@ -81,6 +81,28 @@ const (
 	MLAIAPNamespace   = UserClusterMLANamespace
 )
 
+var (
+	// consulMigration recreates the Consul StatefulSets when upgrading to 2.22 or newer.
+	consulMigration = stack.Migration{
+		Version:     semverlib.MustParse("2.22.0"),
+		Description: "the Consul StatefulSets are temporarily removed and then recreated",
+	}
+
+	// cortexMemcachedMigration recreates the memcached StatefulSets used by Cortex when
+	// upgrading to 2.22 or newer.
+	cortexMemcachedMigration = stack.Migration{
+		Version:     semverlib.MustParse("2.22.0"),
+		Description: "the memcached StatefulSets used by Cortex are temporarily removed and then recreated",
+	}
+
+	// cortexRuntimeConfigMigration recreates the memcached Services, whose clusterIP configuration
+	// is immutable, and renames the runtime config key when upgrading to 2.30 or newer.
+	cortexRuntimeConfigMigration = stack.Migration{
+		Version:     semverlib.MustParse("2.30.0"),
+		Description: "the Cortex memcached Services are recreated and the runtime config key is renamed",
+	}
+)
+
 type UserClusterMLAStack struct{}
 
 func NewStack() stack.Stack {
@@ -139,6 +161,53 @@ func (s *UserClusterMLAStack) Deploy(ctx context.Context, opt stack.DeployOption
 	return nil
 }
 
+func (*UserClusterMLAStack) Plan(_ context.Context, opt stack.DeployOptions) (*stack.DeploymentPlan, error) {
+	mlaRelease := func(chartName, namespace, releaseName string, migrations ...stack.Migration) stack.HelmRelease {
+		return stack.HelmRelease{
+			Namespace:      namespace,
+			ReleaseName:    releaseName,
+			ChartDirectory: filepath.Join(opt.ChartsDirectory, UserClusterMLAChartsPrefix, chartName),
+			Migrations:     migrations,
+		}
+	}
+
+	mlaSecrets := mlaRelease(MLASecretsChartName, MLASecretsNamespace, MLASecretsReleaseName)
+	mlaSecrets.VersionChangesOnly = !opt.MLAForceSecrets
+
+	candidates := []struct {
+		chartName string
+		skip      bool
+		release   stack.HelmRelease
+	}{
+		{MLASecretsChartName, false, mlaSecrets},
+		{AlertmanagerProxyChartName, false, mlaRelease(AlertmanagerProxyChartName, AlertmanagerProxyNamespace, AlertmanagerProxyReleaseName)},
+		{ConsulChartName, false, mlaRelease(ConsulChartName, ConsulNamespace, ConsulReleaseName, consulMigration)},
+		{MinioChartName, opt.MLASkipMinio, mlaRelease(MinioChartName, MinioNamespace, MinioReleaseName)},
+		{CortexChartName, false, mlaRelease(CortexChartName, CortexNamespace, CortexReleaseName, cortexMemcachedMigration, cortexRuntimeConfigMigration)},
+		{GrafanaChartName, false, mlaRelease(GrafanaChartName, GrafanaNamespace, GrafanaReleaseName)},
+		{LokiChartName, opt.MLASkipLogging, mlaRelease(LokiChartName, LokiNamespace, LokiReleaseName)},
+		{MinioLifecycleMgrChartName, opt.MLASkipMinioLifecycleMgr, mlaRelease(MinioLifecycleMgrChartName, MinioLifecycleMgrNamespace, MinioLifecycleMgrReleaseName)},
+		{MLAIAPChartName, !opt.MLAIncludeIap, stack.HelmRelease{
+			Namespace:      MLAIAPNamespace,
+			ReleaseName:    MLAIAPReleaseName,
+			ChartDirectory: filepath.Join(opt.ChartsDirectory, MLAIAPChartName),
+		}},
+	}
+
+	plan := &stack.DeploymentPlan{}
+
+	for _, candidate := range candidates {
+		// IAP is only controlled by --mla-include-iap, just like in Deploy()
+		if candidate.skip || (candidate.chartName != MLAIAPChartName && slices.Contains(opt.SkipCharts, candidate.chartName)) {
+			continue
+		}
+
+		plan.Releases = append(plan.Releases, candidate.release)
+	}
+
+	return plan, nil
+}
+
 func deployMLASecrets(ctx context.Context, logger *logrus.Entry, kubeClient ctrlruntimeclient.Client, helmClient helm.Client, opt stack.DeployOptions) error {
 	if slices.Contains(opt.SkipCharts, MLASecretsChartName) {
 		logger.Info("⭕ Skipping MLA Secrets deployment.")
@@ -230,9 +299,7 @@ func deployConsul(ctx context.Context, logger *logrus.Entry, kubeClient ctrlrunt
 	if err != nil {
 		return fmt.Errorf("failed to check to Helm release: %w", err)
 	}
-	v22 := semverlib.MustParse("2.22.0")
-
-	if release != nil && release.Version.LessThan(v22) && !chart.Version.LessThan(v22) {
+	if release != nil && consulMigration.Triggered(release.Version, chart.Version) {
 		sublogger.Warn("Installation process will temporarily remove and then upgrade Statefulset used by Consul.")
 
 		err = upgradeConsulStatefulsets(ctx, sublogger, kubeClient, helmClient, opt, chart, release)
@@ -273,10 +340,7 @@ func deployCortex(ctx context.Context, logger *logrus.Entry, kubeClient ctrlrunt
 		return fmt.Errorf("failed to check to Helm release: %w", err)
 	}
 
-	v22 := semverlib.MustParse("2.22.0")
-	v30 := semverlib.MustParse("2.30.0")
-
-	if release != nil && release.Version.LessThan(v22) && !chart.Version.LessThan(v22) {
+	if release != nil && cortexMemcachedMigration.Triggered(release.Version, chart.Version) {
 		sublogger.Warn("Installation process will temporarily remove and then upgrade memcached instances used by Cortex.")
 
 		err = upgradeCortexStatefulsets(ctx, sublogger, kubeClient, helmClient, opt, chart, release)
@@ -298,7 +362,7 @@ func deployCortex(ctx context.Context, logger *logrus.Entry, kubeClient ctrlrunt
 	// Custom Upgrade steps for KKP v2.30.0+
 	// Upgrade runtime config key from runtime-config.yaml to runtime_config.yaml after ensuring ConfigMap exists
 	// Delete few memcached services to allow Helm to recreate them with correct immutable clusterIP configuration
-	if release != nil && release.Version.LessThan(v30) && !chart.Version.LessThan(v30) {
+	if release != nil && cortexRuntimeConfigMigration.Triggered(release.Version, chart.Version) {
 		sublogger.Warn("Installation process will delete memcached services for v2.30.0+ upgrade (immutable clusterIP change).")
 
 		err = deleteCortexMemcachedServices(ctx, sublogger, kubeClient, chart, release)
@@ -53,7 +53,7 @@ func CheckHelmRelease(ctx context.Context, log logrus.FieldLogger, helmClient he
 		// run the installer again and pick up where they left. Unfortunately Helm does not
 		// support "upgrade --install" on failed installations: https://github.com/helm/helm/issues/3353
 		// To work around this, we check the release status and purge it manually if it's failed.
-		if statusRequiresPurge(release.Status) {
+		if StatusRequiresPurge(release.Status) {
 			log.Warn("Uninstalling defunct release before a clean installation is attempted…")
 
 			if err := helmClient.UninstallRelease(namespace, releaseName); err != nil {
@@ -104,7 +104,7 @@ func DeployHelmChart(
 			return fmt.Errorf("failed to retrieve Helm values used for release: %w", err)
 		}
 
-		if (!values.IsEmpty() && appliedValues.Equal(values)) || (values.IsEmpty() && appliedValues.IsEmpty()) {
+		if !HelmValuesChanged(appliedValues, values) {
 			log.Info("Release is up-to-date, nothing to do. Set --force to re-install anyway.")
 			return nil
 		}
@@ -112,7 +112,7 @@ func DeployHelmChart(
 		log.Info("Re-installing because values have been changed…")
 	}
 
-	helmValues, err := dumpHelmValues(values)
+	helmValues, err := DumpHelmValues(values)
 	if helmValues != "" {
 		defer os.Remove(helmValues)
 	}
@@ -138,16 +138,31 @@ func DeployHelmChart(
 	return nil
 }
 
-// purging pending releases is intended with Helm 3, see
-// https://github.com/helm/helm/issues/5595#issuecomment-634186584 for more information
-func statusRequiresPurge(status helm.ReleaseStatus) bool {
+// HelmValuesChanged returns true if the given values differ from the values
+// an existing release was installed with.
+func HelmValuesChanged(appliedValues, values *yamled.Document) bool {
+	if values.IsEmpty() {
+		return !appliedValues.IsEmpty()
+	}
+
+	return !appliedValues.Equal(values)
+}
+
+// StatusRequiresPurge returns true if a release with the given status is
+// uninstalled by CheckHelmRelease to allow a clean re-installation.
+// Purging pending releases is intended with Helm 3, see
+// https://github.com/helm/helm/issues/5595#issuecomment-634186584 for more information.
+func StatusRequiresPurge(status helm.ReleaseStatus) bool {
 	return status == helm.ReleaseStatusFailed ||
 		status == helm.ReleaseStatusPendingInstall ||
 		status == helm.ReleaseStatusPendingRollback ||
 		status == helm.ReleaseStatusPendingUpgrade
 }
 
-func dumpHelmValues(values *yamled.Document) (string, error) {
+// DumpHelmValues writes the values into a temporary file and returns its
+// name. If the values are empty, no file is created and an empty string is
+// returned. The caller is responsible for removing the file.
+func DumpHelmValues(values *yamled.Document) (string, error) {
 	if values.IsEmpty() {
 		return "", nil
 	}
@@ -34,53 +34,135 @@ import (
 
 // CreateOrUpdateCredentialSecretForCluster creates a new secret for a credential.
 func CreateOrUpdateCredentialSecretForCluster(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
-	_, err := createOrUpdateCredentialSecretForCluster(ctx, seedClient, cluster)
+	_, err := createOrUpdateCredentialSecretForCluster(ctx, seedClient, cluster, func(secretData map[string][]byte) (*providerconfig.GlobalSecretKeySelector, error) {
+		return ensureCredentialSecret(ctx, seedClient, cluster, secretData)
+	})
 	return err
 }
 
-func createOrUpdateCredentialSecretForCluster(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+// CredentialSecretDataForCluster returns the data CreateOrUpdateCredentialSecretForCluster would store in the
+// credential Secret of the cluster, without writing the Secret or changing the cluster. nil is returned if the
+// cluster has no inline credentials.
+func CredentialSecretDataForCluster(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (map[string][]byte, error) {
+	var data map[string][]byte
+
+	_, err := createOrUpdateCredentialSecretForCluster(ctx, seedClient, cluster.DeepCopy(), func(secretData map[string][]byte) (*providerconfig.GlobalSecretKeySelector, error) {
+		data = secretData
+		return CredentialSecretReferenceForCluster(cluster), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// credentialSecretWriter stores the given credentials of a cluster and returns a reference to them.
+type credentialSecretWriter func(secretData map[string][]byte) (*providerconfig.GlobalSecretKeySelector, error)
+
+func createOrUpdateCredentialSecretForCluster(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	if cluster.Spec.Cloud.AWS != nil {
-		return createOrUpdateAWSSecret(ctx, seedClient, cluster)
+		return createOrUpdateAWSSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Azure != nil {
-		return createOrUpdateAzureSecret(ctx, seedClient, cluster)
+		return createOrUpdateAzureSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Digitalocean != nil {
-		return createOrUpdateDigitaloceanSecret(ctx, seedClient, cluster)
+		return createOrUpdateDigitaloceanSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.GCP != nil {
-		return createOrUpdateGCPSecret(ctx, seedClient, cluster)
+		return createOrUpdateGCPSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Hetzner != nil {
-		return createOrUpdateHetznerSecret(ctx, seedClient, cluster)
+		return createOrUpdateHetznerSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Openstack != nil {
-		return createOrUpdateOpenstackSecret(ctx, seedClient, cluster)
+		return createOrUpdateOpenstackSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Kubevirt != nil {
-		return createOrUpdateKubevirtSecret(ctx, seedClient, cluster)
+		return createOrUpdateKubevirtSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.VSphere != nil {
-		return createVSphereSecret(ctx, seedClient, cluster)
+		return createVSphereSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Baremetal != nil {
-		return createOrUpdateBaremetalSecret(ctx, seedClient, cluster)
+		return createOrUpdateBaremetalSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Alibaba != nil {
-		return createAlibabaSecret(ctx, seedClient, cluster)
+		return createAlibabaSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Anexia != nil {
-		return createOrUpdateAnexiaSecret(ctx, seedClient, cluster)
+		return createOrUpdateAnexiaSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.Nutanix != nil {
-		return createOrUpdateNutanixSecret(ctx, seedClient, cluster)
+		return createOrUpdateNutanixSecret(ctx, seedClient, cluster, write)
 	}
 	if cluster.Spec.Cloud.VMwareCloudDirector != nil {
-		return createOrUpdateVMwareCloudDirectorSecret(ctx, seedClient, cluster)
+		return createOrUpdateVMwareCloudDirectorSecret(ctx, seedClient, cluster, write)
 	}
 	return false, nil
 }
 
+// CreateOrUpdateCredentialSecretForClusterFromData stores the given credentials in the dedicated credential
+// Secret of the cluster and references the Secret in the cloud spec. This is used for credentials that are not
+// part of the Cluster object, like credentials resolved from the external secret store of a Preset.
+func CreateOrUpdateCredentialSecretForClusterFromData(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, secretData map[string][]byte) error {
+	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, secretData)
+	if err != nil {
+		return err
+	}
+
+	return SetCredentialsReference(cluster, credentialRef)
+}
+
+// CredentialSecretReferenceForCluster returns the reference to the dedicated credential Secret of the cluster.
+func CredentialSecretReferenceForCluster(cluster *kubermaticv1.Cluster) *providerconfig.GlobalSecretKeySelector {
+	return &providerconfig.GlobalSecretKeySelector{
+		ObjectReference: corev1.ObjectReference{
+			Name:      cluster.GetSecretName(),
+			Namespace: resources.KubermaticNamespace,
+		},
+	}
+}
+
+// SetCredentialsReference sets the credentials reference in the cloud spec of the cluster.
+func SetCredentialsReference(cluster *kubermaticv1.Cluster, ref *providerconfig.GlobalSecretKeySelector) error {
+	cloud := &cluster.Spec.Cloud
+
+	switch {
+	case cloud.AWS != nil:
+		cloud.AWS.CredentialsReference = ref
+	case cloud.Azure != nil:
+		cloud.Azure.CredentialsReference = ref
+	case cloud.Baremetal != nil:
+		cloud.Baremetal.CredentialsReference = ref
+	case cloud.Digitalocean != nil:
+		cloud.Digitalocean.CredentialsReference = ref
+	case cloud.GCP != nil:
+		cloud.GCP.CredentialsReference = ref
+	case cloud.Hetzner != nil:
+		cloud.Hetzner.CredentialsReference = ref
+	case cloud.Openstack != nil:
+		cloud.Openstack.CredentialsReference = ref
+	case cloud.Kubevirt != nil:
+		cloud.Kubevirt.CredentialsReference = ref
+	case cloud.VSphere != nil:
+		cloud.VSphere.CredentialsReference = ref
+	case cloud.Alibaba != nil:
+		cloud.Alibaba.CredentialsReference = ref
+	case cloud.Anexia != nil:
+		cloud.Anexia.CredentialsReference = ref
+	case cloud.Nutanix != nil:
+		cloud.Nutanix.CredentialsReference = ref
+	case cloud.VMwareCloudDirector != nil:
+		cloud.VMwareCloudDirector.CredentialsReference = ref
+	default:
+		return errors.New("cluster has no cloud provider spec that supports credential Secrets")
+	}
+
+	return nil
+}
+
 func CreateOrUpdateSecretForCluster(ctx context.Context, client ctrlruntimeclient.Client, externalcluster *kubermaticv1.ExternalCluster, secretData map[string][]byte, secretName, secretNamespace string) (*providerconfig.GlobalSecretKeySelector, error) {
 	reconciler, err := credentialSecretReconcilerFactory(secretName, externalcluster.Labels, secretData)
 	if err != nil {
@@ -109,12 +191,7 @@ func ensureCredentialSecret(ctx context.Context, seedClient ctrlruntimeclient.Cl
 		return nil, err
 	}
 
-	return &providerconfig.GlobalSecretKeySelector{
-		ObjectReference: corev1.ObjectReference{
-			Name:      cluster.GetSecretName(),
-			Namespace: resources.KubermaticNamespace,
-		},
-	}, nil
+	return CredentialSecretReferenceForCluster(cluster), nil
 }
 
 func credentialSecretReconcilerFactory(secretName string, clusterLabels map[string]string, secretData map[string][]byte) (reconciling.NamedSecretReconcilerFactory, error) {
@@ -137,7 +214,7 @@ func credentialSecretReconcilerFactory(secretName string, clusterLabels map[stri
 	}, nil
 }
 
-func createOrUpdateAWSSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateAWSSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.AWS
 
 	// already migrated
@@ -146,7 +223,7 @@ func createOrUpdateAWSSecret(ctx context.Context, seedClient ctrlruntimeclient.C
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.AWSAccessKeyID:     []byte(spec.AccessKeyID),
 		resources.AWSSecretAccessKey: []byte(spec.SecretAccessKey),
 	})
@@ -164,7 +241,7 @@ func createOrUpdateAWSSecret(ctx context.Context, seedClient ctrlruntimeclient.C
 	return true, nil
 }
 
-func createOrUpdateAzureSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateAzureSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Azure
 
 	// already migrated
@@ -173,7 +250,7 @@ func createOrUpdateAzureSecret(ctx context.Context, seedClient ctrlruntimeclient
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.AzureTenantID:       []byte(spec.TenantID),
 		resources.AzureSubscriptionID: []byte(spec.SubscriptionID),
 		resources.AzureClientID:       []byte(spec.ClientID),
@@ -195,7 +272,7 @@ func createOrUpdateAzureSecret(ctx context.Context, seedClient ctrlruntimeclient
 	return true, nil
 }
 
-func createOrUpdateDigitaloceanSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateDigitaloceanSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Digitalocean
 
 	// already migrated
@@ -204,7 +281,7 @@ func createOrUpdateDigitaloceanSecret(ctx context.Context, seedClient ctrlruntim
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.DigitaloceanToken: []byte(spec.Token),
 	})
 	if err != nil {
@@ -220,7 +297,7 @@ func createOrUpdateDigitaloceanSecret(ctx context.Context, seedClient ctrlruntim
 	return true, nil
 }
 
-func createOrUpdateGCPSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateGCPSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.GCP
 
 	// already migrated
@@ -229,7 +306,7 @@ func createOrUpdateGCPSecret(ctx context.Context, seedClient ctrlruntimeclient.C
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.GCPServiceAccount: []byte(spec.ServiceAccount),
 	})
 	if err != nil {
@@ -245,7 +322,7 @@ func createOrUpdateGCPSecret(ctx context.Context, seedClient ctrlruntimeclient.C
 	return true, nil
 }
 
-func createOrUpdateHetznerSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateHetznerSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Hetzner
 
 	// already migrated
@@ -254,7 +331,7 @@ func createOrUpdateHetznerSecret(ctx context.Context, seedClient ctrlruntimeclie
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.HetznerToken: []byte(spec.Token),
 	})
 	if err != nil {
@@ -270,7 +347,7 @@ func createOrUpdateHetznerSecret(ctx context.Context, seedClient ctrlruntimeclie
 	return true, nil
 }
 
-func createOrUpdateOpenstackSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateOpenstackSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Openstack
 	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, seedClient)
 
@@ -325,7 +402,7 @@ func createOrUpdateOpenstackSecret(ctx context.Context, seedClient ctrlruntimecl
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.OpenstackUsername:                    []byte(spec.Username),
 		resources.OpenstackPassword:                    []byte(spec.Password),
 		resources.OpenstackProject:                     []byte(spec.Project),
@@ -355,7 +432,7 @@ func createOrUpdateOpenstackSecret(ctx context.Context, seedClient ctrlruntimecl
 	return true, nil
 }
 
-func createOrUpdateKubevirtSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateKubevirtSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Kubevirt
 	// already migrated
 	if spec.Kubeconfig == "" {
@@ -363,7 +440,7 @@ func createOrUpdateKubevirtSecret(ctx context.Context, seedClient ctrlruntimecli
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.KubeVirtKubeconfig: []byte(spec.Kubeconfig),
 	})
 	if err != nil {
@@ -379,7 +456,7 @@ func createOrUpdateKubevirtSecret(ctx context.Context, seedClient ctrlruntimecli
 	return true, nil
 }
 
-func createVSphereSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createVSphereSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.VSphere
 
 	// already migrated
@@ -388,7 +465,7 @@ func createVSphereSecret(ctx context.Context, seedClient ctrlruntimeclient.Clien
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.VsphereUsername:                    []byte(spec.Username),
 		resources.VspherePassword:                    []byte(spec.Password),
 		resources.VsphereInfraManagementUserUsername: []byte(spec.InfraManagementUser.Username),
@@ -412,7 +489,7 @@ func createVSphereSecret(ctx context.Context, seedClient ctrlruntimeclient.Clien
 
 // createOrUpdateBaremetalSecret checks and migrates Tinkerbell credentials from inline storage to a dedicated Kubernetes secret.
 // Returns true if migration occurs, false otherwise along with any error encountered.
-func createOrUpdateBaremetalSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateBaremetalSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Baremetal
 
 	// Ensure Tinkerbell provisioner is configured, as it is mandatory.
@@ -426,7 +503,7 @@ func createOrUpdateBaremetalSecret(ctx context.Context, seedClient ctrlruntimecl
 	}
 
 	// Move credentials into a dedicated Secret and retrieve reference.
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.TinkerbellKubeconfig: []byte(spec.Tinkerbell.Kubeconfig),
 	})
 	if err != nil {
@@ -441,7 +518,7 @@ func createOrUpdateBaremetalSecret(ctx context.Context, seedClient ctrlruntimecl
 	return true, nil
 }
 
-func createAlibabaSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createAlibabaSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Alibaba
 
 	// already migrated
@@ -450,7 +527,7 @@ func createAlibabaSecret(ctx context.Context, seedClient ctrlruntimeclient.Clien
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.AlibabaAccessKeyID:     []byte(spec.AccessKeyID),
 		resources.AlibabaAccessKeySecret: []byte(spec.AccessKeySecret),
 	})
@@ -468,7 +545,7 @@ func createAlibabaSecret(ctx context.Context, seedClient ctrlruntimeclient.Clien
 	return true, nil
 }
 
-func createOrUpdateAnexiaSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateAnexiaSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Anexia
 
 	// already migrated
@@ -477,7 +554,7 @@ func createOrUpdateAnexiaSecret(ctx context.Context, seedClient ctrlruntimeclien
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.AnexiaToken: []byte(spec.Token),
 	})
 	if err != nil {
@@ -493,7 +570,7 @@ func createOrUpdateAnexiaSecret(ctx context.Context, seedClient ctrlruntimeclien
 	return true, nil
 }
 
-func createOrUpdateNutanixSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateNutanixSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.Nutanix
 
 	// already migrated
@@ -523,7 +600,7 @@ func createOrUpdateNutanixSecret(ctx context.Context, seedClient ctrlruntimeclie
 		cluster.Spec.Cloud.Nutanix.CSI.Password = ""
 	}
 
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, secretData)
+	credentialRef, err := write(secretData)
 	if err != nil {
 		return false, err
 	}
@@ -534,7 +611,7 @@ func createOrUpdateNutanixSecret(ctx context.Context, seedClient ctrlruntimeclie
 	return true, nil
 }
 
-func createOrUpdateVMwareCloudDirectorSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
+func createOrUpdateVMwareCloudDirectorSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, write credentialSecretWriter) (bool, error) {
 	spec := cluster.Spec.Cloud.VMwareCloudDirector
 
 	// already migrated
@@ -543,7 +620,7 @@ func createOrUpdateVMwareCloudDirectorSecret(ctx context.Context, seedClient ctr
 	}
 
 	// move credentials into dedicated Secret
-	credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+	credentialRef, err := write(map[string][]byte{
 		resources.VMwareCloudDirectorUsername:     []byte(spec.Username),
 		resources.VMwareCloudDirectorPassword:     []byte(spec.Password),
 		resources.VMwareCloudDirectorAPIToken:     []byte(spec.APIToken),
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+)
+
+var errNoPresetCredentials = errors.New("preset has no credentials for the cloud provider of the cluster")
+
+// ApplyPresetCredentials sets the inline credentials in the given cloud spec to the
+// credentials of the preset. Only the credentials of the provider configured in the
+// cloud spec are changed, all other fields are left untouched. Combined with
+// CreateOrUpdateCredentialSecretForCluster, this updates the credential Secret of a
+// cluster with the current credentials of a preset.
+func ApplyPresetCredentials(preset *kubermaticv1.Preset, cloud *kubermaticv1.CloudSpec) error {
+	spec := preset.Spec
+
+	switch {
+	case cloud.AWS != nil:
+		if spec.AWS == nil {
+			return errNoPresetCredentials
+		}
+		cloud.AWS.AccessKeyID = spec.AWS.AccessKeyID
+		cloud.AWS.SecretAccessKey = spec.AWS.SecretAccessKey
+
+	case cloud.Azure != nil:
+		if spec.Azure == nil {
+			return errNoPresetCredentials
+		}
+		cloud.Azure.TenantID = spec.Azure.TenantID
+		cloud.Azure.SubscriptionID = spec.Azure.SubscriptionID
+		cloud.Azure.ClientID = spec.Azure.ClientID
+		cloud.Azure.ClientSecret = spec.Azure.ClientSecret
+
+	case cloud.Baremetal != nil:
+		if spec.Baremetal == nil || spec.Baremetal.Tinkerbell == nil {
+			return errNoPresetCredentials
+		}
+		if cloud.Baremetal.Tinkerbell == nil {
+			return errors.New("cluster has no Tinkerbell configuration")
+		}
+		cloud.Baremetal.Tinkerbell.Kubeconfig = spec.Baremetal.Tinkerbell.Kubeconfig
+
+	case cloud.Digitalocean != nil:
+		if spec.Digitalocean == nil {
+			return errNoPresetCredentials
+		}
+		cloud.Digitalocean.Token = spec.Digitalocean.Token
+
+	case cloud.GCP != nil:
+		if spec.GCP == nil {
+			return errNoPresetCredentials
+		}
+		cloud.GCP.ServiceAccount = spec.GCP.ServiceAccount
+
+	case cloud.Hetzner != nil:
+		if spec.Hetzner == nil {
+			return errNoPresetCredentials
+		}
+		cloud.Hetzner.Token = spec.Hetzner.Token
+
+	case cloud.Openstack != nil:
+		if spec.Openstack == nil {
+			return errNoPresetCredentials
+		}
+		cloud.Openstack.Username = spec.Openstack.Username
+		cloud.Openstack.Password = spec.Openstack.Password
+		cloud.Openstack.Project = spec.Openstack.Project
+		cloud.Openstack.ProjectID = spec.Openstack.ProjectID
+		cloud.Openstack.Domain = spec.Openstack.Domain
+		cloud.Openstack.ApplicationCredentialID = spec.Openstack.ApplicationCredentialID
+		cloud.Openstack.ApplicationCredentialSecret = spec.Openstack.ApplicationCredentialSecret
+
+	case cloud.Kubevirt != nil:
+		if spec.Kubevirt == nil {
+			return errNoPresetCredentials
+		}
+		cloud.Kubevirt.Kubeconfig = spec.Kubevirt.Kubeconfig
+
+	case cloud.VSphere != nil:
+		if spec.VSphere == nil {
+			return errNoPresetCredentials
+		}
+		cloud.VSphere.Username = spec.VSphere.Username
+		cloud.VSphere.Password = spec.VSphere.Password
+
+	case cloud.Alibaba != nil:
+		if spec.Alibaba == nil {
+			return errNoPresetCredentials
+		}
+		cloud.Alibaba.AccessKeyID = spec.Alibaba.AccessKeyID
+		cloud.Alibaba.AccessKeySecret = spec.Alibaba.AccessKeySecret
+
+	case cloud.Anexia != nil:
+		if spec.Anexia == nil {
+			return errNoPresetCredentials
+		}
+		cloud.Anexia.Token = spec.Anexia.Token
+
+	case cloud.Nutanix != nil:
+		if spec.Nutanix == nil {
+			return errNoPresetCredentials
+		}
+		cloud.Nutanix.Username = spec.Nutanix.Username
+		cloud.Nutanix.Password = spec.Nutanix.Password
+		cloud.Nutanix.ProxyURL = spec.Nutanix.ProxyURL
+		if cloud.Nutanix.CSI != nil {
+			cloud.Nutanix.CSI.Username = spec.Nutanix.CSIUsername
+			cloud.Nutanix.CSI.Password = spec.Nutanix.CSIPassword
+		}
+
+	case cloud.VMwareCloudDirector != nil:
+		if spec.VMwareCloudDirector == nil {
+			return errNoPresetCredentials
+		}
+		cloud.VMwareCloudDirector.Username = spec.VMwareCloudDirector.Username
+		cloud.VMwareCloudDirector.Password = spec.VMwareCloudDirector.Password
+		cloud.VMwareCloudDirector.APIToken = spec.VMwareCloudDirector.APIToken
+		cloud.VMwareCloudDirector.Organization = spec.VMwareCloudDirector.Organization
+		cloud.VMwareCloudDirector.VDC = spec.VMwareCloudDirector.VDC
+
+	default:
+		providerName, _ := kubermaticv1helper.ClusterCloudProviderName(*cloud)
+		return fmt.Errorf("cloud provider %q does not support credentials from presets", providerName)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/diff"
+)
+
+func TestApplyPresetCredentials(t *testing.T) {
+	testCases := []struct {
+		name          string
+		preset        kubermaticv1.PresetSpec
+		cloud         kubermaticv1.CloudSpec
+		expectedCloud kubermaticv1.CloudSpec
+		expectedErr   bool
+	}{
+		{
+			name: "credentials of the cluster provider are applied",
+			preset: kubermaticv1.PresetSpec{
+				AWS: &kubermaticv1.AWS{
+					AccessKeyID:     "new-key",
+					SecretAccessKey: "new-secret",
+					VPCID:           "vpc-from-preset",
+				},
+				Hetzner: &kubermaticv1.Hetzner{Token: "hetzner-token"},
+			},
+			cloud: kubermaticv1.CloudSpec{
+				DatacenterName: "aws-eu-central-1a",
+				AWS: &kubermaticv1.AWSCloudSpec{
+					VPCID: "vpc-of-cluster",
+				},
+			},
+			expectedCloud: kubermaticv1.CloudSpec{
+				DatacenterName: "aws-eu-central-1a",
+				AWS: &kubermaticv1.AWSCloudSpec{
+					AccessKeyID:     "new-key",
+					SecretAccessKey: "new-secret",
+					VPCID:           "vpc-of-cluster",
+				},
+			},
+		},
+		{
+			name: "preset without credentials for the cluster provider",
+			preset: kubermaticv1.PresetSpec{
+				Hetzner: &kubermaticv1.Hetzner{Token: "hetzner-token"},
+			},
+			cloud: kubermaticv1.CloudSpec{
+				AWS: &kubermaticv1.AWSCloudSpec{},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "provider without credentials",
+			cloud: kubermaticv1.CloudSpec{
+				BringYourOwn: &kubermaticv1.BringYourOwnCloudSpec{},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			preset := &kubermaticv1.Preset{Spec: tc.preset}
+
+			err := ApplyPresetCredentials(preset, &tc.cloud)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("Expected an error, but got none.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if changes := diff.ObjectDiff(tc.expectedCloud, tc.cloud); changes != "" {
+				t.Fatalf("CloudSpec is not as expected:\n\n%s", changes)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// kubernetesProvider reads credentials from a Secret.
+type kubernetesProvider struct {
+	client ctrlruntimeclient.Reader
+	key    types.NamespacedName
+}
+
+func newKubernetesProvider(client ctrlruntimeclient.Reader, store *kubermaticv1.KubernetesSecretStore) *kubernetesProvider {
+	namespace := store.SecretReference.Namespace
+	if namespace == "" {
+		namespace = resources.KubermaticNamespace
+	}
+
+	return &kubernetesProvider{
+		client: client,
+		key:    types.NamespacedName{Namespace: namespace, Name: store.SecretReference.Name},
+	}
+}
+
+func (p *kubernetesProvider) GetSecret(ctx context.Context) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	if err := p.client.Get(ctx, p.key, secret); err != nil {
+		return nil, fmt.Errorf("failed to get Secret %s: %w", p.key, err)
+	}
+
+	return maps.Clone(secret.Data), nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultRefreshInterval is the duration for which resolved credentials are cached
+// if the secret store does not configure a refresh interval.
+const DefaultRefreshInterval = 5 * time.Minute
+
+// Provider fetches credentials from an external secret store.
+type Provider interface {
+	// GetSecret returns the key/value pairs stored in the secret store.
+	GetSecret(ctx context.Context) (map[string][]byte, error)
+}
+
+// NewProvider returns the Provider for the backend configured in the given store.
+func NewProvider(client ctrlruntimeclient.Reader, store *kubermaticv1.PresetSecretStore, caBundle *x509.CertPool) (Provider, error) {
+	if store == nil {
+		return nil, errors.New("no secret store configured")
+	}
+
+	switch {
+	case store.Vault != nil && store.Kubernetes != nil:
+		return nil, errors.New("only one secret store backend can be configured")
+	case store.Vault != nil:
+		return newVaultProvider(client, store.Vault, caBundle)
+	case store.Kubernetes != nil:
+		return newKubernetesProvider(client, store.Kubernetes), nil
+	default:
+		return nil, errors.New("no secret store backend configured")
+	}
+}
+
+// RefreshInterval returns the duration for which credentials resolved from the
+// given store can be cached.
+func RefreshInterval(store *kubermaticv1.PresetSecretStore) time.Duration {
+	if store == nil || store.RefreshInterval == nil || store.RefreshInterval.Duration <= 0 {
+		return DefaultRefreshInterval
+	}
+
+	return store.RefreshInterval.Duration
+}
+
+// Resolver resolves and caches the credentials of Presets. It is safe for concurrent
+// use and is meant to be shared between all controllers that need preset credentials.
+type Resolver struct {
+	client   ctrlruntimeclient.Reader
+	caBundle *x509.CertPool
+	now      func() time.Time
+
+	lock  sync.Mutex
+	cache map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	generation int64
+	expires    time.Time
+	data       map[string][]byte
+}
+
+// NewResolver returns a new Resolver. The CA bundle is used to verify the TLS
+// certificates of secret store servers.
+func NewResolver(client ctrlruntimeclient.Reader, caBundle *x509.CertPool) *Resolver {
+	return &Resolver{
+		client:   client,
+		caBundle: caBundle,
+		now:      time.Now,
+		cache:    map[string]cacheEntry{},
+	}
+}
+
+// Resolve returns the credentials of the given preset from its secret store. Results
+// are cached for the refresh interval of the store; any change to the preset discards
+// the cached credentials.
+func (r *Resolver) Resolve(ctx context.Context, preset *kubermaticv1.Preset) (map[string][]byte, error) {
+	store := preset.Spec.SecretStore
+	if store == nil {
+		return nil, fmt.Errorf("preset %s has no secret store configured", preset.Name)
+	}
+
+	r.lock.Lock()
+	entry, ok := r.cache[preset.Name]
+	r.lock.Unlock()
+
+	if ok && entry.generation == preset.Generation && r.now().Before(entry.expires) {
+		return maps.Clone(entry.data), nil
+	}
+
+	provider, err := NewProvider(r.client, store, r.caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret store: %w", err)
+	}
+
+	data, err := provider.GetSecret(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch credentials from secret store: %w", err)
+	}
+
+	if len(data) == 0 {
+		return nil, errors.New("secret store returned no credentials")
+	}
+
+	r.lock.Lock()
+	r.cache[preset.Name] = cacheEntry{
+		generation: preset.Generation,
+		expires:    r.now().Add(RefreshInterval(store)),
+		data:       data,
+	}
+	r.lock.Unlock()
+
+	return maps.Clone(data), nil
+}
+
+// Invalidate drops the cached credentials of the given preset, so that the next
+// call to Resolve queries the secret store again.
+func (r *Resolver) Invalidate(presetName string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.cache, presetName)
+}
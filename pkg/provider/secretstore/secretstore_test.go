@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "preset-credentials",
+			Namespace: "kubermatic",
+		},
+		Data: map[string][]byte{
+			"token": []byte("old-token"),
+		},
+	}
+
+	preset := &kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "my-preset",
+			Generation: 1,
+		},
+		Spec: kubermaticv1.PresetSpec{
+			SecretStore: &kubermaticv1.PresetSecretStore{
+				Kubernetes: &kubermaticv1.KubernetesSecretStore{
+					SecretReference: corev1.SecretReference{Name: "preset-credentials"},
+				},
+				RefreshInterval: &metav1.Duration{Duration: time.Minute},
+			},
+		},
+	}
+
+	client := fake.NewClientBuilder().WithObjects(secret).Build()
+
+	now := time.Now()
+	resolver := NewResolver(client, nil)
+	resolver.now = func() time.Time { return now }
+
+	resolve := func(expected string) {
+		t.Helper()
+
+		data, err := resolver.Resolve(ctx, preset)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data["token"]))
+	}
+
+	resolve("old-token")
+
+	// rotate the credentials in the store
+	secret.Data["token"] = []byte("new-token")
+	require.NoError(t, client.Update(ctx, secret))
+
+	// cached credentials are returned until the refresh interval has passed
+	resolve("old-token")
+
+	now = now.Add(2 * time.Minute)
+	resolve("new-token")
+
+	// changing the preset discards the cache
+	secret.Data["token"] = []byte("newer-token")
+	require.NoError(t, client.Update(ctx, secret))
+
+	preset.Generation++
+	resolve("newer-token")
+
+	// and so does invalidating it explicitly
+	secret.Data["token"] = []byte("newest-token")
+	require.NoError(t, client.Update(ctx, secret))
+
+	resolver.Invalidate(preset.Name)
+	resolve("newest-token")
+}
+
+func TestNewProvider(t *testing.T) {
+	testCases := []struct {
+		name        string
+		store       *kubermaticv1.PresetSecretStore
+		expectedErr bool
+	}{
+		{
+			name:        "no store",
+			expectedErr: true,
+		},
+		{
+			name:        "no backend",
+			store:       &kubermaticv1.PresetSecretStore{},
+			expectedErr: true,
+		},
+		{
+			name: "multiple backends",
+			store: &kubermaticv1.PresetSecretStore{
+				Vault:      &kubermaticv1.VaultSecretStore{Server: "https://vault", Path: "presets/aws"},
+				Kubernetes: &kubermaticv1.KubernetesSecretStore{},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "Vault without path",
+			store: &kubermaticv1.PresetSecretStore{
+				Vault: &kubermaticv1.VaultSecretStore{Server: "https://vault"},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "Vault",
+			store: &kubermaticv1.PresetSecretStore{
+				Vault: &kubermaticv1.VaultSecretStore{Server: "https://vault", Path: "presets/aws"},
+			},
+		},
+		{
+			name: "Kubernetes",
+			store: &kubermaticv1.PresetSecretStore{
+				Kubernetes: &kubermaticv1.KubernetesSecretStore{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewProvider(fake.NewClientBuilder().Build(), tc.store, nil)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
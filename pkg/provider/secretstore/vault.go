@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultVaultMountPath = "secret"
+
+	vaultTokenHeader     = "X-Vault-Token"
+	vaultNamespaceHeader = "X-Vault-Namespace"
+)
+
+// vaultProvider reads credentials from a HashiCorp Vault KV secrets engine.
+type vaultProvider struct {
+	client     ctrlruntimeclient.Reader
+	store      *kubermaticv1.VaultSecretStore
+	httpClient *http.Client
+}
+
+func newVaultProvider(client ctrlruntimeclient.Reader, store *kubermaticv1.VaultSecretStore, caBundle *x509.CertPool) (*vaultProvider, error) {
+	if store.Server == "" {
+		return nil, errors.New("no Vault server configured")
+	}
+
+	if store.Path == "" {
+		return nil, errors.New("no Vault secret path configured")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caBundle != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: caBundle}
+	}
+
+	return &vaultProvider{
+		client: client,
+		store:  store,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   30 * time.Second,
+		},
+	}, nil
+}
+
+// secretURL returns the URL to read the secret from, following the KV v1 and v2
+// API conventions.
+func (p *vaultProvider) secretURL() (string, error) {
+	mountPath := strings.Trim(p.store.MountPath, "/")
+	if mountPath == "" {
+		mountPath = defaultVaultMountPath
+	}
+
+	secretPath := strings.Trim(p.store.Path, "/")
+
+	var apiPath string
+	switch p.store.Version {
+	case kubermaticv1.VaultKVVersion1:
+		apiPath = path.Join("v1", mountPath, secretPath)
+	case kubermaticv1.VaultKVVersion2, "":
+		apiPath = path.Join("v1", mountPath, "data", secretPath)
+	default:
+		return "", fmt.Errorf("unsupported KV secrets engine version %q", p.store.Version)
+	}
+
+	return url.JoinPath(p.store.Server, apiPath)
+}
+
+func (p *vaultProvider) GetSecret(ctx context.Context) (map[string][]byte, error) {
+	tokenRef := p.store.TokenReference
+	token, err := provider.SecretKeySelectorValueFuncFactory(ctx, p.client)(&tokenRef, tokenRef.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Vault token: %w", err)
+	}
+
+	secretURL, err := p.secretURL()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set(vaultTokenHeader, token)
+	if p.store.Namespace != "" {
+		req.Header.Set(vaultNamespaceHeader, p.store.Namespace)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from Vault for %s: %s", resp.StatusCode, secretURL, vaultErrors(body))
+	}
+
+	return parseVaultSecret(p.store.Version, body)
+}
+
+// parseVaultSecret extracts the key/value pairs from a Vault read response. KV v2
+// nests the secret data in a second "data" object alongside the version metadata.
+func parseVaultSecret(version kubermaticv1.VaultKVVersion, body []byte) (map[string][]byte, error) {
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	rawData := response.Data
+	if version != kubermaticv1.VaultKVVersion1 {
+		var versioned struct {
+			Data json.RawMessage `json:"data"`
+		}
+
+		if err := json.Unmarshal(rawData, &versioned); err != nil {
+			return nil, fmt.Errorf("failed to decode secret: %w", err)
+		}
+
+		rawData = versioned.Data
+	}
+
+	// a deleted KV v2 secret is returned with null data
+	if len(rawData) == 0 || string(rawData) == "null" {
+		return nil, errors.New("secret has no data, it might have been deleted")
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal(rawData, &values); err != nil {
+		return nil, fmt.Errorf("failed to decode secret, all values must be strings: %w", err)
+	}
+
+	data := map[string][]byte{}
+	for key, value := range values {
+		data[key] = []byte(value)
+	}
+
+	return data, nil
+}
+
+// vaultErrors returns the error messages from a Vault error response, falling back
+// to the raw body if it cannot be decoded.
+func vaultErrors(body []byte) string {
+	var response struct {
+		Errors []string `json:"errors"`
+	}
+
+	if err := json.Unmarshal(body, &response); err != nil || len(response.Errors) == 0 {
+		return strings.TrimSpace(string(body))
+	}
+
+	return strings.Join(response.Errors, "; ")
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	"k8c.io/machine-controller/sdk/providerconfig"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVaultProvider(t *testing.T) {
+	const token = "s.not-a-real-token"
+
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault-token",
+			Namespace: "kubermatic",
+		},
+		Data: map[string][]byte{
+			"token": []byte(token),
+		},
+	}
+
+	responses := map[string]string{
+		"/v1/secret/data/presets/aws": `{"data": {"data": {"accessKeyId": "AKIA", "secretAccessKey": "s3cr3t"}, "metadata": {"version": 3}}}`,
+		"/v1/kv/presets/aws":          `{"data": {"accessKeyId": "AKIA", "secretAccessKey": "s3cr3t"}}`,
+		"/v1/secret/data/deleted":     `{"data": {"data": null, "metadata": {"version": 2}}}`,
+		"/v1/secret/data/nested":      `{"data": {"data": {"accessKeyId": {"value": "AKIA"}}}}`,
+	}
+
+	var namespaceHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(vaultTokenHeader) != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+			return
+		}
+
+		namespaceHeader = r.Header.Get(vaultNamespaceHeader)
+
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": []}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	tokenReference := providerconfig.GlobalSecretKeySelector{
+		ObjectReference: corev1.ObjectReference{Name: "vault-token", Namespace: "kubermatic"},
+		Key:             "token",
+	}
+
+	testCases := []struct {
+		name              string
+		store             kubermaticv1.VaultSecretStore
+		expectedData      map[string][]byte
+		expectedNamespace string
+		expectedErr       bool
+	}{
+		{
+			name: "KV v2 secret with defaulted mount path",
+			store: kubermaticv1.VaultSecretStore{
+				Path:      "presets/aws",
+				Namespace: "team-a",
+			},
+			expectedData: map[string][]byte{
+				"accessKeyId":     []byte("AKIA"),
+				"secretAccessKey": []byte("s3cr3t"),
+			},
+			expectedNamespace: "team-a",
+		},
+		{
+			name: "KV v1 secret",
+			store: kubermaticv1.VaultSecretStore{
+				MountPath: "/kv/",
+				Version:   kubermaticv1.VaultKVVersion1,
+				Path:      "presets/aws",
+			},
+			expectedData: map[string][]byte{
+				"accessKeyId":     []byte("AKIA"),
+				"secretAccessKey": []byte("s3cr3t"),
+			},
+		},
+		{
+			name: "deleted secret",
+			store: kubermaticv1.VaultSecretStore{
+				Path: "deleted",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "non-string values",
+			store: kubermaticv1.VaultSecretStore{
+				Path: "nested",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "missing secret",
+			store: kubermaticv1.VaultSecretStore{
+				Path: "presets/does-not-exist",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid token",
+			store: kubermaticv1.VaultSecretStore{
+				Path: "presets/aws",
+				TokenReference: providerconfig.GlobalSecretKeySelector{
+					ObjectReference: tokenReference.ObjectReference,
+					Key:             "does-not-exist",
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespaceHeader = ""
+
+			store := tc.store
+			store.Server = server.URL
+			if store.TokenReference.Key == "" {
+				store.TokenReference = tokenReference
+			}
+
+			client := fake.NewClientBuilder().WithObjects(tokenSecret).Build()
+
+			provider, err := newVaultProvider(client, &store, nil)
+			require.NoError(t, err)
+
+			data, err := provider.GetSecret(context.Background())
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedData, data)
+			assert.Equal(t, tc.expectedNamespace, namespaceHeader)
+		})
+	}
+}
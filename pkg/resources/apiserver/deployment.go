@@ -55,6 +55,17 @@ var (
 		},
 	}
 
+	defaultKMSPluginResourceRequirements = corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("32Mi"),
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+		},
+	}
+
 	gte131, _ = semverlib.NewConstraint(">= 1.31")
 	lt135, _  = semverlib.NewConstraint("<= 1.34")
 )
@@ -100,6 +111,9 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 
 			// these volumes should not block the autoscaler from evicting the pod
 			safeToEvictVolumes := []string{resources.AuditLogVolumeName, resources.KonnectivityUDS}
+			if kmsConfiguration(data.Cluster()) != nil {
+				safeToEvictVolumes = append(safeToEvictVolumes, resources.KMSPluginSocketVolumeName)
+			}
 
 			kubernetes.EnsureLabels(&dep.Spec.Template, map[string]string{
 				resources.VersionLabel: version.String(),
@@ -235,6 +249,23 @@ func DeploymentReconciler(data *resources.TemplateData) reconciling.NamedDeploym
 
 			overrides := resources.GetOverrides(data.Cluster().Spec.ComponentsOverride)
 
+			if kms := kmsConfiguration(data.Cluster()); kms != nil {
+				defResourceRequirements[resources.KMSPluginContainerName] = defaultKMSPluginResourceRequirements.DeepCopy()
+				if kms.Plugin.Resources != nil {
+					overrides[resources.KMSPluginContainerName] = kms.Plugin.Resources.DeepCopy()
+				}
+
+				dep.Spec.Template.Spec.Containers = append(dep.Spec.Template.Spec.Containers, kmsPluginContainer(kms))
+			}
+
+			if data.Cluster().IsAPIServerProxyProtocolEnabled() {
+				defResourceRequirements[resources.ProxyProtocolContainerName] = defaultProxyProtocolResourceRequirements.DeepCopy()
+
+				dep.Spec.Template.Spec.Volumes = append(dep.Spec.Template.Spec.Volumes, proxyProtocolVolume())
+				dep.Spec.Template.Spec.InitContainers = append(dep.Spec.Template.Spec.InitContainers, proxyProtocolInitContainer(data))
+				dep.Spec.Template.Spec.Containers = append(dep.Spec.Template.Spec.Containers, proxyProtocolContainer(data))
+			}
+
 			if auditLogEnabled {
 				defResourceRequirements[auditLogsSidecarName] = &corev1.ResourceRequirements{
 					Requests: corev1.ResourceList{
@@ -611,6 +642,10 @@ func getVolumeMounts(data *resources.TemplateData, isEncryptionEnabled, isAuditW
 		})
 	}
 
+	if kmsConfiguration(data.Cluster()) != nil {
+		vms = append(vms, kmsPluginSocketVolumeMount())
+	}
+
 	if isAuditWebhookEnabled {
 		vms = append(vms, corev1.VolumeMount{
 			Name:      resources.AuditWebhookVolumeName,
@@ -836,6 +871,15 @@ func getVolumes(data *resources.TemplateData, isEncryptionEnabled, isAuditEnable
 		})
 	}
 
+	if kmsConfiguration(data.Cluster()) != nil {
+		vs = append(vs, corev1.Volume{
+			Name: resources.KMSPluginSocketVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+
 	if isAuditEnabled {
 		vs = append(vs, corev1.Volume{
 			Name: resources.FluentBitSecretName,
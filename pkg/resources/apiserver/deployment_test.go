@@ -0,0 +1,237 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/sdk/v2/semver"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeploymentReconcilerKMSPlugin(t *testing.T) {
+	kms := &kubermaticv1.KMSEncryptionConfiguration{
+		Name: "vault",
+		Plugin: kubermaticv1.KMSPluginConfiguration{
+			Image: "example.com/vault-kms-plugin:v1.0.0",
+			Env: []corev1.EnvVar{
+				{Name: "VAULT_ADDR", Value: "https://vault.example.com"},
+				{Name: "VAULT_TOKEN", ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "admin-kubeconfig"},
+						Key:                  "kubeconfig",
+					},
+				}},
+			},
+			Resources: &corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                    string
+		encryption              *kubermaticv1.EncryptionConfiguration
+		encryptionStatus        *kubermaticv1.ClusterEncryptionStatus
+		encryptionInitialized   bool
+		expectPlugin            bool
+		expectedPluginResources *corev1.ResourceRequirements
+	}{
+		{
+			name:         "no encryption configured",
+			expectPlugin: false,
+		},
+		{
+			name: "encryption without KMS",
+			encryption: &kubermaticv1.EncryptionConfiguration{
+				Enabled:   true,
+				Resources: []string{"secrets"},
+				Secretbox: &kubermaticv1.SecretboxEncryptionConfiguration{
+					Keys: []kubermaticv1.SecretboxKey{{Name: "key", Value: "dGVzdA=="}},
+				},
+			},
+			expectPlugin: false,
+		},
+		{
+			name: "KMS encryption enabled",
+			encryption: &kubermaticv1.EncryptionConfiguration{
+				Enabled:   true,
+				Resources: []string{"secrets"},
+				KMS:       kms,
+			},
+			expectPlugin: true,
+			expectedPluginResources: &corev1.ResourceRequirements{
+				Requests: defaultKMSPluginResourceRequirements.Requests,
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+			},
+		},
+		{
+			name: "KMS configuration removed while data is still being decrypted",
+			encryptionStatus: &kubermaticv1.ClusterEncryptionStatus{
+				Phase: kubermaticv1.ClusterEncryptionPhaseEncryptionNeeded,
+				KMS: &kubermaticv1.ClusterKMSStatus{
+					Name:          kms.Name,
+					Endpoint:      kms.GetEndpoint(),
+					Configuration: kms.DeepCopy(),
+				},
+			},
+			encryptionInitialized: true,
+			expectPlugin:          true,
+		},
+		{
+			name: "KMS configuration removed after data has been decrypted",
+			encryptionStatus: &kubermaticv1.ClusterEncryptionStatus{
+				Phase: kubermaticv1.ClusterEncryptionPhaseActive,
+				KMS: &kubermaticv1.ClusterKMSStatus{
+					Name:          kms.Name,
+					Endpoint:      kms.GetEndpoint(),
+					Configuration: kms.DeepCopy(),
+				},
+			},
+			encryptionInitialized: false,
+			expectPlugin:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Features: map[string]bool{
+						kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+					},
+					EncryptionConfiguration: tt.encryption,
+					ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
+						Pods:     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"172.25.0.0/16"}},
+						Services: kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.16.0/20"}},
+					},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-test",
+					Address: kubermaticv1.ClusterAddress{
+						Port:         6443,
+						ExternalName: "test.example.com",
+					},
+					Versions: kubermaticv1.ClusterVersionsStatus{
+						ControlPlane: *semver.NewSemverOrDie("1.33.0"),
+						Apiserver:    *semver.NewSemverOrDie("1.33.0"),
+					},
+					Encryption: tt.encryptionStatus,
+				},
+			}
+			if tt.encryptionInitialized {
+				cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+					kubermaticv1.ClusterConditionEncryptionInitialized: {Status: corev1.ConditionTrue},
+				}
+			}
+
+			data := resources.NewTemplateDataBuilder().
+				WithCluster(cluster).
+				WithSeed(&kubermaticv1.Seed{}).
+				WithKonnectivityEnabled(true).
+				WithEtcdLauncherImage("quay.io/kubermatic/etcd-launcher").
+				WithKubermaticImage("quay.io/kubermatic/kubermatic").
+				Build()
+
+			_, reconcile := DeploymentReconciler(data)()
+			deployment, err := reconcile(&appsv1.Deployment{})
+			require.NoError(t, err)
+
+			podSpec := deployment.Spec.Template.Spec
+			plugin := findContainer(podSpec.Containers, resources.KMSPluginContainerName)
+			apiserver := findContainer(podSpec.Containers, name)
+			require.NotNil(t, apiserver, "expected the apiserver container")
+
+			safeToEvict := strings.Split(deployment.Spec.Template.Annotations[resources.ClusterAutoscalerSafeToEvictVolumesAnnotation], ",")
+
+			if !tt.expectPlugin {
+				require.Nil(t, plugin, "expected no KMS plugin container")
+				require.NotContains(t, volumeNames(podSpec.Volumes), resources.KMSPluginSocketVolumeName)
+				require.NotContains(t, volumeMountNames(apiserver.VolumeMounts), resources.KMSPluginSocketVolumeName)
+				require.NotContains(t, safeToEvict, resources.KMSPluginSocketVolumeName)
+				return
+			}
+
+			require.NotNil(t, plugin, "expected a KMS plugin container")
+			require.Equal(t, kms.Plugin.Image, plugin.Image)
+			require.Empty(t, plugin.Command)
+			require.Empty(t, plugin.Args)
+			require.Equal(t, []corev1.EnvVar{{Name: "VAULT_ADDR", Value: "https://vault.example.com"}}, plugin.Env, "expected environment variables referencing Secrets to be left out")
+			if tt.expectedPluginResources != nil {
+				require.Equal(t, *tt.expectedPluginResources, plugin.Resources)
+			}
+
+			// the socket is shared between the plugin and the apiserver via an emptyDir
+			socketVolume := findVolume(podSpec.Volumes, resources.KMSPluginSocketVolumeName)
+			require.NotNil(t, socketVolume, "expected the KMS plugin socket volume")
+			require.NotNil(t, socketVolume.EmptyDir, "expected the KMS plugin socket volume to be an emptyDir")
+			require.Equal(t, []corev1.VolumeMount{kmsPluginSocketVolumeMount()}, plugin.VolumeMounts, "expected the KMS plugin to only mount its socket")
+			require.Contains(t, apiserver.VolumeMounts, kmsPluginSocketVolumeMount())
+			require.Contains(t, safeToEvict, resources.KMSPluginSocketVolumeName)
+		})
+	}
+}
+
+func findContainer(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+func findVolume(volumes []corev1.Volume, name string) *corev1.Volume {
+	for i := range volumes {
+		if volumes[i].Name == name {
+			return &volumes[i]
+		}
+	}
+	return nil
+}
+
+func volumeNames(volumes []corev1.Volume) []string {
+	names := make([]string, 0, len(volumes))
+	for _, v := range volumes {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+func volumeMountNames(mounts []corev1.VolumeMount) []string {
+	names := make([]string, 0, len(mounts))
+	for _, m := range mounts {
+		names = append(names, m.Name)
+	}
+	return names
+}
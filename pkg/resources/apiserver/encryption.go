@@ -21,6 +21,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -34,6 +35,9 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// defaultKMSTimeout is the timeout for gRPC calls to the KMS plugin, if none is configured.
+const defaultKMSTimeout = 3 * time.Second
+
 type encryptionData interface {
 	Cluster() *kubermaticv1.Cluster
 	GetSecretKeyValue(ref *corev1.SecretKeySelector) ([]byte, error)
@@ -130,6 +134,22 @@ func EncryptionConfigurationSecretReconciler(data encryptionData) reconciling.Na
 					})
 				}
 
+				if kms := data.Cluster().Spec.EncryptionConfiguration.KMS; kms != nil {
+					timeout := kms.Timeout
+					if timeout == nil {
+						timeout = &metav1.Duration{Duration: defaultKMSTimeout}
+					}
+
+					providerList = append(providerList, apiserverconfigv1.ProviderConfiguration{
+						KMS: &apiserverconfigv1.KMSConfiguration{
+							APIVersion: "v2",
+							Name:       kms.Name,
+							Endpoint:   kms.GetEndpoint(),
+							Timeout:    timeout,
+						},
+					})
+				}
+
 				// always append the "unencrypted" provider.
 				providerList = append(providerList, apiserverconfigv1.ProviderConfiguration{
 					Identity: &apiserverconfigv1.IdentityConfiguration{},
@@ -197,6 +217,54 @@ func EncryptionConfigurationSecretReconciler(data encryptionData) reconciling.Na
 	}
 }
 
+// kmsConfiguration returns the KMS provider configuration of the cluster, if the KMS plugin needs
+// to run next to the kube-apiserver. This is also the case while encryption is being disabled, as
+// the plugin is required to decrypt existing data until it has been rewritten. If the provider has
+// been removed from the ClusterSpec, the configuration recorded in the cluster status is used.
+func kmsConfiguration(cluster *kubermaticv1.Cluster) *kubermaticv1.KMSEncryptionConfiguration {
+	if !cluster.IsEncryptionEnabled() && !cluster.IsEncryptionActive() {
+		return nil
+	}
+
+	if cluster.Spec.EncryptionConfiguration != nil && cluster.Spec.EncryptionConfiguration.KMS != nil {
+		return cluster.Spec.EncryptionConfiguration.KMS
+	}
+
+	if cluster.IsEncryptionActive() && cluster.Status.Encryption != nil && cluster.Status.Encryption.KMS != nil {
+		return cluster.Status.Encryption.KMS.Configuration
+	}
+
+	return nil
+}
+
+func kmsPluginSocketVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      resources.KMSPluginSocketVolumeName,
+		MountPath: kubermaticv1.KMSPluginSocketDirectory,
+	}
+}
+
+// kmsPluginContainer returns the sidecar running the KMS plugin with the default entrypoint of its image.
+// Environment variables referencing other sources are rejected by the validation and are left out here
+// as well, so that the plugin can never read Secrets in the seed.
+func kmsPluginContainer(kms *kubermaticv1.KMSEncryptionConfiguration) corev1.Container {
+	var env []corev1.EnvVar
+	for _, envVar := range kms.Plugin.Env {
+		if envVar.ValueFrom == nil {
+			env = append(env, envVar)
+		}
+	}
+
+	return corev1.Container{
+		Name:  resources.KMSPluginContainerName,
+		Image: kms.Plugin.Image,
+		Env:   env,
+		VolumeMounts: []corev1.VolumeMount{
+			kmsPluginSocketVolumeMount(),
+		},
+	}
+}
+
 func getKeyByName(keys []apiserverconfigv1.Key, name string) *apiserverconfigv1.Key {
 	for _, key := range keys {
 		if key.Name == name {
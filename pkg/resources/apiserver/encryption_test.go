@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"sigs.k8s.io/yaml"
+)
+
+type fakeEncryptionData struct {
+	cluster *kubermaticv1.Cluster
+}
+
+func (f *fakeEncryptionData) Cluster() *kubermaticv1.Cluster { return f.cluster }
+func (f *fakeEncryptionData) GetSecretKeyValue(ref *corev1.SecretKeySelector) ([]byte, error) {
+	return nil, nil
+}
+
+func TestEncryptionConfigurationSecretReconcilerKMS(t *testing.T) {
+	tests := []struct {
+		name             string
+		kms              *kubermaticv1.KMSEncryptionConfiguration
+		expectedProvider apiserverconfigv1.KMSConfiguration
+	}{
+		{
+			name: "defaults are applied",
+			kms: &kubermaticv1.KMSEncryptionConfiguration{
+				Name: "vault",
+				Plugin: kubermaticv1.KMSPluginConfiguration{
+					Image: "example.com/vault-kms-plugin:v1.0.0",
+				},
+			},
+			expectedProvider: apiserverconfigv1.KMSConfiguration{
+				APIVersion: "v2",
+				Name:       "vault",
+				Endpoint:   "unix:///var/run/kmsplugin/socket.sock",
+				Timeout:    &metav1.Duration{Duration: 3 * time.Second},
+			},
+		},
+		{
+			name: "custom endpoint and timeout",
+			kms: &kubermaticv1.KMSEncryptionConfiguration{
+				Name:     "vault",
+				Endpoint: "unix:///var/run/kmsplugin/vault.sock",
+				Timeout:  &metav1.Duration{Duration: 10 * time.Second},
+				Plugin: kubermaticv1.KMSPluginConfiguration{
+					Image: "example.com/vault-kms-plugin:v1.0.0",
+				},
+			},
+			expectedProvider: apiserverconfigv1.KMSConfiguration{
+				APIVersion: "v2",
+				Name:       "vault",
+				Endpoint:   "unix:///var/run/kmsplugin/vault.sock",
+				Timeout:    &metav1.Duration{Duration: 10 * time.Second},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &fakeEncryptionData{
+				cluster: &kubermaticv1.Cluster{
+					Spec: kubermaticv1.ClusterSpec{
+						Features: map[string]bool{
+							kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+						},
+						EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+							Enabled:   true,
+							Resources: []string{"secrets"},
+							KMS:       tt.kms,
+						},
+					},
+				},
+			}
+
+			_, reconciler := EncryptionConfigurationSecretReconciler(data)()
+
+			secret, err := reconciler(&corev1.Secret{})
+			require.NoError(t, err)
+
+			config := apiserverconfigv1.EncryptionConfiguration{}
+			require.NoError(t, yaml.Unmarshal(secret.Data[resources.EncryptionConfigurationKeyName], &config))
+
+			require.Len(t, config.Resources, 1)
+			require.Len(t, config.Resources[0].Providers, 2)
+			require.NotNil(t, config.Resources[0].Providers[0].KMS)
+			require.Equal(t, tt.expectedProvider, *config.Resources[0].Providers[0].KMS)
+			require.NotNil(t, config.Resources[0].Providers[1].Identity)
+		})
+	}
+}
+
+func TestKMSPluginContainer(t *testing.T) {
+	kms := &kubermaticv1.KMSEncryptionConfiguration{
+		Name: "vault",
+		Plugin: kubermaticv1.KMSPluginConfiguration{
+			Image: "example.com/vault-kms-plugin:v1.0.0",
+			Env: []corev1.EnvVar{
+				{Name: "VAULT_ADDR", Value: "https://vault.example.com"},
+				{Name: "VAULT_TOKEN", ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "admin-kubeconfig"},
+						Key:                  "kubeconfig",
+					},
+				}},
+			},
+		},
+	}
+
+	cluster := &kubermaticv1.Cluster{
+		Spec: kubermaticv1.ClusterSpec{
+			Features: map[string]bool{
+				kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+			},
+			EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+				Enabled: false,
+				KMS:     kms,
+			},
+		},
+	}
+
+	require.Nil(t, kmsConfiguration(cluster), "expected no KMS plugin while encryption is neither enabled nor active")
+
+	// while encryption is being disabled, the plugin is still required to decrypt existing data
+	cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+		kubermaticv1.ClusterConditionEncryptionInitialized: {Status: corev1.ConditionTrue},
+	}
+	require.Equal(t, kms, kmsConfiguration(cluster))
+
+	// once the configuration has been removed, the plugin recorded in the status is kept running
+	// until all data has been decrypted
+	cluster.Spec.EncryptionConfiguration = nil
+	require.Nil(t, kmsConfiguration(cluster), "expected no KMS plugin without a recorded configuration")
+
+	cluster.Status.Encryption = &kubermaticv1.ClusterEncryptionStatus{
+		Phase: kubermaticv1.ClusterEncryptionPhaseEncryptionNeeded,
+		KMS: &kubermaticv1.ClusterKMSStatus{
+			Name:          kms.Name,
+			Endpoint:      kms.GetEndpoint(),
+			Configuration: kms.DeepCopy(),
+		},
+	}
+	require.Equal(t, kms, kmsConfiguration(cluster))
+
+	// after decryption has finished, the plugin is removed
+	cluster.Status.Conditions[kubermaticv1.ClusterConditionEncryptionInitialized] = kubermaticv1.ClusterCondition{Status: corev1.ConditionFalse}
+	require.Nil(t, kmsConfiguration(cluster))
+
+	container := kmsPluginContainer(kms)
+	require.Equal(t, resources.KMSPluginContainerName, container.Name)
+	require.Equal(t, kms.Plugin.Image, container.Image)
+	require.Empty(t, container.Command)
+	require.Empty(t, container.Args)
+	require.Equal(t, []corev1.EnvVar{{Name: "VAULT_ADDR", Value: "https://vault.example.com"}}, container.Env, "expected environment variables referencing Secrets to be left out")
+	require.Equal(t, []corev1.VolumeMount{kmsPluginSocketVolumeMount()}, container.VolumeMounts)
+}
@@ -0,0 +1,414 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"text/template"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/nodeportproxy"
+	"k8c.io/kubermatic/v2/pkg/resources/registry"
+	"k8c.io/reconciler/pkg/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	proxyProtocolConfigFileName = "envoy.yaml"
+	proxyProtocolVolumeName     = "proxy-protocol-config"
+
+	// The sidecar connects to the API server and the Konnectivity server using the addresses
+	// of the clients, so that they show up in the audit logs. The connections are marked and
+	// their responses are routed back to the sidecar instead of the clients.
+	proxyProtocolMark         = 123
+	proxyProtocolRoutingTable = 100
+
+	// The addresses of the upstreams must not be loopback addresses, which the kernel refuses
+	// to route to or from the addresses of the clients. They are assigned by the init container.
+	proxyProtocolUpstreamIPv4 = "169.254.64.1"
+	proxyProtocolUpstreamIPv6 = "fd6b:6b70::1"
+)
+
+var defaultProxyProtocolResourceRequirements = corev1.ResourceRequirements{
+	Requests: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("32Mi"),
+		corev1.ResourceCPU:    resource.MustParse("10m"),
+	},
+	Limits: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("128Mi"),
+		corev1.ResourceCPU:    resource.MustParse("200m"),
+	},
+}
+
+// The listeners terminate the PROXY protocol, only allow connections from the
+// allowed IP ranges (if configured) and pass on the original client addresses.
+var proxyProtocolConfigTemplate = template.Must(template.New("envoy-config").Parse(`static_resources:
+  listeners:
+{{- range $listener := .Listeners }}
+  - name: {{ .Name }}
+    address:
+      socket_address:
+        protocol: TCP
+        address: 0.0.0.0
+        port_value: {{ .Port }}
+    listener_filters:
+    - name: envoy.filters.listener.proxy_protocol
+      typed_config:
+        "@type": type.googleapis.com/envoy.extensions.filters.listener.proxy_protocol.v3.ProxyProtocol
+    - name: envoy.filters.listener.original_src
+      typed_config:
+        "@type": type.googleapis.com/envoy.extensions.filters.listener.original_src.v3.OriginalSrc
+        mark: {{ $.Mark }}
+    access_log:
+    - name: envoy.access_loggers.stdout
+      typed_config:
+        "@type": type.googleapis.com/envoy.extensions.access_loggers.stream.v3.StdoutAccessLog
+        log_format:
+          text_format_source:
+            inline_string: "[%START_TIME%] {{ .Name }} client=%DOWNSTREAM_REMOTE_ADDRESS% flags=%RESPONSE_FLAGS% received=%BYTES_RECEIVED% sent=%BYTES_SENT% duration=%DURATION%\n"
+    filter_chains:
+{{- range $.Upstreams }}
+    - filter_chain_match:
+        source_prefix_ranges:
+        - address_prefix: "{{ .ClientRange.Address }}"
+          prefix_len: {{ .ClientRange.PrefixLen }}
+      filters:
+{{- if $.AllowedIPRanges }}
+      - name: envoy.filters.network.rbac
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.network.rbac.v3.RBAC
+          stat_prefix: {{ $listener.Name }}
+          rules:
+            action: ALLOW
+            policies:
+              allowed-ip-ranges:
+                permissions:
+                - any: true
+                principals:
+{{- range $.AllowedIPRanges }}
+                - remote_ip:
+                    address_prefix: "{{ .Address }}"
+                    prefix_len: {{ .PrefixLen }}
+{{- end }}
+{{- end }}
+      - name: envoy.filters.network.tcp_proxy
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+          stat_prefix: {{ $listener.Name }}
+          cluster: {{ $listener.Name }}-{{ .Name }}
+{{- end }}
+{{- end }}
+  clusters:
+{{- range $listener := .Listeners }}
+{{- range $.Upstreams }}
+  - name: {{ $listener.Name }}-{{ .Name }}
+    connect_timeout: 1s
+    type: STATIC
+    load_assignment:
+      cluster_name: {{ $listener.Name }}-{{ .Name }}
+      endpoints:
+      - lb_endpoints:
+        - endpoint:
+            address:
+              socket_address:
+                address: {{ .Address }}
+                port_value: {{ $listener.TargetPort }}
+{{- end }}
+{{- end }}
+`))
+
+type proxyProtocolConfig struct {
+	Listeners       []proxyProtocolListener
+	Upstreams       []proxyProtocolUpstream
+	AllowedIPRanges []proxyProtocolIPRange
+	Mark            int
+}
+
+type proxyProtocolListener struct {
+	Name       string
+	Port       int
+	TargetPort int
+}
+
+// proxyProtocolUpstream is the address the connections of the clients in
+// ClientRange are passed on to, as the original source address of a
+// connection has to be of the same IP family as its destination.
+type proxyProtocolUpstream struct {
+	Name        string
+	Address     string
+	ClientRange proxyProtocolIPRange
+}
+
+type proxyProtocolIPRange struct {
+	Address   string
+	PrefixLen int
+}
+
+// ProxyProtocolConfigMapReconciler returns a ConfigMap containing the configuration of the sidecar
+// terminating the PROXY protocol in front of the API server and the Konnectivity server.
+func ProxyProtocolConfigMapReconciler(data *resources.TemplateData) reconciling.NamedConfigMapReconcilerFactory {
+	return func() (string, reconciling.ConfigMapReconciler) {
+		return resources.ProxyProtocolConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			cfg := proxyProtocolConfig{
+				Listeners: []proxyProtocolListener{
+					{
+						Name:       "apiserver",
+						Port:       resources.APIServerProxyProtocolPort,
+						TargetPort: resources.APIServerSecurePort,
+					},
+				},
+				Upstreams: []proxyProtocolUpstream{
+					{
+						Name:        "ipv4",
+						Address:     proxyProtocolUpstreamIPv4,
+						ClientRange: proxyProtocolIPRange{Address: "0.0.0.0", PrefixLen: 0},
+					},
+					{
+						Name:        "ipv6",
+						Address:     proxyProtocolUpstreamIPv6,
+						ClientRange: proxyProtocolIPRange{Address: "::", PrefixLen: 0},
+					},
+				},
+				Mark: proxyProtocolMark,
+			}
+
+			if data.IsKonnectivityEnabled() {
+				cfg.Listeners = append(cfg.Listeners, proxyProtocolListener{
+					Name:       "konnectivity",
+					Port:       resources.KonnectivityProxyProtocolPort,
+					TargetPort: 8132,
+				})
+			}
+
+			for _, cidr := range AllowedIPRanges(data) {
+				_, ipNet, err := net.ParseCIDR(cidr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid allowed IP range %q: %w", cidr, err)
+				}
+
+				prefixLen, _ := ipNet.Mask.Size()
+				cfg.AllowedIPRanges = append(cfg.AllowedIPRanges, proxyProtocolIPRange{
+					Address:   ipNet.IP.String(),
+					PrefixLen: prefixLen,
+				})
+			}
+
+			var b strings.Builder
+			if err := proxyProtocolConfigTemplate.Execute(&b, cfg); err != nil {
+				return nil, fmt.Errorf("failed to render PROXY protocol sidecar configuration: %w", err)
+			}
+
+			cm.Labels = resources.BaseAppLabels(resources.ProxyProtocolConfigMapName, nil)
+			cm.Data = map[string]string{
+				proxyProtocolConfigFileName: b.String(),
+			}
+
+			return cm, nil
+		}
+	}
+}
+
+// AllowedIPRanges returns the IP ranges allowed to access the API server. The default
+// ranges of the Seed are only taken into account if the cluster configures ranges
+// itself, mirroring how the LoadBalancer source ranges are set up.
+func AllowedIPRanges(data *resources.TemplateData) []string {
+	allowed := data.Cluster().Spec.APIServerAllowedIPRanges
+	if allowed == nil {
+		return nil
+	}
+
+	ranges := sets.New(allowed.CIDRBlocks...)
+	if seed := data.Seed(); seed != nil {
+		ranges.Insert(seed.Spec.DefaultAPIServerAllowedIPRanges...)
+	}
+
+	return sets.List(ranges)
+}
+
+func proxyProtocolVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: proxyProtocolVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: resources.ProxyProtocolConfigMapName,
+				},
+			},
+		},
+	}
+}
+
+func proxyProtocolContainer(data *resources.TemplateData) corev1.Container {
+	ports := []corev1.ContainerPort{
+		{
+			ContainerPort: resources.APIServerProxyProtocolPort,
+			Protocol:      corev1.ProtocolTCP,
+		},
+	}
+
+	if data.IsKonnectivityEnabled() {
+		ports = append(ports, corev1.ContainerPort{
+			ContainerPort: resources.KonnectivityProxyProtocolPort,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+
+	return corev1.Container{
+		Name:  resources.ProxyProtocolContainerName,
+		Image: registry.Must(data.RewriteImage(resources.RegistryDocker + "/envoyproxy/envoy:" + nodeportproxy.EnvoyVersion)),
+		Args:  []string{"--config-path", "/etc/envoy/" + proxyProtocolConfigFileName},
+		Ports: ports,
+		// binding to the addresses of the clients requires CAP_NET_ADMIN, which only
+		// takes effect for root as the image runs as non-root user by default
+		SecurityContext: &corev1.SecurityContext{
+			RunAsUser: ptr.To[int64](0),
+			Capabilities: &corev1.Capabilities{
+				Add: []corev1.Capability{"NET_ADMIN"},
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      proxyProtocolVolumeName,
+				MountPath: "/etc/envoy",
+				ReadOnly:  true,
+			},
+		},
+	}
+}
+
+func proxyProtocolInitContainer(data *resources.TemplateData) corev1.Container {
+	procMountType := corev1.DefaultProcMount
+
+	return corev1.Container{
+		Name:    resources.ProxyProtocolInitContainerName,
+		Image:   registry.Must(data.RewriteImage(resources.RegistryQuay + "/kubermatic/openvpn:v2.5.2-r0")),
+		Command: []string{"/bin/bash"},
+		Args: []string{
+			"-c", fmt.Sprintf(`set -euo pipefail
+
+# the sidecar passes connections on to these addresses instead of 127.0.0.1
+ip addr add %[3]s/32 dev lo
+
+# route the responses to the connections of the sidecar, which use the addresses
+# of the clients as source, back to the sidecar
+iptables -t mangle -A PREROUTING -m mark --mark %[1]d -j CONNMARK --save-mark
+iptables -t mangle -A OUTPUT -m connmark --mark %[1]d -j CONNMARK --restore-mark
+ip rule add fwmark %[1]d lookup %[2]d
+ip route add local 0.0.0.0/0 dev lo table %[2]d
+
+if [ -e /proc/net/if_inet6 ]; then
+  ip -6 addr add %[4]s/128 dev lo
+  ip6tables -t mangle -A PREROUTING -m mark --mark %[1]d -j CONNMARK --save-mark
+  ip6tables -t mangle -A OUTPUT -m connmark --mark %[1]d -j CONNMARK --restore-mark
+  ip -6 rule add fwmark %[1]d lookup %[2]d
+  ip -6 route add local ::/0 dev lo table %[2]d
+fi
+`, proxyProtocolMark, proxyProtocolRoutingTable, proxyProtocolUpstreamIPv4, proxyProtocolUpstreamIPv6),
+		},
+		SecurityContext: &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{
+				Add: []corev1.Capability{
+					"NET_ADMIN",
+					"NET_RAW",
+				},
+			},
+			ProcMount: &procMountType,
+		},
+	}
+}
+
+// ProxyProtocolAllowReconciler returns a func to create/update the proxy-protocol-allow ingress policy.
+// The PROXY protocol ports are only reachable by the nodeport-proxy of the Seed, as other pods could
+// otherwise pass arbitrary client addresses to bypass the allowed IP ranges. All other ports remain
+// reachable from anywhere.
+func ProxyProtocolAllowReconciler(data *resources.TemplateData, nodePortProxyNamespace string) reconciling.NamedNetworkPolicyReconcilerFactory {
+	return func() (string, reconciling.NetworkPolicyReconciler) {
+		return resources.NetworkPolicyProxyProtocolAllow, func(np *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+			proxyProtocolPorts := []int32{resources.APIServerProxyProtocolPort}
+			if data.IsKonnectivityEnabled() {
+				proxyProtocolPorts = append(proxyProtocolPorts, resources.KonnectivityProxyProtocolPort)
+			}
+
+			otherPorts := []networkingv1.NetworkPolicyPort{
+				{
+					Protocol: ptr.To(corev1.ProtocolUDP),
+				},
+				{
+					Protocol: ptr.To(corev1.ProtocolSCTP),
+				},
+			}
+
+			start := int32(1)
+			for _, port := range proxyProtocolPorts {
+				otherPorts = append(otherPorts, networkingv1.NetworkPolicyPort{
+					Protocol: ptr.To(corev1.ProtocolTCP),
+					Port:     ptr.To(intstr.FromInt32(start)),
+					EndPort:  ptr.To(port - 1),
+				})
+				start = port + 1
+			}
+			otherPorts = append(otherPorts, networkingv1.NetworkPolicyPort{
+				Protocol: ptr.To(corev1.ProtocolTCP),
+				Port:     ptr.To(intstr.FromInt32(start)),
+				EndPort:  ptr.To[int32](65535),
+			})
+
+			np.Spec = networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{
+					networkingv1.PolicyTypeIngress,
+				},
+				PodSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						resources.AppLabelKey: name,
+					},
+				},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{
+					{
+						From: []networkingv1.NetworkPolicyPeer{
+							{
+								NamespaceSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{
+										corev1.LabelMetadataName: nodePortProxyNamespace,
+									},
+								},
+								PodSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{
+										"app.kubernetes.io/name": resources.NodePortProxyEnvoyDeploymentName,
+									},
+								},
+							},
+						},
+					},
+					{
+						Ports: otherPorts,
+					},
+				},
+			}
+
+			return np, nil
+		}
+	}
+}
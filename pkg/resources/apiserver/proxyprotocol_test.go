@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestProxyProtocolConfigMapReconciler(t *testing.T) {
+	testCases := []struct {
+		name                string
+		allowedIPRanges     *kubermaticv1.NetworkRanges
+		defaultRanges       []string
+		konnectivityEnabled bool
+		expectedListeners   int
+		expectedRanges      []string
+	}{
+		{
+			name:              "no allowed IP ranges",
+			defaultRanges:     []string{"192.168.0.0/16"},
+			expectedListeners: 1,
+		},
+		{
+			name: "allowed IP ranges with seed defaults",
+			allowedIPRanges: &kubermaticv1.NetworkRanges{
+				CIDRBlocks: []string{"10.0.0.0/8", "2001:db8::/32"},
+			},
+			defaultRanges:       []string{"192.168.0.0/16"},
+			konnectivityEnabled: true,
+			expectedListeners:   2,
+			expectedRanges:      []string{`address_prefix: "10.0.0.0"`, `address_prefix: "192.168.0.0"`, `address_prefix: "2001:db8::"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					ExposeStrategy:           kubermaticv1.ExposeStrategyTunneling,
+					APIServerProxyProtocol:   true,
+					APIServerAllowedIPRanges: tc.allowedIPRanges,
+				},
+			}
+
+			seed := &kubermaticv1.Seed{
+				Spec: kubermaticv1.SeedSpec{
+					DefaultAPIServerAllowedIPRanges: tc.defaultRanges,
+				},
+			}
+
+			data := resources.NewTemplateDataBuilder().
+				WithCluster(cluster).
+				WithSeed(seed).
+				WithKonnectivityEnabled(tc.konnectivityEnabled).
+				Build()
+
+			_, reconciler := ProxyProtocolConfigMapReconciler(data)()
+			cm, err := reconciler(&corev1.ConfigMap{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			config := cm.Data[proxyProtocolConfigFileName]
+
+			parsed := struct {
+				StaticResources struct {
+					Listeners []interface{} `json:"listeners"`
+					Clusters  []interface{} `json:"clusters"`
+				} `json:"static_resources"`
+			}{}
+			if err := yaml.UnmarshalStrict([]byte(config), &parsed); err != nil {
+				t.Fatalf("Failed to parse rendered configuration: %v\n%s", err, config)
+			}
+
+			if got := len(parsed.StaticResources.Listeners); got != tc.expectedListeners {
+				t.Errorf("Expected %d listeners, got %d", tc.expectedListeners, got)
+			}
+
+			// one cluster per listener and IP family
+			if got := len(parsed.StaticResources.Clusters); got != 2*tc.expectedListeners {
+				t.Errorf("Expected %d clusters, got %d", 2*tc.expectedListeners, got)
+			}
+
+			if !strings.Contains(config, "envoy.filters.listener.original_src") {
+				t.Errorf("Expected configuration to pass on the client addresses:\n%s", config)
+			}
+
+			if hasRBAC := strings.Contains(config, "envoy.filters.network.rbac"); hasRBAC != (len(tc.expectedRanges) > 0) {
+				t.Errorf("Expected RBAC filter = %v, got %v", len(tc.expectedRanges) > 0, hasRBAC)
+			}
+
+			for _, expected := range tc.expectedRanges {
+				if !strings.Contains(config, expected) {
+					t.Errorf("Expected configuration to contain %q:\n%s", expected, config)
+				}
+			}
+		})
+	}
+}
+
+func TestProxyProtocolAllowReconciler(t *testing.T) {
+	testCases := []struct {
+		name                string
+		konnectivityEnabled bool
+		expectedTCPRanges   [][2]int32
+	}{
+		{
+			name:              "API server only",
+			expectedTCPRanges: [][2]int32{{1, 6443}, {6445, 65535}},
+		},
+		{
+			name:                "API server and Konnectivity server",
+			konnectivityEnabled: true,
+			expectedTCPRanges:   [][2]int32{{1, 6443}, {6445, 8134}, {8136, 65535}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := resources.NewTemplateDataBuilder().
+				WithCluster(&kubermaticv1.Cluster{}).
+				WithKonnectivityEnabled(tc.konnectivityEnabled).
+				Build()
+
+			_, reconciler := ProxyProtocolAllowReconciler(data, "kubermatic")()
+			np, err := reconciler(&networkingv1.NetworkPolicy{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(np.Spec.Ingress) != 2 {
+				t.Fatalf("Expected 2 ingress rules, got %d", len(np.Spec.Ingress))
+			}
+
+			from := np.Spec.Ingress[0].From
+			if len(from) != 1 || from[0].NamespaceSelector.MatchLabels[corev1.LabelMetadataName] != "kubermatic" || from[0].PodSelector.MatchLabels["app.kubernetes.io/name"] != resources.NodePortProxyEnvoyDeploymentName {
+				t.Errorf("Expected the first rule to allow the nodeport-proxy, got %+v", from)
+			}
+
+			var tcpRanges [][2]int32
+			for _, port := range np.Spec.Ingress[1].Ports {
+				if *port.Protocol == corev1.ProtocolTCP {
+					tcpRanges = append(tcpRanges, [2]int32{port.Port.IntVal, *port.EndPort})
+				}
+			}
+
+			if !reflect.DeepEqual(tcpRanges, tc.expectedTCPRanges) {
+				t.Errorf("Expected TCP port ranges %v, got %v", tc.expectedTCPRanges, tcpRanges)
+			}
+		})
+	}
+}
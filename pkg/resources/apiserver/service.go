@@ -30,7 +30,10 @@ import (
 )
 
 // ServiceReconciler returns the function to reconcile the external API server service.
-func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL string, apiServerServiceType *corev1.ServiceType) reconciling.NamedServiceReconcilerFactory {
+// If proxyProtocol is set, the API server is exposed via SNI on an additional port
+// expecting the PROXY protocol. If allowedIPRanges are given, the nodeport-proxy only
+// accepts tunneled connections from these ranges.
+func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL string, apiServerServiceType *corev1.ServiceType, proxyProtocol bool, allowedIPRanges []string) reconciling.NamedServiceReconcilerFactory {
 	return func() (string, reconciling.ServiceReconciler) {
 		return resources.ApiserverServiceName, func(se *corev1.Service) (*corev1.Service, error) {
 			if se.Annotations == nil {
@@ -59,10 +62,24 @@ func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL s
 				// We map the secure port to the internal name for SNI routing.
 				se.Annotations[nodeportproxy.PortHostMappingAnnotationKey] = fmt.Sprintf(`{"secure": %q}`, externalURL)
 				delete(se.Annotations, nodeportproxy.NodePortProxyExposeNamespacedAnnotationKey)
+				if proxyProtocol {
+					se.Annotations[nodeportproxy.PortHostMappingAnnotationKey] = fmt.Sprintf(`{%q: %q}`, nodeportproxy.ProxyProtocolPortName, externalURL)
+					se.Annotations[nodeportproxy.ProxyProtocolPortsAnnotationKey] = nodeportproxy.ProxyProtocolPortName
+				}
 			default:
 				return nil, fmt.Errorf("unsupported expose strategy: %q", exposeStrategy)
 			}
 
+			if !proxyProtocol {
+				delete(se.Annotations, nodeportproxy.ProxyProtocolPortsAnnotationKey)
+			}
+
+			if exposeStrategy == kubermaticv1.ExposeStrategyTunneling && len(allowedIPRanges) > 0 {
+				se.Annotations[nodeportproxy.TunnelingAllowedIPRangesAnnotationKey] = strings.Join(allowedIPRanges, ",")
+			} else {
+				delete(se.Annotations, nodeportproxy.TunnelingAllowedIPRangesAnnotationKey)
+			}
+
 			if apiServerServiceType != nil {
 				se.Spec.Type = *apiServerServiceType
 			}
@@ -78,25 +95,36 @@ func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL s
 						TargetPort: intstr.FromInt(resources.APIServerSecurePort),
 					},
 				}
+			} else {
+				// drop the PROXY protocol port, it is re-added below if needed
+				se.Spec.Ports = se.Spec.Ports[:1]
 
-				return se, nil
+				se.Spec.Ports[0].Name = "secure"
+				se.Spec.Ports[0].Protocol = corev1.ProtocolTCP
+				se.Spec.Ports[0].Port = 443
+				if exposeStrategy == kubermaticv1.ExposeStrategyTunneling {
+					se.Spec.Ports[0].TargetPort = intstr.FromInt(resources.APIServerSecurePort)
+					if se.Spec.Type == corev1.ServiceTypeClusterIP {
+						se.Spec.Ports[0].NodePort = 0 // allows switching from other expose strategies
+					}
+				} else {
+					// We assign the target port the same value as the NodePort port.
+					// The reason is that we need  both access the apiserver using
+					// this service (i.e. from seed cluster) and from the kubernetes
+					// nodeport service in the default namespace of the user cluster.
+					se.Spec.Ports[0].TargetPort = intstr.FromInt(int(se.Spec.Ports[0].NodePort))
+				}
 			}
 
-			se.Spec.Ports[0].Name = "secure"
-			se.Spec.Ports[0].Protocol = corev1.ProtocolTCP
-			se.Spec.Ports[0].Port = 443
-			if exposeStrategy == kubermaticv1.ExposeStrategyTunneling {
-				se.Spec.Ports[0].TargetPort = intstr.FromInt(resources.APIServerSecurePort)
-				if se.Spec.Type == corev1.ServiceTypeClusterIP {
-					se.Spec.Ports[0].NodePort = 0 // allows switching from other expose strategies
-				}
-			} else {
-				// We assign the target port the same value as the NodePort port.
-				// The reason is that we need  both access the apiserver using
-				// this service (i.e. from seed cluster) and from the kubernetes
-				// nodeport service in the default namespace of the user cluster.
-				se.Spec.Ports[0].TargetPort = intstr.FromInt(int(se.Spec.Ports[0].NodePort))
+			if proxyProtocol {
+				se.Spec.Ports = append(se.Spec.Ports, corev1.ServicePort{
+					Name:       nodeportproxy.ProxyProtocolPortName,
+					Port:       resources.APIServerProxyProtocolPort,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt(resources.APIServerProxyProtocolPort),
+				})
 			}
+
 			return se, nil
 		}
 	}
@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/nodeportproxy"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -48,7 +50,7 @@ func TestServiceReconcilerRequiresExposeStrategy(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, creator := ServiceReconciler(tc.exposeStrategy, tc.internalService, nil)()
+			_, creator := ServiceReconciler(tc.exposeStrategy, tc.internalService, nil, false, nil)()
 			_, err := creator(&corev1.Service{})
 			if (err != nil) != tc.errExpected {
 				t.Errorf("Expected err: %t, but got err %v", tc.errExpected, err)
@@ -147,7 +149,7 @@ func TestServiceReconcilerSetsPort(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, creator := ServiceReconciler(tc.exposeStrategy, tc.internalService, tc.expectedServiceType)()
+			_, creator := ServiceReconciler(tc.exposeStrategy, tc.internalService, tc.expectedServiceType, false, nil)()
 			svc, err := creator(tc.inService)
 			if err != nil {
 				t.Fatalf("Unexpected error: %v", err)
@@ -176,3 +178,69 @@ func TestServiceReconcilerSetsPort(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceReconcilerProxyProtocol(t *testing.T) {
+	inService := &corev1.Service{}
+
+	for _, proxyProtocol := range []bool{true, false} {
+		_, creator := ServiceReconciler(kubermaticv1.ExposeStrategyTunneling, "apiserver.example.com", nil, proxyProtocol, nil)()
+		svc, err := creator(inService)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expectedPorts := 1
+		expectedMapping := `{"secure": "apiserver.example.com"}`
+		if proxyProtocol {
+			expectedPorts = 2
+			expectedMapping = `{"secure-proxy-protocol": "apiserver.example.com"}`
+		}
+
+		if portlen := len(svc.Spec.Ports); portlen != expectedPorts {
+			t.Fatalf("proxyProtocol=%v: expected %d ports, got %d", proxyProtocol, expectedPorts, portlen)
+		}
+		if mapping := svc.Annotations[nodeportproxy.PortHostMappingAnnotationKey]; mapping != expectedMapping {
+			t.Errorf("proxyProtocol=%v: expected port host mapping %q, got %q", proxyProtocol, expectedMapping, mapping)
+		}
+
+		ports, exists := svc.Annotations[nodeportproxy.ProxyProtocolPortsAnnotationKey]
+		if exists != proxyProtocol {
+			t.Errorf("proxyProtocol=%v: expected PROXY protocol ports annotation to exist: %v", proxyProtocol, proxyProtocol)
+		}
+
+		if proxyProtocol {
+			if ports != nodeportproxy.ProxyProtocolPortName {
+				t.Errorf("Expected PROXY protocol ports annotation to be %q, got %q", nodeportproxy.ProxyProtocolPortName, ports)
+			}
+			if port := svc.Spec.Ports[1]; port.Name != nodeportproxy.ProxyProtocolPortName || port.Port != resources.APIServerProxyProtocolPort {
+				t.Errorf("Unexpected PROXY protocol port: %+v", port)
+			}
+		}
+
+		// reconcile the same service again to verify that disabling removes the port
+		inService = svc
+	}
+}
+
+func TestServiceReconcilerTunnelingAllowedIPRanges(t *testing.T) {
+	allowedIPRanges := []string{"10.0.0.0/8", "192.168.1.0/24"}
+
+	_, creator := ServiceReconciler(kubermaticv1.ExposeStrategyTunneling, "apiserver.example.com", nil, true, allowedIPRanges)()
+	svc, err := creator(&corev1.Service{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ranges := svc.Annotations[nodeportproxy.TunnelingAllowedIPRangesAnnotationKey]; ranges != "10.0.0.0/8,192.168.1.0/24" {
+		t.Errorf("Expected tunneling allowed IP ranges annotation to be %q, got %q", "10.0.0.0/8,192.168.1.0/24", ranges)
+	}
+
+	// removing the ranges must lift the restriction
+	_, creator = ServiceReconciler(kubermaticv1.ExposeStrategyTunneling, "apiserver.example.com", nil, true, nil)()
+	svc, err = creator(svc)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, exists := svc.Annotations[nodeportproxy.TunnelingAllowedIPRangesAnnotationKey]; exists {
+		t.Error("Expected tunneling allowed IP ranges annotation to be removed")
+	}
+}
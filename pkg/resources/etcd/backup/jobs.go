@@ -17,6 +17,8 @@ limitations under the License.
 package etcd
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -34,6 +36,8 @@ import (
 const (
 	// BackupConfigNameLabelKey is the label key which should be used to name the BackupConfig a job belongs to.
 	BackupConfigNameLabelKey = "backupConfig"
+	// EtcdRestoreNameLabelKey is the label key which should be used to name the EtcdRestore a job belongs to.
+	EtcdRestoreNameLabelKey = "etcdRestore"
 	// SharedVolumeName is the name of the `emptyDir` volume the initContainer
 	// will write the backup to.
 	SharedVolumeName = "etcd-backup"
@@ -63,6 +67,17 @@ const (
 	// BackupInsecureEnvVarKey defines the environment variable key for a boolean that tells whether the
 	// configured endpoint uses HTTPS ("false") or HTTP ("true").
 	BackupInsecureEnvVarKey = "INSECURE"
+
+	// ReplicationEnvVarPrefix is prepended to the names of the environment variables describing
+	// the target destination of replication jobs.
+	ReplicationEnvVarPrefix = "REPLICATION_"
+
+	// encryptionKeyVolumeName is the name of the volume holding the key to encrypt snapshots with.
+	encryptionKeyVolumeName = "etcd-backup-encryption-key"
+	encryptionKeyMountPath  = "/etc/etcd/backup-encryption"
+	encryptionKeyFileName   = "key"
+
+	defaultCompression = "gzip"
 )
 
 type etcdBackupData interface {
@@ -139,7 +154,7 @@ func BackupJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig, statu
 		{
 			Name:    "backup-creator",
 			Image:   fmt.Sprintf("%s:%s", data.EtcdLauncherImage(), data.EtcdLauncherTag()),
-			Command: snapshotCommand(data.Cluster()),
+			Command: snapshotCommand(data.Cluster(), data.EtcdBackupDestination()),
 			VolumeMounts: []corev1.VolumeMount{
 				{
 					Name:      SharedVolumeName,
@@ -185,11 +200,47 @@ func BackupJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig, statu
 		},
 	}
 
+	if destination := data.EtcdBackupDestination(); destination != nil && destination.EncryptionKey != nil {
+		job.Spec.Template.Spec.InitContainers[0].VolumeMounts = append(job.Spec.Template.Spec.InitContainers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      encryptionKeyVolumeName,
+			MountPath: encryptionKeyMountPath,
+			ReadOnly:  true,
+		})
+
+		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, encryptionKeyVolume(destination))
+	}
+
 	return job
 }
 
-func snapshotCommand(cluster *kubermaticv1.Cluster) []string {
-	return []string{
+func encryptionKeyVolume(destination *kubermaticv1.BackupDestination) corev1.Volume {
+	return corev1.Volume{
+		Name: encryptionKeyVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: destination.EncryptionKey.Name,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  destination.EncryptionKey.Key,
+						Path: encryptionKeyFileName,
+					},
+				},
+			},
+		},
+	}
+}
+
+// snapshotCommand returns the etcd-launcher command to create the snapshot. Note that
+// the file name is kept stable regardless of the compression and encryption settings,
+// as the store container expects the snapshot at this location; restores detect the
+// format based on the content.
+func snapshotCommand(cluster *kubermaticv1.Cluster, destination *kubermaticv1.BackupDestination) []string {
+	compression := defaultCompression
+	if destination != nil && destination.Compression != "" {
+		compression = destination.Compression
+	}
+
+	command := []string{
 		"/etcd-launcher",
 		"snapshot",
 		"--etcd-ca-file=/etc/etcd/pki/client/ca.crt",
@@ -197,7 +248,276 @@ func snapshotCommand(cluster *kubermaticv1.Cluster) []string {
 		"--etcd-client-key-file=/etc/etcd/pki/client/backup-etcd-client.key",
 		fmt.Sprintf("--cluster=%s", cluster.Name),
 		"--file=/backup/snapshot.db.gz",
-		"--compress=gzip",
+		fmt.Sprintf("--compress=%s", compression),
+	}
+
+	if destination != nil && destination.EncryptionKey != nil {
+		command = append(command, fmt.Sprintf("--encryption-key-file=%s/%s", encryptionKeyMountPath, encryptionKeyFileName))
+	}
+
+	return command
+}
+
+// VerificationJob returns a job that downloads the given backup and verifies that it can
+// be restored into a temporary etcd.
+func VerificationJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig, status *kubermaticv1.BackupStatus) *batchv1.Job {
+	destination := data.EtcdBackupDestination()
+
+	command := []string{
+		"/etcd-launcher",
+		"verify-snapshot",
+		fmt.Sprintf("--cluster=%s", data.Cluster().Name),
+		fmt.Sprintf("--backup-name=%s", status.BackupName),
+		"--work-dir=/backup",
+		fmt.Sprintf("--ca-bundle=/etc/ca-bundle/%s", resources.CABundleConfigMapKey),
+	}
+
+	if destination.EncryptionKey != nil {
+		command = append(command, fmt.Sprintf("--encryption-key-file=%s/%s", encryptionKeyMountPath, encryptionKeyFileName))
+	}
+
+	container := corev1.Container{
+		Name:    "backup-verifier",
+		Image:   fmt.Sprintf("%s:%s", data.EtcdLauncherImage(), data.EtcdLauncherTag()),
+		Command: command,
+		Env:     destinationEnvVars("", destination),
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      SharedVolumeName,
+				MountPath: "/backup",
+			},
+			{
+				Name:      "ca-bundle",
+				MountPath: "/etc/ca-bundle/",
+				ReadOnly:  true,
+			},
+		},
+	}
+
+	job := jobBase(config, data.Cluster(), status.VerificationJobName)
+	// downloading and restoring a large snapshot takes considerably longer than creating it
+	job.Spec.ActiveDeadlineSeconds = resources.Int64(10 * 60)
+	job.Spec.Template.Spec.Containers = []corev1.Container{container}
+	job.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: SharedVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+		{
+			Name: "ca-bundle",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: resources.BackupCABundleConfigMapName(data.Cluster()),
+					},
+				},
+			},
+		},
+	}
+
+	if destination.EncryptionKey != nil {
+		job.Spec.Template.Spec.Containers[0].VolumeMounts = append(job.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      encryptionKeyVolumeName,
+			MountPath: encryptionKeyMountPath,
+			ReadOnly:  true,
+		})
+
+		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, encryptionKeyVolume(destination))
+	}
+
+	return job
+}
+
+// RestoreVerificationJobName returns the name of the job verifying the backup of the given
+// restore. The name is stable, so the job can be found again on later reconciliations.
+func RestoreVerificationJobName(cluster *kubermaticv1.Cluster, restore *kubermaticv1.EtcdRestore) string {
+	hash := sha256.Sum256([]byte(restore.Namespace + "/" + restore.Name))
+	return fmt.Sprintf("%s-restore-verify-%s", cluster.Name, hex.EncodeToString(hash[:])[:10])
+}
+
+// RestoreVerificationJob returns a job that downloads the backup of the given restore and
+// verifies that it can be restored into a temporary etcd. The S3 bucket, endpoint and credentials
+// are read from the given secret, which must live in the job's namespace and use the same keys
+// as the restore's backup download credentials secret.
+func RestoreVerificationJob(cluster *kubermaticv1.Cluster, restore *kubermaticv1.EtcdRestore, image string, credentials *corev1.Secret) *batchv1.Job {
+	command := []string{
+		"/etcd-launcher",
+		"verify-snapshot",
+		fmt.Sprintf("--cluster=%s", cluster.Name),
+		fmt.Sprintf("--backup-name=%s", restore.Spec.BackupName),
+		"--work-dir=/backup",
+		fmt.Sprintf("--ca-bundle=/etc/ca-bundle/%s", resources.CABundleConfigMapKey),
+	}
+
+	encrypted := len(credentials.Data[resources.EtcdRestoreEncryptionKeyKey]) > 0
+	if encrypted {
+		command = append(command, fmt.Sprintf("--encryption-key-file=%s/%s", encryptionKeyMountPath, encryptionKeyFileName))
+	}
+
+	env := []corev1.EnvVar{}
+	for _, key := range []string{AccessKeyIDEnvVarKey, SecretAccessKeyEnvVarKey, BucketNameEnvVarKey, BackupEndpointEnvVarKey} {
+		env = append(env, corev1.EnvVar{
+			Name: key,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: credentials.Name},
+					Key:                  key,
+				},
+			},
+		})
+	}
+
+	container := corev1.Container{
+		Name:    "backup-verifier",
+		Image:   image,
+		Command: command,
+		Env:     env,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      SharedVolumeName,
+				MountPath: "/backup",
+			},
+			{
+				Name:      "ca-bundle",
+				MountPath: "/etc/ca-bundle/",
+				ReadOnly:  true,
+			},
+		},
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RestoreVerificationJobName(cluster, restore),
+			Namespace: metav1.NamespaceSystem,
+			Labels: map[string]string{
+				resources.AppLabelKey:   BackupJobLabel,
+				EtcdRestoreNameLabelKey: restore.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				resources.GetClusterRef(cluster),
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](3),
+			Completions:  ptr.To[int32](1),
+			Parallelism:  ptr.To[int32](1),
+			// downloading and restoring a large snapshot takes considerably longer than creating it
+			ActiveDeadlineSeconds: resources.Int64(10 * 60),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers:    []corev1.Container{container},
+					Volumes: []corev1.Volume{
+						{
+							Name: SharedVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+						{
+							Name: "ca-bundle",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: resources.BackupCABundleConfigMapName(cluster),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if encrypted {
+		job.Spec.Template.Spec.Containers[0].VolumeMounts = append(job.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      encryptionKeyVolumeName,
+			MountPath: encryptionKeyMountPath,
+			ReadOnly:  true,
+		})
+
+		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: encryptionKeyVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: credentials.Name,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  resources.EtcdRestoreEncryptionKeyKey,
+							Path: encryptionKeyFileName,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return job
+}
+
+// ReplicationJob returns a job that copies the given backup from the backup destination
+// to the given replication destination.
+func ReplicationJob(data etcdBackupData, config *kubermaticv1.EtcdBackupConfig, status *kubermaticv1.BackupStatus, replication *kubermaticv1.BackupReplicationStatus, target *kubermaticv1.BackupDestination) *batchv1.Job {
+	env := destinationEnvVars("", data.EtcdBackupDestination())
+	env = append(env, destinationEnvVars(ReplicationEnvVarPrefix, target)...)
+
+	container := corev1.Container{
+		Name:  "backup-replicator",
+		Image: fmt.Sprintf("%s:%s", data.EtcdLauncherImage(), data.EtcdLauncherTag()),
+		Command: []string{
+			"/etcd-launcher",
+			"replicate-snapshot",
+			fmt.Sprintf("--cluster=%s", data.Cluster().Name),
+			fmt.Sprintf("--backup-name=%s", status.BackupName),
+			fmt.Sprintf("--ca-bundle=/etc/ca-bundle/%s", resources.CABundleConfigMapKey),
+		},
+		Env: env,
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "ca-bundle",
+				MountPath: "/etc/ca-bundle/",
+				ReadOnly:  true,
+			},
+		},
+	}
+
+	job := jobBase(config, data.Cluster(), replication.JobName)
+	// uploads to a remote region can take a while
+	job.Spec.ActiveDeadlineSeconds = resources.Int64(10 * 60)
+	job.Spec.Template.Spec.Containers = []corev1.Container{container}
+	job.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "ca-bundle",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: resources.BackupCABundleConfigMapName(data.Cluster()),
+					},
+				},
+			},
+		},
+	}
+
+	return job
+}
+
+// destinationEnvVars returns the environment variables describing the S3 bucket and
+// credentials of a backup destination, with their names prefixed by prefix.
+func destinationEnvVars(prefix string, destination *kubermaticv1.BackupDestination) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		GenSecretEnvVar(prefix+AccessKeyIDEnvVarKey, AccessKeyIDEnvVarKey, destination),
+		GenSecretEnvVar(prefix+SecretAccessKeyEnvVarKey, SecretAccessKeyEnvVarKey, destination),
+		{
+			Name:  prefix + BucketNameEnvVarKey,
+			Value: destination.BucketName,
+		},
+		{
+			Name:  prefix + BackupEndpointEnvVarKey,
+			Value: destination.Endpoint,
+		},
 	}
 }
 
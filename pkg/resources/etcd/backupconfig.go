@@ -64,6 +64,11 @@ func BackupConfigReconciler(data etcdBackupConfigReconcilerData, seed *kubermati
 				config.Spec.Keep = data.BackupCount()
 			}
 
+			config.Spec.Retention = nil
+			if seed.Spec.EtcdBackupRestore != nil && seed.Spec.EtcdBackupRestore.Retention != nil {
+				config.Spec.Retention = seed.Spec.EtcdBackupRestore.Retention.DeepCopy()
+			}
+
 			config.Spec.Name = resources.EtcdDefaultBackupConfigName
 			config.Spec.Schedule = backupScheduleString
 			config.Spec.Cluster = corev1.ObjectReference{
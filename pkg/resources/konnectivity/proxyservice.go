@@ -30,7 +30,10 @@ import (
 )
 
 // ServiceReconciler returns function to create konnectivity proxy service.
-func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL string) reconciling.NamedServiceReconcilerFactory {
+// If proxyProtocol is set, the konnectivity server is exposed via SNI on an
+// additional port expecting the PROXY protocol. If allowedIPRanges are given,
+// the nodeport-proxy only accepts tunneled connections from these ranges.
+func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL string, proxyProtocol bool, allowedIPRanges []string) reconciling.NamedServiceReconcilerFactory {
 	return func() (string, reconciling.ServiceReconciler) {
 		return resources.KonnectivityProxyServiceName, func(se *corev1.Service) (*corev1.Service, error) {
 			// because konnectivity proxy runs in sidecar in apiserver pod
@@ -54,14 +57,31 @@ func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL s
 				se.Annotations[nodeportproxy.DefaultExposeAnnotationKey] = strings.Join([]string{nodeportproxy.SNIType.String(), nodeportproxy.TunnelingType.String()}, ",")
 				se.Annotations[nodeportproxy.PortHostMappingAnnotationKey] = fmt.Sprintf(`{"secure": %q}`, "konnectivity-server."+externalURL)
 				delete(se.Annotations, nodeportproxy.NodePortProxyExposeNamespacedAnnotationKey)
+				if proxyProtocol {
+					se.Annotations[nodeportproxy.PortHostMappingAnnotationKey] = fmt.Sprintf(`{%q: %q}`, nodeportproxy.ProxyProtocolPortName, "konnectivity-server."+externalURL)
+					se.Annotations[nodeportproxy.ProxyProtocolPortsAnnotationKey] = nodeportproxy.ProxyProtocolPortName
+				}
 			default:
 				return nil, fmt.Errorf("unsupported expose strategy: %q", exposeStrategy)
 			}
 
+			if !proxyProtocol {
+				delete(se.Annotations, nodeportproxy.ProxyProtocolPortsAnnotationKey)
+			}
+
+			if exposeStrategy == kubermaticv1.ExposeStrategyTunneling && len(allowedIPRanges) > 0 {
+				se.Annotations[nodeportproxy.TunnelingAllowedIPRangesAnnotationKey] = strings.Join(allowedIPRanges, ",")
+			} else {
+				delete(se.Annotations, nodeportproxy.TunnelingAllowedIPRangesAnnotationKey)
+			}
+
 			if len(se.Spec.Ports) == 0 {
 				se.Spec.Ports = make([]corev1.ServicePort, 1)
 			}
 
+			// drop the PROXY protocol port, it is re-added below if needed
+			se.Spec.Ports = se.Spec.Ports[:1]
+
 			const port = 8132
 
 			se.Spec.Ports[0].Name = "secure"
@@ -73,6 +93,15 @@ func ServiceReconciler(exposeStrategy kubermaticv1.ExposeStrategy, externalURL s
 				se.Spec.Ports[0].NodePort = 0
 			}
 
+			if proxyProtocol {
+				se.Spec.Ports = append(se.Spec.Ports, corev1.ServicePort{
+					Name:       nodeportproxy.ProxyProtocolPortName,
+					Port:       resources.KonnectivityProxyProtocolPort,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt(resources.KonnectivityProxyProtocolPort),
+				})
+			}
+
 			return se, nil
 		}
 	}
@@ -54,6 +54,26 @@ const (
 	// exposed and the hostname, this is only used when the ExposeType is
 	// SNIType.
 	PortHostMappingAnnotationKey = "nodeport-proxy.k8s.io/port-mapping"
+	// MaxConnectionsAnnotationKey overrides the maximum number of concurrent
+	// connections per port of the exposed service. "0" disables the limit.
+	MaxConnectionsAnnotationKey = "nodeport-proxy.k8s.io/max-connections"
+	// ConnectionRateAnnotationKey overrides the number of new connections per
+	// second accepted per port of the exposed service. "0" disables the limit.
+	ConnectionRateAnnotationKey = "nodeport-proxy.k8s.io/connection-rate"
+	// ConnectionBurstAnnotationKey overrides the number of new connections that
+	// can be accepted at once before the connection rate is enforced.
+	ConnectionBurstAnnotationKey = "nodeport-proxy.k8s.io/connection-burst"
+	// ProxyProtocolPortsAnnotationKey contains the comma separated names of the
+	// ports of the exposed service that expect the client address to be passed
+	// using the PROXY protocol v2.
+	ProxyProtocolPortsAnnotationKey = "nodeport-proxy.k8s.io/proxy-protocol-ports"
+	// ProxyProtocolPortName is the name of the ports of the control plane
+	// services that expect the PROXY protocol.
+	ProxyProtocolPortName = "secure-proxy-protocol"
+	// TunnelingAllowedIPRangesAnnotationKey contains the comma separated IP
+	// ranges allowed to connect to the exposed service via the Tunneling
+	// listener. CONNECT requests from other addresses are rejected.
+	TunnelingAllowedIPRangesAnnotationKey = "nodeport-proxy.k8s.io/tunneling-allowed-ip-ranges"
 
 	loadBalancerSourceRangesAnnotationKey = "service.beta.kubernetes.io/load-balancer-source-ranges"
 )
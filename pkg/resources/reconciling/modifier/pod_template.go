@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modifier
+
+import (
+	"fmt"
+
+	"k8c.io/reconciler/pkg/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HoldPodTemplate returns a modifier that keeps the pod template of existing Deployments, StatefulSets
+// and DaemonSets unchanged, so that reconciling does not trigger a rollout. New objects are not affected.
+// If a change to the pod template was held back, held is set to true.
+func HoldPodTemplate(held *bool) reconciling.ObjectModifier {
+	return func(reconciler reconciling.ObjectReconciler) reconciling.ObjectReconciler {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			// the reconciler might modify the existing object in-place
+			var existingTemplate *corev1.PodTemplateSpec
+			if existing != nil && existing.GetResourceVersion() != "" {
+				existingTemplate = podTemplate(existing).DeepCopy()
+			}
+
+			obj, err := reconciler(existing)
+			if err != nil || existingTemplate == nil {
+				return obj, err
+			}
+
+			template := podTemplate(obj)
+			if !apiequality.Semantic.DeepEqual(*template, *existingTemplate) {
+				*template = *existingTemplate
+				*held = true
+			}
+
+			return obj, nil
+		}
+	}
+}
+
+func podTemplate(obj ctrlruntimeclient.Object) *corev1.PodTemplateSpec {
+	switch asserted := obj.(type) {
+	case *appsv1.Deployment:
+		return &asserted.Spec.Template
+	case *appsv1.StatefulSet:
+		return &asserted.Spec.Template
+	case *appsv1.DaemonSet:
+		return &asserted.Spec.Template
+	default:
+		panic(fmt.Sprintf("HoldPodTemplate modifier used on incompatible type %T", obj))
+	}
+}
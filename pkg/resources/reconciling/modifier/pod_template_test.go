@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestHoldPodTemplate(t *testing.T) {
+	t.Parallel()
+
+	statefulSet := func(resourceVersion string, image string) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				ResourceVersion: resourceVersion,
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](3),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "etcd", Image: image}},
+					},
+				},
+			},
+		}
+	}
+
+	// the reconciler modifies the existing object in-place, like most reconcilers do
+	baseReconciler := func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		sts := existing.(*appsv1.StatefulSet)
+		sts.Spec.Replicas = ptr.To[int32](5)
+		sts.Spec.Template.Spec.Containers = []corev1.Container{{Name: "etcd", Image: "etcd:new"}}
+
+		return sts, nil
+	}
+
+	testCases := []struct {
+		name          string
+		existing      *appsv1.StatefulSet
+		expectedImage string
+		expectedHeld  bool
+	}{
+		{
+			name:          "new objects are created with the desired template",
+			existing:      statefulSet("", ""),
+			expectedImage: "etcd:new",
+		},
+		{
+			name:          "changes to existing objects are held back",
+			existing:      statefulSet("1", "etcd:old"),
+			expectedImage: "etcd:old",
+			expectedHeld:  true,
+		},
+		{
+			name:          "unchanged templates are not held back",
+			existing:      statefulSet("1", "etcd:new"),
+			expectedImage: "etcd:new",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			held := false
+			reconciler := HoldPodTemplate(&held)(baseReconciler)
+
+			obj, err := reconciler(tc.existing)
+			require.NoError(t, err)
+
+			sts := obj.(*appsv1.StatefulSet)
+			require.Equal(t, tc.expectedImage, sts.Spec.Template.Spec.Containers[0].Image)
+			require.Equal(t, int32(5), *sts.Spec.Replicas, "changes outside of the pod template should be applied")
+			require.Equal(t, tc.expectedHeld, held)
+		})
+	}
+}
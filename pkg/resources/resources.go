@@ -55,6 +55,10 @@ import (
 const (
 	// ApiServer secure port.
 	APIServerSecurePort = 6443
+	// APIServerProxyProtocolPort is the port on which the API server accepts connections using the PROXY protocol.
+	APIServerProxyProtocolPort = 6444
+	// KonnectivityProxyProtocolPort is the port on which the Konnectivity server accepts connections using the PROXY protocol.
+	KonnectivityProxyProtocolPort = 8135
 
 	NodeLocalDNSCacheAddress = "169.254.20.10"
 )
@@ -118,6 +122,17 @@ const (
 	EncryptionConfigurationSecretName = "apiserver-encryption-configuration"
 	// EncryptionConfigurationKeyName is the name of the secret key that is used to store the configuration file for encryption-at-rest.
 	EncryptionConfigurationKeyName = "encryption-configuration.yaml"
+	// KMSPluginContainerName is the name of the KMS plugin sidecar in the API server pods.
+	KMSPluginContainerName = "kms-plugin"
+	// KMSPluginSocketVolumeName is the name of the volume shared between the API server and the KMS plugin.
+	KMSPluginSocketVolumeName = "kms-plugin-socket"
+	// ProxyProtocolContainerName is the name of the sidecar terminating the PROXY protocol in the API server pods.
+	ProxyProtocolContainerName = "proxy-protocol"
+	// ProxyProtocolInitContainerName is the name of the init container routing the responses of the API server
+	// and the Konnectivity server back to the PROXY protocol sidecar.
+	ProxyProtocolInitContainerName = "proxy-protocol-init"
+	// ProxyProtocolConfigMapName is the name of the ConfigMap containing the configuration of the PROXY protocol sidecar.
+	ProxyProtocolConfigMapName = "apiserver-proxy-protocol"
 	// NodePortProxyEnvoyDeploymentName is the name of the nodeport-proxy deployment in the user cluster.
 	NodePortProxyEnvoyDeploymentName = "nodeport-proxy-envoy"
 	// NodePortProxyEnvoyContainerName is the name of the envoy container in the nodeport-proxy deployment.
@@ -581,6 +596,9 @@ const (
 	EtcdRestoreS3BucketNameKey    = "BUCKET_NAME"
 	EtcdRestoreS3EndpointKey      = "ENDPOINT"
 	EtcdRestoreDefaultS3SEndpoint = "s3.amazonaws.com"
+	// EtcdRestoreEncryptionKeyKey is the key in the backup download credentials secret
+	// holding the key to decrypt encrypted backups.
+	EtcdRestoreEncryptionKeyKey = "ENCRYPTION_KEY"
 
 	// ApiserverEtcdClientCertificateCertSecretKey apiserver-etcd-client.crt.
 	ApiserverEtcdClientCertificateCertSecretKey = "apiserver-etcd-client.crt"
@@ -1035,6 +1053,7 @@ const (
 	NetworkPolicyApiserverInternalAllow             = "apiserver-internal-allow"
 	NetworkPolicyKyvernoWebhookAllow                = "kyverno-webhook-allow"
 	NetworkPolicyAuthorizationWebhookAllow          = "authorization-webhook-allow"
+	NetworkPolicyProxyProtocolAllow                 = "proxy-protocol-allow"
 )
 
 const (
@@ -1641,6 +1660,14 @@ func GetEtcdRestoreS3Client(ctx context.Context, restore *kubermaticv1.EtcdResto
 		secretData[EtcdRestoreS3BucketNameKey] = destination.BucketName
 		secretData[EtcdRestoreS3EndpointKey] = destination.Endpoint
 
+		if destination.EncryptionKey != nil {
+			encryptionKeySecret := &corev1.Secret{}
+			if err := client.Get(ctx, types.NamespacedName{Namespace: destination.Credentials.Namespace, Name: destination.EncryptionKey.Name}, encryptionKeySecret); err != nil {
+				return nil, "", fmt.Errorf("failed to get encryption key secret %v/%v: %w", destination.Credentials.Namespace, destination.EncryptionKey.Name, err)
+			}
+			secretData[EtcdRestoreEncryptionKeyKey] = string(encryptionKeySecret.Data[destination.EncryptionKey.Key])
+		}
+
 		creator := func(se *corev1.Secret) (*corev1.Secret, error) {
 			if se.Data == nil {
 				se.Data = map[string][]byte{}
@@ -1701,6 +1728,26 @@ func GetEtcdRestoreS3Client(ctx context.Context, restore *kubermaticv1.EtcdResto
 	return s3Client, bucketName, nil
 }
 
+// GetEtcdRestoreEncryptionKey returns the key to decrypt the backup of the given restore, or nil
+// if the backup destination does not use encryption.
+func GetEtcdRestoreEncryptionKey(ctx context.Context, restore *kubermaticv1.EtcdRestore, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) ([]byte, error) {
+	if restore.Spec.BackupDownloadCredentialsSecret == "" {
+		return nil, fmt.Errorf("BackupDownloadCredentialsSecret not set")
+	}
+
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: restore.Spec.BackupDownloadCredentialsSecret}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get BackupDownloadCredentialsSecret credentials secret %v: %w", restore.Spec.BackupDownloadCredentialsSecret, err)
+	}
+
+	key, ok := secret.Data[EtcdRestoreEncryptionKeyKey]
+	if !ok || len(key) == 0 {
+		return nil, nil
+	}
+
+	return key, nil
+}
+
 // GetClusterNodeCIDRMaskSizeIPv4 returns effective mask size used to address the nodes within provided IPv4 Pods CIDR.
 func GetClusterNodeCIDRMaskSizeIPv4(cluster *kubermaticv1.Cluster) int32 {
 	if cluster.Spec.ClusterNetwork.NodeCIDRMaskSizeIPv4 != nil {
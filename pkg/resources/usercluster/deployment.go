@@ -222,6 +222,9 @@ func DeploymentReconciler(data userclusterControllerData) reconciling.NamedDeplo
 
 			if data.Cluster().Spec.UpdateWindow != nil && data.Cluster().Spec.UpdateWindow.Length != "" && data.Cluster().Spec.UpdateWindow.Start != "" {
 				args = append(args, "-update-window-start", data.Cluster().Spec.UpdateWindow.Start, "-update-window-length", data.Cluster().Spec.UpdateWindow.Length)
+			} else if data.Cluster().Spec.MaintenanceWindow != nil {
+				// OS updates are disruptive as well, so they follow the cluster's maintenance window by default
+				args = append(args, "-update-window-start", data.Cluster().Spec.MaintenanceWindow.Start, "-update-window-length", data.Cluster().Spec.MaintenanceWindow.Length)
 			}
 
 			if data.Cluster().Spec.OPAIntegration != nil && data.Cluster().Spec.OPAIntegration.WebhookTimeoutSeconds != nil {
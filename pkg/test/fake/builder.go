@@ -57,6 +57,8 @@ func NewClientBuilder() *ctrlruntimefakeclient.ClientBuilder {
 			&kubermaticv1.Seed{},
 			&kubermaticv1.EtcdBackupConfig{},
 			&kubermaticv1.EtcdRestore{},
+			&kubermaticv1.IPAMPool{},
+			&kubermaticv1.Preset{},
 			&kubermaticv1.Project{},
 			&kubermaticv1.ResourceQuota{},
 			&kubermaticv1.User{},
@@ -20,6 +20,9 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
+
+	semverlib "github.com/Masterminds/semver/v3"
 
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -28,6 +31,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -79,6 +83,166 @@ func ValidateApplicationInstallationSpec(ctx context.Context, client ctrlruntime
 		allErrs = append(allErrs, field.Forbidden(specPath.Child("valuesBlock"), "Only values or valuesBlock can be set, but not both simultaneously"))
 	}
 
+	allErrs = append(allErrs, validateValuesFrom(spec.ValuesFrom, specPath.Child("valuesFrom"))...)
+	allErrs = append(allErrs, validateDependsOn(ai, specPath.Child("dependsOn"))...)
+	allErrs = append(allErrs, validateUpdatePolicy(spec.UpdatePolicy, spec.ApplicationRef.Version, specPath.Child("updatePolicy"))...)
+	allErrs = append(allErrs, validateDriftDetection(spec.DriftDetection, specPath.Child("driftDetection"))...)
+
+	return allErrs
+}
+
+func validateUpdatePolicy(policy *appskubermaticv1.ApplicationUpdatePolicy, version string, f *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+
+	// the installed version is compared against the constraint, so it must be semver as well
+	if _, err := semverlib.NewVersion(version); err != nil {
+		allErrs = append(allErrs, field.Invalid(f, version, fmt.Sprintf("update policy requires spec.applicationRef.version to be a valid semver version: %v", err)))
+	}
+
+	if _, err := semverlib.NewConstraint(policy.VersionConstraint); err != nil {
+		allErrs = append(allErrs, field.Invalid(f.Child("versionConstraint"), policy.VersionConstraint, fmt.Sprintf("must be a valid semver constraint: %v", err)))
+	}
+
+	switch policy.Mode {
+	case "", appskubermaticv1.ApplicationUpdateModeAutomatic, appskubermaticv1.ApplicationUpdateModeManual:
+	default:
+		allErrs = append(allErrs, field.NotSupported(f.Child("mode"), policy.Mode, []appskubermaticv1.ApplicationUpdateMode{appskubermaticv1.ApplicationUpdateModeAutomatic, appskubermaticv1.ApplicationUpdateModeManual}))
+	}
+
+	return allErrs
+}
+
+func validateDriftDetection(driftDetection *appskubermaticv1.DriftDetection, f *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if driftDetection == nil {
+		return allErrs
+	}
+
+	if driftDetection.Interval.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(f.Child("interval"), driftDetection.Interval.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateDependsOn(ai appskubermaticv1.ApplicationInstallation, f *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	self := types.NamespacedName{Namespace: ai.Namespace, Name: ai.Name}
+	seen := sets.New[types.NamespacedName]()
+
+	for i, ref := range ai.Spec.DependsOn {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(f.Index(i).Child("name"), "name of the referenced ApplicationInstallation is required"))
+			continue
+		}
+
+		key := ref.NamespacedName(ai.Namespace)
+		if key == self {
+			allErrs = append(allErrs, field.Forbidden(f.Index(i), "an ApplicationInstallation cannot depend on itself"))
+		}
+		if seen.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(f.Index(i), key.String()))
+		}
+		seen.Insert(key)
+	}
+
+	return allErrs
+}
+
+// ValidateApplicationInstallationDependencies ensures that the dependencies of the ApplicationInstallation do not
+// form a cycle with the ApplicationInstallations existing in the user cluster.
+func ValidateApplicationInstallationDependencies(ctx context.Context, userClient ctrlruntimeclient.Client, ai appskubermaticv1.ApplicationInstallation) field.ErrorList {
+	f := field.NewPath("spec").Child("dependsOn")
+	allErrs := field.ErrorList{}
+
+	if len(ai.Spec.DependsOn) == 0 || !ai.DeletionTimestamp.IsZero() {
+		return allErrs
+	}
+
+	appList := &appskubermaticv1.ApplicationInstallationList{}
+	if err := userClient.List(ctx, appList); err != nil {
+		return append(allErrs, field.InternalError(f, err))
+	}
+
+	graph := map[types.NamespacedName][]types.NamespacedName{}
+	for _, app := range appList.Items {
+		graph[types.NamespacedName{Namespace: app.Namespace, Name: app.Name}] = dependencyKeys(app)
+	}
+	self := types.NamespacedName{Namespace: ai.Namespace, Name: ai.Name}
+	graph[self] = dependencyKeys(ai)
+
+	for i, dependency := range graph[self] {
+		if dependency == self {
+			// already reported by ValidateApplicationInstallationSpec
+			continue
+		}
+		if path := findDependencyPath(graph, dependency, self, sets.New[types.NamespacedName]()); path != nil {
+			cycle := []string{self.String()}
+			for _, key := range path {
+				cycle = append(cycle, key.String())
+			}
+			allErrs = append(allErrs, field.Forbidden(f.Index(i), fmt.Sprintf("dependencies must not form a cycle: %s", strings.Join(cycle, " -> "))))
+		}
+	}
+
+	return allErrs
+}
+
+func dependencyKeys(ai appskubermaticv1.ApplicationInstallation) []types.NamespacedName {
+	keys := make([]types.NamespacedName, 0, len(ai.Spec.DependsOn))
+	for _, ref := range ai.Spec.DependsOn {
+		keys = append(keys, ref.NamespacedName(ai.Namespace))
+	}
+	return keys
+}
+
+// findDependencyPath returns the path of dependencies leading from "from" to "to" (both included), or nil if "to"
+// cannot be reached.
+func findDependencyPath(graph map[types.NamespacedName][]types.NamespacedName, from, to types.NamespacedName, visited sets.Set[types.NamespacedName]) []types.NamespacedName {
+	if from == to {
+		return []types.NamespacedName{to}
+	}
+	if visited.Has(from) {
+		return nil
+	}
+	visited.Insert(from)
+
+	for _, next := range graph[from] {
+		if path := findDependencyPath(graph, next, to, visited); path != nil {
+			return append([]types.NamespacedName{from}, path...)
+		}
+	}
+	return nil
+}
+
+func validateValuesFrom(valuesFrom []appskubermaticv1.ValuesReference, f *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := map[appskubermaticv1.ValuesReference]struct{}{}
+
+	for i, ref := range valuesFrom {
+		switch ref.Kind {
+		case appskubermaticv1.ValuesReferenceKindSecret, appskubermaticv1.ValuesReferenceKindConfigMap:
+		default:
+			allErrs = append(allErrs, field.NotSupported(f.Index(i).Child("kind"), ref.Kind, []appskubermaticv1.ValuesReferenceKind{appskubermaticv1.ValuesReferenceKindSecret, appskubermaticv1.ValuesReferenceKindConfigMap}))
+		}
+
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(f.Index(i).Child("name"), "name of the referenced object is required"))
+		}
+
+		// the same values are referenced twice if only the optional flag differs
+		key := ref
+		key.Key = ref.GetKey()
+		key.Optional = false
+		if _, ok := seen[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Index(i), ref.Name))
+		}
+		seen[key] = struct{}{}
+	}
+
 	return allErrs
 }
 
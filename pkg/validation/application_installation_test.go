@@ -210,6 +210,91 @@ func TestValidateApplicationInstallationSpec(t *testing.T) {
 				}(),
 			}, expectedError: `[]`,
 		},
+		{
+			name: "Create ApplicationInstallation Success - ValuesFrom references a Secret and a ConfigMap with the same name",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.ValuesFrom = []appskubermaticv1.ValuesReference{
+						{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "values"},
+						{Kind: appskubermaticv1.ValuesReferenceKindConfigMap, Name: "values"},
+						{Kind: appskubermaticv1.ValuesReferenceKindConfigMap, Name: "values", Seed: true},
+					}
+					return *spec
+				}(),
+			}, expectedError: `[]`,
+		},
+		{
+			name: "Create ApplicationInstallation Failure - ValuesFrom has invalid and duplicate references",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.ValuesFrom = []appskubermaticv1.ValuesReference{
+						{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "values"},
+						{Kind: appskubermaticv1.ValuesReferenceKindSecret, Name: "values", Key: "values.yaml", Optional: true},
+						{Kind: "Pod", Name: ""},
+					}
+					return *spec
+				}(),
+			}, expectedError: `[spec.valuesFrom[1]: Duplicate value: "values" spec.valuesFrom[2].kind: Unsupported value: "Pod": supported values: "Secret", "ConfigMap" spec.valuesFrom[2].name: Required value: name of the referenced object is required]`,
+		},
+		{
+			name: "Create ApplicationInstallation Failure - DependsOn has self, duplicate and unnamed references",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				ObjectMeta: ai.ObjectMeta,
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.DependsOn = []appskubermaticv1.ApplicationInstallationReference{
+						{Name: "cert-manager"},
+						{Name: defaultAppName},
+						{Name: "cert-manager", Namespace: defaultAppName},
+						{Name: ""},
+					}
+					return *spec
+				}(),
+			}, expectedError: `[spec.dependsOn[1]: Forbidden: an ApplicationInstallation cannot depend on itself spec.dependsOn[2]: Duplicate value: "app/cert-manager" spec.dependsOn[3].name: Required value: name of the referenced ApplicationInstallation is required]`,
+		},
+		{
+			name: "Create ApplicationInstallation Success - UpdatePolicy is valid",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.UpdatePolicy = &appskubermaticv1.ApplicationUpdatePolicy{VersionConstraint: "~1.2", Mode: appskubermaticv1.ApplicationUpdateModeAutomatic}
+					return *spec
+				}(),
+			}, expectedError: "[]",
+		},
+		{
+			name: "Create ApplicationInstallation Failure - UpdatePolicy has invalid constraint and mode",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.UpdatePolicy = &appskubermaticv1.ApplicationUpdatePolicy{VersionConstraint: "latest", Mode: "Always"}
+					return *spec
+				}(),
+			}, expectedError: `[spec.updatePolicy.versionConstraint: Invalid value: "latest": must be a valid semver constraint: improper constraint: latest spec.updatePolicy.mode: Unsupported value: "Always": supported values: "Automatic", "Manual"]`,
+		},
+		{
+			name: "Create ApplicationInstallation Failure - UpdatePolicy with non-semver application version",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.ApplicationRef.Version = "latest"
+					spec.UpdatePolicy = &appskubermaticv1.ApplicationUpdatePolicy{VersionConstraint: "~1.2", Mode: appskubermaticv1.ApplicationUpdateModeAutomatic}
+					return *spec
+				}(),
+			}, expectedError: `[spec.applicationRef.version: Not found: "latest" spec.updatePolicy: Invalid value: "latest": update policy requires spec.applicationRef.version to be a valid semver version: invalid semantic version]`,
+		},
+		{
+			name: "Create ApplicationInstallation Failure - DriftDetection interval is negative",
+			ai: &appskubermaticv1.ApplicationInstallation{
+				Spec: func() appskubermaticv1.ApplicationInstallationSpec {
+					spec := ai.Spec.DeepCopy()
+					spec.DriftDetection = &appskubermaticv1.DriftDetection{Interval: metav1.Duration{Duration: -time.Minute}, SelfHeal: true}
+					return *spec
+				}(),
+			}, expectedError: `[spec.driftDetection.interval: Invalid value: "-1m0s": must not be negative]`,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -225,6 +310,62 @@ func TestValidateApplicationInstallationSpec(t *testing.T) {
 	}
 }
 
+// TestValidateApplicationInstallationDependencies tests the cycle detection for ApplicationInstallation dependencies.
+func TestValidateApplicationInstallationDependencies(t *testing.T) {
+	withDependencies := func(ai *appskubermaticv1.ApplicationInstallation, refs ...appskubermaticv1.ApplicationInstallationReference) *appskubermaticv1.ApplicationInstallation {
+		ai.Spec.DependsOn = refs
+		return ai
+	}
+
+	// "a" depends on "b", which depends on "c" living in another namespace.
+	fakeClient := fake.
+		NewClientBuilder().
+		WithObjects(
+			withDependencies(getApplicationInstallation("a", defaultAppName, defaultAppVersion, nil), appskubermaticv1.ApplicationInstallationReference{Name: "b", Namespace: "b"}),
+			withDependencies(getApplicationInstallation("b", defaultAppName, defaultAppVersion, nil), appskubermaticv1.ApplicationInstallationReference{Name: "c", Namespace: "c"}),
+			getApplicationInstallation("c", defaultAppName, defaultAppVersion, nil),
+		).
+		Build()
+
+	testCases := []struct {
+		name          string
+		ai            *appskubermaticv1.ApplicationInstallation
+		expectedError string
+	}{
+		{
+			name:          "No dependencies",
+			ai:            getApplicationInstallation("d", defaultAppName, defaultAppVersion, nil),
+			expectedError: "[]",
+		},
+		{
+			name:          "Dependency chain without cycle",
+			ai:            withDependencies(getApplicationInstallation("d", defaultAppName, defaultAppVersion, nil), appskubermaticv1.ApplicationInstallationReference{Name: "a", Namespace: "a"}),
+			expectedError: "[]",
+		},
+		{
+			name:          "Dependency on a missing ApplicationInstallation",
+			ai:            withDependencies(getApplicationInstallation("d", defaultAppName, defaultAppVersion, nil), appskubermaticv1.ApplicationInstallationReference{Name: "missing"}),
+			expectedError: "[]",
+		},
+		{
+			name: "Updated dependencies form a cycle",
+			ai: withDependencies(getApplicationInstallation("c", defaultAppName, defaultAppVersion, nil),
+				appskubermaticv1.ApplicationInstallationReference{Name: "d", Namespace: "d"},
+				appskubermaticv1.ApplicationInstallationReference{Name: "a", Namespace: "a"}),
+			expectedError: `[spec.dependsOn[1]: Forbidden: dependencies must not form a cycle: c/c -> a/a -> b/b -> c/c]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateApplicationInstallationDependencies(context.Background(), fakeClient, *testCase.ai)
+			if fmt.Sprint(err) != testCase.expectedError {
+				t.Fatalf("expected error to be %s but got %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
 // TestValidateApplicationInstallationSpec tests the validation for ApplicationInstallation creation.
 func TestValidateApplicationInstallationUpdate(t *testing.T) {
 	ad := getApplicationDefinition(defaultAppName, false, false, nil, nil)
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path"
 	"slices"
 	"strings"
 	"time"
@@ -32,6 +33,7 @@ import (
 	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
 	"k8c.io/kubermatic/sdk/v2/semver"
 	"k8c.io/kubermatic/v2/pkg/cni"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
 	"k8c.io/kubermatic/v2/pkg/features"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
@@ -103,9 +105,15 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 		allErrs = append(allErrs, field.NotSupported(parentFieldPath.Child("exposeStrategy"), spec.ExposeStrategy, kubermaticv1.AllExposeStrategies.Items()))
 	}
 
-	// Validate APIServerAllowedIPRanges for LoadBalancer expose strategy
-	if spec.ExposeStrategy != kubermaticv1.ExposeStrategyLoadBalancer && spec.APIServerAllowedIPRanges != nil && len(spec.APIServerAllowedIPRanges.CIDRBlocks) > 0 {
-		allErrs = append(allErrs, field.Forbidden(parentFieldPath.Child("APIServerAllowedIPRanges"), "Access control for API server is supported only for LoadBalancer expose strategy"))
+	// Validate APIServerProxyProtocol for Tunneling expose strategy
+	if spec.ExposeStrategy != kubermaticv1.ExposeStrategyTunneling && spec.APIServerProxyProtocol {
+		allErrs = append(allErrs, field.Forbidden(parentFieldPath.Child("APIServerProxyProtocol"), "PROXY protocol for API server is supported only for Tunneling expose strategy"))
+	}
+
+	// Validate APIServerAllowedIPRanges for LoadBalancer expose strategy, or Tunneling expose strategy with PROXY protocol
+	ipRangesSupported := spec.ExposeStrategy == kubermaticv1.ExposeStrategyLoadBalancer || (spec.ExposeStrategy == kubermaticv1.ExposeStrategyTunneling && spec.APIServerProxyProtocol)
+	if !ipRangesSupported && spec.APIServerAllowedIPRanges != nil && len(spec.APIServerAllowedIPRanges.CIDRBlocks) > 0 {
+		allErrs = append(allErrs, field.Forbidden(parentFieldPath.Child("APIServerAllowedIPRanges"), "Access control for API server is supported only for LoadBalancer expose strategy, or Tunneling expose strategy with PROXY protocol enabled"))
 	}
 
 	// Validate TunnelingAgentIP for Tunneling Expose strategy
@@ -182,6 +190,12 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 
 	allErrs = append(allErrs, validateAuthenticationConfiguration(spec, parentFieldPath)...)
 
+	if spec.MaintenanceWindow != nil {
+		if _, err := controllerutil.ParseUpdateWindow(spec.MaintenanceWindow); err != nil {
+			allErrs = append(allErrs, field.Invalid(parentFieldPath.Child("maintenanceWindow"), spec.MaintenanceWindow, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
@@ -208,6 +222,8 @@ func ValidateNewClusterSpec(ctx context.Context, spec *kubermaticv1.ClusterSpec,
 		allErrs = append(allErrs, errs...)
 	}
 
+	allErrs = append(allErrs, validateKMSPluginImage(spec, nil, seed, parentFieldPath)...)
+
 	// Note: We had to move this out of "ValidateClusterSpec" since it's something that we only want to check for "newly created" clusters.
 	// KubeLB can only be enabled on the cluster when
 	// a) It's either enforced or enabled at the datacenter level.
@@ -343,6 +359,7 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 	}
 	allErrs = append(allErrs, validateClusterNetworkingConfigUpdateImmutability(&newCluster.Spec.ClusterNetwork, &oldCluster.Spec.ClusterNetwork, newCluster.Labels, specPath.Child("clusterNetwork"))...)
 	allErrs = append(allErrs, validateKubeLBUpdate(oldCluster, newCluster, dc, seed, specPath)...)
+	allErrs = append(allErrs, validateKMSPluginImage(&newCluster.Spec, &oldCluster.Spec, seed, specPath)...)
 
 	// even though ErrorList later in ToAggregate() will filter out nil errors, it does so by
 	// stringifying them. A field.Error that is nil will panic when doing so, so one cannot simply
@@ -531,13 +548,20 @@ func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *
 				fmt.Sprintf("cannot enable encryption configuration if feature gate '%s' is not set", kubermaticv1.ClusterFeatureEncryptionAtRest)))
 		}
 
-		// TODO: Update with implementations of other encryption providers (KMS)
+		secretbox := spec.EncryptionConfiguration.Secretbox
+		kms := spec.EncryptionConfiguration.KMS
 
-		if spec.EncryptionConfiguration.Secretbox == nil {
+		switch {
+		case secretbox == nil && kms == nil:
 			allErrs = append(allErrs, field.Required(fieldPath.Child("secretbox"),
 				"exactly one encryption provider (secretbox, kms) needs to be configured"))
-		} else {
-			for i, key := range spec.EncryptionConfiguration.Secretbox.Keys {
+
+		case secretbox != nil && kms != nil:
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("kms"),
+				"exactly one encryption provider (secretbox, kms) needs to be configured"))
+
+		case secretbox != nil:
+			for i, key := range secretbox.Keys {
 				childPath := fieldPath.Child("secretbox", "keys").Index(i)
 				if key.Name == "" {
 					allErrs = append(allErrs, field.Required(childPath.Child("name"),
@@ -560,14 +584,74 @@ func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *
 					}
 				}
 			}
+
+		case kms != nil:
+			allErrs = append(allErrs, validateKMSConfiguration(kms, fieldPath.Child("kms"))...)
 		}
+	}
 
-		// END TODO
+	return allErrs
+}
+
+func validateKMSConfiguration(kms *kubermaticv1.KMSEncryptionConfiguration, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kms.Name == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("name"), "KMS provider name is required"))
+	}
+
+	if kms.Endpoint != "" {
+		socket, found := strings.CutPrefix(kms.Endpoint, "unix://")
+		if !found || path.Dir(path.Clean(socket)) != kubermaticv1.KMSPluginSocketDirectory {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("endpoint"), kms.Endpoint,
+				fmt.Sprintf("endpoint must be a unix socket in %s", kubermaticv1.KMSPluginSocketDirectory)))
+		}
+	}
+
+	if kms.Timeout != nil && kms.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("timeout"), kms.Timeout.Duration.String(), "timeout must be positive"))
+	}
+
+	if kms.Plugin.Image == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("plugin", "image"), "KMS plugin image is required"))
+	}
+
+	// the plugin runs in the seed and must not be able to read any of its Secrets
+	for i, env := range kms.Plugin.Env {
+		if env.ValueFrom != nil {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("plugin", "env").Index(i).Child("valueFrom"), "KMS plugin environment variables must have plain values"))
+		}
 	}
 
 	return allErrs
 }
 
+// validateKMSPluginImage ensures that the Seed allows the image of the KMS plugin, which runs next to the
+// kube-apiserver in the seed. Only a changed image is validated, so that removing an image from the Seed
+// does not block updates of clusters already using it.
+func validateKMSPluginImage(spec, oldSpec *kubermaticv1.ClusterSpec, seed *kubermaticv1.Seed, specPath *field.Path) field.ErrorList {
+	image := kmsPluginImage(spec)
+	if image == "" || (oldSpec != nil && kmsPluginImage(oldSpec) == image) {
+		return nil
+	}
+
+	if seed == nil || !slices.Contains(seed.Spec.KMSPluginImages, image) {
+		return field.ErrorList{
+			field.Forbidden(specPath.Child("encryptionConfiguration", "kms", "plugin", "image"), fmt.Sprintf("KMS plugin image %q is not allowed by the seed", image)),
+		}
+	}
+
+	return nil
+}
+
+func kmsPluginImage(spec *kubermaticv1.ClusterSpec) string {
+	if spec.EncryptionConfiguration == nil || spec.EncryptionConfiguration.KMS == nil {
+		return ""
+	}
+
+	return spec.EncryptionConfiguration.KMS.Plugin.Image
+}
+
 // validateKeyLength base64 decodes key and checks length.
 func validateKeyLength(key string) error {
 	data, err := base64.StdEncoding.DecodeString(key)
@@ -604,6 +688,29 @@ func validateEncryptionUpdate(oldCluster *kubermaticv1.Cluster, newCluster *kube
 					oldCluster.Spec.EncryptionConfiguration.Enabled &&
 						newCluster.Spec.EncryptionConfiguration.Enabled
 
+				oldKMS := oldCluster.Spec.EncryptionConfiguration.KMS
+				newKMS := newCluster.Spec.EncryptionConfiguration.KMS
+
+				// the KMS provider name is part of all encrypted data, so it cannot be changed, and switching
+				// between providers would leave the data encrypted by the old provider unreadable
+				if encryptionConfigEnabled && (oldKMS == nil) != (newKMS == nil) {
+					allErrs = append(
+						allErrs,
+						field.Forbidden(
+							field.NewPath("spec", "encryptionConfiguration"),
+							"encryption provider cannot be changed. Please disable encryption and re-configure",
+						),
+					)
+				} else if encryptionConfigEnabled && oldKMS != nil && oldKMS.Name != newKMS.Name {
+					allErrs = append(
+						allErrs,
+						field.Forbidden(
+							field.NewPath("spec", "encryptionConfiguration", "kms", "name"),
+							"KMS provider name cannot be changed. Please disable encryption and re-configure",
+						),
+					)
+				}
+
 				if encryptionConfigEnabled && !equality.Semantic.DeepEqual(oldCluster.Spec.EncryptionConfiguration.Resources, newCluster.Spec.EncryptionConfiguration.Resources) {
 					allErrs = append(
 						allErrs,
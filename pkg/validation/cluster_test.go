@@ -33,6 +33,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/version"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
@@ -1166,6 +1167,182 @@ func TestValidateEncryptionConfiguration(t *testing.T) {
 			},
 			expectErr: field.ErrorList{},
 		},
+		{
+			name: "good KMS provider",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+					KMS: &kubermaticv1.KMSEncryptionConfiguration{
+						Name:     "vault",
+						Endpoint: "unix:///var/run/kmsplugin/vault.sock",
+						Plugin: kubermaticv1.KMSPluginConfiguration{
+							Image: "example.com/vault-kms-plugin:v1.0.0",
+						},
+					},
+				},
+			},
+			expectErr: field.ErrorList{},
+		},
+		{
+			name: "KMS endpoint outside of the shared directory",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+					KMS: &kubermaticv1.KMSEncryptionConfiguration{
+						Name:     "vault",
+						Endpoint: "unix:///var/run/kmsplugin/../vault.sock",
+						Plugin: kubermaticv1.KMSPluginConfiguration{
+							Image: "example.com/vault-kms-plugin:v1.0.0",
+						},
+					},
+				},
+			},
+			expectErr: field.ErrorList{
+				&field.Error{
+					Type:     "FieldValueInvalid",
+					Field:    "spec.encryptionConfiguration.kms.endpoint",
+					BadValue: "unix:///var/run/kmsplugin/../vault.sock",
+					Detail:   "endpoint must be a unix socket in /var/run/kmsplugin",
+				},
+			},
+		},
+		{
+			name: "KMS provider without plugin image",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+					KMS: &kubermaticv1.KMSEncryptionConfiguration{
+						Name: "vault",
+					},
+				},
+			},
+			expectErr: field.ErrorList{
+				&field.Error{
+					Type:     "FieldValueRequired",
+					Field:    "spec.encryptionConfiguration.kms.plugin.image",
+					BadValue: "",
+					Detail:   "KMS plugin image is required",
+				},
+			},
+		},
+		{
+			name: "KMS plugin environment variable referencing a Secret",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+					KMS: &kubermaticv1.KMSEncryptionConfiguration{
+						Name: "vault",
+						Plugin: kubermaticv1.KMSPluginConfiguration{
+							Image: "example.com/vault-kms-plugin:v1.0.0",
+							Env: []corev1.EnvVar{
+								{
+									Name:  "VAULT_ADDR",
+									Value: "https://vault.example.com",
+								},
+								{
+									Name: "VAULT_TOKEN",
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "admin-kubeconfig"},
+											Key:                  "kubeconfig",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: field.ErrorList{
+				&field.Error{
+					Type:     "FieldValueForbidden",
+					Field:    "spec.encryptionConfiguration.kms.plugin.env[1].valueFrom",
+					BadValue: "",
+					Detail:   "KMS plugin environment variables must have plain values",
+				},
+			},
+		},
+		{
+			name: "KMS plugin environment variable referencing a ConfigMap",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+					KMS: &kubermaticv1.KMSEncryptionConfiguration{
+						Name: "vault",
+						Plugin: kubermaticv1.KMSPluginConfiguration{
+							Image: "example.com/vault-kms-plugin:v1.0.0",
+							Env: []corev1.EnvVar{
+								{
+									Name: "VAULT_ADDR",
+									ValueFrom: &corev1.EnvVarSource{
+										ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "vault"},
+											Key:                  "address",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: field.ErrorList{
+				&field.Error{
+					Type:     "FieldValueForbidden",
+					Field:    "spec.encryptionConfiguration.kms.plugin.env[0].valueFrom",
+					BadValue: "",
+					Detail:   "KMS plugin environment variables must have plain values",
+				},
+			},
+		},
+		{
+			name: "secretbox and KMS provider at the same time",
+			clusterSpec: &kubermaticv1.ClusterSpec{
+				Features: map[string]bool{
+					kubermaticv1.ClusterFeatureEncryptionAtRest: true,
+				},
+				EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+					Enabled: true,
+					Secretbox: &kubermaticv1.SecretboxEncryptionConfiguration{
+						Keys: []kubermaticv1.SecretboxKey{
+							{
+								Name:  "good-key",
+								Value: "RGolflgAc+eBbm1lys87pTNQZVf0i67rlpPZGtTkVjQ=",
+							},
+						},
+					},
+					KMS: &kubermaticv1.KMSEncryptionConfiguration{
+						Name: "vault",
+						Plugin: kubermaticv1.KMSPluginConfiguration{
+							Image: "example.com/vault-kms-plugin:v1.0.0",
+						},
+					},
+				},
+			},
+			expectErr: field.ErrorList{
+				&field.Error{
+					Type:     "FieldValueForbidden",
+					Field:    "spec.encryptionConfiguration.kms",
+					BadValue: "",
+					Detail:   "exactly one encryption provider (secretbox, kms) needs to be configured",
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -1176,6 +1353,94 @@ func TestValidateEncryptionConfiguration(t *testing.T) {
 	}
 }
 
+func TestValidateKMSPluginImage(t *testing.T) {
+	const (
+		allowedImage = "example.com/vault-kms-plugin:v1.0.0"
+		removedImage = "example.com/vault-kms-plugin:v0.9.0"
+	)
+
+	kmsSpec := func(image string) *kubermaticv1.ClusterSpec {
+		return &kubermaticv1.ClusterSpec{
+			EncryptionConfiguration: &kubermaticv1.EncryptionConfiguration{
+				Enabled: true,
+				KMS: &kubermaticv1.KMSEncryptionConfiguration{
+					Name: "vault",
+					Plugin: kubermaticv1.KMSPluginConfiguration{
+						Image: image,
+					},
+				},
+			},
+		}
+	}
+
+	seed := &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			KMSPluginImages: []string{allowedImage},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		spec    *kubermaticv1.ClusterSpec
+		oldSpec *kubermaticv1.ClusterSpec
+		seed    *kubermaticv1.Seed
+		valid   bool
+	}{
+		{
+			name:  "no KMS provider",
+			spec:  &kubermaticv1.ClusterSpec{},
+			seed:  &kubermaticv1.Seed{},
+			valid: true,
+		},
+		{
+			name:  "allowed image",
+			spec:  kmsSpec(allowedImage),
+			seed:  seed,
+			valid: true,
+		},
+		{
+			name:  "image not allowed by the seed",
+			spec:  kmsSpec("example.com/malicious:latest"),
+			seed:  seed,
+			valid: false,
+		},
+		{
+			name:  "no seed",
+			spec:  kmsSpec(allowedImage),
+			valid: false,
+		},
+		{
+			name:  "seed without allowed images",
+			spec:  kmsSpec(allowedImage),
+			seed:  &kubermaticv1.Seed{},
+			valid: false,
+		},
+		{
+			name:    "unchanged image that is no longer allowed",
+			spec:    kmsSpec(removedImage),
+			oldSpec: kmsSpec(removedImage),
+			seed:    seed,
+			valid:   true,
+		},
+		{
+			name:    "changed image that is not allowed",
+			spec:    kmsSpec(removedImage),
+			oldSpec: kmsSpec(allowedImage),
+			seed:    seed,
+			valid:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateKMSPluginImage(test.spec, test.oldSpec, test.seed, field.NewPath("spec"))
+			if test.valid != (len(errs) == 0) {
+				t.Errorf("Expected valid=%v, got errors: %v", test.valid, errs)
+			}
+		})
+	}
+}
+
 func TestValidateVersion(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ValidatePresetUsage ensures that a new cluster can be created from the preset it was
+// created from, i.e. that the preset has not expired and that its cluster limit has not
+// been reached yet.
+func ValidatePresetUsage(ctx context.Context, client ctrlruntimeclient.Reader, cluster *kubermaticv1.Cluster, now time.Time) *field.Error {
+	presetName := cluster.Annotations[kubermaticv1.PresetNameAnnotation]
+	if presetName == "" {
+		return nil
+	}
+
+	fieldPath := field.NewPath("metadata", "annotations").Key(kubermaticv1.PresetNameAnnotation)
+
+	preset := &kubermaticv1.Preset{}
+	if err := client.Get(ctx, types.NamespacedName{Name: presetName}, preset); err != nil {
+		// presets are only referenced for informational purposes, so a missing
+		// preset must not block the cluster creation
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return field.InternalError(fieldPath, fmt.Errorf("failed to get preset: %w", err))
+	}
+
+	if preset.Spec.IsExpired(now) {
+		return field.Forbidden(fieldPath, fmt.Sprintf("preset %s expired at %s", presetName, preset.Spec.ValidUntil.UTC().Format(time.RFC3339)))
+	}
+
+	if preset.Spec.MaxClusters == nil {
+		return nil
+	}
+
+	clusters := &kubermaticv1.ClusterList{}
+	if err := client.List(ctx, clusters, ctrlruntimeclient.MatchingLabels{kubermaticv1.IsCredentialPresetLabelKey: "true"}); err != nil {
+		return field.InternalError(fieldPath, fmt.Errorf("failed to list clusters: %w", err))
+	}
+
+	// clusters on other seeds are counted by the master
+	used := preset.Status.ClustersOnOtherSeeds
+	for _, existing := range clusters.Items {
+		if existing.Name != cluster.Name && existing.DeletionTimestamp == nil && existing.Annotations[kubermaticv1.PresetNameAnnotation] == presetName {
+			used++
+		}
+	}
+
+	if used >= *preset.Spec.MaxClusters {
+		return field.Forbidden(fieldPath, fmt.Sprintf("preset %s is limited to %d clusters", presetName, *preset.Spec.MaxClusters))
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func presetCluster(name, preset string) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				kubermaticv1.IsCredentialPresetLabelKey: "true",
+			},
+			Annotations: map[string]string{
+				kubermaticv1.PresetNameAnnotation: preset,
+			},
+		},
+	}
+}
+
+func TestValidatePresetUsage(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	deletingCluster := presetCluster("deleting", "limited")
+	deletingCluster.DeletionTimestamp = &metav1.Time{Time: now}
+	deletingCluster.Finalizers = []string{"test"}
+
+	testCases := []struct {
+		name          string
+		cluster       *kubermaticv1.Cluster
+		objects       []ctrlruntimeclient.Object
+		expectedError field.ErrorType
+	}{
+		{
+			name:    "cluster without preset",
+			cluster: &kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "new"}},
+		},
+		{
+			name:    "unknown preset",
+			cluster: presetCluster("new", "unknown"),
+		},
+		{
+			name:    "valid preset",
+			cluster: presetCluster("new", "valid"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.Preset{
+					ObjectMeta: metav1.ObjectMeta{Name: "valid"},
+					Spec: kubermaticv1.PresetSpec{
+						ValidUntil: &metav1.Time{Time: now.Add(time.Hour)},
+					},
+				},
+			},
+		},
+		{
+			name:    "expired preset",
+			cluster: presetCluster("new", "expired"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.Preset{
+					ObjectMeta: metav1.ObjectMeta{Name: "expired"},
+					Spec: kubermaticv1.PresetSpec{
+						ValidUntil: &metav1.Time{Time: now.Add(-time.Hour)},
+					},
+				},
+			},
+			expectedError: field.ErrorTypeForbidden,
+		},
+		{
+			name:    "cluster limit not reached",
+			cluster: presetCluster("new", "limited"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.Preset{
+					ObjectMeta: metav1.ObjectMeta{Name: "limited"},
+					Spec: kubermaticv1.PresetSpec{
+						MaxClusters: ptr.To[int32](2),
+					},
+				},
+				presetCluster("existing", "limited"),
+				presetCluster("other", "other"),
+				deletingCluster,
+			},
+		},
+		{
+			name:    "cluster limit reached",
+			cluster: presetCluster("new", "limited"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.Preset{
+					ObjectMeta: metav1.ObjectMeta{Name: "limited"},
+					Spec: kubermaticv1.PresetSpec{
+						MaxClusters: ptr.To[int32](2),
+					},
+				},
+				presetCluster("existing", "limited"),
+				presetCluster("existing-2", "limited"),
+			},
+			expectedError: field.ErrorTypeForbidden,
+		},
+		{
+			name:    "cluster limit reached by clusters on other seeds",
+			cluster: presetCluster("new", "limited"),
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.Preset{
+					ObjectMeta: metav1.ObjectMeta{Name: "limited"},
+					Spec: kubermaticv1.PresetSpec{
+						MaxClusters: ptr.To[int32](2),
+					},
+					Status: kubermaticv1.PresetStatus{
+						ClustersOnOtherSeeds: 1,
+					},
+				},
+				presetCluster("existing", "limited"),
+			},
+			expectedError: field.ErrorTypeForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithObjects(tc.objects...).Build()
+
+			err := ValidatePresetUsage(context.Background(), client, tc.cluster, now)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, but got: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Expected %s error, but got none", tc.expectedError)
+			}
+
+			if err.Type != tc.expectedError {
+				t.Fatalf("Expected %s error, but got: %v", tc.expectedError, err)
+			}
+		})
+	}
+}
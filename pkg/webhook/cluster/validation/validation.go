@@ -21,22 +21,29 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/cloud"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/util/kyverno"
 	"k8c.io/kubermatic/v2/pkg/validation"
 	"k8c.io/kubermatic/v2/pkg/version"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -48,6 +55,8 @@ type validator struct {
 	seedGetter   provider.SeedGetter
 	configGetter provider.KubermaticConfigurationGetter
 	caBundle     *x509.CertPool
+	// namespace is the namespace KKP runs in, used to identify the KKP controllers.
+	namespace string
 
 	// disableProviderValidation is only for unit tests, to ensure no
 	// provider would phone home to validate dummy test credentials
@@ -55,16 +64,25 @@ type validator struct {
 }
 
 // NewValidator returns a new cluster validator.
-func NewValidator(client ctrlruntimeclient.Client, seedGetter provider.SeedGetter, configGetter provider.KubermaticConfigurationGetter, features features.FeatureGate, caBundle *x509.CertPool) *validator {
+func NewValidator(client ctrlruntimeclient.Client, seedGetter provider.SeedGetter, configGetter provider.KubermaticConfigurationGetter, features features.FeatureGate, caBundle *x509.CertPool, namespace string) *validator {
 	return &validator{
 		client:       client,
 		features:     features,
 		seedGetter:   seedGetter,
 		configGetter: configGetter,
 		caBundle:     caBundle,
+		namespace:    namespace,
 	}
 }
 
+// skipDeletionStepsServiceAccounts are the service accounts of the KKP controllers that are allowed to set the
+// SkipDeletionStepsAnnotation. The KKP API is deliberately not included, as it writes Clusters on behalf of users.
+var skipDeletionStepsServiceAccounts = []string{
+	"kubermatic-operator",
+	"kubermatic-master",
+	"kubermatic-seed",
+}
+
 var _ admission.Validator[*kubermaticv1.Cluster] = &validator{}
 
 func (v *validator) ValidateCreate(ctx context.Context, cluster *kubermaticv1.Cluster) (admission.Warnings, error) {
@@ -82,6 +100,17 @@ func (v *validator) ValidateCreate(ctx context.Context, cluster *kubermaticv1.Cl
 		return nil, err
 	}
 
+	cluster, pendingCredentials, presetErr := v.withPendingPresetCredentials(ctx, cluster)
+	if presetErr != nil {
+		return nil, presetErr
+	}
+
+	// the credentials of secret store presets are only resolved by the
+	// seed-controller-manager, so the provider cannot validate the spec yet
+	if pendingCredentials {
+		cloudProvider = nil
+	}
+
 	config, configErr := v.configGetter(ctx)
 	if configErr != nil {
 		return nil, configErr
@@ -95,6 +124,15 @@ func (v *validator) ValidateCreate(ctx context.Context, cluster *kubermaticv1.Cl
 		errs = append(errs, err)
 	}
 
+	if err := validation.ValidatePresetUsage(ctx, v.client, cluster, time.Now()); err != nil {
+		errs = append(errs, err)
+	}
+
+	warnings, quotaErr := validateClusterQuota(ctx, v.client, cluster)
+	if quotaErr != nil {
+		errs = append(errs, field.Forbidden(field.NewPath("metadata", "labels").Key(kubermaticv1.ProjectIDLabelKey), quotaErr.Error()))
+	}
+
 	if err := v.validateKyvernoEnforcement(cluster, nil, datacenter, seed, config); err != nil {
 		errs = append(errs, err)
 	}
@@ -103,7 +141,13 @@ func (v *validator) ValidateCreate(ctx context.Context, cluster *kubermaticv1.Cl
 		errs = append(errs, err)
 	}
 
-	return nil, errs.ToAggregate()
+	if err := v.validateSkipDeletionSteps(ctx, cluster, nil); err != nil {
+		errs = append(errs, err)
+	}
+
+	warnings = append(warnings, proxyProtocolWarnings(cluster, nil, seed)...)
+
+	return warnings, errs.ToAggregate()
 }
 
 func (v *validator) ValidateUpdate(ctx context.Context, oldCluster, newCluster *kubermaticv1.Cluster) (admission.Warnings, error) {
@@ -112,6 +156,11 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldCluster, newCluster *
 		return nil, err
 	}
 
+	newCluster, _, presetErr := v.withPendingPresetCredentials(ctx, newCluster)
+	if presetErr != nil {
+		return nil, presetErr
+	}
+
 	config, configErr := v.configGetter(ctx)
 	if configErr != nil {
 		return nil, configErr
@@ -133,7 +182,11 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldCluster, newCluster *
 		errs = append(errs, err)
 	}
 
-	return nil, errs.ToAggregate()
+	if err := v.validateSkipDeletionSteps(ctx, newCluster, oldCluster); err != nil {
+		errs = append(errs, err)
+	}
+
+	return proxyProtocolWarnings(newCluster, oldCluster, seed), errs.ToAggregate()
 }
 
 func (v *validator) ValidateDelete(ctx context.Context, obj *kubermaticv1.Cluster) (admission.Warnings, error) {
@@ -167,6 +220,95 @@ func (v *validator) buildValidationDependencies(ctx context.Context, c *kubermat
 	return datacenter, seed, cloudProvider, nil
 }
 
+// withPendingPresetCredentials returns the cluster as it will look like once the cluster-credentials-controller
+// has resolved the credentials of the secret store Preset the cluster was created from. Such clusters do not
+// contain any credentials until then and would otherwise always fail validation. The returned bool is true if
+// the credentials are still pending.
+func (v *validator) withPendingPresetCredentials(ctx context.Context, cluster *kubermaticv1.Cluster) (*kubermaticv1.Cluster, bool, error) {
+	presetName := cluster.Annotations[kubermaticv1.PresetNameAnnotation]
+	if presetName == "" {
+		return cluster, false, nil
+	}
+
+	if ref, err := resources.GetCredentialsReference(cluster); err != nil || ref != nil {
+		return cluster, false, nil
+	}
+
+	preset := &kubermaticv1.Preset{}
+	if err := v.client.Get(ctx, types.NamespacedName{Name: presetName}, preset); err != nil {
+		if apierrors.IsNotFound(err) {
+			return cluster, false, nil
+		}
+
+		return nil, false, fmt.Errorf("failed to get preset %s: %w", presetName, err)
+	}
+
+	if preset.Spec.SecretStore == nil {
+		return cluster, false, nil
+	}
+
+	pending := cluster.DeepCopy()
+	if err := kubernetesprovider.SetCredentialsReference(pending, kubernetesprovider.CredentialSecretReferenceForCluster(pending)); err != nil {
+		// providers without credential Secrets are validated as-is
+		return cluster, false, nil
+	}
+
+	return pending, true, nil
+}
+
+// validateSkipDeletionSteps ensures that only cluster administrators (system:masters)
+// and the KKP controllers can set or change the SkipDeletionStepsAnnotation, as
+// skipping deletion steps can leave orphaned resources behind. KKP administrators
+// using the dashboard are covered by the annotation being protected in the
+// AdminSettings.
+func (v *validator) validateSkipDeletionSteps(ctx context.Context, cluster *kubermaticv1.Cluster, oldCluster *kubermaticv1.Cluster) *field.Error {
+	value, ok := cluster.Annotations[kubermaticv1.SkipDeletionStepsAnnotation]
+	if !ok || value == "" {
+		return nil
+	}
+
+	if oldCluster != nil && oldCluster.Annotations[kubermaticv1.SkipDeletionStepsAnnotation] == value {
+		return nil
+	}
+
+	fieldPath := field.NewPath("metadata", "annotations").Key(kubermaticv1.SkipDeletionStepsAnnotation)
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return field.Forbidden(fieldPath, "cannot determine the user setting this annotation")
+	}
+
+	userInfo := req.UserInfo
+	if slices.Contains(userInfo.Groups, user.SystemPrivilegedGroup) {
+		return nil
+	}
+
+	for _, name := range skipDeletionStepsServiceAccounts {
+		if userInfo.Username == serviceaccount.MakeUsername(v.namespace, name) {
+			return nil
+		}
+	}
+
+	return field.Forbidden(fieldPath, "only administrators can skip cluster deletion steps")
+}
+
+// proxyProtocolWarnings warns when the PROXY protocol is enabled for the API server, but the
+// nodeport-proxy of the Seed does not preserve the client addresses, in which case the node
+// addresses are passed on and checked against the APIServerAllowedIPRanges instead.
+func proxyProtocolWarnings(cluster *kubermaticv1.Cluster, oldCluster *kubermaticv1.Cluster, seed *kubermaticv1.Seed) admission.Warnings {
+	if !cluster.IsAPIServerProxyProtocolEnabled() || (oldCluster != nil && oldCluster.IsAPIServerProxyProtocolEnabled()) {
+		return nil
+	}
+
+	if seed.Spec.NodeportProxy.Envoy.LoadBalancerService.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyLocal {
+		return nil
+	}
+
+	return admission.Warnings{
+		fmt.Sprintf("the nodeport-proxy of Seed %q does not use the Local external traffic policy, so the PROXY protocol passes node addresses instead of client addresses to the API server", seed.Name),
+	}
+}
+
 func (v *validator) validateProjectRelation(ctx context.Context, cluster *kubermaticv1.Cluster, oldCluster *kubermaticv1.Cluster) *field.Error {
 	label := kubermaticv1.ProjectIDLabelKey
 	fieldPath := field.NewPath("metadata", "labels")
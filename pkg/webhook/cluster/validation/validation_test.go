@@ -31,9 +31,12 @@ import (
 	"k8c.io/kubermatic/v2/pkg/validation"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 var (
@@ -2266,3 +2269,348 @@ func TestValidateEventRateLimitEnforcement(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSecretStorePresetCluster(t *testing.T) {
+	seed := kubermaticv1.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: "kubermatic",
+		},
+		Spec: kubermaticv1.SeedSpec{
+			Datacenters: map[string]kubermaticv1.Datacenter{
+				datacenterName: {
+					Spec: kubermaticv1.DatacenterSpec{
+						Hetzner: &kubermaticv1.DatacenterSpecHetzner{},
+					},
+				},
+			},
+		},
+	}
+
+	config := kubermaticv1.KubermaticConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubermatic",
+			Namespace: "kubermatic",
+		},
+	}
+
+	project := kubermaticv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "abcd1234",
+		},
+		Spec: kubermaticv1.ProjectSpec{
+			Name: "my project",
+		},
+		Status: kubermaticv1.ProjectStatus{
+			Phase: kubermaticv1.ProjectActive,
+		},
+	}
+
+	secretStorePreset := kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "secret-store",
+		},
+		Spec: kubermaticv1.PresetSpec{
+			SecretStore: &kubermaticv1.PresetSecretStore{
+				Kubernetes: &kubermaticv1.KubernetesSecretStore{
+					SecretReference: corev1.SecretReference{
+						Name:      "hetzner-credentials",
+						Namespace: "kubermatic",
+					},
+				},
+			},
+		},
+	}
+
+	inlinePreset := kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "inline",
+		},
+		Spec: kubermaticv1.PresetSpec{
+			Hetzner: &kubermaticv1.Hetzner{
+				Token: "thisis.reallyreallyfake",
+			},
+		},
+	}
+
+	// clusters created from a preset do not contain any credentials
+	genCluster := func(presetName string) *kubermaticv1.Cluster {
+		cluster := rawClusterGen{
+			Name:      "foo",
+			Namespace: "kubermatic",
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: project.Name,
+			},
+			ExposeStrategy:        "NodePort",
+			ExternalCloudProvider: true,
+			NetworkConfig: kubermaticv1.ClusterNetworkingConfig{
+				Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+				Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+				DNSDomain:                "cluster.local",
+				ProxyMode:                resources.IPVSProxyMode,
+				NodeLocalDNSCacheEnabled: ptr.To(true),
+			},
+			ComponentSettings: kubermaticv1.ComponentSettings{
+				Apiserver: kubermaticv1.APIServerSettings{
+					NodePortRange: "30000-32768",
+				},
+			},
+		}.BuildPtr()
+
+		cluster.Annotations = map[string]string{
+			kubermaticv1.PresetNameAnnotation: presetName,
+		}
+		cluster.Spec.Cloud.Hetzner.Token = ""
+
+		return cluster
+	}
+
+	tests := []struct {
+		name        string
+		op          admissionv1.Operation
+		presetName  string
+		wantAllowed bool
+	}{
+		{
+			name:        "Create cluster from secret store preset before its credentials are resolved",
+			op:          admissionv1.Create,
+			presetName:  secretStorePreset.Name,
+			wantAllowed: true,
+		},
+		{
+			name:        "Update cluster from secret store preset before its credentials are resolved",
+			op:          admissionv1.Update,
+			presetName:  secretStorePreset.Name,
+			wantAllowed: true,
+		},
+		{
+			name:        "Create cluster from inline preset without credentials",
+			op:          admissionv1.Create,
+			presetName:  inlinePreset.Name,
+			wantAllowed: false,
+		},
+		{
+			name:        "Create cluster from unknown preset without credentials",
+			op:          admissionv1.Create,
+			presetName:  "unknown",
+			wantAllowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seedClient := fake.
+				NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(&seed, &project, &secretStorePreset, &inlinePreset).
+				Build()
+
+			clusterValidator := validator{
+				client:                    seedClient,
+				seedGetter:                test.NewSeedGetter(&seed),
+				configGetter:              test.NewConfigGetter(&config),
+				disableProviderValidation: true,
+			}
+
+			ctx := context.Background()
+			cluster := genCluster(tt.presetName)
+
+			var err error
+
+			switch tt.op {
+			case admissionv1.Create:
+				_, err = clusterValidator.ValidateCreate(ctx, cluster)
+			case admissionv1.Update:
+				_, err = clusterValidator.ValidateUpdate(ctx, cluster.DeepCopy(), cluster)
+			}
+
+			allowed := err == nil
+
+			if allowed != tt.wantAllowed {
+				t.Errorf("Allowed %t, but wanted %t: %v", allowed, tt.wantAllowed, err)
+			}
+
+			if cluster.Spec.Cloud.Hetzner.CredentialsReference != nil {
+				t.Error("Validation must not modify the cluster")
+			}
+		})
+	}
+}
+
+func TestValidateSkipDeletionSteps(t *testing.T) {
+	genCluster := func(skipSteps string) *kubermaticv1.Cluster {
+		cluster := &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+			},
+		}
+		if skipSteps != "" {
+			cluster.Annotations = map[string]string{
+				kubermaticv1.SkipDeletionStepsAnnotation: skipSteps,
+			}
+		}
+		return cluster
+	}
+
+	tests := []struct {
+		name        string
+		userInfo    authenticationv1.UserInfo
+		oldCluster  *kubermaticv1.Cluster
+		cluster     *kubermaticv1.Cluster
+		wantAllowed bool
+	}{
+		{
+			name:        "Regular user can create cluster without annotation",
+			userInfo:    authenticationv1.UserInfo{Username: "owner@example.com"},
+			cluster:     genCluster(""),
+			wantAllowed: true,
+		},
+		{
+			name:        "Regular user cannot add annotation",
+			userInfo:    authenticationv1.UserInfo{Username: "owner@example.com"},
+			oldCluster:  genCluster(""),
+			cluster:     genCluster("Volumes"),
+			wantAllowed: false,
+		},
+		{
+			name:        "Regular user cannot change annotation",
+			userInfo:    authenticationv1.UserInfo{Username: "owner@example.com"},
+			oldCluster:  genCluster("Volumes"),
+			cluster:     genCluster("Volumes,LoadBalancers"),
+			wantAllowed: false,
+		},
+		{
+			name:        "Regular user can update cluster with unchanged annotation",
+			userInfo:    authenticationv1.UserInfo{Username: "owner@example.com"},
+			oldCluster:  genCluster("Volumes"),
+			cluster:     genCluster("Volumes"),
+			wantAllowed: true,
+		},
+		{
+			name:        "Regular user can remove annotation",
+			userInfo:    authenticationv1.UserInfo{Username: "owner@example.com"},
+			oldCluster:  genCluster("Volumes"),
+			cluster:     genCluster(""),
+			wantAllowed: true,
+		},
+		{
+			name:        "KKP user marked as admin cannot add annotation directly",
+			userInfo:    authenticationv1.UserInfo{Username: "admin@example.com"},
+			oldCluster:  genCluster(""),
+			cluster:     genCluster("Volumes"),
+			wantAllowed: false,
+		},
+		{
+			name:        "KKP API cannot add annotation on behalf of users",
+			userInfo:    authenticationv1.UserInfo{Username: "system:serviceaccount:kubermatic:kubermatic-api"},
+			oldCluster:  genCluster(""),
+			cluster:     genCluster("Volumes"),
+			wantAllowed: false,
+		},
+		{
+			name:        "Other service account cannot add annotation",
+			userInfo:    authenticationv1.UserInfo{Username: "system:serviceaccount:cluster-xyz:kubermatic-seed"},
+			oldCluster:  genCluster(""),
+			cluster:     genCluster("Volumes"),
+			wantAllowed: false,
+		},
+		{
+			name:        "KKP seed controller can add annotation",
+			userInfo:    authenticationv1.UserInfo{Username: "system:serviceaccount:kubermatic:kubermatic-seed"},
+			oldCluster:  genCluster(""),
+			cluster:     genCluster("Volumes"),
+			wantAllowed: true,
+		},
+		{
+			name:        "Cluster admin can add annotation",
+			userInfo:    authenticationv1.UserInfo{Username: "kubernetes-admin", Groups: []string{"system:masters"}},
+			cluster:     genCluster("Volumes"),
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterValidator := validator{
+				namespace: "kubermatic",
+			}
+
+			ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: tt.userInfo,
+				},
+			})
+
+			err := clusterValidator.validateSkipDeletionSteps(ctx, tt.cluster, tt.oldCluster)
+
+			allowed := err == nil
+			if allowed != tt.wantAllowed {
+				t.Errorf("Allowed %t, but wanted %t: %v", allowed, tt.wantAllowed, err)
+			}
+		})
+	}
+}
+
+func TestProxyProtocolWarnings(t *testing.T) {
+	genCluster := func(proxyProtocol bool) *kubermaticv1.Cluster {
+		return &kubermaticv1.Cluster{
+			Spec: kubermaticv1.ClusterSpec{
+				ExposeStrategy:         kubermaticv1.ExposeStrategyTunneling,
+				APIServerProxyProtocol: proxyProtocol,
+			},
+		}
+	}
+
+	genSeed := func(policy corev1.ServiceExternalTrafficPolicy) *kubermaticv1.Seed {
+		seed := &kubermaticv1.Seed{}
+		seed.Spec.NodeportProxy.Envoy.LoadBalancerService.ExternalTrafficPolicy = policy
+		return seed
+	}
+
+	tests := []struct {
+		name         string
+		oldCluster   *kubermaticv1.Cluster
+		cluster      *kubermaticv1.Cluster
+		seed         *kubermaticv1.Seed
+		wantWarnings bool
+	}{
+		{
+			name:    "No warning without PROXY protocol",
+			cluster: genCluster(false),
+			seed:    genSeed(""),
+		},
+		{
+			name:         "Warning when creating a cluster with PROXY protocol on a Seed without Local policy",
+			cluster:      genCluster(true),
+			seed:         genSeed(corev1.ServiceExternalTrafficPolicyCluster),
+			wantWarnings: true,
+		},
+		{
+			name:    "No warning on a Seed with Local policy",
+			cluster: genCluster(true),
+			seed:    genSeed(corev1.ServiceExternalTrafficPolicyLocal),
+		},
+		{
+			name:         "Warning when enabling PROXY protocol",
+			oldCluster:   genCluster(false),
+			cluster:      genCluster(true),
+			seed:         genSeed(""),
+			wantWarnings: true,
+		},
+		{
+			name:       "No warning when PROXY protocol was already enabled",
+			oldCluster: genCluster(true),
+			cluster:    genCluster(true),
+			seed:       genSeed(""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := proxyProtocolWarnings(tt.cluster, tt.oldCluster, tt.seed)
+			if (len(warnings) > 0) != tt.wantWarnings {
+				t.Errorf("Expected warnings %t, got %v", tt.wantWarnings, warnings)
+			}
+		})
+	}
+}
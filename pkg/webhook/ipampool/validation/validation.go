@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"slices"
 	"strings"
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -61,41 +62,61 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldIPAMPool, newIPAMPool
 	for dc, dcOldConfig := range oldIPAMPool.Spec.Datacenters {
 		dcNewConfig, dcExistsInNewPool := newIPAMPool.Spec.Datacenters[dc]
 		if !dcExistsInNewPool {
-			// we allow deletion of a specific datacenter from the IPAM Pool
+			// we allow deletion of a specific datacenter from the IPAM Pool,
+			// as long as no cluster would lose its allocation
+			if err := v.checkDatacenterNotAllocated(ctx, oldIPAMPool.Name, dc); err != nil {
+				return nil, err
+			}
 			continue
 		}
 
-		if dcOldConfig.PoolCIDR != dcNewConfig.PoolCIDR {
-			return nil, errors.New("it's not allowed to update the pool CIDR for a datacenter")
-		}
-
 		if dcOldConfig.Type != dcNewConfig.Type {
 			return nil, errors.New("it's not allowed to update the allocation type for a datacenter")
 		}
 
-		var addedExclusions []string
+		if err := v.validateFamilyUpdate(ctx, oldIPAMPool.Name, dc, dcOldConfig.Type, dcOldConfig.PrimaryFamily(), dcNewConfig.PrimaryFamily(), false); err != nil {
+			return nil, err
+		}
 
-		switch dcOldConfig.Type {
-		case kubermaticv1.IPAMPoolAllocationTypeRange:
-			addedExclusions = getSliceAdditions(dcOldConfig.ExcludeRanges, dcNewConfig.ExcludeRanges)
-		case kubermaticv1.IPAMPoolAllocationTypePrefix:
-			if dcOldConfig.AllocationPrefix != dcNewConfig.AllocationPrefix {
-				return nil, errors.New("it's not allowed to update the allocation prefix for a datacenter")
-			}
-			addedExclusions = getSliceAdditions(
-				subnetCIDRSliceToStringSlice(dcOldConfig.ExcludePrefixes),
-				subnetCIDRSliceToStringSlice(dcNewConfig.ExcludePrefixes),
-			)
+		// adding an IPv6 pool to an existing datacenter is allowed
+		if dcOldConfig.IPv6 == nil {
+			continue
+		}
+		if dcNewConfig.IPv6 == nil {
+			return nil, errors.New("it's not allowed to remove the IPv6 pool for a datacenter")
 		}
 
-		if err := v.checkExclusionsNotAllocated(ctx, addedExclusions, oldIPAMPool.Name, dc, dcOldConfig.Type); err != nil {
-			return nil, err
+		if err := v.validateFamilyUpdate(ctx, oldIPAMPool.Name, dc, dcOldConfig.Type, *dcOldConfig.IPv6, *dcNewConfig.IPv6, true); err != nil {
+			return nil, fmt.Errorf("invalid IPv6 pool update: %w", err)
 		}
 	}
 
 	return nil, nil
 }
 
+func (v *validator) validateFamilyUpdate(ctx context.Context, ipamPoolName, dc string, allocationType kubermaticv1.IPAMPoolAllocationType, oldConfig, newConfig kubermaticv1.IPAMPoolFamilySettings, ipv6 bool) error {
+	if oldConfig.PoolCIDR != newConfig.PoolCIDR {
+		return errors.New("it's not allowed to update the pool CIDR for a datacenter")
+	}
+
+	var addedExclusions []string
+
+	switch allocationType {
+	case kubermaticv1.IPAMPoolAllocationTypeRange:
+		addedExclusions = getSliceAdditions(oldConfig.ExcludeRanges, newConfig.ExcludeRanges)
+	case kubermaticv1.IPAMPoolAllocationTypePrefix:
+		if oldConfig.AllocationPrefix != newConfig.AllocationPrefix {
+			return errors.New("it's not allowed to update the allocation prefix for a datacenter")
+		}
+		addedExclusions = getSliceAdditions(
+			subnetCIDRSliceToStringSlice(oldConfig.ExcludePrefixes),
+			subnetCIDRSliceToStringSlice(newConfig.ExcludePrefixes),
+		)
+	}
+
+	return v.checkExclusionsNotAllocated(ctx, addedExclusions, ipamPoolName, dc, allocationType, ipv6)
+}
+
 func (v *validator) ValidateDelete(_ context.Context, _ *kubermaticv1.IPAMPool) (admission.Warnings, error) {
 	// NOP we allow delete operation
 	return nil, nil
@@ -103,54 +124,84 @@ func (v *validator) ValidateDelete(_ context.Context, _ *kubermaticv1.IPAMPool)
 
 func (v *validator) validate(ctx context.Context, ipamPool *kubermaticv1.IPAMPool) error {
 	for _, dcConfig := range ipamPool.Spec.Datacenters {
-		_, poolSubnet, err := net.ParseCIDR(string(dcConfig.PoolCIDR))
-		if err != nil {
+		if err := validateFamily(dcConfig.Type, dcConfig.PrimaryFamily()); err != nil {
 			return err
 		}
-		poolPrefix, bits := poolSubnet.Mask.Size()
 
-		switch dcConfig.Type {
-		case kubermaticv1.IPAMPoolAllocationTypeRange:
-			if dcConfig.AllocationRange <= 0 {
-				return errors.New("allocation range should be greater than zero")
-			}
+		if dcConfig.IPv6 == nil {
+			continue
+		}
 
-			numberOfPoolSubnetIPsFloat64 := math.Pow(2, float64(bits-poolPrefix))
-			numberOfPoolSubnetIPs := int(numberOfPoolSubnetIPsFloat64)
-			if float64(numberOfPoolSubnetIPs) != numberOfPoolSubnetIPsFloat64 {
-				return errors.New("the pool is too big to be processed")
-			}
+		// the primary pool has already been validated, so the CIDR can be parsed
+		poolIP, _, _ := net.ParseCIDR(string(dcConfig.PoolCIDR))
+		if poolIP.To4() == nil {
+			return errors.New("pool CIDR must be an IPv4 CIDR if an IPv6 pool is configured")
+		}
 
-			if bits-poolPrefix > 12 {
-				return errors.New("pool prefix is too low for range allocation type")
-			}
+		ipv6PoolIP, _, err := net.ParseCIDR(string(dcConfig.IPv6.PoolCIDR))
+		if err != nil {
+			return fmt.Errorf("invalid IPv6 pool: %w", err)
+		}
+		if ipv6PoolIP.To4() != nil {
+			return errors.New("invalid IPv6 pool: pool CIDR must be an IPv6 CIDR")
+		}
 
-			if dcConfig.AllocationRange > numberOfPoolSubnetIPs {
-				return errors.New("allocation range cannot be greater than the pool subnet possible number of IP addresses")
-			}
+		if err := validateFamily(dcConfig.Type, *dcConfig.IPv6); err != nil {
+			return fmt.Errorf("invalid IPv6 pool: %w", err)
+		}
+	}
 
-			for _, rangeToExclude := range dcConfig.ExcludeRanges {
-				if err := validateRange(rangeToExclude); err != nil {
-					return err
-				}
-			}
-		case kubermaticv1.IPAMPoolAllocationTypePrefix:
-			if dcConfig.AllocationPrefix < poolPrefix {
-				return errors.New("allocation prefix cannot be smaller than the pool subnet mask size")
-			}
-			if dcConfig.AllocationPrefix > bits {
-				return errors.New("invalid allocation prefix for IP version")
+	return nil
+}
+
+func validateFamily(allocationType kubermaticv1.IPAMPoolAllocationType, config kubermaticv1.IPAMPoolFamilySettings) error {
+	_, poolSubnet, err := net.ParseCIDR(string(config.PoolCIDR))
+	if err != nil {
+		return err
+	}
+	poolPrefix, bits := poolSubnet.Mask.Size()
+
+	switch allocationType {
+	case kubermaticv1.IPAMPoolAllocationTypeRange:
+		if config.AllocationRange <= 0 {
+			return errors.New("allocation range should be greater than zero")
+		}
+
+		numberOfPoolSubnetIPsFloat64 := math.Pow(2, float64(bits-poolPrefix))
+		numberOfPoolSubnetIPs := int(numberOfPoolSubnetIPsFloat64)
+		if float64(numberOfPoolSubnetIPs) != numberOfPoolSubnetIPsFloat64 {
+			return errors.New("the pool is too big to be processed")
+		}
+
+		if bits-poolPrefix > 12 {
+			return errors.New("pool prefix is too low for range allocation type")
+		}
+
+		if config.AllocationRange > numberOfPoolSubnetIPs {
+			return errors.New("allocation range cannot be greater than the pool subnet possible number of IP addresses")
+		}
+
+		for _, rangeToExclude := range config.ExcludeRanges {
+			if err := validateRange(rangeToExclude); err != nil {
+				return err
 			}
+		}
+	case kubermaticv1.IPAMPoolAllocationTypePrefix:
+		if config.AllocationPrefix < poolPrefix {
+			return errors.New("allocation prefix cannot be smaller than the pool subnet mask size")
+		}
+		if config.AllocationPrefix > bits {
+			return errors.New("invalid allocation prefix for IP version")
+		}
 
-			for _, subnetCIDRToExclude := range dcConfig.ExcludePrefixes {
-				_, subnet, err := net.ParseCIDR(string(subnetCIDRToExclude))
-				if err != nil {
-					return fmt.Errorf("invalid CIDR for subnet to exclude: %w", err)
-				}
-				subnetPrefix, _ := subnet.Mask.Size()
-				if dcConfig.AllocationPrefix != subnetPrefix {
-					return fmt.Errorf("invalid length for subnet to exclude \"%s\": must be the same as the pool allocation prefix (%d)", subnetCIDRToExclude, subnetPrefix)
-				}
+		for _, subnetCIDRToExclude := range config.ExcludePrefixes {
+			_, subnet, err := net.ParseCIDR(string(subnetCIDRToExclude))
+			if err != nil {
+				return fmt.Errorf("invalid CIDR for subnet to exclude: %w", err)
+			}
+			subnetPrefix, _ := subnet.Mask.Size()
+			if config.AllocationPrefix != subnetPrefix {
+				return fmt.Errorf("invalid length for subnet to exclude \"%s\": must be the same as the pool allocation prefix (%d)", subnetCIDRToExclude, subnetPrefix)
 			}
 		}
 	}
@@ -201,7 +252,34 @@ func (v *validator) getSeedClient(ctx context.Context) (ctrlruntimeclient.Client
 	return client, nil
 }
 
-func (v *validator) checkExclusionsNotAllocated(ctx context.Context, exclusions []string, ipamPoolName string, dc string, allocationType kubermaticv1.IPAMPoolAllocationType) error {
+func (v *validator) checkDatacenterNotAllocated(ctx context.Context, ipamPoolName string, dc string) error {
+	seedClient, err := v.getSeedClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ipamAllocationList := &kubermaticv1.IPAMAllocationList{}
+	err = seedClient.List(ctx, ipamAllocationList)
+	if err != nil {
+		return fmt.Errorf("failed to list IPAM allocations: %w", err)
+	}
+
+	var namespaces []string
+	for _, ipamAllocation := range ipamAllocationList.Items {
+		if ipamAllocation.Name == ipamPoolName && ipamAllocation.Spec.DC == dc && ipamAllocation.DeletionTimestamp == nil {
+			namespaces = append(namespaces, ipamAllocation.Namespace)
+		}
+	}
+
+	if len(namespaces) > 0 {
+		slices.Sort(namespaces)
+		return fmt.Errorf("failed to remove datacenter \"%s\": IPAM pool \"%s\" still has allocations in cluster namespaces %s", dc, ipamPoolName, strings.Join(namespaces, ", "))
+	}
+
+	return nil
+}
+
+func (v *validator) checkExclusionsNotAllocated(ctx context.Context, exclusions []string, ipamPoolName string, dc string, allocationType kubermaticv1.IPAMPoolAllocationType, ipv6 bool) error {
 	if len(exclusions) == 0 {
 		return nil
 	}
@@ -224,11 +302,19 @@ func (v *validator) checkExclusionsNotAllocated(ctx context.Context, exclusions
 			continue
 		}
 
+		allocatedCIDR, allocatedAddresses := ipamAllocation.Spec.CIDR, ipamAllocation.Spec.Addresses
+		if ipv6 {
+			if ipamAllocation.Spec.IPv6 == nil {
+				continue
+			}
+			allocatedCIDR, allocatedAddresses = ipamAllocation.Spec.IPv6.CIDR, ipamAllocation.Spec.IPv6.Addresses
+		}
+
 		errExclusionConflict := fmt.Errorf("failed to add exclusion: there is an conflicted allocation in IPAM pool \"%s\" and datacenter \"%s\"", ipamPoolName, dc)
 
 		switch allocationType {
 		case kubermaticv1.IPAMPoolAllocationTypeRange:
-			if addressRangesConflict(ipamAllocation.Spec.Addresses, exclusions) {
+			if addressRangesConflict(allocatedAddresses, exclusions) {
 				return errExclusionConflict
 			}
 		case kubermaticv1.IPAMPoolAllocationTypePrefix:
@@ -237,11 +323,11 @@ func (v *validator) checkExclusionsNotAllocated(ctx context.Context, exclusions
 				if err != nil {
 					return err
 				}
-				_, allocatedSubnet, err := net.ParseCIDR(string(ipamAllocation.Spec.CIDR))
+				_, allocatedSubnet, err := net.ParseCIDR(string(allocatedCIDR))
 				if err != nil {
 					return err
 				}
-				if string(ipamAllocation.Spec.CIDR) == exclusion || allocatedSubnet.Contains(excludePrefixIP) {
+				if string(allocatedCIDR) == exclusion || allocatedSubnet.Contains(excludePrefixIP) {
 					return errExclusionConflict
 				}
 			}
@@ -441,6 +441,70 @@ func TestValidator(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "not allowed to remove a datacenter pool with allocations",
+			op:   admissionv1.Update,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc2": {
+							Type:            "range",
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 8,
+						},
+					},
+				},
+			},
+			oldIPAMPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             "prefix",
+							PoolCIDR:         "192.168.1.0/27",
+							AllocationPrefix: 28,
+						},
+						"dc2": {
+							Type:            "range",
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 8,
+						},
+					},
+				},
+			},
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool",
+						Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-2"),
+						ResourceVersion: "1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "dc",
+						CIDR: "192.168.1.16/28",
+					},
+				},
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool",
+						Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-1"),
+						ResourceVersion: "1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type: kubermaticv1.IPAMPoolAllocationTypePrefix,
+						DC:   "dc",
+						CIDR: "192.168.1.0/28",
+					},
+				},
+			},
+			expectedError: errors.New("failed to remove datacenter \"dc\": IPAM pool \"test-pool\" still has allocations in cluster namespaces cluster-test-cluster-1, cluster-test-cluster-2"),
+		},
 		{
 			name: "exclude range: invalid format",
 			op:   admissionv1.Create,
@@ -1018,6 +1082,233 @@ func TestValidator(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("it's not allowed to update the allocation prefix for a datacenter"),
 		},
+		{
+			name: "allowed dual-stack creation",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ipam-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:            "range",
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 8,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:        "2001:db8::/120",
+								AllocationRange: 16,
+								ExcludeRanges:   []string{"2001:db8::-2001:db8::f"},
+							},
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "dual-stack: primary pool must be IPv4",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ipam-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             "prefix",
+							PoolCIDR:         "2001:db8::/56",
+							AllocationPrefix: 64,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:         "2001:db8:1::/56",
+								AllocationPrefix: 64,
+							},
+						},
+					},
+				},
+			},
+			expectedError: errors.New("pool CIDR must be an IPv4 CIDR if an IPv6 pool is configured"),
+		},
+		{
+			name: "dual-stack: IPv6 pool must be IPv6",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ipam-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             "prefix",
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:         "192.168.2.0/28",
+								AllocationPrefix: 30,
+							},
+						},
+					},
+				},
+			},
+			expectedError: errors.New("invalid IPv6 pool: pool CIDR must be an IPv6 CIDR"),
+		},
+		{
+			name: "dual-stack: invalid IPv6 allocation prefix",
+			op:   admissionv1.Create,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ipam-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             "prefix",
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:         "2001:db8::/56",
+								AllocationPrefix: 48,
+							},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf("invalid IPv6 pool: %w", errors.New("allocation prefix cannot be smaller than the pool subnet mask size")),
+		},
+		{
+			name: "dual-stack: allowed to add an IPv6 pool",
+			op:   admissionv1.Update,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ipam-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             "prefix",
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:         "2001:db8::/56",
+								AllocationPrefix: 64,
+							},
+						},
+					},
+				},
+			},
+			oldIPAMPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ipam-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             "prefix",
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "dual-stack: not allowed to remove the IPv6 pool",
+			op:   admissionv1.Update,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ipam-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             "prefix",
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+						},
+					},
+				},
+			},
+			oldIPAMPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-ipam-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:             "prefix",
+							PoolCIDR:         "192.168.1.0/28",
+							AllocationPrefix: 30,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:         "2001:db8::/56",
+								AllocationPrefix: 64,
+							},
+						},
+					},
+				},
+			},
+			expectedError: errors.New("it's not allowed to remove the IPv6 pool for a datacenter"),
+		},
+		{
+			name: "dual-stack: added IPv6 range exclusions: conflict with allocation",
+			op:   admissionv1.Update,
+			ipamPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:            "range",
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 4,
+							ExcludeRanges:   []string{"192.168.1.8"},
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:        "2001:db8::/120",
+								AllocationRange: 4,
+								ExcludeRanges:   []string{"2001:db8::2"},
+							},
+						},
+					},
+				},
+			},
+			oldIPAMPool: &kubermaticv1.IPAMPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pool",
+				},
+				Spec: kubermaticv1.IPAMPoolSpec{
+					Datacenters: map[string]kubermaticv1.IPAMPoolDatacenterSettings{
+						"dc": {
+							Type:            "range",
+							PoolCIDR:        "192.168.1.0/28",
+							AllocationRange: 4,
+							IPv6: &kubermaticv1.IPAMPoolFamilySettings{
+								PoolCIDR:        "2001:db8::/120",
+								AllocationRange: 4,
+							},
+						},
+					},
+				},
+			},
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.IPAMAllocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "test-pool",
+						Namespace:       fmt.Sprintf("cluster-%s", "test-cluster-1"),
+						ResourceVersion: "1",
+					},
+					Spec: kubermaticv1.IPAMAllocationSpec{
+						Type:      kubermaticv1.IPAMPoolAllocationTypeRange,
+						DC:        "dc",
+						Addresses: []string{"192.168.1.0-192.168.1.3"},
+						IPv6: &kubermaticv1.IPAMAllocationFamily{
+							Addresses: []string{"2001:db8::-2001:db8::3"},
+						},
+					},
+				},
+			},
+			expectedError: fmt.Errorf("invalid IPv6 pool update: %w", fmt.Errorf("failed to add exclusion: there is an conflicted allocation in IPAM pool \"%s\" and datacenter \"%s\"", "test-pool", "dc")),
+		},
 	}
 
 	for _, tc := range testCases {
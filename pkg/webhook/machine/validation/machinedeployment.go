@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/labels"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// machineDeploymentValidator for validating MachineDeployment CRD.
+type machineDeploymentValidator struct {
+	log             *zap.SugaredLogger
+	seedClient      ctrlruntimeclient.Client
+	userClient      ctrlruntimeclient.Client
+	caBundle        *certificates.CABundle
+	subjectSelector labels.Selector
+}
+
+// NewMachineDeploymentValidator returns a new MachineDeployment validator, which rejects MachineDeployments
+// that would exceed the resource quota of the project when created or scaled up.
+func NewMachineDeploymentValidator(seedClient, userClient ctrlruntimeclient.Client, log *zap.SugaredLogger, caBundle *certificates.CABundle,
+	projectID string) (*machineDeploymentValidator, error) {
+	subjectSelector, err := resourceQuotaSubjectSelector(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machineDeploymentValidator{
+		log:             log,
+		seedClient:      seedClient,
+		userClient:      userClient,
+		caBundle:        caBundle,
+		subjectSelector: subjectSelector,
+	}, nil
+}
+
+var _ admission.Validator[*clusterv1alpha1.MachineDeployment] = &machineDeploymentValidator{}
+
+func (v *machineDeploymentValidator) ValidateCreate(ctx context.Context, md *clusterv1alpha1.MachineDeployment) (admission.Warnings, error) {
+	return v.validate(ctx, nil, md)
+}
+
+func (v *machineDeploymentValidator) ValidateUpdate(ctx context.Context, oldMD, newMD *clusterv1alpha1.MachineDeployment) (admission.Warnings, error) {
+	return v.validate(ctx, oldMD, newMD)
+}
+
+func (v *machineDeploymentValidator) ValidateDelete(_ context.Context, _ *clusterv1alpha1.MachineDeployment) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *machineDeploymentValidator) validate(ctx context.Context, oldMD, newMD *clusterv1alpha1.MachineDeployment) (admission.Warnings, error) {
+	log := v.log.With("machinedeployment", newMD.Name)
+	log.Debug("validating")
+
+	quota, err := getResourceQuota(ctx, v.seedClient, v.subjectSelector)
+	if err != nil {
+		return nil, err
+	}
+	if quota != nil {
+		return validateMachineDeploymentQuota(ctx, log, v.userClient, oldMD, newMD, v.caBundle, quota)
+	}
+	return nil, nil
+}
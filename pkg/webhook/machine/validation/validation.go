@@ -44,15 +44,10 @@ type validator struct {
 // NewValidator returns a new Machine validator.
 func NewValidator(seedClient, userClient ctrlruntimeclient.Client, log *zap.SugaredLogger, caBundle *certificates.CABundle,
 	projectID string) (*validator, error) {
-	subjectNameReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectNameLabelKey, selection.Equals, []string{projectID})
-	if err != nil {
-		return nil, fmt.Errorf("error creating resource quota subject name requirement: %w", err)
-	}
-	subjectKindReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectKindLabelKey, selection.Equals, []string{kubermaticv1.ProjectSubjectKind})
+	subjectSelector, err := resourceQuotaSubjectSelector(projectID)
 	if err != nil {
-		return nil, fmt.Errorf("error creating resource quota subject kind requirement: %w", err)
+		return nil, err
 	}
-	subjectSelector := labels.NewSelector().Add(*subjectNameReq, *subjectKindReq)
 
 	return &validator{
 		log:             log,
@@ -74,7 +69,7 @@ func (v *validator) ValidateCreate(ctx context.Context, machine *clusterv1alpha1
 		return nil, err
 	}
 	if quota != nil {
-		return nil, validateQuota(ctx, log, v.userClient, machine, v.caBundle, quota)
+		return validateQuota(ctx, log, v.userClient, machine, v.caBundle, quota)
 	}
 	return nil, nil
 }
@@ -88,3 +83,17 @@ func (v *validator) ValidateUpdate(_ context.Context, _, _ *clusterv1alpha1.Mach
 func (v *validator) ValidateDelete(_ context.Context, _ *clusterv1alpha1.Machine) (admission.Warnings, error) {
 	return nil, nil
 }
+
+// resourceQuotaSubjectSelector returns a selector for the resource quota of the given project.
+func resourceQuotaSubjectSelector(projectID string) (labels.Selector, error) {
+	subjectNameReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectNameLabelKey, selection.Equals, []string{projectID})
+	if err != nil {
+		return nil, fmt.Errorf("error creating resource quota subject name requirement: %w", err)
+	}
+	subjectKindReq, err := labels.NewRequirement(kubermaticv1.ResourceQuotaSubjectKindLabelKey, selection.Equals, []string{kubermaticv1.ProjectSubjectKind})
+	if err != nil {
+		return nil, fmt.Errorf("error creating resource quota subject kind requirement: %w", err)
+	}
+
+	return labels.NewSelector().Add(*subjectNameReq, *subjectKindReq), nil
+}
@@ -26,18 +26,37 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	eemachinevalidation "k8c.io/kubermatic/v2/pkg/ee/validation/machine"
+	eeresourcequotavalidation "k8c.io/kubermatic/v2/pkg/ee/validation/resourcequota"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
 
 	"k8s.io/apimachinery/pkg/labels"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 func validateQuota(ctx context.Context, log *zap.SugaredLogger, userClient ctrlruntimeclient.Client,
-	machine *clusterv1alpha1.Machine, caBundle *certificates.CABundle, resourceQuota *kubermaticv1.ResourceQuota) error {
+	machine *clusterv1alpha1.Machine, caBundle *certificates.CABundle, resourceQuota *kubermaticv1.ResourceQuota) (admission.Warnings, error) {
 	return eemachinevalidation.ValidateQuota(ctx, log, userClient, machine, caBundle, resourceQuota)
 }
 
+func validateMachineDeploymentQuota(ctx context.Context, log *zap.SugaredLogger, userClient ctrlruntimeclient.Client,
+	oldMD, newMD *clusterv1alpha1.MachineDeployment, caBundle *certificates.CABundle, resourceQuota *kubermaticv1.ResourceQuota) (admission.Warnings, error) {
+	// all Machines of a MachineDeployment are created from the same template
+	machineUsage, err := eemachinevalidation.GetMachineResourceUsage(ctx, userClient, &clusterv1alpha1.Machine{Spec: newMD.Spec.Template.Spec}, caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("error getting machine resource request: %w", err)
+	}
+
+	warnings, err := eeresourcequotavalidation.ValidateMachineDeploymentQuota(resourceQuota, oldMD, newMD, machineUsage.NodeUsage())
+	if err != nil {
+		log.Debugw("requested resources would exceed current quota", zap.Error(err))
+		return nil, err
+	}
+
+	return warnings, nil
+}
+
 func getResourceQuota(ctx context.Context, seedClient ctrlruntimeclient.Client, subjectSelector labels.Selector) (*kubermaticv1.ResourceQuota, error) {
 	quotaList := &kubermaticv1.ResourceQuotaList{}
 	if err := seedClient.List(ctx, quotaList, &ctrlruntimeclient.ListOptions{
@@ -194,6 +194,10 @@ func (v *validator) validate(ctx context.Context, subject *kubermaticv1.Seed, is
 		return err
 	}
 
+	if err := validateNodePortProxyEnvoyConnectionLimits(subject); err != nil {
+		return err
+	}
+
 	if err := validateEtcdBackupConfiguration(ctx, seedClient, subject); err != nil {
 		return err
 	}
@@ -287,6 +291,16 @@ func validateNodePortProxyEnvoyConnectionSettings(seed *kubermaticv1.Seed) error
 	return nil
 }
 
+func validateNodePortProxyEnvoyConnectionLimits(seed *kubermaticv1.Seed) error {
+	limits := seed.Spec.NodeportProxy.Envoy.ConnectionLimits
+
+	if limits.ConnectionBurst > 0 && limits.ConnectionBurst < limits.ConnectionRate {
+		return errors.New("spec.nodeportProxy.envoy.connectionLimits.connectionBurst must be 0 or >= connectionRate")
+	}
+
+	return nil
+}
+
 func validateNoClustersRemaining(ctx context.Context, seedClient ctrlruntimeclient.Client, _ *kubermaticv1.Seed, subjectDatacenters, existingDatacenters sets.Set[string]) error {
 	// new seed clusters might not yet have the CRDs installed into them,
 	// which for the purpose of this validation is not a problem and simply
@@ -346,6 +360,22 @@ func validateEtcdBackupConfiguration(ctx context.Context, seedClient ctrlruntime
 					return fmt.Errorf("invalid etcd backup configuration: invalid destination %q credentials %s: %w", name, dest.Credentials.Name, err)
 				}
 			}
+
+			if dest.EncryptionKey != nil {
+				if dest.Credentials == nil {
+					return fmt.Errorf("invalid etcd backup configuration: destination %q must define credentials when using an encryption key", name)
+				}
+
+				encryptionKeySecret := corev1.Secret{}
+				if err := seedClient.Get(ctx, types.NamespacedName{Name: dest.EncryptionKey.Name,
+					Namespace: dest.Credentials.Namespace}, &encryptionKeySecret); err != nil {
+					return fmt.Errorf("invalid etcd backup configuration: invalid destination %q encryption key %s: %w", name, dest.EncryptionKey.Name, err)
+				}
+
+				if _, ok := encryptionKeySecret.Data[dest.EncryptionKey.Key]; !ok {
+					return fmt.Errorf("invalid etcd backup configuration: encryption key secret %s of destination %q has no key %q", dest.EncryptionKey.Name, name, dest.EncryptionKey.Key)
+				}
+			}
 		}
 	}
 
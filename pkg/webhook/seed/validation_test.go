@@ -801,3 +801,56 @@ func TestValidateNodePortProxyEnvoyConnectionSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateNodePortProxyEnvoyConnectionLimits(t *testing.T) {
+	testCases := []struct {
+		name        string
+		limits      kubermaticv1.NodePortProxyEnvoyConnectionLimits
+		errExpected bool
+	}{
+		{
+			name: "accepts zero values",
+		},
+		{
+			name: "accepts rate without burst",
+			limits: kubermaticv1.NodePortProxyEnvoyConnectionLimits{
+				MaxConnections: 1000,
+				ConnectionRate: 50,
+			},
+		},
+		{
+			name: "accepts burst above rate",
+			limits: kubermaticv1.NodePortProxyEnvoyConnectionLimits{
+				ConnectionRate:  50,
+				ConnectionBurst: 100,
+			},
+		},
+		{
+			name: "rejects burst below rate",
+			limits: kubermaticv1.NodePortProxyEnvoyConnectionLimits{
+				ConnectionRate:  50,
+				ConnectionBurst: 10,
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seed := &kubermaticv1.Seed{
+				Spec: kubermaticv1.SeedSpec{
+					NodeportProxy: kubermaticv1.NodeportProxyConfig{
+						Envoy: kubermaticv1.NodePortProxyComponentEnvoy{
+							ConnectionLimits: tc.limits,
+						},
+					},
+				},
+			}
+
+			err := validateNodePortProxyEnvoyConnectionLimits(seed)
+			if tc.errExpected != (err != nil) {
+				t.Fatalf("expected error = %v, got %v", tc.errExpected, err)
+			}
+		})
+	}
+}
@@ -168,9 +168,17 @@ type ApplicationSource struct {
 
 const (
 	HelmTemplateMethod TemplateMethod = "helm"
+
+	// KustomizeTemplateMethod builds the kustomization found at the root of the application's source. Values of the
+	// ApplicationInstallation are used as an overlay kustomization (e.g. patches, images, namePrefix).
+	KustomizeTemplateMethod TemplateMethod = "kustomize"
+
+	// ManifestsTemplateMethod applies all YAML and JSON manifests found in the application's source. Values of the
+	// ApplicationInstallation are used as an overlay kustomization (e.g. patches, images, namePrefix).
+	ManifestsTemplateMethod TemplateMethod = "manifests"
 )
 
-// +kubebuilder:validation:Enum=helm
+// +kubebuilder:validation:Enum=helm;kustomize;manifests
 type TemplateMethod string
 
 type ApplicationTemplate struct {
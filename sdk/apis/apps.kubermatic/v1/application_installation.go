@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/yaml"
 )
 
@@ -77,6 +78,12 @@ type ApplicationInstallationSpec struct {
 	// ValuesBlock specifies values overrides that are passed to helm templating. Comments are preserved.
 	ValuesBlock string `json:"valuesBlock,omitempty"`
 
+	// ValuesFrom references Secrets and ConfigMaps holding values for the application. The referenced values are merged
+	// in the listed order, later references overriding earlier ones, and Values / ValuesBlock are merged on top of them.
+	// The application is re-installed when the referenced data changes.
+	// +optional
+	ValuesFrom []ValuesReference `json:"valuesFrom,omitempty"`
+
 	// ReconciliationInterval is the interval at which to force the reconciliation of the application. By default, Applications are only reconciled
 	// on changes on spec, annotations, or the parent application definition. Meaning that if the user manually deletes the workload
 	// deployed by the application, nothing will happen until the application CR change.
@@ -88,6 +95,82 @@ type ApplicationInstallationSpec struct {
 
 	// DeployOptions holds the settings specific to the templating method used to deploy the application.
 	DeployOptions *DeployOptions `json:"deployOptions,omitempty"`
+
+	// DependsOn lists ApplicationInstallations that must be Ready before this application is installed. Dependencies
+	// must not form a cycle. When deleting, this application is only uninstalled after all installations depending
+	// on it have been removed.
+	// +optional
+	DependsOn []ApplicationInstallationReference `json:"dependsOn,omitempty"`
+
+	// UpdatePolicy configures automatic upgrades of the application to newer versions of its ApplicationDefinition.
+	// Upgrades are only performed within the update window of the cluster, if one is configured.
+	// +optional
+	UpdatePolicy *ApplicationUpdatePolicy `json:"updatePolicy,omitempty"`
+
+	// DriftDetection configures the periodic comparison of the rendered resources of the application with the live
+	// objects in the user cluster.
+	// +optional
+	DriftDetection *DriftDetection `json:"driftDetection,omitempty"`
+}
+
+// DriftDetection configures the detection of manual changes to the resources deployed by an application.
+type DriftDetection struct {
+	// Interval is the interval at which the resources are compared. Defaults to 10m.
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// SelfHeal re-applies the rendered resources of the application when drift is detected. If disabled, the
+	// application is not applied again while its resources have drifted, unless the ApplicationInstallation changes.
+	// +optional
+	SelfHeal bool `json:"selfHeal,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Automatic;Manual
+
+// ApplicationUpdateMode defines how newer versions matching the version constraint of an ApplicationUpdatePolicy are handled.
+type ApplicationUpdateMode string
+
+const (
+	// ApplicationUpdateModeAutomatic upgrades the application to the newest matching version.
+	ApplicationUpdateModeAutomatic ApplicationUpdateMode = "Automatic"
+
+	// ApplicationUpdateModeManual only reports the newest matching version in the status of the ApplicationInstallation.
+	ApplicationUpdateModeManual ApplicationUpdateMode = "Manual"
+)
+
+// ApplicationUpdatePolicy describes which versions of the ApplicationDefinition an application can be upgraded to.
+type ApplicationUpdatePolicy struct {
+	// VersionConstraint is a semver constraint (e.g. "~1.14" or ">= 1.2, < 2") the versions of the ApplicationDefinition
+	// must match. Only versions newer than the installed version are considered, so applications are never downgraded.
+	// +kubebuilder:validation:MinLength:=1
+	VersionConstraint string `json:"versionConstraint"`
+
+	// Mode defines whether the application is upgraded automatically or the newest matching version is only reported.
+	// Defaults to Automatic.
+	// +kubebuilder:default:=Automatic
+	// +optional
+	Mode ApplicationUpdateMode `json:"mode,omitempty"`
+}
+
+// ApplicationInstallationReference references another ApplicationInstallation in the same user cluster.
+type ApplicationInstallationReference struct {
+	// Name of the referenced ApplicationInstallation.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Namespace of the referenced ApplicationInstallation. Defaults to the namespace of the referencing ApplicationInstallation.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NamespacedName returns the namespace and name of the referenced ApplicationInstallation, defaulting
+// the namespace to defaultNamespace.
+func (r ApplicationInstallationReference) NamespacedName(defaultNamespace string) types.NamespacedName {
+	namespace := r.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: r.Name}
 }
 
 // DeployOptions holds the settings specific to the templating method used to deploy the application.
@@ -115,6 +198,51 @@ type HelmDeployOptions struct {
 	EnableDNS bool `json:"enableDNS,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=Secret;ConfigMap
+
+// ValuesReferenceKind is the kind of object referenced in ApplicationInstallationSpec.ValuesFrom.
+type ValuesReferenceKind string
+
+const (
+	ValuesReferenceKindSecret    ValuesReferenceKind = "Secret"
+	ValuesReferenceKindConfigMap ValuesReferenceKind = "ConfigMap"
+
+	// DefaultValuesReferenceKey is the data key read from a referenced Secret or ConfigMap if no key is specified.
+	DefaultValuesReferenceKey = "values.yaml"
+)
+
+// ValuesReference references a Secret or ConfigMap holding values for the application.
+type ValuesReference struct {
+	// Kind of the referenced object.
+	Kind ValuesReferenceKind `json:"kind"`
+
+	// Name of the referenced object. Unless Seed is set, the object must exist in the namespace of the ApplicationInstallation.
+	// +kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+
+	// Key in the data of the referenced object holding the values as YAML. Defaults to "values.yaml".
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Seed reads the referenced object from the cluster namespace in the seed cluster instead of the user cluster.
+	// To prevent access to the cluster's control plane credentials, only objects labelled by a KKP admin with
+	// "apps.kubermatic.k8c.io/values-source=true" can be referenced.
+	// +optional
+	Seed bool `json:"seed,omitempty"`
+
+	// Optional makes the installation ignore a missing object or key instead of failing.
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// GetKey returns the data key holding the values, falling back to DefaultValuesReferenceKey.
+func (r ValuesReference) GetKey() string {
+	if r.Key == "" {
+		return DefaultValuesReferenceKey
+	}
+	return r.Key
+}
+
 // AppNamespaceSpec describe the desired state of the namespace where application will be created.
 type AppNamespaceSpec struct {
 	// Name is the namespace to deploy the Application into.
@@ -175,8 +303,89 @@ type ApplicationInstallationStatus struct {
 	// HelmRelease holds the information about the helm release installed by this application. This field is only filled if template method is 'helm'.
 	HelmRelease *HelmRelease `json:"helmRelease,omitempty"`
 
+	// AppliedResources lists the resources created by this application in the user cluster. This field is only filled if
+	// template method is 'kustomize' or 'manifests'. Resources that are no longer part of the application are pruned and
+	// all listed resources are deleted when the application is uninstalled.
+	AppliedResources []AppliedResource `json:"appliedResources,omitempty"`
+
 	// Failures counts the number of failed installation or updagrade. it is reset on successful reconciliation.
 	Failures int `json:"failures,omitempty"`
+
+	// AvailableVersion is the newest version of the ApplicationDefinition matching the update policy, if it is newer than
+	// the installed version. It is only set while the upgrade is pending, e.g. outside of the cluster update window.
+	AvailableVersion string `json:"availableVersion,omitempty"`
+
+	// UpdatePolicyError explains why the update policy cannot be evaluated, e.g. because the installed version is not
+	// valid semver. Automatic upgrades are skipped while it is set.
+	UpdatePolicyError string `json:"updatePolicyError,omitempty"`
+
+	// UpdateHistory lists the latest automatic upgrades of the application, the most recent one last.
+	UpdateHistory []ApplicationUpdateRecord `json:"updateHistory,omitempty"`
+
+	// DriftedResources lists the resources of the application whose live state differs from the rendered state. This
+	// field is only filled if drift detection is enabled.
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+
+	// AppliedInputsHash is a hash of the inputs the application has last been applied with, i.e. its values, including
+	// the ones referenced in ValuesFrom, and the installed version of its ApplicationDefinition. If self-healing is
+	// disabled, drifted resources are only kept while these inputs are unchanged.
+	AppliedInputsHash string `json:"appliedInputsHash,omitempty"`
+
+	// Workloads lists the Deployments, StatefulSets and Jobs of the application. While the application is unhealthy,
+	// their health is assessed again without installing the application again.
+	Workloads []AppliedResource `json:"workloads,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Missing;Modified
+
+// DriftReason describes how a resource drifted from its rendered state.
+type DriftReason string
+
+const (
+	// DriftReasonMissing indicates that the resource does not exist in the user cluster.
+	DriftReasonMissing DriftReason = "Missing"
+
+	// DriftReasonModified indicates that the live resource differs from the rendered resource.
+	DriftReasonModified DriftReason = "Modified"
+)
+
+// DriftedResource references a resource of an application whose live state differs from the rendered state.
+type DriftedResource struct {
+	AppliedResource `json:",inline"`
+
+	// Reason describes how the resource drifted.
+	Reason DriftReason `json:"reason"`
+
+	// Field is the path of the first field found to differ. Empty if the resource is missing.
+	// +optional
+	Field string `json:"field,omitempty"`
+}
+
+// ApplicationUpdateRecord describes an automatic upgrade of an application.
+type ApplicationUpdateRecord struct {
+	// FromVersion is the version of the application before the upgrade.
+	FromVersion string `json:"fromVersion"`
+
+	// ToVersion is the version the application has been upgraded to.
+	ToVersion string `json:"toVersion"`
+
+	// Time is the time of the upgrade.
+	Time metav1.Time `json:"time"`
+}
+
+// AppliedResource references a resource applied into the user cluster by an application.
+type AppliedResource struct {
+	// APIVersion of the resource (e.g. "apps/v1").
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the resource.
+	Kind string `json:"kind"`
+
+	// Namespace of the resource. Empty for cluster-scoped resources.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the resource.
+	Name string `json:"name"`
 }
 
 type HelmRelease struct {
@@ -264,7 +473,7 @@ type ApplicationInstallationCondition struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=ManifestsRetrieved;Ready
+// +kubebuilder:validation:Enum=ManifestsRetrieved;Ready;Healthy
 
 // swagger:enum ApplicationInstallationConditionType
 // All condition types must be registered within the `AllApplicationInstallationConditionTypes` variable.
@@ -276,11 +485,16 @@ const (
 
 	// Ready describes all components have been successfully rolled out and are ready.
 	Ready ApplicationInstallationConditionType = "Ready"
+
+	// Healthy describes whether the Deployments, StatefulSets and Jobs deployed by the application are available,
+	// ready and succeeded respectively.
+	Healthy ApplicationInstallationConditionType = "Healthy"
 )
 
 var AllApplicationInstallationConditionTypes = []ApplicationInstallationConditionType{
 	ManifestsRetrieved,
 	Ready,
+	Healthy,
 }
 
 // SetCondition of the applicationInstallation. It take care of update LastHeartbeatTime and LastTransitionTime if needed.
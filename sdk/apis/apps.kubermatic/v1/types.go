@@ -38,6 +38,10 @@ const (
 	// application definition / application installation type if CNI (Container Network Interface).
 	ApplicationTypeCNIValue = "cni"
 
+	// ApplicationValuesSourceLabel must be set to "true" on Secrets and ConfigMaps in the seed cluster namespace
+	// that can be referenced in the valuesFrom of an ApplicationInstallation.
+	ApplicationValuesSourceLabel = "apps.kubermatic.k8c.io/values-source"
+
 	// ApplicationEnforcedAnnotation marks an ApplicationInstallation as enforced.
 	ApplicationEnforcedAnnotation = "apps.kubermatic.k8c.io/enforced"
 
@@ -226,6 +226,21 @@ func (in *ApplicationInstallationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationInstallationReference) DeepCopyInto(out *ApplicationInstallationReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationInstallationReference.
+func (in *ApplicationInstallationReference) DeepCopy() *ApplicationInstallationReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationInstallationReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationInstallationSpec) DeepCopyInto(out *ApplicationInstallationSpec) {
 	*out = *in
@@ -236,12 +251,32 @@ func (in *ApplicationInstallationSpec) DeepCopyInto(out *ApplicationInstallation
 	}
 	out.ApplicationRef = in.ApplicationRef
 	in.Values.DeepCopyInto(&out.Values)
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]ValuesReference, len(*in))
+		copy(*out, *in)
+	}
 	out.ReconciliationInterval = in.ReconciliationInterval
 	if in.DeployOptions != nil {
 		in, out := &in.DeployOptions, &out.DeployOptions
 		*out = new(DeployOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationInstallationReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(ApplicationUpdatePolicy)
+		**out = **in
+	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetection)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationInstallationSpec.
@@ -274,6 +309,28 @@ func (in *ApplicationInstallationStatus) DeepCopyInto(out *ApplicationInstallati
 		*out = new(HelmRelease)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AppliedResources != nil {
+		in, out := &in.AppliedResources, &out.AppliedResources
+		*out = make([]AppliedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdateHistory != nil {
+		in, out := &in.UpdateHistory, &out.UpdateHistory
+		*out = make([]ApplicationUpdateRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftedResources != nil {
+		in, out := &in.DriftedResources, &out.DriftedResources
+		*out = make([]DriftedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]AppliedResource, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationInstallationStatus.
@@ -347,6 +404,37 @@ func (in *ApplicationTemplate) DeepCopy() *ApplicationTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationUpdatePolicy) DeepCopyInto(out *ApplicationUpdatePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationUpdatePolicy.
+func (in *ApplicationUpdatePolicy) DeepCopy() *ApplicationUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationUpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationUpdateRecord) DeepCopyInto(out *ApplicationUpdateRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationUpdateRecord.
+func (in *ApplicationUpdateRecord) DeepCopy() *ApplicationUpdateRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationUpdateRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationVersion) DeepCopyInto(out *ApplicationVersion) {
 	*out = *in
@@ -363,6 +451,21 @@ func (in *ApplicationVersion) DeepCopy() *ApplicationVersion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedResource) DeepCopyInto(out *AppliedResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedResource.
+func (in *AppliedResource) DeepCopy() *AppliedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultingSelector) DeepCopyInto(out *DefaultingSelector) {
 	*out = *in
@@ -423,6 +526,38 @@ func (in *DeployOptions) DeepCopy() *DeployOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetection) DeepCopyInto(out *DriftDetection) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetection.
+func (in *DriftDetection) DeepCopy() *DriftDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedResource) DeepCopyInto(out *DriftedResource) {
+	*out = *in
+	out.AppliedResource = in.AppliedResource
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedResource.
+func (in *DriftedResource) DeepCopy() *DriftedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitCredentials) DeepCopyInto(out *GitCredentials) {
 	*out = *in
@@ -607,3 +742,18 @@ func (in *HelmSource) DeepCopy() *HelmSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesReference) DeepCopyInto(out *ValuesReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesReference.
+func (in *ValuesReference) DeepCopy() *ValuesReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesReference)
+	in.DeepCopyInto(out)
+	return out
+}
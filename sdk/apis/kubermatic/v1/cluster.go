@@ -51,6 +51,18 @@ const (
 
 	// PresetInvalidatedAnnotation is key of the annotation used to indicate why the preset was invalidated.
 	PresetInvalidatedAnnotation = "presetInvalidated"
+
+	// SkipDeletionStepsAnnotation is key of the annotation used to force-skip individual steps
+	// when deleting a cluster. The value is a comma-separated list of ClusterDeletionSteps.
+	// Skipping a step can leave orphaned resources behind and should only be used as a last
+	// resort when a step is permanently blocked. Only cluster administrators (system:masters)
+	// and the KKP controllers can set or change this annotation.
+	SkipDeletionStepsAnnotation = "kubermatic.k8c.io/skip-deletion-steps"
+
+	// DeletionDryRunAnnotation is key of the annotation used to request a listing of all resources
+	// that would be removed when deleting the cluster. The result is stored in the cluster status
+	// and the annotation is removed afterwards.
+	DeletionDryRunAnnotation = "kubermatic.k8c.io/deletion-dry-run"
 )
 
 const (
@@ -87,12 +99,13 @@ const (
 	AzureBasicLBSKU    = LBSKU("basic")
 )
 
-// +kubebuilder:validation:Enum=deleted;changed
+// +kubebuilder:validation:Enum=deleted;changed;expired
 type PresetInvalidationReason string
 
 const (
 	PresetDeleted = PresetInvalidationReason("deleted")
 	PresetChanged = PresetInvalidationReason("changed")
+	PresetExpired = PresetInvalidationReason("expired")
 )
 
 // ProtectedClusterLabels is a set of labels that must not be set by users on clusters,
@@ -172,10 +185,25 @@ type ClusterSpec struct {
 	ExposeStrategy ExposeStrategy `json:"exposeStrategy"`
 
 	// Optional: APIServerAllowedIPRanges is a list of IP ranges allowed to access the API server.
-	// Applicable only if the expose strategy of the cluster is LoadBalancer.
+	// Applicable only if the expose strategy of the cluster is LoadBalancer, or Tunneling with
+	// APIServerProxyProtocol enabled.
 	// If not configured, access to the API server is unrestricted.
 	APIServerAllowedIPRanges *NetworkRanges `json:"apiServerAllowedIPRanges,omitempty"`
 
+	// Optional: APIServerProxyProtocol configures the nodeport-proxy to pass the addresses of clients
+	// connecting to the API server and Konnectivity server via SNI using the PROXY protocol v2.
+	// The PROXY protocol is terminated by a sidecar in the API server pod, which enforces the
+	// APIServerAllowedIPRanges based on the original client addresses and connects to the API server
+	// using the client addresses as source, so that they show up in its audit logs. Only the
+	// nodeport-proxy of the Seed may connect to the PROXY protocol ports, which requires the CNI of
+	// the Seed to enforce NetworkPolicies. Connections tunneled from the user cluster nodes are
+	// checked against the APIServerAllowedIPRanges by the nodeport-proxy instead.
+	// Client addresses are only preserved if the nodeport-proxy LoadBalancer service of the Seed
+	// uses the Local external traffic policy, see
+	// spec.nodeportProxy.envoy.loadBalancerService.externalTrafficPolicy.
+	// Applicable only if the expose strategy of the cluster is Tunneling.
+	APIServerProxyProtocol bool `json:"apiServerProxyProtocol,omitempty"`
+
 	// Optional: Component specific overrides that allow customization of control plane components.
 	ComponentsOverride ComponentSettings `json:"componentsOverride,omitempty"`
 
@@ -197,6 +225,13 @@ type ClusterSpec struct {
 	// applying OS updates to nodes. This is only respected on Flatcar nodes currently.
 	UpdateWindow *UpdateWindow `json:"updateWindow,omitempty"`
 
+	// Optional: MaintenanceWindow restricts disruptive operations on the cluster control plane, like
+	// control plane version updates (including automatic updates), etcd rollouts and addon upgrades,
+	// to a recurring time window. Operations outside of the window are queued and listed in
+	// `status.pendingMaintenance`. If no UpdateWindow is configured, the maintenance window is also
+	// used for OS updates on Flatcar nodes. All times are in UTC.
+	MaintenanceWindow *UpdateWindow `json:"maintenanceWindow,omitempty"`
+
 	// Enables the admission plugin `PodSecurityPolicy`. This plugin is deprecated by Kubernetes.
 	UsePodSecurityPolicyAdmissionPlugin bool `json:"usePodSecurityPolicyAdmissionPlugin,omitempty"`
 	// Enables the admission plugin `PodNodeSelector`. Needs additional configuration via the `podNodeSelectorAdmissionPluginConfig` field.
@@ -421,6 +456,32 @@ type UpdateWindow struct {
 	Length string `json:"length,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=ControlPlaneUpdate;AutomaticUpdate;EtcdRollout;AddonUpgrade
+
+// MaintenanceOperation is a disruptive operation that is gated by a cluster's maintenance window.
+type MaintenanceOperation string
+
+const (
+	// MaintenanceOperationControlPlaneUpdate is a step of the control plane version update.
+	MaintenanceOperationControlPlaneUpdate MaintenanceOperation = "ControlPlaneUpdate"
+	// MaintenanceOperationAutomaticUpdate is an automatic update of the control plane or machine deployments.
+	MaintenanceOperationAutomaticUpdate MaintenanceOperation = "AutomaticUpdate"
+	// MaintenanceOperationEtcdRollout is a rolling restart of the etcd StatefulSet.
+	MaintenanceOperationEtcdRollout MaintenanceOperation = "EtcdRollout"
+	// MaintenanceOperationAddonUpgrade is the upgrade of addons after a KKP update.
+	MaintenanceOperationAddonUpgrade MaintenanceOperation = "AddonUpgrade"
+)
+
+// PendingMaintenance describes an operation that is waiting for the maintenance window to open.
+type PendingMaintenance struct {
+	// Message is a human readable description of the pending operation.
+	Message string `json:"message,omitempty"`
+	// Since is the time at which the operation was first deferred.
+	Since metav1.Time `json:"since,omitempty"`
+	// NextWindow is the time at which the next maintenance window opens.
+	NextWindow metav1.Time `json:"nextWindow,omitempty"`
+}
+
 // EncryptionConfiguration configures encryption-at-rest for Kubernetes API data.
 type EncryptionConfiguration struct {
 	// Enables encryption-at-rest on this cluster.
@@ -433,6 +494,11 @@ type EncryptionConfiguration struct {
 	// Configuration for the `secretbox` static key encryption scheme as supported by Kubernetes.
 	// More info: https://kubernetes.io/docs/tasks/administer-cluster/encrypt-data/#providers
 	Secretbox *SecretboxEncryptionConfiguration `json:"secretbox,omitempty"`
+	// Configuration for the KMS v2 envelope encryption scheme. Data is encrypted with keys that
+	// are in turn encrypted by an external key management service through a KMS plugin, so no
+	// key material needs to be stored in the seed cluster.
+	// More info: https://kubernetes.io/docs/tasks/administer-cluster/kms-provider/
+	KMS *KMSEncryptionConfiguration `json:"kms,omitempty"`
 }
 
 // SecretboxEncryptionConfiguration defines static key encryption based on the 'secretbox' solution for Kubernetes.
@@ -458,6 +524,54 @@ type SecretboxKey struct {
 	SecretRef *corev1.SecretKeySelector `json:"secretRef,omitempty"`
 }
 
+const (
+	// KMSPluginSocketDirectory is the directory shared between the kube-apiserver and the KMS plugin.
+	KMSPluginSocketDirectory = "/var/run/kmsplugin"
+	// DefaultKMSPluginEndpoint is the endpoint of the KMS plugin if none is configured.
+	DefaultKMSPluginEndpoint = "unix://" + KMSPluginSocketDirectory + "/socket.sock"
+)
+
+// KMSEncryptionConfiguration defines envelope encryption based on a KMS v2 plugin. The plugin
+// is deployed as a sidecar container next to the kube-apiserver and both containers share the
+// directory `/var/run/kmsplugin`, in which the plugin is expected to create its socket.
+type KMSEncryptionConfiguration struct {
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+
+	// Name of the KMS provider. The name is stored alongside all encrypted data and thus cannot
+	// be changed while encryption is enabled.
+	Name string `json:"name"`
+	// Endpoint is the gRPC address of the KMS plugin, which must be a unix socket within
+	// `/var/run/kmsplugin`. Defaults to `unix:///var/run/kmsplugin/socket.sock`.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Timeout for gRPC calls to the KMS plugin. Defaults to 3 seconds.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Plugin configures the KMS plugin sidecar container.
+	Plugin KMSPluginConfiguration `json:"plugin"`
+}
+
+// KMSPluginConfiguration configures the container running a KMS v2 plugin. The plugin runs in
+// the seed, so only images allowed by the Seed can be used and the plugin is started with the
+// default entrypoint of its image, which must listen on the configured endpoint.
+type KMSPluginConfiguration struct {
+	// Image is the container image of the KMS plugin. It must be listed in the
+	// `kmsPluginImages` of the Seed.
+	Image string `json:"image"`
+	// Env are additional environment variables for the plugin. Only plain values are
+	// supported; variables cannot reference Secrets or other sources in the seed.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Resources overrides the default resource requirements of the plugin container.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// GetEndpoint returns the configured KMS plugin endpoint or the default one.
+func (c *KMSEncryptionConfiguration) GetEndpoint() string {
+	if c.Endpoint == "" {
+		return DefaultKMSPluginEndpoint
+	}
+
+	return c.Endpoint
+}
+
 type BackupConfig struct {
 	BackupStorageLocation *corev1.LocalObjectReference `json:"backupStorageLocation,omitempty"`
 }
@@ -734,6 +848,124 @@ type ClusterStatus struct {
 
 	// ResourceUsage shows the current usage of resources for the cluster.
 	ResourceUsage *ResourceDetails `json:"resourceUsage,omitempty"`
+
+	// PendingMaintenance lists the disruptive operations that are waiting for the
+	// cluster's maintenance window to open.
+	// +optional
+	PendingMaintenance map[MaintenanceOperation]PendingMaintenance `json:"pendingMaintenance,omitempty"`
+
+	// Deletion contains diagnostic information about the cluster deletion, i.e. the progress of
+	// the individual deletion steps and the resources blocking them, or the result of a
+	// deletion dry-run.
+	// +optional
+	Deletion *ClusterDeletionStatus `json:"deletion,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Constraints;LoadBalancers;Volumes;EtcdBackupConfigs;Nodes;Namespace;CredentialsSecrets
+type ClusterDeletionStep string
+
+const (
+	ClusterDeletionStepConstraints        ClusterDeletionStep = "Constraints"
+	ClusterDeletionStepLoadBalancers      ClusterDeletionStep = "LoadBalancers"
+	ClusterDeletionStepVolumes            ClusterDeletionStep = "Volumes"
+	ClusterDeletionStepEtcdBackupConfigs  ClusterDeletionStep = "EtcdBackupConfigs"
+	ClusterDeletionStepNodes              ClusterDeletionStep = "Nodes"
+	ClusterDeletionStepNamespace          ClusterDeletionStep = "Namespace"
+	ClusterDeletionStepCredentialsSecrets ClusterDeletionStep = "CredentialsSecrets"
+)
+
+// AllClusterDeletionSteps contains all deletion steps, in the order in which they are executed.
+var AllClusterDeletionSteps = []ClusterDeletionStep{
+	ClusterDeletionStepConstraints,
+	ClusterDeletionStepLoadBalancers,
+	ClusterDeletionStepVolumes,
+	ClusterDeletionStepEtcdBackupConfigs,
+	ClusterDeletionStepNodes,
+	ClusterDeletionStepNamespace,
+	ClusterDeletionStepCredentialsSecrets,
+}
+
+// +kubebuilder:validation:Enum=Pending;InProgress;Blocked;Completed;Skipped
+type ClusterDeletionStepPhase string
+
+const (
+	ClusterDeletionStepPhasePending    ClusterDeletionStepPhase = "Pending"
+	ClusterDeletionStepPhaseInProgress ClusterDeletionStepPhase = "InProgress"
+	ClusterDeletionStepPhaseBlocked    ClusterDeletionStepPhase = "Blocked"
+	ClusterDeletionStepPhaseCompleted  ClusterDeletionStepPhase = "Completed"
+	ClusterDeletionStepPhaseSkipped    ClusterDeletionStepPhase = "Skipped"
+)
+
+// ClusterDeletionStatus contains diagnostic information about the cluster deletion.
+type ClusterDeletionStatus struct {
+	// Steps lists the progress of the individual deletion steps, in the order in
+	// which they are executed. It is only set once the cluster is being deleted.
+	// +optional
+	Steps []ClusterDeletionStepStatus `json:"steps,omitempty"`
+
+	// DryRun is the result of the last deletion dry-run, requested by setting the
+	// "kubermatic.k8c.io/deletion-dry-run" annotation on the cluster.
+	// +optional
+	DryRun *ClusterDeletionDryRun `json:"dryRun,omitempty"`
+}
+
+// ClusterDeletionStepStatus describes the progress of a single deletion step.
+type ClusterDeletionStepStatus struct {
+	// Name of the deletion step.
+	Name ClusterDeletionStep `json:"name"`
+	// Phase of the deletion step.
+	Phase ClusterDeletionStepPhase `json:"phase"`
+	// Message is a human-readable description of what the step is waiting for.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// BlockingResources lists (a subset of) the resources the step is waiting for.
+	// +optional
+	BlockingResources []ClusterDeletionResource `json:"blockingResources,omitempty"`
+	// LastTransitionTime is the time when the phase of the step last changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ClusterDeletionDryRun lists the resources that would be removed when deleting the cluster.
+type ClusterDeletionDryRun struct {
+	// Time is the time when the dry-run was performed.
+	Time metav1.Time `json:"time"`
+	// Steps lists the resources per deletion step. Steps that have nothing to remove are omitted.
+	// +optional
+	Steps []ClusterDeletionDryRunStep `json:"steps,omitempty"`
+}
+
+// ClusterDeletionDryRunStep lists the resources a single deletion step would remove.
+type ClusterDeletionDryRunStep struct {
+	// Name of the deletion step.
+	Name ClusterDeletionStep `json:"name"`
+	// ResourceCount is the total number of resources the step would remove.
+	ResourceCount int `json:"resourceCount"`
+	// Resources lists (a subset of) the resources the step would remove.
+	// +optional
+	Resources []ClusterDeletionResource `json:"resources,omitempty"`
+	// Message contains additional information, e.g. why the step could not be evaluated.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterDeletionResource references a resource in the seed or user cluster that is
+// affected by the cluster deletion.
+type ClusterDeletionResource struct {
+	// Kind of the resource, e.g. "PersistentVolume".
+	Kind string `json:"kind"`
+	// Namespace of the resource, empty for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the resource.
+	Name string `json:"name"`
+	// UserCluster is true if the resource lives in the user cluster, otherwise it lives in the seed cluster.
+	// +optional
+	UserCluster bool `json:"userCluster,omitempty"`
+	// Finalizers currently set on the resource. Finalizers of other controllers are a common
+	// reason for resources not disappearing.
+	// +optional
+	Finalizers []string `json:"finalizers,omitempty"`
 }
 
 // ClusterVersionsStatus contains information regarding the current and desired versions
@@ -795,6 +1027,25 @@ type ClusterEncryptionStatus struct {
 	// The `encryption_controller` logic will process the cluster based on the current phase and issue necessary changes
 	// to make sure encryption on the cluster is active and updated with what the ClusterSpec defines.
 	Phase ClusterEncryptionPhase `json:"phase"`
+
+	// KMS describes the state of the KMS plugin, if a KMS provider is configured.
+	// +optional
+	KMS *ClusterKMSStatus `json:"kms,omitempty"`
+}
+
+// ClusterKMSStatus holds status information about the KMS plugin used for encryption-at-rest.
+type ClusterKMSStatus struct {
+	// Name of the KMS provider.
+	Name string `json:"name"`
+	// Endpoint the kube-apiserver uses to connect to the KMS plugin.
+	Endpoint string `json:"endpoint"`
+	// PluginReady is true if the KMS plugin containers in all kube-apiserver pods are ready.
+	PluginReady bool `json:"pluginReady"`
+	// Configuration is the KMS provider configuration data has been encrypted with. It is kept
+	// after the provider has been removed from the ClusterSpec, as the KMS plugin is required to
+	// decrypt existing data until encryption has been removed.
+	// +optional
+	Configuration *KMSEncryptionConfiguration `json:"configuration,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Pending;Failed;Active;EncryptionNeeded
@@ -1932,6 +2183,12 @@ func (c *Cluster) IsEncryptionEnabled() bool {
 	return c.Spec.Features[ClusterFeatureEncryptionAtRest] && c.Spec.EncryptionConfiguration != nil && c.Spec.EncryptionConfiguration.Enabled
 }
 
+// IsAPIServerProxyProtocolEnabled returns whether client addresses are passed to the control plane
+// of this cluster using the PROXY protocol.
+func (c *Cluster) IsAPIServerProxyProtocolEnabled() bool {
+	return c.Spec.APIServerProxyProtocol && c.Spec.ExposeStrategy == ExposeStrategyTunneling
+}
+
 // IsEncryptionActive returns whether encryption-at-rest is active on this cluster. This can still be
 // the case when encryption configuration has been disabled, as encrypted resources require a decryption.
 func (c *Cluster) IsEncryptionActive() bool {
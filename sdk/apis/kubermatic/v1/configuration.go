@@ -42,7 +42,7 @@ const (
 // OperationType is the type defining the operations triggering the compatibility check (CREATE or UPDATE).
 type OperationType string
 
-// +kubebuilder:validation:Enum=Addon;Cluster;ClusterBackup;Project;None
+// +kubebuilder:validation:Enum=Addon;Cluster;ClusterBackup;IPAMPool;Project;None
 // MetricsCollector is the name of an available metrics collector.
 type MetricsCollector string
 
@@ -53,6 +53,8 @@ const (
 	ClusterBackupCollector MetricsCollector = "ClusterBackup"
 	// ClusterCollector is cluster metrics collector.
 	ClusterCollector MetricsCollector = "Cluster"
+	// IPAMPoolCollector is IPAM pool metrics collector.
+	IPAMPoolCollector MetricsCollector = "IPAMPool"
 	// ProjectCollector is project metrics collector.
 	ProjectCollector MetricsCollector = "Project"
 	// NoneCollector is a special name that points to no collector.
@@ -346,6 +346,10 @@ type SeedSpec struct {
 	// These settings apply to all user clusters in this seed.
 	// +optional
 	Kyverno *KyvernoConfigurations `json:"kyverno,omitempty"`
+	// Optional: KMSPluginImages are the container images that user clusters in this seed may use as
+	// KMS plugin for encryption-at-rest. As the plugins run next to the kube-apiserver in the seed,
+	// a KMS provider can only be configured for a cluster if its plugin image is listed here.
+	KMSPluginImages []string `json:"kmsPluginImages,omitempty"`
 }
 
 type KyvernoConfigurations struct {
@@ -383,6 +387,10 @@ type EtcdBackupRestore struct {
 	// BackupCount specifies the maximum number of backups to retain (defaults to DefaultKeptBackupsCount).
 	// Oldest backups are automatically deleted when this limit is exceeded. Only applies when Schedule is configured.
 	BackupCount *int `json:"backupCount,omitempty"`
+
+	// Retention is the tiered retention policy for the default etcd backup configs. If any of its
+	// tiers is set, it takes precedence over BackupCount.
+	Retention *EtcdBackupRetentionPolicy `json:"retention,omitempty"`
 }
 
 // BackupDestination defines the bucket name and endpoint as a backup destination, and holds reference to the credentials secret.
@@ -393,6 +401,16 @@ type BackupDestination struct {
 	BucketName string `json:"bucketName"`
 	// Credentials hold the ref to the secret with backup credentials
 	Credentials *corev1.SecretReference `json:"credentials,omitempty"`
+
+	// +kubebuilder:validation:Enum="";gzip;zstd
+
+	// Compression is the algorithm used to compress snapshots before they are uploaded to this
+	// destination. Defaults to gzip. Restores detect the compression automatically.
+	Compression string `json:"compression,omitempty"`
+	// EncryptionKey references a key in a Secret that holds a 32 byte AES key, either raw or base64-encoded.
+	// The Secret must be located in the same namespace as the Credentials secret. If set, snapshots are
+	// encrypted using AES-GCM before they are uploaded to this destination.
+	EncryptionKey *corev1.SecretKeySelector `json:"encryptionKey,omitempty"`
 }
 
 type NodeportProxyConfig struct {
@@ -426,6 +444,11 @@ type EnvoyLoadBalancerService struct {
 	// This field will be ignored if the cloud-provider does not support the feature.
 	// More info: https://kubernetes.io/docs/tasks/access-application-cluster/create-external-load-balancer/
 	SourceRanges []CIDR `json:"sourceRanges,omitempty"`
+	// ExternalTrafficPolicy of the LoadBalancer service. With the Kubernetes default (Cluster),
+	// the client addresses are replaced with node addresses before connections reach the
+	// nodeport-proxy, so it must be set to Local for clusters using APIServerProxyProtocol.
+	// +kubebuilder:validation:Enum="";Cluster;Local
+	ExternalTrafficPolicy corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy,omitempty"`
 }
 type NodePortProxyComponentEnvoy struct {
 	NodeportProxyComponent `json:",inline"`
@@ -439,6 +462,28 @@ type NodePortProxyComponentEnvoy struct {
 	// the nodeport-proxy Envoy listeners and upstream clusters.
 	// Zero values keep Envoy defaults (no KKP override).
 	ConnectionSettings NodePortProxyEnvoyConnectionSettings `json:"connectionSettings,omitempty"`
+	// ConnectionLimits configures the default connection and rate limits that
+	// are applied to every Service exposed by the nodeport-proxy. The limits can
+	// be overridden per Service using annotations.
+	// Zero values disable the corresponding limit.
+	ConnectionLimits NodePortProxyEnvoyConnectionLimits `json:"connectionLimits,omitempty"`
+}
+
+type NodePortProxyEnvoyConnectionLimits struct {
+	// MaxConnections is the maximum number of concurrent upstream connections
+	// per exposed Service port, i.e. per user cluster endpoint. Connections
+	// exceeding the limit are closed by Envoy.
+	// Set to 0 to disable the limit.
+	MaxConnections uint32 `json:"maxConnections,omitempty"`
+	// ConnectionRate is the number of new connections (or HTTP/2 CONNECT streams
+	// on the tunneling listener) per second that are accepted per exposed Service
+	// port. Connections exceeding the rate are closed by Envoy.
+	// Set to 0 to disable rate limiting.
+	ConnectionRate uint32 `json:"connectionRate,omitempty"`
+	// ConnectionBurst is the number of new connections that can be accepted at once
+	// before the ConnectionRate is enforced. Defaults to ConnectionRate. If set, value
+	// must be >= ConnectionRate.
+	ConnectionBurst uint32 `json:"connectionBurst,omitempty"`
 }
 
 type NodePortProxyEnvoyConnectionSettings struct {
@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -31,6 +33,8 @@ const (
 	DefaultKeptBackupsCount = 20
 	MaxKeptBackupsCount     = 50
 
+	DefaultBackupVerificationInterval = 24 * time.Hour
+
 	// BackupStatusPhase value indicating that the corresponding job has started.
 	BackupStatusPhaseRunning = "Running"
 
@@ -72,9 +76,80 @@ type EtcdBackupConfigSpec struct {
 	// Keep is the number of backups to keep around before deleting the oldest one
 	// If not set, defaults to DefaultKeptBackupsCount. Only used if Schedule is set.
 	Keep *int `json:"keep,omitempty"`
+	// Retention is a tiered retention policy for the backups. If any of its tiers is set,
+	// it replaces Keep. Only used if Schedule is set.
+	Retention *EtcdBackupRetentionPolicy `json:"retention,omitempty"`
 	// Destination indicates where the backup will be stored. The destination name must correspond to a destination in
 	// the cluster's Seed.Spec.EtcdBackupRestore.
 	Destination string `json:"destination"`
+	// ReplicationDestinations are the names of additional destinations in the cluster's
+	// Seed.Spec.EtcdBackupRestore to which every completed backup is copied asynchronously,
+	// for example an S3 bucket in another region. Replicas use the same object names as
+	// the primary backups, so clusters can be restored from them by specifying the replication
+	// destination in the EtcdRestore. Replicas are not deleted by KKP; use lifecycle rules on
+	// the replication buckets to expire them.
+	ReplicationDestinations []string `json:"replicationDestinations,omitempty"`
+	// Verification enables the periodic verification of completed backups. If set, the latest
+	// completed backup is regularly downloaded and restored into a temporary etcd to ensure
+	// that it can actually be used to restore the cluster.
+	Verification *EtcdBackupVerification `json:"verification,omitempty"`
+}
+
+// EtcdBackupRetentionPolicy defines a grandfather-father-son retention scheme for etcd backups.
+// For each tier, the latest backup of each of the last N hours, days, weeks or months that
+// have backups is kept. A backup is retained as long as at least one tier selects it.
+// Periods are calculated in UTC, weeks are ISO 8601 weeks. Regardless of the tiers, at most
+// 50 backups are kept in total; shorter tiers take precedence over longer ones.
+type EtcdBackupRetentionPolicy struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=50
+
+	// Hourly is the number of hourly backups to keep.
+	Hourly *int `json:"hourly,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=50
+
+	// Daily is the number of daily backups to keep.
+	Daily *int `json:"daily,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=50
+
+	// Weekly is the number of weekly backups to keep.
+	Weekly *int `json:"weekly,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=50
+
+	// Monthly is the number of monthly backups to keep.
+	Monthly *int `json:"monthly,omitempty"`
+
+	// MaxAge is the maximum age of a backup. Older backups are deleted, even if they would be
+	// retained otherwise. The latest completed backup is never deleted because of its age.
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// HasTiers returns true if at least one retention tier is configured.
+func (p *EtcdBackupRetentionPolicy) HasTiers() bool {
+	return p != nil && p.tieredBackupsCount() > 0
+}
+
+func (p *EtcdBackupRetentionPolicy) tieredBackupsCount() int {
+	count := 0
+	for _, tier := range []*int{p.Hourly, p.Daily, p.Weekly, p.Monthly} {
+		if tier != nil && *tier > 0 {
+			count += *tier
+		}
+	}
+	return count
+}
+
+// EtcdBackupVerification configures how backups are verified.
+type EtcdBackupVerification struct {
+	// Interval is the minimum duration between two backup verifications. If not set,
+	// defaults to DefaultBackupVerificationInterval (24h).
+	Interval *metav1.Duration `json:"interval,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -119,6 +194,35 @@ type BackupStatus struct {
 	DeleteFinishedTime metav1.Time       `json:"deleteFinishedTime,omitempty"`
 	DeletePhase        BackupStatusPhase `json:"deletePhase,omitempty"`
 	DeleteMessage      string            `json:"deleteMessage,omitempty"`
+	// Replications tracks the replication of this backup to the replication destinations.
+	Replications []BackupReplicationStatus `json:"replications,omitempty"`
+	// VerificationJobName is the name of the job verifying this backup. It is reset once
+	// the job has been cleaned up.
+	VerificationJobName string `json:"verificationJobName,omitempty"`
+	// VerificationPhase is the phase of the backup verification. It is empty if the
+	// backup has not been verified.
+	VerificationPhase BackupStatusPhase `json:"verificationPhase,omitempty"`
+	// VerificationMessage contains details about a failed verification.
+	VerificationMessage string `json:"verificationMessage,omitempty"`
+	// VerifiedTime is the time the verification of this backup finished, successfully or not.
+	// +optional
+	VerifiedTime metav1.Time `json:"verifiedTime,omitempty"`
+}
+
+// BackupReplicationStatus is the state of copying a backup to a replication destination.
+type BackupReplicationStatus struct {
+	// Destination is the name of the destination the backup is replicated to.
+	Destination string `json:"destination"`
+	// JobName is the name of the job replicating the backup. It is reset once the job
+	// has been cleaned up.
+	JobName string            `json:"jobName,omitempty"`
+	Phase   BackupStatusPhase `json:"phase,omitempty"`
+	Message string            `json:"message,omitempty"`
+	// +optional
+	FinishedTime metav1.Time `json:"finishedTime,omitempty"`
+	// Attempts is the number of times the replication was attempted. Failed replications
+	// are retried with an increasing delay, up to 5 attempts.
+	Attempts int `json:"attempts,omitempty"`
 }
 
 type EtcdBackupConfigCondition struct {
@@ -150,7 +254,13 @@ const (
 	EtcdBackupConfigConditionSchedulingActive EtcdBackupConfigConditionType = "SchedulingActive"
 )
 
+// GetKeptBackupsCount returns the maximum number of completed backups that are kept. If
+// a tiered retention policy is configured, this is the sum of all tiers. The result never
+// exceeds MaxKeptBackupsCount.
 func (bc *EtcdBackupConfig) GetKeptBackupsCount() int {
+	if bc.Spec.Retention.HasTiers() {
+		return min(bc.Spec.Retention.tieredBackupsCount(), MaxKeptBackupsCount)
+	}
 	if bc.Spec.Keep == nil {
 		return DefaultKeptBackupsCount
 	}
@@ -162,3 +272,10 @@ func (bc *EtcdBackupConfig) GetKeptBackupsCount() int {
 	}
 	return *bc.Spec.Keep
 }
+
+func (bc *EtcdBackupConfig) GetVerificationInterval() time.Duration {
+	if bc.Spec.Verification == nil || bc.Spec.Verification.Interval == nil || bc.Spec.Verification.Interval.Duration <= 0 {
+		return DefaultBackupVerificationInterval
+	}
+	return bc.Spec.Verification.Interval.Duration
+}
@@ -39,9 +39,13 @@ const (
 
 	// EtcdRestorePhaseEtcdLauncherNotEnabled value indicating that etcd-launcher is not enabled.
 	EtcdRestorePhaseEtcdLauncherNotEnabled EtcdRestorePhase = "EtcdLauncherNotEnabled"
+
+	// EtcdRestorePhaseBackupVerificationFailed value indicating that the backup did not pass the integrity
+	// check and the restore was aborted before the cluster was touched.
+	EtcdRestorePhaseBackupVerificationFailed EtcdRestorePhase = "BackupVerificationFailed"
 )
 
-// +kubebuilder:validation:Enum=Started;StsRebuilding;Completed;EtcdLauncherNotEnabled
+// +kubebuilder:validation:Enum=Started;StsRebuilding;Completed;EtcdLauncherNotEnabled;BackupVerificationFailed
 
 // EtcdRestorePhase represents the lifecycle phase of an EtcdRestore.
 type EtcdRestorePhase string
@@ -70,8 +74,19 @@ type EtcdRestoreSpec struct {
 	Name string `json:"name"`
 	// Cluster is the reference to the cluster whose etcd will be backed up
 	Cluster corev1.ObjectReference `json:"cluster"`
-	// BackupName is the name of the backup to restore from
-	BackupName string `json:"backupName"`
+	// BackupName is the name of the backup to restore from. If empty, BackupConfigName must be set
+	// and the controller will fill in the name of the selected backup.
+	BackupName string `json:"backupName,omitempty"`
+	// BackupConfigName is the name of an EtcdBackupConfig in the cluster namespace. If BackupName is empty,
+	// the latest completed backup of this EtcdBackupConfig is restored.
+	BackupConfigName string `json:"backupConfigName,omitempty"`
+	// RestoreBefore limits the backups considered when selecting a backup via BackupConfigName to those
+	// that finished before the given point in time.
+	// +optional
+	RestoreBefore *metav1.Time `json:"restoreBefore,omitempty"`
+	// VerifyBackup enables downloading the backup and checking its integrity before the etcd of
+	// the cluster is shut down. A backup that fails verification is never restored.
+	VerifyBackup bool `json:"verifyBackup,omitempty"`
 	// BackupDownloadCredentialsSecret is the name of a secret in the cluster-xxx namespace containing
 	// credentials needed to download the backup
 	BackupDownloadCredentialsSecret string `json:"backupDownloadCredentialsSecret,omitempty"`
@@ -96,4 +111,8 @@ type EtcdRestoreStatus struct {
 	Phase EtcdRestorePhase `json:"phase"`
 	// +optional
 	RestoreTime metav1.Time `json:"restoreTime,omitempty"`
+	// VerifiedTime is the time at which the backup passed the integrity check. Only set if
+	// Spec.VerifyBackup is enabled.
+	// +optional
+	VerifiedTime metav1.Time `json:"verifiedTime,omitempty"`
 }
@@ -54,6 +54,19 @@ type IPAMAllocationSpec struct {
 	// Addresses are the IP address ranges that are being used for the allocation.
 	// Set when "type=range".
 	Addresses []string `json:"addresses,omitempty"`
+	// IPv6 is the IPv6 part of the allocation. It is only set for dual-stack
+	// clusters and if the IPAMPool has an IPv6 pool configured.
+	IPv6 *IPAMAllocationFamily `json:"ipv6,omitempty"`
+}
+
+// IPAMAllocationFamily is the part of an allocation belonging to a single IP family.
+type IPAMAllocationFamily struct {
+	// CIDR is the CIDR that is being used for the allocation.
+	// Set when "type=prefix".
+	CIDR SubnetCIDR `json:"cidr,omitempty"`
+	// Addresses are the IP address ranges that are being used for the allocation.
+	// Set when "type=range".
+	Addresses []string `json:"addresses,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
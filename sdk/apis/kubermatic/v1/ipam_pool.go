@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,6 +32,8 @@ const (
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:object:generate=true
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.conditions.PoolExhausted.status",name="Exhausted",type="string"
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // IPAMPool is the object representing Multi-Cluster IP Address Management (IPAM)
@@ -41,6 +44,9 @@ type IPAMPool struct {
 
 	// Spec describes the Multi-Cluster IP Address Management (IPAM) configuration for KKP user clusters.
 	Spec IPAMPoolSpec `json:"spec,omitempty"`
+
+	// Status contains the utilization of the pool.
+	Status IPAMPoolStatus `json:"status,omitempty"`
 }
 
 // IPAMPoolSpec specifies the  Multi-Cluster IP Address Management (IPAM)
@@ -78,6 +84,110 @@ type IPAMPoolDatacenterSettings struct {
 	// Examples: "192.168.1.100-192.168.1.110", "192.168.1.255".
 	// Can be used when "type=range".
 	ExcludeRanges []string `json:"excludeRanges,omitempty"`
+
+	// Optional: IPv6 configures an additional IPv6 pool for dual-stack clusters.
+	// If set, PoolCIDR must be an IPv4 CIDR and allocations for dual-stack clusters
+	// contain addresses of both IP families. The allocation type applies to both families.
+	IPv6 *IPAMPoolFamilySettings `json:"ipv6,omitempty"`
+}
+
+// PrimaryFamily returns the settings of the primary IP family, which are
+// configured directly on the datacenter settings.
+func (s IPAMPoolDatacenterSettings) PrimaryFamily() IPAMPoolFamilySettings {
+	return IPAMPoolFamilySettings{
+		PoolCIDR:         s.PoolCIDR,
+		AllocationPrefix: s.AllocationPrefix,
+		ExcludePrefixes:  s.ExcludePrefixes,
+		AllocationRange:  s.AllocationRange,
+		ExcludeRanges:    s.ExcludeRanges,
+	}
+}
+
+// IPAMPoolFamilySettings contains the IPAM Pool configuration of a single IP family.
+type IPAMPoolFamilySettings struct {
+	// PoolCIDR is the pool CIDR to be used for the allocation.
+	PoolCIDR SubnetCIDR `json:"poolCidr"`
+
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=128
+	// AllocationPrefix is the prefix for the allocation.
+	// Used when "type=prefix".
+	AllocationPrefix int `json:"allocationPrefix,omitempty"`
+
+	// Optional: ExcludePrefixes is used to exclude particular subnets for the allocation.
+	// NOTE: must be the same length as allocationPrefix.
+	// Can be used when "type=prefix".
+	ExcludePrefixes []SubnetCIDR `json:"excludePrefixes,omitempty"`
+
+	// +kubebuilder:validation:Minimum:=1
+	// AllocationRange is the range for the allocation.
+	// Used when "type=range".
+	AllocationRange int `json:"allocationRange,omitempty"`
+
+	// Optional: ExcludeRanges is used to exclude particular IPs or IP ranges for the allocation.
+	// Examples: "2001:db8::100-2001:db8::110", "2001:db8::ff".
+	// Can be used when "type=range".
+	ExcludeRanges []string `json:"excludeRanges,omitempty"`
+}
+
+// IPAMPoolStatus contains the utilization of an IPAMPool.
+type IPAMPoolStatus struct {
+	// Datacenters contains the utilization of the pool per datacenter.
+	Datacenters map[string]IPAMPoolDatacenterStatus `json:"datacenters,omitempty"`
+
+	// Conditions contains conditions of the IPAMPool.
+	Conditions map[IPAMPoolConditionType]IPAMPoolCondition `json:"conditions,omitempty"`
+}
+
+// IPAMPoolDatacenterStatus contains the utilization of an IPAMPool in a datacenter.
+type IPAMPoolDatacenterStatus struct {
+	// IPAMPoolUtilization is the utilization of the primary IP family.
+	IPAMPoolUtilization `json:",inline"`
+
+	// IPv6 is the utilization of the IPv6 pool, if one is configured.
+	IPv6 *IPAMPoolUtilization `json:"ipv6,omitempty"`
+
+	// Clusters are the names of the clusters that have an allocation in this datacenter.
+	Clusters []string `json:"clusters,omitempty"`
+
+	// Exhausted is true if the pool cannot satisfy another allocation in this datacenter.
+	Exhausted bool `json:"exhausted,omitempty"`
+}
+
+// IPAMPoolUtilization contains the number of allocated and free IPs (for "type=range")
+// or subnets (for "type=prefix") of a single IP family.
+type IPAMPoolUtilization struct {
+	// Allocated is the number of IPs or subnets allocated to clusters.
+	Allocated int64 `json:"allocated"`
+	// Free is the number of IPs or subnets that are neither allocated nor excluded.
+	Free int64 `json:"free"`
+}
+
+// +kubebuilder:validation:Enum=PoolExhausted
+
+// IPAMPoolConditionType is used to indicate the type of an IPAMPool condition.
+type IPAMPoolConditionType string
+
+const (
+	// IPAMPoolConditionPoolExhausted indicates that the pool cannot satisfy another
+	// allocation in at least one of its datacenters.
+	IPAMPoolConditionPoolExhausted IPAMPoolConditionType = "PoolExhausted"
+)
+
+type IPAMPoolCondition struct {
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time we got an update on a given condition.
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime"`
+	// Last time the condition transit from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// (brief) reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:validation:Pattern="((^((([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5]))/([0-9]|[1-2][0-9]|3[0-2])$)|(^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:))/([0-9]|[0-9][0-9]|1[0-1][0-9]|12[0-8])$))"
@@ -17,6 +17,11 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
+	"k8c.io/machine-controller/sdk/providerconfig"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -36,6 +41,7 @@ type PresetList struct {
 // +kubebuilder:resource:scope=Cluster
 // +kubebuilder:object:generate=true
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
 
 // Presets are preconfigured cloud provider credentials that can be applied
 // to new clusters. This frees end users from having to know the actual
@@ -44,7 +50,59 @@ type Preset struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec PresetSpec `json:"spec"`
+	Spec   PresetSpec   `json:"spec"`
+	Status PresetStatus `json:"status,omitempty"`
+}
+
+// PresetStatus contains the current state of a preset on a seed cluster.
+type PresetStatus struct {
+	// Clusters lists the names of all clusters on the seed that were created from this preset.
+	Clusters []string `json:"clusters,omitempty"`
+
+	// ClustersOnOtherSeeds is the number of clusters that were created from this preset
+	// on all other seeds. It is maintained by the master and used to enforce MaxClusters
+	// across all seeds.
+	ClustersOnOtherSeeds int32 `json:"clustersOnOtherSeeds,omitempty"`
+
+	// Rotations contains the state of the credentials of all clusters created
+	// from this preset, keyed by cluster name. Whenever the credentials of the
+	// preset change, they are rotated in all these clusters.
+	Rotations map[string]PresetCredentialRotation `json:"rotations,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pending;Succeeded;Failed;Skipped
+
+type PresetCredentialRotationPhase string
+
+const (
+	// PresetCredentialRotationPending means the new credentials have been written
+	// to the credential Secret of the cluster, but have not yet been rolled out.
+	PresetCredentialRotationPending PresetCredentialRotationPhase = "Pending"
+	// PresetCredentialRotationSucceeded means the cluster uses the current credentials
+	// of the preset.
+	PresetCredentialRotationSucceeded PresetCredentialRotationPhase = "Succeeded"
+	// PresetCredentialRotationFailed means the current credentials of the preset
+	// could not be rolled out to the cluster, e.g. because they were rejected by
+	// the cloud provider.
+	PresetCredentialRotationFailed PresetCredentialRotationPhase = "Failed"
+	// PresetCredentialRotationSkipped means the credentials of the cluster were changed
+	// independently of the preset and are therefore not rotated.
+	PresetCredentialRotationSkipped PresetCredentialRotationPhase = "Skipped"
+)
+
+// PresetCredentialRotation is the credential rotation state of a single cluster.
+type PresetCredentialRotation struct {
+	Phase PresetCredentialRotationPhase `json:"phase"`
+	// CredentialsHash is a hash of the credentials that are being rotated to.
+	CredentialsHash string `json:"credentialsHash,omitempty"`
+	// AppliedCredentialsHash is a hash of the credentials of the preset that the
+	// cluster is known to use. Only clusters whose credentials still match them are
+	// rotated.
+	AppliedCredentialsHash string `json:"appliedCredentialsHash,omitempty"`
+	// Message explains the current phase, e.g. why the rotation failed.
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the time the phase last changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // Presets specifies default presets for supported providers.
@@ -95,6 +153,71 @@ type PresetSpec struct {
 
 	// Only enabled presets will be available in the KKP dashboard.
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// SecretStore references an external secret store that holds the credentials
+	// of this preset. If set, credentials are not stored inline in this Preset, but
+	// are resolved from the store whenever the credential Secret of a cluster created
+	// from this preset is reconciled. The secret in the store must contain the same
+	// keys as the cluster credential Secrets (e.g. `accessKeyId` and `secretAccessKey`
+	// for AWS).
+	SecretStore *PresetSecretStore `json:"secretStore,omitempty"`
+
+	// MaxClusters is the maximum number of clusters across all seeds that can be created
+	// from this preset. Once the limit is reached, new clusters are rejected; existing
+	// clusters are not affected. As the clusters on other seeds are counted by the master,
+	// clusters created on different seeds at the same time can briefly exceed the limit.
+	// +kubebuilder:validation:Minimum=0
+	MaxClusters *int32 `json:"maxClusters,omitempty"`
+
+	// ValidUntil is the time until which new clusters can be created from this preset.
+	// After it, new clusters are rejected and existing clusters are flagged with the
+	// `presetInvalidated: expired` annotation.
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+}
+
+// PresetSecretStore configures the external secret store of a preset. Exactly one
+// backend must be configured.
+type PresetSecretStore struct {
+	// Vault resolves the credentials from a HashiCorp Vault KV secrets engine.
+	Vault *VaultSecretStore `json:"vault,omitempty"`
+	// Kubernetes resolves the credentials from a Secret in the seed cluster. This
+	// is mostly useful for testing.
+	Kubernetes *KubernetesSecretStore `json:"kubernetes,omitempty"`
+
+	// RefreshInterval is the duration for which resolved credentials are cached.
+	// Rotated credentials are propagated to the clusters after at most this
+	// duration. Defaults to 5m.
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=v1;v2
+
+type VaultKVVersion string
+
+const (
+	VaultKVVersion1 VaultKVVersion = "v1"
+	VaultKVVersion2 VaultKVVersion = "v2"
+)
+
+type VaultSecretStore struct {
+	// Server is the URL of the Vault server, e.g. `https://vault.example.com:8200`.
+	Server string `json:"server"`
+	// Namespace is the Vault Enterprise namespace the secret is stored in.
+	Namespace string `json:"namespace,omitempty"`
+	// MountPath is the path the KV secrets engine is mounted at. Defaults to `secret`.
+	MountPath string `json:"mountPath,omitempty"`
+	// Version is the version of the KV secrets engine. Defaults to `v2`.
+	Version VaultKVVersion `json:"version,omitempty"`
+	// Path is the path of the secret within the secrets engine.
+	Path string `json:"path"`
+	// TokenReference references the key in a Secret that contains the Vault token
+	// used for authentication.
+	TokenReference providerconfig.GlobalSecretKeySelector `json:"tokenReference"`
+}
+
+type KubernetesSecretStore struct {
+	// SecretReference references the Secret holding the credentials.
+	SecretReference corev1.SecretReference `json:"secretReference"`
 }
 
 func (s PresetSpec) IsEnabled() bool {
@@ -109,6 +232,11 @@ func (s *PresetSpec) SetEnabled(enabled bool) {
 	s.Enabled = &enabled
 }
 
+// IsExpired returns true if the preset cannot be used for new clusters anymore at the given time.
+func (s PresetSpec) IsExpired(now time.Time) bool {
+	return s.ValidUntil != nil && !now.Before(s.ValidUntil.Time)
+}
+
 type ProviderPreset struct {
 	// Only enabled presets will be available in the KKP dashboard.
 	Enabled *bool `json:"enabled,omitempty"`
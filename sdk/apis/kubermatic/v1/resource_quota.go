@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -56,6 +57,50 @@ type ResourceQuotaSpec struct {
 	Subject Subject `json:"subject"`
 	// Quota specifies the current maximum allowed usage of resources.
 	Quota ResourceDetails `json:"quota"`
+	// WarningThresholds specifies soft limits for the resources as a percentage of the quota.
+	// Requests that make the usage cross a soft limit are admitted with a warning and the
+	// QuotaWarning condition is set.
+	WarningThresholds *ResourceThresholds `json:"warningThresholds,omitempty"`
+}
+
+// ResourceThresholds holds a percentage of the quota for every resource. Resources without
+// a threshold have no soft limit.
+type ResourceThresholds struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// CPU is the soft limit for CPU in percent of the quota.
+	CPU *int32 `json:"cpu,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Memory is the soft limit for memory in percent of the quota.
+	Memory *int32 `json:"memory,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Storage is the soft limit for storage in percent of the quota.
+	Storage *int32 `json:"storage,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// GPUs is the soft limit for GPUs in percent of the quota.
+	GPUs *int32 `json:"gpus,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Nodes is the soft limit for nodes in percent of the quota.
+	Nodes *int32 `json:"nodes,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// LoadBalancers is the soft limit for load balancers in percent of the quota.
+	LoadBalancers *int32 `json:"loadBalancers,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+
+	// Clusters is the soft limit for clusters in percent of the quota.
+	Clusters *int32 `json:"clusters,omitempty"`
 }
 
 // ResourceQuotaStatus describes the current state of a resource quota.
@@ -64,6 +109,35 @@ type ResourceQuotaStatus struct {
 	GlobalUsage ResourceDetails `json:"globalUsage,omitempty"`
 	// LocalUsage is holds the current usage of resources for the local seed.
 	LocalUsage ResourceDetails `json:"localUsage,omitempty"`
+	// Conditions contains conditions of the resource quota.
+	Conditions map[ResourceQuotaConditionType]ResourceQuotaCondition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=QuotaWarning
+
+// ResourceQuotaConditionType is used to indicate the type of a ResourceQuota condition.
+type ResourceQuotaConditionType string
+
+const (
+	// ResourceQuotaConditionQuotaWarning indicates that the global usage of at least one
+	// resource has crossed its soft limit or exceeds the quota.
+	ResourceQuotaConditionQuotaWarning ResourceQuotaConditionType = "QuotaWarning"
+)
+
+type ResourceQuotaCondition struct {
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time we got an update on a given condition.
+	LastHeartbeatTime metav1.Time `json:"lastHeartbeatTime"`
+	// Last time the condition transit from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// (brief) reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // Subject describes the entity to which the quota applies to.
@@ -78,7 +152,7 @@ type Subject struct {
 	Kind string `json:"kind"`
 }
 
-// ResourceDetails holds the CPU, Memory and Storage quantities.
+// ResourceDetails holds the CPU, Memory, Storage, GPU, node, load balancer and cluster quantities.
 type ResourceDetails struct {
 	// CPU holds the quantity of CPU. For the format, please check k8s.io/apimachinery/pkg/api/resource.Quantity.
 	CPU *resource.Quantity `json:"cpu,omitempty"`
@@ -86,10 +160,50 @@ type ResourceDetails struct {
 	Memory *resource.Quantity `json:"memory,omitempty"`
 	// Storage represents the disk size. For the format, please check k8s.io/apimachinery/pkg/api/resource.Quantity.
 	Storage *resource.Quantity `json:"storage,omitempty"`
+	// GPUs represents the number of GPUs attached to worker nodes.
+	GPUs *resource.Quantity `json:"gpus,omitempty"`
+	// Nodes represents the number of worker nodes.
+	Nodes *resource.Quantity `json:"nodes,omitempty"`
+	// LoadBalancers represents the number of Services of type LoadBalancer.
+	LoadBalancers *resource.Quantity `json:"loadBalancers,omitempty"`
+	// Clusters represents the number of user clusters. It is only tracked for project quotas.
+	Clusters *resource.Quantity `json:"clusters,omitempty"`
 }
 
 func (r ResourceDetails) IsEmpty() bool {
-	return (r.CPU == nil || r.CPU.IsZero()) && (r.Memory == nil || r.Memory.IsZero()) && (r.Storage == nil || r.Storage.IsZero())
+	for _, q := range []*resource.Quantity{r.CPU, r.Memory, r.Storage, r.GPUs, r.Nodes, r.LoadBalancers, r.Clusters} {
+		if q != nil && !q.IsZero() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Add adds all quantities that are set in other to r. Quantities that are not set in r yet
+// are initialized with the value from other.
+func (r *ResourceDetails) Add(other ResourceDetails) {
+	addQuantity(&r.CPU, other.CPU)
+	addQuantity(&r.Memory, other.Memory)
+	addQuantity(&r.Storage, other.Storage)
+	addQuantity(&r.GPUs, other.GPUs)
+	addQuantity(&r.Nodes, other.Nodes)
+	addQuantity(&r.LoadBalancers, other.LoadBalancers)
+	addQuantity(&r.Clusters, other.Clusters)
+}
+
+func addQuantity(dst **resource.Quantity, q *resource.Quantity) {
+	if q == nil {
+		return
+	}
+
+	if *dst == nil {
+		sum := q.DeepCopy()
+		*dst = &sum
+		return
+	}
+
+	(*dst).Add(*q)
 }
 
 // +kubebuilder:object:generate=true
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestResourceDetailsAdd(t *testing.T) {
+	initialCPU := resource.MustParse("2")
+	cpu := resource.MustParse("2")
+	gpus := resource.MustParse("1")
+
+	details := ResourceDetails{CPU: &initialCPU}
+	details.Add(ResourceDetails{CPU: &cpu, GPUs: &gpus})
+	details.Add(ResourceDetails{GPUs: &gpus})
+
+	if details.CPU.Cmp(resource.MustParse("4")) != 0 {
+		t.Errorf("Expected 4 CPUs, got %s.", details.CPU.String())
+	}
+	if details.GPUs.Cmp(resource.MustParse("2")) != 0 {
+		t.Errorf("Expected 2 GPUs, got %s.", details.GPUs.String())
+	}
+	if details.Memory != nil {
+		t.Errorf("Expected memory to remain unset, got %s.", details.Memory.String())
+	}
+
+	// quantities of the added details must not be modified
+	if cpu.Cmp(resource.MustParse("2")) != 0 {
+		t.Errorf("Expected added quantity to remain 2, got %s.", cpu.String())
+	}
+	if gpus.Cmp(resource.MustParse("1")) != 0 {
+		t.Errorf("Expected added quantity to remain 1, got %s.", gpus.String())
+	}
+}
@@ -157,7 +157,7 @@ type AnnotationSettings struct {
 	// +optional
 	HiddenAnnotations []string `json:"hiddenAnnotations,omitempty"`
 
-	// +kubebuilder:default:={"presetName"}
+	// +kubebuilder:default:={"presetName", "kubermatic.k8c.io/skip-deletion-steps"}
 
 	// ProtectedAnnotations are the annotations that are visible in the UI but cannot be added or modified by the user.
 	// +optional
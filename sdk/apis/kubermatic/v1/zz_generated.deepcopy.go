@@ -1100,6 +1100,11 @@ func (in *BackupDestination) DeepCopyInto(out *BackupDestination) {
 		*out = new(corev1.SecretReference)
 		**out = **in
 	}
+	if in.EncryptionKey != nil {
+		in, out := &in.EncryptionKey, &out.EncryptionKey
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupDestination.
@@ -1112,6 +1117,22 @@ func (in *BackupDestination) DeepCopy() *BackupDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupReplicationStatus) DeepCopyInto(out *BackupReplicationStatus) {
+	*out = *in
+	in.FinishedTime.DeepCopyInto(&out.FinishedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupReplicationStatus.
+func (in *BackupReplicationStatus) DeepCopy() *BackupReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	*out = *in
@@ -1120,6 +1141,14 @@ func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	in.BackupFinishedTime.DeepCopyInto(&out.BackupFinishedTime)
 	in.DeleteStartTime.DeepCopyInto(&out.DeleteStartTime)
 	in.DeleteFinishedTime.DeepCopyInto(&out.DeleteFinishedTime)
+	if in.Replications != nil {
+		in, out := &in.Replications, &out.Replications
+		*out = make([]BackupReplicationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.VerifiedTime.DeepCopyInto(&out.VerifiedTime)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
@@ -1549,6 +1578,121 @@ func (in *ClusterCondition) DeepCopy() *ClusterCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDeletionDryRun) DeepCopyInto(out *ClusterDeletionDryRun) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ClusterDeletionDryRunStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDeletionDryRun.
+func (in *ClusterDeletionDryRun) DeepCopy() *ClusterDeletionDryRun {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDeletionDryRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDeletionDryRunStep) DeepCopyInto(out *ClusterDeletionDryRunStep) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ClusterDeletionResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDeletionDryRunStep.
+func (in *ClusterDeletionDryRunStep) DeepCopy() *ClusterDeletionDryRunStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDeletionDryRunStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDeletionResource) DeepCopyInto(out *ClusterDeletionResource) {
+	*out = *in
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDeletionResource.
+func (in *ClusterDeletionResource) DeepCopy() *ClusterDeletionResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDeletionResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDeletionStatus) DeepCopyInto(out *ClusterDeletionStatus) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ClusterDeletionStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(ClusterDeletionDryRun)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDeletionStatus.
+func (in *ClusterDeletionStatus) DeepCopy() *ClusterDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDeletionStepStatus) DeepCopyInto(out *ClusterDeletionStepStatus) {
+	*out = *in
+	if in.BlockingResources != nil {
+		in, out := &in.BlockingResources, &out.BlockingResources
+		*out = make([]ClusterDeletionResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDeletionStepStatus.
+func (in *ClusterDeletionStepStatus) DeepCopy() *ClusterDeletionStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDeletionStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterEncryptionStatus) DeepCopyInto(out *ClusterEncryptionStatus) {
 	*out = *in
@@ -1557,6 +1701,11 @@ func (in *ClusterEncryptionStatus) DeepCopyInto(out *ClusterEncryptionStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(ClusterKMSStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEncryptionStatus.
@@ -1569,6 +1718,26 @@ func (in *ClusterEncryptionStatus) DeepCopy() *ClusterEncryptionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterKMSStatus) DeepCopyInto(out *ClusterKMSStatus) {
+	*out = *in
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(KMSEncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterKMSStatus.
+func (in *ClusterKMSStatus) DeepCopy() *ClusterKMSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterKMSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	*out = *in
@@ -1695,6 +1864,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(UpdateWindow)
 		**out = **in
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(UpdateWindow)
+		**out = **in
+	}
 	if in.AdmissionPlugins != nil {
 		in, out := &in.AdmissionPlugins, &out.AdmissionPlugins
 		*out = make([]string, len(*in))
@@ -1836,6 +2010,18 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = new(ResourceDetails)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PendingMaintenance != nil {
+		in, out := &in.PendingMaintenance, &out.PendingMaintenance
+		*out = make(map[MaintenanceOperation]PendingMaintenance, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Deletion != nil {
+		in, out := &in.Deletion, &out.Deletion
+		*out = new(ClusterDeletionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -3256,6 +3442,11 @@ func (in *EncryptionConfiguration) DeepCopyInto(out *EncryptionConfiguration) {
 		*out = new(SecretboxEncryptionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSEncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfiguration.
@@ -3380,6 +3571,21 @@ func (in *EtcdBackupConfigSpec) DeepCopyInto(out *EtcdBackupConfigSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(EtcdBackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationDestinations != nil {
+		in, out := &in.ReplicationDestinations, &out.ReplicationDestinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(EtcdBackupVerification)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupConfigSpec.
@@ -3446,6 +3652,11 @@ func (in *EtcdBackupRestore) DeepCopyInto(out *EtcdBackupRestore) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(EtcdBackupRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupRestore.
@@ -3458,12 +3669,72 @@ func (in *EtcdBackupRestore) DeepCopy() *EtcdBackupRestore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupRetentionPolicy) DeepCopyInto(out *EtcdBackupRetentionPolicy) {
+	*out = *in
+	if in.Hourly != nil {
+		in, out := &in.Hourly, &out.Hourly
+		*out = new(int)
+		**out = **in
+	}
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
+		*out = new(int)
+		**out = **in
+	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
+		*out = new(int)
+		**out = **in
+	}
+	if in.Monthly != nil {
+		in, out := &in.Monthly, &out.Monthly
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupRetentionPolicy.
+func (in *EtcdBackupRetentionPolicy) DeepCopy() *EtcdBackupRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupVerification) DeepCopyInto(out *EtcdBackupVerification) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupVerification.
+func (in *EtcdBackupVerification) DeepCopy() *EtcdBackupVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdRestore) DeepCopyInto(out *EtcdRestore) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -3521,6 +3792,10 @@ func (in *EtcdRestoreList) DeepCopyObject() runtime.Object {
 func (in *EtcdRestoreSpec) DeepCopyInto(out *EtcdRestoreSpec) {
 	*out = *in
 	out.Cluster = in.Cluster
+	if in.RestoreBefore != nil {
+		in, out := &in.RestoreBefore, &out.RestoreBefore
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdRestoreSpec.
@@ -3537,6 +3812,7 @@ func (in *EtcdRestoreSpec) DeepCopy() *EtcdRestoreSpec {
 func (in *EtcdRestoreStatus) DeepCopyInto(out *EtcdRestoreStatus) {
 	*out = *in
 	in.RestoreTime.DeepCopyInto(&out.RestoreTime)
+	in.VerifiedTime.DeepCopyInto(&out.VerifiedTime)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdRestoreStatus.
@@ -4341,6 +4617,26 @@ func (in *IPAMAllocation) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMAllocationFamily) DeepCopyInto(out *IPAMAllocationFamily) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMAllocationFamily.
+func (in *IPAMAllocationFamily) DeepCopy() *IPAMAllocationFamily {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMAllocationFamily)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMAllocationList) DeepCopyInto(out *IPAMAllocationList) {
 	*out = *in
@@ -4381,6 +4677,11 @@ func (in *IPAMAllocationSpec) DeepCopyInto(out *IPAMAllocationSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPAMAllocationFamily)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMAllocationSpec.
@@ -4399,6 +4700,7 @@ func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
@@ -4419,6 +4721,23 @@ func (in *IPAMPool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolCondition) DeepCopyInto(out *IPAMPoolCondition) {
+	*out = *in
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolCondition.
+func (in *IPAMPoolCondition) DeepCopy() *IPAMPoolCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPoolDatacenterSettings) DeepCopyInto(out *IPAMPoolDatacenterSettings) {
 	*out = *in
@@ -4432,6 +4751,11 @@ func (in *IPAMPoolDatacenterSettings) DeepCopyInto(out *IPAMPoolDatacenterSettin
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPAMPoolFamilySettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolDatacenterSettings.
@@ -4444,6 +4768,57 @@ func (in *IPAMPoolDatacenterSettings) DeepCopy() *IPAMPoolDatacenterSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolDatacenterStatus) DeepCopyInto(out *IPAMPoolDatacenterStatus) {
+	*out = *in
+	out.IPAMPoolUtilization = in.IPAMPoolUtilization
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPAMPoolUtilization)
+		**out = **in
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolDatacenterStatus.
+func (in *IPAMPoolDatacenterStatus) DeepCopy() *IPAMPoolDatacenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolDatacenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolFamilySettings) DeepCopyInto(out *IPAMPoolFamilySettings) {
+	*out = *in
+	if in.ExcludePrefixes != nil {
+		in, out := &in.ExcludePrefixes, &out.ExcludePrefixes
+		*out = make([]SubnetCIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeRanges != nil {
+		in, out := &in.ExcludeRanges, &out.ExcludeRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolFamilySettings.
+func (in *IPAMPoolFamilySettings) DeepCopy() *IPAMPoolFamilySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolFamilySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPoolList) DeepCopyInto(out *IPAMPoolList) {
 	*out = *in
@@ -4498,6 +4873,50 @@ func (in *IPAMPoolSpec) DeepCopy() *IPAMPoolSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolStatus) DeepCopyInto(out *IPAMPoolStatus) {
+	*out = *in
+	if in.Datacenters != nil {
+		in, out := &in.Datacenters, &out.Datacenters
+		*out = make(map[string]IPAMPoolDatacenterStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(map[IPAMPoolConditionType]IPAMPoolCondition, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolStatus.
+func (in *IPAMPoolStatus) DeepCopy() *IPAMPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolUtilization) DeepCopyInto(out *IPAMPoolUtilization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolUtilization.
+func (in *IPAMPoolUtilization) DeepCopy() *IPAMPoolUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPVSConfiguration) DeepCopyInto(out *IPVSConfiguration) {
 	*out = *in
@@ -4586,6 +5005,54 @@ func (in *Incompatibility) DeepCopy() *Incompatibility {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionConfiguration) DeepCopyInto(out *KMSEncryptionConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	in.Plugin.DeepCopyInto(&out.Plugin)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionConfiguration.
+func (in *KMSEncryptionConfiguration) DeepCopy() *KMSEncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSPluginConfiguration) DeepCopyInto(out *KMSPluginConfiguration) {
+	*out = *in
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSPluginConfiguration.
+func (in *KMSPluginConfiguration) DeepCopy() *KMSPluginConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSPluginConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kind) DeepCopyInto(out *Kind) {
 	*out = *in
@@ -5516,6 +5983,22 @@ func (in *KubernetesDashboard) DeepCopy() *KubernetesDashboard {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesSecretStore) DeepCopyInto(out *KubernetesSecretStore) {
+	*out = *in
+	out.SecretReference = in.SecretReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSecretStore.
+func (in *KubernetesSecretStore) DeepCopy() *KubernetesSecretStore {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesSecretStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubevirt) DeepCopyInto(out *Kubevirt) {
 	*out = *in
@@ -6133,6 +6616,7 @@ func (in *NodePortProxyComponentEnvoy) DeepCopyInto(out *NodePortProxyComponentE
 	}
 	in.LoadBalancerService.DeepCopyInto(&out.LoadBalancerService)
 	out.ConnectionSettings = in.ConnectionSettings
+	out.ConnectionLimits = in.ConnectionLimits
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortProxyComponentEnvoy.
@@ -6145,6 +6629,21 @@ func (in *NodePortProxyComponentEnvoy) DeepCopy() *NodePortProxyComponentEnvoy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePortProxyEnvoyConnectionLimits) DeepCopyInto(out *NodePortProxyEnvoyConnectionLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePortProxyEnvoyConnectionLimits.
+func (in *NodePortProxyEnvoyConnectionLimits) DeepCopy() *NodePortProxyEnvoyConnectionLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePortProxyEnvoyConnectionLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePortProxyEnvoyConnectionSettings) DeepCopyInto(out *NodePortProxyEnvoyConnectionSettings) {
 	*out = *in
@@ -6636,6 +7135,23 @@ func (in Parameters) DeepCopy() Parameters {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingMaintenance) DeepCopyInto(out *PendingMaintenance) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	in.NextWindow.DeepCopyInto(&out.NextWindow)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingMaintenance.
+func (in *PendingMaintenance) DeepCopy() *PendingMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSchedulingConfigurations) DeepCopyInto(out *PodSchedulingConfigurations) {
 	*out = *in
@@ -6921,6 +7437,7 @@ func (in *Preset) DeepCopyInto(out *Preset) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Preset.
@@ -6941,6 +7458,22 @@ func (in *Preset) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PresetCredentialRotation) DeepCopyInto(out *PresetCredentialRotation) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PresetCredentialRotation.
+func (in *PresetCredentialRotation) DeepCopy() *PresetCredentialRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(PresetCredentialRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PresetList) DeepCopyInto(out *PresetList) {
 	*out = *in
@@ -6973,6 +7506,36 @@ func (in *PresetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PresetSecretStore) DeepCopyInto(out *PresetSecretStore) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSecretStore)
+		**out = **in
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesSecretStore)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PresetSecretStore.
+func (in *PresetSecretStore) DeepCopy() *PresetSecretStore {
+	if in == nil {
+		return nil
+	}
+	out := new(PresetSecretStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PresetSpec) DeepCopyInto(out *PresetSpec) {
 	*out = *in
@@ -7076,6 +7639,20 @@ func (in *PresetSpec) DeepCopyInto(out *PresetSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SecretStore != nil {
+		in, out := &in.SecretStore, &out.SecretStore
+		*out = new(PresetSecretStore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxClusters != nil {
+		in, out := &in.MaxClusters, &out.MaxClusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PresetSpec.
@@ -7088,6 +7665,33 @@ func (in *PresetSpec) DeepCopy() *PresetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PresetStatus) DeepCopyInto(out *PresetStatus) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rotations != nil {
+		in, out := &in.Rotations, &out.Rotations
+		*out = make(map[string]PresetCredentialRotation, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PresetStatus.
+func (in *PresetStatus) DeepCopy() *PresetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PresetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -7376,6 +7980,26 @@ func (in *ResourceDetails) DeepCopyInto(out *ResourceDetails) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.GPUs != nil {
+		in, out := &in.GPUs, &out.GPUs
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDetails.
@@ -7415,6 +8039,23 @@ func (in *ResourceQuota) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaCondition) DeepCopyInto(out *ResourceQuotaCondition) {
+	*out = *in
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaCondition.
+func (in *ResourceQuotaCondition) DeepCopy() *ResourceQuotaCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuotaList) DeepCopyInto(out *ResourceQuotaList) {
 	*out = *in
@@ -7452,6 +8093,11 @@ func (in *ResourceQuotaSpec) DeepCopyInto(out *ResourceQuotaSpec) {
 	*out = *in
 	out.Subject = in.Subject
 	in.Quota.DeepCopyInto(&out.Quota)
+	if in.WarningThresholds != nil {
+		in, out := &in.WarningThresholds, &out.WarningThresholds
+		*out = new(ResourceThresholds)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaSpec.
@@ -7469,6 +8115,13 @@ func (in *ResourceQuotaStatus) DeepCopyInto(out *ResourceQuotaStatus) {
 	*out = *in
 	in.GlobalUsage.DeepCopyInto(&out.GlobalUsage)
 	in.LocalUsage.DeepCopyInto(&out.LocalUsage)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(map[ResourceQuotaConditionType]ResourceQuotaCondition, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaStatus.
@@ -7481,6 +8134,56 @@ func (in *ResourceQuotaStatus) DeepCopy() *ResourceQuotaStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceThresholds) DeepCopyInto(out *ResourceThresholds) {
+	*out = *in
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GPUs != nil {
+		in, out := &in.GPUs, &out.GPUs
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceThresholds.
+func (in *ResourceThresholds) DeepCopy() *ResourceThresholds {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceThresholds)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleGroup) DeepCopyInto(out *RuleGroup) {
 	*out = *in
@@ -7786,6 +8489,11 @@ func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 		*out = new(KyvernoConfigurations)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMSPluginImages != nil {
+		in, out := &in.KMSPluginImages, &out.KMSPluginImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSpec.
@@ -8641,6 +9349,22 @@ func (in *VSphereTag) DeepCopy() *VSphereTag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretStore) DeepCopyInto(out *VaultSecretStore) {
+	*out = *in
+	out.TokenReference = in.TokenReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretStore.
+func (in *VaultSecretStore) DeepCopy() *VaultSecretStore {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebTerminalOptions) DeepCopyInto(out *WebTerminalOptions) {
 	*out = *in